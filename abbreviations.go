@@ -0,0 +1,275 @@
+package uniseg
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Abbreviations is a list of abbreviations such as "Mr." or "e.g." after which
+// a sentence must not end, like the sentence break suppressions ("ULI
+// exceptions") of ICU. [UAX #29] ends a sentence after a full stop followed by
+// a space and an uppercase letter, so "Mr. Smith arrived." is split after
+// "Mr.". Assigned to [Parser.Abbreviations], the sentence boundary after such
+// an abbreviation and any following spaces is suppressed.
+//
+// An abbreviation only matches if it starts at the beginning of the text or
+// after a character which is neither a letter, a digit, a mark, nor a full
+// stop. Han, Hiragana, and Katakana characters, which are not separated from
+// Latin abbreviations by spaces, don't count as letters here. Matching is
+// case-sensitive. Boundaries after line or paragraph
+// separators are never suppressed.
+//
+// Like in ICU, the suppression does not look at the text which follows the
+// abbreviation. Abbreviations which are also common words at the end of
+// sentences, such as "etc.", therefore suppress some correct boundaries.
+//
+// [UAX #29]: https://www.unicode.org/reports/tr29/tr29-45.html#Sentence_Boundaries
+type Abbreviations struct {
+	// The words, sorted and without duplicates.
+	words []string
+
+	// The edges of the trie of the words. Node 0 is the root.
+	edges map[abbreviationEdge]int
+
+	// Whether a sentence boundary is suppressed at a node, i.e. whether a word
+	// followed by zero or more spaces ends there, indexed by node.
+	suppress []bool
+}
+
+// abbreviationEdge is an edge of the trie of an [Abbreviations] list.
+type abbreviationEdge struct {
+	node int
+	r    rune
+}
+
+// The states of the matcher of an [Abbreviations] list which are not trie
+// nodes. These are stored in the [Step] state, so together with the trie nodes
+// they must fit in maskAbbreviationState.
+const (
+	abbreviationStart   = 0                         // An abbreviation may start with the next character.
+	abbreviationMatched = maskAbbreviationState - 1 // An abbreviation and spaces were matched.
+	abbreviationNone    = maskAbbreviationState     // Inside a word which is not an abbreviation.
+)
+
+// NewAbbreviations returns a new list of the given abbreviations. Empty
+// strings and invalid UTF-8 are ignored. The list holds up to about 1,000
+// characters in total (characters shared by the beginnings of several words
+// count only once), and an error is returned if the words don't fit.
+func NewAbbreviations(words []string) (*Abbreviations, error) {
+	a := &Abbreviations{
+		edges:    make(map[abbreviationEdge]int),
+		suppress: []bool{false},
+	}
+	for _, word := range words {
+		if word != "" && utf8.ValidString(word) {
+			a.words = append(a.words, word)
+		}
+	}
+	var wl wordList
+	wl.words = a.words
+	wl.init()
+	a.words = wl.words
+	for _, word := range a.words {
+		if !a.add(word) {
+			return nil, fmt.Errorf("uniseg: too many abbreviations, no room for %q", word)
+		}
+	}
+	return a, nil
+}
+
+// add adds the given word to the trie. It returns false if there was no room
+// for the word. Words must be added in sorted order so that a word is added
+// before the longer words it is a prefix of.
+func (a *Abbreviations) add(word string) bool {
+	var missing int
+	node := 0
+	for i, r := range word {
+		child, ok := a.edges[abbreviationEdge{node, r}]
+		if !ok {
+			missing = utf8.RuneCountInString(word[i:])
+			break
+		}
+		node = child
+	}
+	if len(a.suppress)+missing > abbreviationMatched {
+		return false
+	}
+	node = 0
+	for _, r := range word {
+		edge := abbreviationEdge{node, r}
+		child, ok := a.edges[edge]
+		if !ok {
+			child = len(a.suppress)
+			a.edges[edge] = child
+			a.suppress = append(a.suppress, a.suppress[node] && isAbbreviationSpace(r))
+		}
+		node = child
+	}
+	a.suppress[node] = true
+	return true
+}
+
+// Len returns the number of abbreviations in the list.
+func (a *Abbreviations) Len() int {
+	return len(a.words)
+}
+
+// Words returns a sorted copy of the abbreviations in the list. It may be used
+// to extend a list:
+//
+//	words := append(uniseg.LocaleAbbreviations("en").Words(), "Approx.")
+//	abbreviations, err := uniseg.NewAbbreviations(words)
+//	if err != nil {
+//		// Handle the error.
+//	}
+//	p := &uniseg.Parser{Abbreviations: abbreviations}
+func (a *Abbreviations) Words() []string {
+	return append([]string(nil), a.words...)
+}
+
+// transition returns the state of the matcher after the given rune.
+func (a *Abbreviations) transition(state int, r rune) int {
+	node := state
+	if state == abbreviationStart || state == abbreviationMatched {
+		node = 0
+	}
+	if state != abbreviationNone {
+		if child, ok := a.edges[abbreviationEdge{node, r}]; ok {
+			return child
+		}
+	}
+	switch {
+	case a.suppresses(state) && isAbbreviationSpace(r):
+		return abbreviationMatched
+	case r == '.' || unicode.IsLetter(r) && !isIdeographicOrKana(r) || unicode.IsDigit(r) || unicode.IsMark(r):
+		return abbreviationNone
+	}
+	return abbreviationStart
+}
+
+// suppresses returns true if a sentence boundary must not occur in the given
+// state of the matcher.
+func (a *Abbreviations) suppresses(state int) bool {
+	switch state {
+	case abbreviationMatched:
+		return true
+	case abbreviationStart, abbreviationNone:
+		return false
+	}
+	return a.suppress[state]
+}
+
+// isIdeographicOrKana returns true if the given rune is a Han, Hiragana, or
+// Katakana character. Abbreviations may directly follow these in Chinese and
+// Japanese text.
+func isIdeographicOrKana(r rune) bool {
+	switch scripts.search(r) {
+	case ScriptHan, ScriptHiragana, ScriptKatakana:
+		return true
+	}
+	return false
+}
+
+// isAbbreviationSpace returns true if the given rune may follow an
+// abbreviation without ending its suppression.
+func isAbbreviationSpace(r rune) bool {
+	return r == '\t' || unicode.Is(unicode.Zs, r)
+}
+
+// abbreviationState returns the state of the matcher of the parser's
+// abbreviations after the given rune.
+func (p *Parser) abbreviationState(state int, r rune) int {
+	if p == nil || p.Abbreviations == nil {
+		return abbreviationStart
+	}
+	return p.Abbreviations.transition(state, r)
+}
+
+// suppressesSentenceBoundary returns true if the parser's abbreviations
+// suppress a sentence boundary in the given state of their matcher.
+func (p *Parser) suppressesSentenceBoundary(state int) bool {
+	return p != nil && p.Abbreviations != nil && p.Abbreviations.suppresses(state)
+}
+
+// LocaleAbbreviations returns the built-in list of abbreviations for the given
+// locale, such as "en" or "pt-BR", or nil if there is none. Lists are included
+// for English (en), German (de), French (fr), Spanish (es), Italian (it),
+// Japanese (ja), and Portuguese (pt). Regional variants use the list of their
+// language. The returned list is shared and must not be modified.
+func LocaleAbbreviations(locale string) *Abbreviations {
+	language, _, _ := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+	language = strings.ToLower(language)
+	if _, ok := localeAbbreviationWords[language]; !ok {
+		return nil
+	}
+	return localeAbbreviations()[language]
+}
+
+// localeAbbreviations returns the built-in lists, building them on first use.
+var localeAbbreviations = sync.OnceValue(func() map[string]*Abbreviations {
+	lists := make(map[string]*Abbreviations, len(localeAbbreviationWords))
+	for language, words := range localeAbbreviationWords {
+		a, err := NewAbbreviations(words)
+		if err != nil {
+			panic(err) // The built-in lists are small enough.
+		}
+		lists[language] = a
+	}
+	return lists
+})
+
+// localeAbbreviationWords are the built-in abbreviations, keyed by language.
+var localeAbbreviationWords = map[string][]string{
+	"en": {
+		"Mr.", "Mrs.", "Ms.", "Mx.", "Messrs.", "Dr.", "Prof.", "Sr.", "Jr.", "St.",
+		"Mt.", "Ft.", "Rev.", "Hon.", "Gen.", "Col.", "Lt.", "Capt.", "Cmdr.", "Sgt.",
+		"Gov.", "Sen.", "Rep.", "Pres.", "Supt.", "Esq.",
+		"Inc.", "Ltd.", "Co.", "Corp.", "Bros.", "Assn.", "Dept.", "Univ.",
+		"Ave.", "Blvd.", "Rd.",
+		"No.", "Nos.", "Vol.", "Vols.", "Ch.", "Fig.", "Figs.", "Sec.", "Eq.", "Ref.", "p.", "pp.",
+		"Jan.", "Feb.", "Mar.", "Apr.", "Jun.", "Jul.", "Aug.", "Sep.", "Sept.", "Oct.", "Nov.", "Dec.",
+		"e.g.", "i.e.", "cf.", "vs.", "viz.", "approx.", "est.", "al.", "etc.",
+		"a.m.", "p.m.", "U.S.", "U.K.", "U.N.", "Ph.D.", "M.D.", "B.A.", "M.A.",
+	},
+	"de": {
+		"Hr.", "Hrn.", "Fr.", "Frl.", "Dr.", "Prof.", "Dipl.", "Ing.", "St.",
+		"Nr.", "Str.", "Tel.", "Abb.", "Abs.", "Art.", "Bd.", "Kap.", "S.", "Jh.", "Jhd.",
+		"Mio.", "Mrd.", "geb.", "gest.",
+		"Jan.", "Feb.", "Apr.", "Aug.", "Sept.", "Okt.", "Nov.", "Dez.",
+		"bzw.", "ca.", "d.h.", "evtl.", "ggf.", "inkl.", "exkl.", "bzgl.", "usw.", "vgl.",
+		"z.B.", "z.T.", "u.a.", "u.U.", "o.ä.", "s.o.", "s.u.", "etc.",
+	},
+	"fr": {
+		"M.", "MM.", "Mme.", "Mmes.", "Mlle.", "Mlles.", "Dr.", "Pr.", "Me.", "St.", "Ste.",
+		"av.", "bd.", "chap.", "éd.", "fig.", "vol.", "p.", "cf.", "env.", "etc.",
+		"p. ex.", "c.-à-d.", "J.-C.",
+		"janv.", "févr.", "avr.", "juil.", "sept.", "oct.", "nov.", "déc.",
+	},
+	"es": {
+		"Sr.", "Sra.", "Srta.", "Sres.", "Dr.", "Dra.", "Lic.", "Ing.", "Prof.",
+		"Ud.", "Uds.", "Vd.", "Vds.", "Av.", "Avda.", "Cía.", "S.A.", "EE.UU.",
+		"art.", "cap.", "pág.", "págs.", "núm.", "vol.", "tel.", "aprox.", "etc.", "p. ej.",
+		"ene.", "feb.", "abr.", "ago.", "sept.", "oct.", "nov.", "dic.",
+	},
+	"it": {
+		"Sig.", "Sig.ra", "Sigg.", "Dott.", "Dott.ssa", "Prof.", "Avv.", "Ing.", "Arch.",
+		"On.", "Geom.", "Rag.", "S.p.A.",
+		"art.", "cap.", "pag.", "pagg.", "vol.", "n.", "tel.", "ca.", "es.", "ecc.",
+		"gen.", "feb.", "apr.", "giu.", "lug.", "ago.", "sett.", "ott.", "nov.", "dic.",
+	},
+	"ja": {
+		// Japanese sentences end with "。", only Latin abbreviations use a full
+		// stop.
+		"Mr.", "Mrs.", "Ms.", "Dr.", "Prof.", "St.", "Inc.", "Co.", "Ltd.", "Corp.",
+		"No.", "Vol.", "Fig.", "p.", "pp.", "e.g.", "i.e.", "etc.", "vs.",
+	},
+	"pt": {
+		"Sr.", "Sra.", "Srta.", "Dr.", "Dra.", "Prof.", "Profa.", "Eng.", "Exmo.", "Exma.",
+		"Av.", "Ltda.", "Cia.", "S.A.",
+		"art.", "cap.", "pág.", "págs.", "n.", "vol.", "tel.", "aprox.", "etc.", "p. ex.",
+		"jan.", "fev.", "mar.", "abr.", "mai.", "jun.", "jul.", "ago.", "set.", "out.", "nov.", "dez.",
+	},
+}
@@ -0,0 +1,917 @@
+package uniseg
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Direction is the base direction of the paragraphs of bidirectional text, see
+// [NewBidi].
+type Direction int
+
+// The paragraph directions.
+const (
+	// DirectionAuto takes the direction of each paragraph from its first
+	// strong character, following rules P2 and P3 of UAX #9. Paragraphs
+	// without strong characters are left-to-right.
+	DirectionAuto Direction = iota
+
+	// DirectionLTR makes all paragraphs left-to-right.
+	DirectionLTR
+
+	// DirectionRTL makes all paragraphs right-to-left.
+	DirectionRTL
+)
+
+// Bidi holds the embedding levels of a text resolved by the Unicode
+// Bidirectional Algorithm ([UAX #9]), which determines the visual order of
+// text mixing left-to-right scripts like Latin with right-to-left scripts like
+// Arabic and Hebrew.
+//
+// The text is split into paragraphs after each paragraph separator (rule P1)
+// and the embedding levels of each paragraph are resolved (rules P2 to I2).
+// Paragraph separators are the characters of bidirectional class B: CR, LF,
+// U+001C to U+001E, U+0085 NEXT LINE, and U+2029 PARAGRAPH SEPARATOR, with
+// CR LF counting as one. Unlike with [Paragraphs], U+2028 LINE SEPARATOR
+// doesn't end a paragraph.
+// Even levels are left-to-right and odd levels are right-to-left. As the
+// visual order depends on where the lines are broken, it is determined for one
+// line at a time with [Bidi.ReorderLine] or [Bidi.VisualLine] (rules L1 to L4),
+// for example for each [Line] returned by [WrapGreedy]. Lines are reordered by
+// grapheme clusters, so combining marks and emoji sequences stay intact.
+//
+// [UAX #9]: https://www.unicode.org/reports/tr9/tr9-50.html
+type Bidi struct {
+	parser *Parser
+
+	// The text and the direction it was resolved with.
+	text      string
+	direction Direction
+
+	// The byte offsets of the code points, followed by the length of the text.
+	offsets []int
+
+	// The Bidi_Class of each code point.
+	classes []bidiClass
+
+	// The resolved embedding level of each code point, before rule L1.
+	levels []uint8
+
+	// The paragraphs of the text.
+	paragraphs []bidiParagraph
+}
+
+// bidiParagraph is a paragraph of a [Bidi] text.
+type bidiParagraph struct {
+	start, end int   // The indices of the first code point and after the last.
+	level      uint8 // The paragraph embedding level.
+}
+
+// BidiRun is a run of grapheme clusters with the same embedding level on a
+// line, as returned by [Bidi.ReorderLine].
+type BidiRun struct {
+	// Start and End are the byte offsets of the run in the text.
+	Start, End int
+
+	// Level is the embedding level of the run. If it is odd, the run is
+	// right-to-left: its grapheme clusters are displayed in reverse order and
+	// characters like brackets are mirrored, see [BidiMirror].
+	Level int
+}
+
+// bidiCluster is a grapheme cluster on a line with its embedding level.
+type bidiCluster struct {
+	start, end int
+	level      uint8
+}
+
+// NewBidi resolves the embedding levels of the given text. The paragraphs of
+// the text are left-to-right or right-to-left as selected by the direction.
+func NewBidi(text string, direction Direction) *Bidi {
+	return DefaultParser.NewBidi(text, direction)
+}
+
+// NewBidi is like [NewBidi] but uses the given parser.
+func (p *Parser) NewBidi(text string, direction Direction) *Bidi {
+	b := &Bidi{parser: p, text: text, direction: direction}
+	runes := make([]rune, 0, len(text))
+	for pos := 0; pos < len(text); {
+		r, length := utf8.DecodeRuneInString(text[pos:])
+		b.offsets = append(b.offsets, pos)
+		b.classes = append(b.classes, bidiClasses.search(r))
+		runes = append(runes, r)
+		pos += length
+	}
+	b.offsets = append(b.offsets, len(text))
+	b.levels = make([]uint8, len(runes))
+
+	// Rule P1: Split the text into paragraphs, keeping CR LF together.
+	var start int
+	for i, class := range b.classes {
+		if i+1 < len(runes) && (class != bcprB || runes[i] == '\r' && runes[i+1] == '\n') {
+			continue
+		}
+		level, levels := resolveBidi(runes[start:i+1], b.classes[start:i+1], direction)
+		copy(b.levels[start:], levels)
+		b.paragraphs = append(b.paragraphs, bidiParagraph{start: start, end: i + 1, level: level})
+		start = i + 1
+	}
+
+	return b
+}
+
+// Text returns the text whose embedding levels were resolved.
+func (b *Bidi) Text() string {
+	return b.text
+}
+
+// ParagraphLevel returns the embedding level of the paragraph containing the
+// given byte offset, 0 for a left-to-right and 1 for a right-to-left
+// paragraph. Offsets outside the text are clamped.
+func (b *Bidi) ParagraphLevel(pos int) int {
+	if len(b.paragraphs) == 0 {
+		if b.direction == DirectionRTL {
+			return 1
+		}
+		return 0
+	}
+	i := min(b.index(pos), len(b.classes)-1)
+	k := sort.Search(len(b.paragraphs), func(k int) bool {
+		return b.paragraphs[k].end > i
+	})
+	return int(b.paragraphs[k].level)
+}
+
+// Level returns the resolved embedding level of the code point at the given
+// byte offset, before the line-based rule L1 is applied. The characters removed
+// by rule X9, that is explicit embeddings and overrides and boundary neutrals
+// like ZERO WIDTH JOINER, take the level of the preceding character. At the end
+// of the text, the level of the last paragraph is returned.
+func (b *Bidi) Level(pos int) int {
+	i := b.index(pos)
+	if i >= len(b.levels) {
+		return b.ParagraphLevel(pos)
+	}
+	return int(b.levels[i])
+}
+
+// ReorderLine returns the runs of the line between the given byte offsets in
+// visual order, from left to right. The offsets are usually the Start and End
+// of a [Line] returned by the line wrapping functions, they must not be inside
+// a grapheme cluster. Whitespace at the end of the line and before segment and
+// paragraph separators takes the paragraph embedding level (rule L1). If the
+// line extends over several paragraphs, their runs are returned in logical
+// order.
+func (b *Bidi) ReorderLine(start, end int) []BidiRun {
+	var runs []BidiRun
+	for _, cluster := range b.reorderLine(start, end) {
+		if n := len(runs); n > 0 && runs[n-1].Level == int(cluster.level) {
+			last := &runs[n-1]
+			if cluster.level&1 == 0 && last.End == cluster.start {
+				last.End = cluster.end
+				continue
+			}
+			if cluster.level&1 == 1 && last.Start == cluster.end {
+				last.Start = cluster.start
+				continue
+			}
+		}
+		runs = append(runs, BidiRun{Start: cluster.start, End: cluster.end, Level: int(cluster.level)})
+	}
+	return runs
+}
+
+// VisualLine returns the line between the given byte offsets (see
+// [Bidi.ReorderLine]) in visual order, ready to be printed from left to right.
+// Characters in right-to-left runs are replaced with their mirrored
+// counterparts (rule L4) where they exist, see [BidiMirror]. Explicit
+// directional formatting characters, which are not displayed, are removed. A
+// mandatory line break at the end of the line stays at the end.
+func (b *Bidi) VisualLine(start, end int) string {
+	start, end = max(start, 0), min(end, len(b.text))
+	if start >= end {
+		return ""
+	}
+
+	// Keep a line break at the end.
+	lineBreak := end
+	if r, length := utf8.DecodeLastRuneInString(b.text[start:end]); b.parser.isHardLineBreak(r) {
+		if lineBreak -= length; r == '\n' && lineBreak > start && b.text[lineBreak-1] == '\r' {
+			lineBreak--
+		}
+	}
+
+	var line strings.Builder
+	line.Grow(end - start)
+	for _, cluster := range b.reorderLine(start, lineBreak) {
+		text := b.text[cluster.start:cluster.end]
+		r, length := utf8.DecodeRuneInString(text)
+		if length == len(text) && bidiClasses.search(r) >= bcprLRE {
+			continue // An explicit directional formatting character.
+		}
+		if cluster.level&1 == 1 {
+			if mirror, ok := BidiMirror(r); ok {
+				line.WriteRune(mirror)
+				text = text[length:]
+			}
+		}
+		line.WriteString(text)
+	}
+	line.WriteString(b.text[lineBreak:end])
+	return line.String()
+}
+
+// BidiMirror returns the Bidi_Mirroring_Glyph of the given rune, that is the
+// character whose glyph is the mirror image of the rune's glyph, such as ")"
+// for "(" or "≥" for "≤". It returns false if there is no such character. Some
+// characters which are mirrored in right-to-left text, for example "∑", have
+// no counterpart and must be mirrored by the font.
+func BidiMirror(r rune) (rune, bool) {
+	mirror := bidiMirrors.search(r)
+	return mirror, mirror != 0
+}
+
+// index returns the index of the code point containing the given byte offset.
+// Offsets outside the text are clamped, the end of the text has the index of
+// the number of code points.
+func (b *Bidi) index(pos int) int {
+	i, found := slices.BinarySearch(b.offsets, pos)
+	if !found {
+		i--
+	}
+	return max(i, 0)
+}
+
+// reorderLine returns the grapheme clusters of the line between the given byte
+// offsets in visual order, with their embedding levels after rule L1.
+func (b *Bidi) reorderLine(start, end int) []bidiCluster {
+	start, end = max(start, 0), min(end, len(b.text))
+	if start >= end {
+		return nil
+	}
+	from, to := b.index(start), b.index(end)
+
+	var visual []bidiCluster
+	k := sort.Search(len(b.paragraphs), func(k int) bool {
+		return b.paragraphs[k].end > from
+	})
+	for ; k < len(b.paragraphs) && b.paragraphs[k].start < to; k++ {
+		paragraph := b.paragraphs[k]
+		i, j := max(paragraph.start, from), min(paragraph.end, to)
+
+		// Rule L1.
+		levels := slices.Clone(b.levels[i:j])
+		resetWhitespaceLevels(b.classes[i:j], levels, paragraph.level)
+
+		// The levels of the grapheme clusters are the levels of their first
+		// code points.
+		var (
+			clusters      []bidiCluster
+			clusterLevels []uint8
+			cluster       string
+			state         GraphemeBreakState
+		)
+		for pos, index := b.offsets[i], i; pos < b.offsets[j]; pos += len(cluster) {
+			cluster, _, _, state = firstGraphemeCluster(b.parser, b.text[pos:b.offsets[j]], state, utf8.DecodeRuneInString)
+			clusters = append(clusters, bidiCluster{start: pos, end: pos + len(cluster), level: levels[index-i]})
+			clusterLevels = append(clusterLevels, levels[index-i])
+			index += utf8.RuneCountInString(cluster)
+		}
+
+		// Rule L2.
+		for _, c := range reorderLevels(clusterLevels) {
+			visual = append(visual, clusters[c])
+		}
+	}
+	return visual
+}
+
+// resetWhitespaceLevels applies rule L1 to the embedding levels of the code
+// points of a line, or a part of it, which belong to a paragraph with the given
+// level: Segment and paragraph separators, and any sequence of whitespace and
+// isolate formatting characters preceding them or at the end of the line, are
+// reset to the paragraph level. The characters removed by rule X9 are treated
+// like whitespace.
+func resetWhitespaceLevels(classes []bidiClass, levels []uint8, level uint8) {
+	trailing := true
+	for i := len(classes) - 1; i >= 0; i-- {
+		switch class := classes[i]; {
+		case class == bcprB || class == bcprS:
+			levels[i] = level
+			trailing = true
+		case class == bcprWS || class >= bcprLRI || isRemovedByX9(class):
+			if trailing {
+				levels[i] = level
+			}
+		default:
+			trailing = false
+		}
+	}
+}
+
+// reorderLevels returns the indices of the given embedding levels of a line in
+// visual order (rule L2): From the highest level to the lowest odd level, any
+// sequence of characters at that level or higher is reversed.
+func reorderLevels(levels []uint8) []int {
+	order := make([]int, len(levels))
+	highest, lowestOdd := uint8(0), uint8(bidiMaxDepth+2)
+	for i, level := range levels {
+		order[i] = i
+		highest = max(highest, level)
+		if level&1 == 1 {
+			lowestOdd = min(lowestOdd, level)
+		}
+	}
+	for level := highest; level >= lowestOdd; level-- {
+		for i := 0; i < len(levels); i++ {
+			if levels[i] < level {
+				continue
+			}
+			j := i + 1
+			for j < len(levels) && levels[j] >= level {
+				j++
+			}
+			slices.Reverse(order[i:j])
+			i = j
+		}
+	}
+	return order
+}
+
+// bidiMaxDepth is the maximum explicit embedding level (BD2).
+const bidiMaxDepth = 125
+
+// bidiMaxBrackets is the number of opening brackets remembered when
+// identifying bracket pairs (BD16).
+const bidiMaxBrackets = 63
+
+// bidiResolver resolves the embedding levels of a paragraph.
+type bidiResolver struct {
+	// The code points of the paragraph, or nil if only their classes are
+	// known. Brackets are not paired in that case.
+	runes []rune
+
+	// The original Bidi_Class of each code point and the current type during
+	// resolution.
+	classes, types []bidiClass
+
+	// The embedding level of each code point.
+	levels []uint8
+
+	// The index of the matching PDI of each isolate initiator, or the number
+	// of code points if there is none. And the index of the matching isolate
+	// initiator of each PDI, or -1 if there is none (BD9).
+	matchingPDI, matchingInitiator []int
+
+	// The paragraph embedding level.
+	level uint8
+}
+
+// resolveBidi resolves the embedding levels of a paragraph with the given code
+// points and their Bidi_Class values, following rules P2 to I2 of UAX #9. It
+// returns the paragraph embedding level and the level of each code point. The
+// characters removed by rule X9 take the level of the preceding character.
+func resolveBidi(runes []rune, classes []bidiClass, direction Direction) (uint8, []uint8) {
+	r := &bidiResolver{
+		runes:   runes,
+		classes: classes,
+		types:   slices.Clone(classes),
+		levels:  make([]uint8, len(classes)),
+	}
+	r.matchIsolates()
+
+	// Rules P2 and P3.
+	switch direction {
+	case DirectionLTR:
+		r.level = 0
+	case DirectionRTL:
+		r.level = 1
+	default:
+		r.level = r.firstStrongLevel(0, len(classes))
+	}
+
+	// Rules X1 to X8.
+	r.resolveExplicit()
+
+	// Rules X9 and X10. The removed characters are not part of the isolating
+	// run sequences.
+	for _, s := range r.sequences() {
+		s.resolveWeak()     // Rules W1 to W7.
+		s.resolveBrackets() // Rule N0.
+		s.resolveNeutral()  // Rules N1 and N2.
+		s.resolveImplicit() // Rules I1 and I2.
+	}
+
+	for i, class := range classes {
+		if !isRemovedByX9(class) {
+			continue
+		}
+		if i == 0 {
+			r.levels[i] = r.level
+		} else {
+			r.levels[i] = r.levels[i-1]
+		}
+	}
+
+	return r.level, r.levels
+}
+
+// matchIsolates finds the matching isolate initiators and PDIs (BD9).
+func (r *bidiResolver) matchIsolates() {
+	r.matchingPDI = make([]int, len(r.classes))
+	r.matchingInitiator = make([]int, len(r.classes))
+	var initiators []int
+	for i, class := range r.classes {
+		r.matchingPDI[i], r.matchingInitiator[i] = len(r.classes), -1
+		switch class {
+		case bcprLRI, bcprRLI, bcprFSI:
+			initiators = append(initiators, i)
+		case bcprPDI:
+			if n := len(initiators); n > 0 {
+				r.matchingPDI[initiators[n-1]], r.matchingInitiator[i] = i, initiators[n-1]
+				initiators = initiators[:n-1]
+			}
+		}
+	}
+}
+
+// firstStrongLevel returns the embedding level of the first strong character
+// between the given indices, skipping isolates: 0 for L, 1 for R and AL, and 0
+// if there is none (rules P2 and P3).
+func (r *bidiResolver) firstStrongLevel(start, end int) uint8 {
+	for i := start; i < end; i++ {
+		switch r.classes[i] {
+		case bcprL:
+			return 0
+		case bcprR, bcprAL:
+			return 1
+		case bcprLRI, bcprRLI, bcprFSI:
+			i = r.matchingPDI[i]
+		}
+	}
+	return 0
+}
+
+// resolveExplicit determines the explicit embedding levels and directional
+// overrides (rules X1 to X8).
+func (r *bidiResolver) resolveExplicit() {
+	type status struct {
+		level    uint8
+		override bidiClass // bcprON, bcprL, or bcprR.
+		isolate  bool
+	}
+	stack := make([]status, 1, bidiMaxDepth+2)
+	stack[0] = status{level: r.level, override: bcprON}
+	var overflowIsolates, overflowEmbeddings, validIsolates int
+
+	for i, class := range r.classes {
+		last := stack[len(stack)-1]
+		switch class {
+		case bcprRLE, bcprLRE, bcprRLO, bcprLRO, bcprRLI, bcprLRI, bcprFSI:
+			// Rules X2 to X5c.
+			isolate := class == bcprRLI || class == bcprLRI || class == bcprFSI
+			rtl := class == bcprRLE || class == bcprRLO || class == bcprRLI
+			if class == bcprFSI {
+				rtl = r.firstStrongLevel(i+1, r.matchingPDI[i]) == 1
+			}
+			r.levels[i] = last.level
+			if isolate && last.override != bcprON {
+				r.types[i] = last.override
+			}
+			level := (last.level + 2) &^ 1
+			if rtl {
+				level = (last.level + 1) | 1
+			}
+			if level > bidiMaxDepth || overflowIsolates > 0 || overflowEmbeddings > 0 {
+				if isolate {
+					overflowIsolates++
+				} else if overflowIsolates == 0 {
+					overflowEmbeddings++
+				}
+				continue
+			}
+			override := bcprON
+			if class == bcprLRO {
+				override = bcprL
+			} else if class == bcprRLO {
+				override = bcprR
+			}
+			if isolate {
+				validIsolates++
+			}
+			stack = append(stack, status{level: level, override: override, isolate: isolate})
+
+		case bcprPDI:
+			// Rule X6a.
+			if overflowIsolates > 0 {
+				overflowIsolates--
+			} else if validIsolates > 0 {
+				overflowEmbeddings = 0
+				for !stack[len(stack)-1].isolate {
+					stack = stack[:len(stack)-1]
+				}
+				stack = stack[:len(stack)-1]
+				validIsolates--
+			}
+			last = stack[len(stack)-1]
+			r.levels[i] = last.level
+			if last.override != bcprON {
+				r.types[i] = last.override
+			}
+
+		case bcprPDF:
+			// Rule X7.
+			r.levels[i] = last.level
+			if overflowIsolates > 0 {
+				// Do nothing.
+			} else if overflowEmbeddings > 0 {
+				overflowEmbeddings--
+			} else if !last.isolate && len(stack) >= 2 {
+				stack = stack[:len(stack)-1]
+			}
+
+		case bcprB:
+			// Rule X8.
+			r.levels[i] = r.level
+			stack = stack[:1]
+			overflowIsolates, overflowEmbeddings, validIsolates = 0, 0, 0
+
+		case bcprBN:
+			// Ignored by rule X6 and removed by rule X9.
+			r.levels[i] = last.level
+
+		default:
+			// Rule X6.
+			r.levels[i] = last.level
+			if last.override != bcprON {
+				r.types[i] = last.override
+			}
+		}
+	}
+}
+
+// bidiSequence is an isolating run sequence (BD13).
+type bidiSequence struct {
+	r *bidiResolver
+
+	// The indices of the code points of the sequence and their current types.
+	indices []int
+	types   []bidiClass
+
+	// The embedding level of the sequence.
+	level uint8
+
+	// The types at the start and the end of the sequence, bcprL or bcprR.
+	sos, eos bidiClass
+}
+
+// sequences returns the isolating run sequences of the paragraph (rule X10).
+func (r *bidiResolver) sequences() []*bidiSequence {
+	// Find the level runs, without the characters removed by rule X9.
+	var runs [][]int
+	runOf := make([]int, len(r.classes))
+	for i, class := range r.classes {
+		if isRemovedByX9(class) {
+			continue
+		}
+		if n := len(runs); n == 0 || r.levels[runs[n-1][0]] != r.levels[i] {
+			runs = append(runs, nil)
+		}
+		runs[len(runs)-1] = append(runs[len(runs)-1], i)
+		runOf[i] = len(runs) - 1
+	}
+
+	// Chain the level runs connected by matching isolate initiators and PDIs.
+	var sequences []*bidiSequence
+	for _, run := range runs {
+		if first := run[0]; r.classes[first] == bcprPDI && r.matchingInitiator[first] >= 0 {
+			continue // This run continues a sequence.
+		}
+		var indices []int
+		for {
+			indices = append(indices, run...)
+			last := indices[len(indices)-1]
+			if !isIsolateInitiator(r.classes[last]) || r.matchingPDI[last] == len(r.classes) {
+				break
+			}
+			run = runs[runOf[r.matchingPDI[last]]]
+		}
+		sequences = append(sequences, r.sequence(indices))
+	}
+	return sequences
+}
+
+// sequence returns the isolating run sequence with the given code points,
+// determining its sos and eos types.
+func (r *bidiResolver) sequence(indices []int) *bidiSequence {
+	s := &bidiSequence{
+		r:       r,
+		indices: indices,
+		types:   make([]bidiClass, len(indices)),
+		level:   r.levels[indices[0]],
+	}
+	for k, i := range indices {
+		s.types[k] = r.types[i]
+	}
+
+	// Compare the level of the sequence with the levels of the characters
+	// before and after it, or the paragraph level if there are none.
+	before, after := r.level, r.level
+	for i := indices[0] - 1; i >= 0; i-- {
+		if !isRemovedByX9(r.classes[i]) {
+			before = r.levels[i]
+			break
+		}
+	}
+	if last := indices[len(indices)-1]; !isIsolateInitiator(r.classes[last]) {
+		for i := last + 1; i < len(r.classes); i++ {
+			if !isRemovedByX9(r.classes[i]) {
+				after = r.levels[i]
+				break
+			}
+		}
+	}
+	s.sos = levelDirection(max(before, s.level))
+	s.eos = levelDirection(max(after, s.level))
+
+	return s
+}
+
+// resolveWeak resolves the weak types (rules W1 to W7).
+func (s *bidiSequence) resolveWeak() {
+	types := s.types
+
+	// Rule W1.
+	previous := s.sos
+	for k, t := range types {
+		switch t {
+		case bcprNSM:
+			types[k] = previous
+		case bcprLRI, bcprRLI, bcprFSI, bcprPDI:
+			previous = bcprON
+		default:
+			previous = t
+		}
+	}
+
+	// Rules W2 and W3.
+	strong := s.sos
+	for k, t := range types {
+		switch t {
+		case bcprEN:
+			if strong == bcprAL {
+				types[k] = bcprAN
+			}
+		case bcprL, bcprR:
+			strong = t
+		case bcprAL:
+			strong = t
+			types[k] = bcprR
+		}
+	}
+
+	// Rule W4.
+	for k := 1; k < len(types)-1; k++ {
+		before, after := types[k-1], types[k+1]
+		switch types[k] {
+		case bcprES:
+			if before == bcprEN && after == bcprEN {
+				types[k] = bcprEN
+			}
+		case bcprCS:
+			if before == after && (before == bcprEN || before == bcprAN) {
+				types[k] = before
+			}
+		}
+	}
+
+	// Rule W5.
+	for k := 0; k < len(types); k++ {
+		if types[k] != bcprET {
+			continue
+		}
+		end := k + 1
+		for end < len(types) && types[end] == bcprET {
+			end++
+		}
+		if k > 0 && types[k-1] == bcprEN || end < len(types) && types[end] == bcprEN {
+			for ; k < end; k++ {
+				types[k] = bcprEN
+			}
+		}
+		k = end
+	}
+
+	// Rules W6 and W7.
+	strong = s.sos
+	for k, t := range types {
+		switch t {
+		case bcprES, bcprET, bcprCS:
+			types[k] = bcprON
+		case bcprEN:
+			if strong == bcprL {
+				types[k] = bcprL
+			}
+		case bcprL, bcprR:
+			strong = t
+		}
+	}
+}
+
+// resolveBrackets resolves the types of paired brackets (rule N0).
+func (s *bidiSequence) resolveBrackets() {
+	if s.r.runes == nil {
+		return
+	}
+
+	// Identify the bracket pairs (BD16).
+	type opening struct {
+		pair rune // The matching closing bracket.
+		k    int  // The index in the sequence.
+	}
+	var (
+		openings []opening
+		pairs    [][2]int
+	)
+identify:
+	for k, i := range s.indices {
+		if s.types[k] != bcprON {
+			continue
+		}
+		r := s.r.runes[i]
+		bracket := bidiBrackets.search(r)
+		switch {
+		case bracket.pair == 0:
+		case bracket.open:
+			if len(openings) == bidiMaxBrackets {
+				break identify
+			}
+			openings = append(openings, opening{pair: canonicalBracket(bracket.pair), k: k})
+		default:
+			r = canonicalBracket(r)
+			for n := len(openings) - 1; n >= 0; n-- {
+				if openings[n].pair == r {
+					pairs = append(pairs, [2]int{openings[n].k, k})
+					openings = openings[:n]
+					break
+				}
+			}
+		}
+	}
+	slices.SortFunc(pairs, func(a, b [2]int) int {
+		return a[0] - b[0]
+	})
+
+	// Resolve the pairs in the order of their opening brackets.
+	direction := levelDirection(s.level)
+	for _, pair := range pairs {
+		inside := bcprON
+		for k := pair[0] + 1; k < pair[1]; k++ {
+			if t := strongBracketType(s.types[k]); t != bcprON {
+				if inside = t; t == direction {
+					break
+				}
+			}
+		}
+		if inside == bcprON {
+			continue // No strong types inside the brackets.
+		}
+		if inside != direction {
+			// Use the opposite direction only if it is established by the
+			// context before the brackets.
+			before := s.sos
+			for k := pair[0] - 1; k >= 0; k-- {
+				if t := strongBracketType(s.types[k]); t != bcprON {
+					before = t
+					break
+				}
+			}
+			if before != inside {
+				inside = direction
+			}
+		}
+
+		// Nonspacing marks following the brackets take their types.
+		for _, k := range pair {
+			s.types[k] = inside
+			for k++; k < len(s.types) && s.r.classes[s.indices[k]] == bcprNSM; k++ {
+				s.types[k] = inside
+			}
+		}
+	}
+}
+
+// resolveNeutral resolves the types of neutral and isolate formatting
+// characters (rules N1 and N2).
+func (s *bidiSequence) resolveNeutral() {
+	types := s.types
+	for k := 0; k < len(types); k++ {
+		if !isNeutral(types[k]) {
+			continue
+		}
+		end := k + 1
+		for end < len(types) && isNeutral(types[end]) {
+			end++
+		}
+		before, after := s.sos, s.eos
+		if k > 0 {
+			before = strongNeutralType(types[k-1])
+		}
+		if end < len(types) {
+			after = strongNeutralType(types[end])
+		}
+		t := levelDirection(s.level)
+		if before == after {
+			t = before
+		}
+		for ; k < end; k++ {
+			types[k] = t
+		}
+	}
+}
+
+// resolveImplicit resolves the embedding levels of the sequence (rules I1 and
+// I2) and stores them in the paragraph.
+func (s *bidiSequence) resolveImplicit() {
+	for k, i := range s.indices {
+		level, t := s.level, s.types[k]
+		switch {
+		case level&1 == 0 && t == bcprR:
+			level++
+		case level&1 == 0 && (t == bcprAN || t == bcprEN):
+			level += 2
+		case level&1 == 1 && t != bcprR:
+			level++
+		}
+		s.r.types[i], s.r.levels[i] = t, level
+	}
+}
+
+// levelDirection returns the type of the direction of the given embedding
+// level, bcprL for even levels and bcprR for odd levels.
+func levelDirection(level uint8) bidiClass {
+	if level&1 == 1 {
+		return bcprR
+	}
+	return bcprL
+}
+
+// strongBracketType returns the strong type of the given type for rule N0, with
+// numbers treated as bcprR, or bcprON if the type is not strong.
+func strongBracketType(t bidiClass) bidiClass {
+	switch t {
+	case bcprL:
+		return bcprL
+	case bcprR, bcprAL, bcprEN, bcprAN:
+		return bcprR
+	}
+	return bcprON
+}
+
+// strongNeutralType returns the strong type of the given resolved type next to
+// neutrals for rules N1 and N2: bcprL or bcprR, with numbers treated as bcprR.
+func strongNeutralType(t bidiClass) bidiClass {
+	if t == bcprL {
+		return bcprL
+	}
+	return bcprR
+}
+
+// canonicalBracket maps the brackets U+2329 and U+232A to their canonical
+// equivalents U+3008 and U+3009 so they pair with each other (BD16).
+func canonicalBracket(r rune) rune {
+	switch r {
+	case 0x2329:
+		return 0x3008
+	case 0x232a:
+		return 0x3009
+	}
+	return r
+}
+
+// isNeutral returns true for the neutral and isolate formatting types which are
+// resolved by rules N1 and N2.
+func isNeutral(t bidiClass) bool {
+	switch t {
+	case bcprB, bcprS, bcprWS, bcprON, bcprLRI, bcprRLI, bcprFSI, bcprPDI:
+		return true
+	}
+	return false
+}
+
+// isIsolateInitiator returns true for the classes LRI, RLI, and FSI.
+func isIsolateInitiator(class bidiClass) bool {
+	return class == bcprLRI || class == bcprRLI || class == bcprFSI
+}
+
+// isRemovedByX9 returns true for the classes of the characters removed by rule
+// X9: explicit embeddings and overrides, PDF, and BN.
+func isRemovedByX9(class bidiClass) bool {
+	switch class {
+	case bcprLRE, bcprLRO, bcprRLE, bcprRLO, bcprPDF, bcprBN:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,313 @@
+package uniseg
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// bidiTestCase is a test case of BidiTest.txt.
+type bidiTestCase struct {
+	classes    string // The space-separated Bidi_Class values.
+	paragraphs int    // The directions to test: 1 for auto, 2 for LTR, 4 for RTL.
+	levels     string // The resolved levels, "x" for characters removed by X9.
+	reorder    string // The visual order of the characters not removed by X9.
+}
+
+// bidiCharacterTestCase is a test case of BidiCharacterTest.txt.
+type bidiCharacterTestCase struct {
+	text      string
+	direction Direction
+	level     uint8  // The resolved paragraph embedding level.
+	levels    string // The resolved levels, "x" for characters removed by X9.
+	reorder   string // The visual order of the characters not removed by X9.
+}
+
+// bidiTestCases and bidiCharacterTestCases are assigned by the files generated
+// from BidiTest.txt and BidiCharacterTest.txt, see uniseg.go.
+var (
+	bidiTestCases          []bidiTestCase
+	bidiCharacterTestCases []bidiCharacterTestCase
+)
+
+// bidiClassNames maps the short names of the Bidi_Class values to the classes.
+var bidiClassNames = map[string]bidiClass{
+	"L": bcprL, "R": bcprR, "AL": bcprAL, "EN": bcprEN, "ES": bcprES, "ET": bcprET,
+	"AN": bcprAN, "CS": bcprCS, "NSM": bcprNSM, "BN": bcprBN, "B": bcprB, "S": bcprS,
+	"WS": bcprWS, "ON": bcprON, "LRE": bcprLRE, "LRO": bcprLRO, "RLE": bcprRLE,
+	"RLO": bcprRLO, "PDF": bcprPDF, "LRI": bcprLRI, "RLI": bcprRLI, "FSI": bcprFSI,
+	"PDI": bcprPDI,
+}
+
+// bidiResults returns the levels of a line after rule L1 in the format of the
+// conformance tests, "x" for characters removed by X9, and the visual order of
+// the other characters.
+func bidiResults(classes []bidiClass, levels []uint8, level uint8) (string, string) {
+	resetWhitespaceLevels(classes, levels, level)
+	result := make([]string, len(levels))
+	for i, level := range levels {
+		result[i] = "x"
+		if !isRemovedByX9(classes[i]) {
+			result[i] = strconv.Itoa(int(level))
+		}
+	}
+	var order []string
+	for _, i := range reorderLevels(levels) {
+		if !isRemovedByX9(classes[i]) {
+			order = append(order, strconv.Itoa(i))
+		}
+	}
+	return strings.Join(result, " "), strings.Join(order, " ")
+}
+
+// Run the conformance tests of BidiTest.txt.
+func TestBidiConformance(t *testing.T) {
+	if bidiTestCases == nil {
+		t.Skip("BidiTest.txt has not been generated, run go generate")
+	}
+	for _, tt := range bidiTestCases {
+		var classes []bidiClass
+		for _, name := range strings.Fields(tt.classes) {
+			classes = append(classes, bidiClassNames[name])
+		}
+		for bit, direction := range []Direction{DirectionAuto, DirectionLTR, DirectionRTL} {
+			if tt.paragraphs&(1<<bit) == 0 {
+				continue
+			}
+			level, levels := resolveBidi(nil, classes, direction)
+			gotLevels, gotOrder := bidiResults(classes, levels, level)
+			if gotLevels != tt.levels || gotOrder != tt.reorder {
+				t.Errorf("%s (direction %d): levels %q, order %q, want %q, %q", tt.classes, direction, gotLevels, gotOrder, tt.levels, tt.reorder)
+			}
+		}
+	}
+}
+
+// Run the conformance tests of BidiCharacterTest.txt.
+func TestBidiCharacterConformance(t *testing.T) {
+	if bidiCharacterTestCases == nil {
+		t.Skip("BidiCharacterTest.txt has not been generated, run go generate")
+	}
+	for _, tt := range bidiCharacterTestCases {
+		runes := []rune(tt.text)
+		classes := make([]bidiClass, len(runes))
+		for i, r := range runes {
+			classes[i] = bidiClasses.search(r)
+		}
+		level, levels := resolveBidi(runes, classes, tt.direction)
+		gotLevels, gotOrder := bidiResults(classes, levels, level)
+		if level != tt.level || gotLevels != tt.levels || gotOrder != tt.reorder {
+			t.Errorf("%+q (direction %d): level %d, levels %q, order %q, want %d, %q, %q", tt.text, tt.direction, level, gotLevels, gotOrder, tt.level, tt.levels, tt.reorder)
+		}
+	}
+}
+
+// bidiExamples are texts with their expected visual order.
+var bidiExamples = []struct {
+	name      string
+	original  string
+	direction Direction
+	level     int
+	visual    string
+}{
+	{"empty", "", DirectionAuto, 0, ""},
+	{"empty RTL", "", DirectionRTL, 1, ""},
+	{"latin", "abc def", DirectionAuto, 0, "abc def"},
+	{"hebrew", "שלום עולם", DirectionAuto, 1, "םלוע םולש"},
+	{"hebrew in latin", "I said שלום עולם!", DirectionAuto, 0, "I said םלוע םולש!"},
+	{"latin in hebrew", "שלום hello world!", DirectionAuto, 1, "!hello world םולש"},
+	{"forced LTR", "שלום hello", DirectionLTR, 0, "םולש hello"},
+	{"forced RTL", "hello שלום", DirectionRTL, 1, "םולש hello"},
+	{"numbers", "עמוד 123 מתוך 456", DirectionAuto, 1, "456 ךותמ 123 דומע"},
+	{"number with separators", "מחיר: 1,234.50 ש״ח", DirectionAuto, 1, "ח״ש 1,234.50 :ריחמ"},
+	{"arabic digits", "صفحة ١٢٣", DirectionAuto, 1, "١٢٣ ةحفص"},
+	{"mirrored brackets", "שלום (עולם)", DirectionAuto, 1, "(םלוע) םולש"},
+	{"mirrored comparison", "א < ב", DirectionAuto, 1, "ב > א"},
+	{"brackets in latin", "abc (אבג) def", DirectionAuto, 0, "abc (גבא) def"},
+	{"bracket pair context", "אבג (abc) דהו", DirectionAuto, 1, "והד (abc) גבא"},
+	{"combining marks", "שָׁלוֹם", DirectionAuto, 1, "םוֹלשָׁ"},
+	{"emoji sequence", "שלום 👩\u200d👩\u200d👧", DirectionAuto, 1, "👩\u200d👩\u200d👧 םולש"},
+	{"isolate", "\u2067שלום\u2069 abc", DirectionAuto, 0, "םולש abc"},
+	{"override", "\u202eabc\u202c def", DirectionAuto, 0, "cba def"},
+	{"trailing whitespace", "שלום  ", DirectionLTR, 0, "םולש  "},
+	{"line break", "שלום\n", DirectionAuto, 1, "םולש\n"},
+	{"CR LF", "abc אבג\r\n", DirectionAuto, 0, "abc גבא\r\n"},
+	{"paragraphs", "אבג abc\nabc אבג", DirectionAuto, 1, "abc גבא\nabc גבא"},
+	{"invalid UTF-8", "א\xffב", DirectionAuto, 1, "ב\xffא"},
+}
+
+func TestBidi(t *testing.T) {
+	for _, tt := range bidiExamples {
+		b := NewBidi(tt.original, tt.direction)
+		if b.Text() != tt.original {
+			t.Errorf("%s: Text() = %q, want %q", tt.name, b.Text(), tt.original)
+		}
+		if level := b.ParagraphLevel(0); level != tt.level {
+			t.Errorf("%s: ParagraphLevel(0) = %d, want %d", tt.name, level, tt.level)
+		}
+		var (
+			visual strings.Builder
+			start  int
+		)
+		for _, line := range HardLinesInString(tt.original) {
+			visual.WriteString(b.VisualLine(start, start+len(line)))
+			start += len(line)
+		}
+		if visual.String() != tt.visual {
+			t.Errorf("%s: visual order %q, want %q", tt.name, visual.String(), tt.visual)
+		}
+	}
+}
+
+func TestBidiLevels(t *testing.T) {
+	b := NewBidi("abc אבג 123\nאבג abc ", DirectionAuto)
+	for _, tt := range []struct {
+		pos              int
+		level, paragraph int
+	}{
+		{0, 0, 0},   // a
+		{3, 0, 0},   // Space between L and R.
+		{4, 1, 0},   // א
+		{5, 1, 0},   // Inside א.
+		{10, 1, 0},  // Space between R and EN.
+		{11, 2, 0},  // 1
+		{14, 0, 0},  // LF
+		{15, 1, 1},  // א
+		{21, 1, 1},  // Space between R and L.
+		{22, 2, 1},  // a
+		{25, 1, 1},  // PARAGRAPH SEPARATOR
+		{28, 1, 1},  // The end of the text.
+		{100, 1, 1}, // Beyond the end.
+		{-1, 0, 0},  // Before the beginning.
+	} {
+		if level := b.Level(tt.pos); level != tt.level {
+			t.Errorf("Level(%d) = %d, want %d", tt.pos, level, tt.level)
+		}
+		if level := b.ParagraphLevel(tt.pos); level != tt.paragraph {
+			t.Errorf("ParagraphLevel(%d) = %d, want %d", tt.pos, level, tt.paragraph)
+		}
+	}
+}
+
+func TestBidiReorderLine(t *testing.T) {
+	b := NewBidi("abc אבג def", DirectionAuto)
+	expected := []BidiRun{{0, 4, 0}, {4, 10, 1}, {10, 14, 0}}
+	if runs := b.ReorderLine(0, 14); !reflect.DeepEqual(runs, expected) {
+		t.Errorf("ReorderLine = %v, want %v", runs, expected)
+	}
+
+	// Trailing whitespace is reset to the paragraph level (rule L1), so it stays
+	// at the end of a right-to-left line.
+	b = NewBidi("אבג abc ", DirectionAuto)
+	expected = []BidiRun{{10, 11, 1}, {7, 10, 2}, {0, 7, 1}}
+	if runs := b.ReorderLine(0, 11); !reflect.DeepEqual(runs, expected) {
+		t.Errorf("ReorderLine = %v, want %v", runs, expected)
+	}
+	expected = []BidiRun{{7, 10, 2}, {0, 7, 1}}
+	if runs := b.ReorderLine(0, 10); !reflect.DeepEqual(runs, expected) {
+		t.Errorf("ReorderLine = %v, want %v", runs, expected)
+	}
+	if runs := b.ReorderLine(5, 5); runs != nil {
+		t.Errorf("ReorderLine of an empty line = %v, want nil", runs)
+	}
+}
+
+func TestBidiWrap(t *testing.T) {
+	const text = "שלום לכולם, this is a test of עברית mixed with English."
+	b := NewBidi(text, DirectionAuto)
+	var got []string
+	for _, line := range WrapGreedy(text, WrapOptions{Width: 20}) {
+		got = append(got, b.VisualLine(line.Start, line.End))
+	}
+	// Trailing whitespace and the final full stop take the paragraph level, so
+	// they are displayed on the left of the right-to-left lines.
+	expected := []string{
+		" this is ,םלוכל םולש",
+		" תירבע a test of",
+		".mixed with English",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Visual lines %q, want %q", got, expected)
+	}
+}
+
+func TestBidiMirror(t *testing.T) {
+	for _, tt := range []struct {
+		r        rune
+		expected rune
+		ok       bool
+	}{
+		{'(', ')', true},
+		{')', '(', true},
+		{'<', '>', true},
+		{'≤', '≥', true},
+		{'«', '»', true},
+		{'〈', '〉', true},
+		{'a', 0, false},
+		{'∑', 0, false},
+	} {
+		if r, ok := BidiMirror(tt.r); r != tt.expected || ok != tt.ok {
+			t.Errorf("BidiMirror(%q) = %q, %t, want %q, %t", tt.r, r, ok, tt.expected, tt.ok)
+		}
+	}
+}
+
+// Unassigned code points take the default Bidi_Class of the block they are in,
+// given by the "@missing" lines of DerivedBidiClass.txt.
+func TestBidiUnassignedClasses(t *testing.T) {
+	for _, tt := range []struct {
+		r        rune
+		expected bidiClass
+	}{
+		{0x0378, bcprL},
+		{0x05C8, bcprR},
+		{0x07FB, bcprR},
+		{0x085F, bcprR},
+		{0x070E, bcprAL},
+		{0x086B, bcprAL},
+		{0x0892, bcprAL},
+		{0x0896, bcprAL},
+		{0x20C2, bcprET},
+		{0x20CF, bcprET},
+		{0xFB37, bcprR},
+		{0xFEFD, bcprAL},
+		{0x10D3A, bcprAL},
+		{0x10D90, bcprR},
+		{0x10EC0, bcprAL},
+		{0x10F5A, bcprAL},
+		{0x10FF7, bcprR},
+		{0x1EC70, bcprAL},
+		{0x1ECC0, bcprR},
+		{0x1ED3E, bcprAL},
+		{0x1ED50, bcprR},
+		{0x1EEF2, bcprAL},
+		{0x1EF00, bcprR},
+		{0xFDD0, bcprBN},
+		{0xE0FFF, bcprBN},
+	} {
+		if class := bidiClasses.search(tt.r); class != tt.expected {
+			t.Errorf("Bidi_Class of U+%04X = %d, want %d", tt.r, class, tt.expected)
+		}
+	}
+}
+
+func TestBidiExplicitDepth(t *testing.T) {
+	// Embeddings beyond the maximum depth are ignored.
+	text := strings.Repeat("\u202b\u202a", 100) + "a"
+	b := NewBidi(text, DirectionLTR)
+	if level := b.Level(len(text) - 1); level != bidiMaxDepth+1 {
+		t.Errorf("Level of the innermost character = %d, want %d", level, bidiMaxDepth+1)
+	}
+	if got := b.VisualLine(0, len(text)); got != "a" {
+		t.Errorf("VisualLine = %q, want %q", got, "a")
+	}
+}
+
+func BenchmarkBidi(b *testing.B) {
+	text := strings.Repeat("שלום לכולם, this is a test (בדיקה) of 123 עברית mixed with English. ", 50)
+	for b.Loop() {
+		bidi := NewBidi(text, DirectionAuto)
+		bidi.VisualLine(0, len(text))
+	}
+}
@@ -0,0 +1,1341 @@
+// Code generated by internal/cmd/gen_bidi; DO NOT EDIT.
+
+package uniseg
+
+// bidiClasses are taken from
+// https://www.unicode.org/Public/17.0.0/ucd/extracted/DerivedBidiClass.txt
+// Code points with the class L are omitted.
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+var bidiClasses = dictionary[bidiClass]{
+	{runeRange{0x10D30, 0x10D39}, bcprAN},
+	{runeRange{0x1A73, 0x1A7C}, bcprNSM},
+	{runeRange{0x16B30, 0x16B36}, bcprNSM},
+	{runeRange{0x0941, 0x0948}, bcprNSM},
+	{runeRange{0xA673, 0xA673}, bcprON},
+	{runeRange{0x115B2, 0x115B5}, bcprNSM},
+	{runeRange{0x1ECC0, 0x1ECFF}, bcprR},
+	{runeRange{0x05C6, 0x05C6}, bcprR},
+	{runeRange{0x0E34, 0x0E3A}, bcprNSM},
+	{runeRange{0x20D0, 0x20F0}, bcprNSM},
+	{runeRange{0xFE52, 0xFE52}, bcprCS},
+	{runeRange{0x11127, 0x1112B}, bcprNSM},
+	{runeRange{0x11A98, 0x11A99}, bcprNSM},
+	{runeRange{0x1D789, 0x1D789}, bcprON},
+	{runeRange{0x1F8D0, 0x1F8D8}, bcprON},
+	{runeRange{0x00AE, 0x00AF}, bcprON},
+	{runeRange{0x0712, 0x072F}, bcprAL},
+	{runeRange{0x0B4D, 0x0B4D}, bcprNSM},
+	{runeRange{0x169B, 0x169C}, bcprON},
+	{runeRange{0x1FFD, 0x1FFE}, bcprON},
+	{runeRange{0x2D7F, 0x2D7F}, bcprNSM},
+	{runeRange{0xAAB2, 0xAAB4}, bcprNSM},
+	{runeRange{0x10101, 0x10101}, bcprON},
+	{runeRange{0x10F30, 0x10F45}, bcprAL},
+	{runeRange{0x11340, 0x11340}, bcprNSM},
+	{runeRange{0x1182F, 0x11837}, bcprNSM},
+	{runeRange{0x11D95, 0x11D95}, bcprNSM},
+	{runeRange{0x1D173, 0x1D17A}, bcprBN},
+	{runeRange{0x1E130, 0x1E136}, bcprNSM},
+	{runeRange{0x1F16A, 0x1F16F}, bcprON},
+	{runeRange{0x3FFFE, 0x3FFFF}, bcprBN},
+	{runeRange{0x002D, 0x002D}, bcprES},
+	{runeRange{0x037E, 0x037E}, bcprON},
+	{runeRange{0x066B, 0x066C}, bcprAN},
+	{runeRange{0x0825, 0x0827}, bcprNSM},
+	{runeRange{0x0A4B, 0x0A4D}, bcprNSM},
+	{runeRange{0x0C62, 0x0C63}, bcprNSM},
+	{runeRange{0x0FC6, 0x0FC6}, bcprNSM},
+	{runeRange{0x1885, 0x1886}, bcprNSM},
+	{runeRange{0x1BED, 0x1BED}, bcprNSM},
+	{runeRange{0x2045, 0x205E}, bcprON},
+	{runeRange{0x2190, 0x2211}, bcprON},
+	{runeRange{0x30A0, 0x30A0}, bcprON},
+	{runeRange{0xA8E0, 0xA8F1}, bcprNSM},
+	{runeRange{0xFBC3, 0xFBD2}, bcprON},
+	{runeRange{0xFF0B, 0xFF0B}, bcprES},
+	{runeRange{0x10A10, 0x10A37}, bcprR},
+	{runeRange{0x10E7F, 0x10EAA}, bcprR},
+	{runeRange{0x11052, 0x11065}, bcprON},
+	{runeRange{0x11234, 0x11234}, bcprNSM},
+	{runeRange{0x11438, 0x1143F}, bcprNSM},
+	{runeRange{0x116AB, 0x116AB}, bcprNSM},
+	{runeRange{0x11A01, 0x11A06}, bcprNSM},
+	{runeRange{0x11CB2, 0x11CB3}, bcprNSM},
+	{runeRange{0x11FD5, 0x11FDC}, bcprON},
+	{runeRange{0x1CCF0, 0x1CCF9}, bcprEN},
+	{runeRange{0x1D300, 0x1D356}, bcprON},
+	{runeRange{0x1DA9B, 0x1DA9F}, bcprNSM},
+	{runeRange{0x1E6EE, 0x1E6EF}, bcprNSM},
+	{runeRange{0x1F030, 0x1F093}, bcprON},
+	{runeRange{0x1F7F0, 0x1F7F0}, bcprON},
+	{runeRange{0x1FADF, 0x1FAEA}, bcprON},
+	{runeRange{0xBFFFE, 0xBFFFF}, bcprBN},
+	{runeRange{0x001C, 0x001E}, bcprB},
+	{runeRange{0x0085, 0x0085}, bcprB},
+	{runeRange{0x00F7, 0x00F7}, bcprON},
+	{runeRange{0x0590, 0x0590}, bcprR},
+	{runeRange{0x060C, 0x060C}, bcprCS},
+	{runeRange{0x06E5, 0x06E6}, bcprAL},
+	{runeRange{0x07F6, 0x07F9}, bcprON},
+	{runeRange{0x0892, 0x0896}, bcprAL},
+	{runeRange{0x09E2, 0x09E3}, bcprNSM},
+	{runeRange{0x0ACD, 0x0ACD}, bcprNSM},
+	{runeRange{0x0BFA, 0x0BFA}, bcprON},
+	{runeRange{0x0D41, 0x0D44}, bcprNSM},
+	{runeRange{0x0F37, 0x0F37}, bcprNSM},
+	{runeRange{0x1082, 0x1082}, bcprNSM},
+	{runeRange{0x17C9, 0x17D3}, bcprNSM},
+	{runeRange{0x19DE, 0x19FF}, bcprON},
+	{runeRange{0x1B42, 0x1B42}, bcprNSM},
+	{runeRange{0x1CF4, 0x1CF4}, bcprNSM},
+	{runeRange{0x202B, 0x202B}, bcprRLE},
+	{runeRange{0x2070, 0x2070}, bcprEN},
+	{runeRange{0x2127, 0x2127}, bcprON},
+	{runeRange{0x2488, 0x249B}, bcprEN},
+	{runeRange{0x3001, 0x3004}, bcprON},
+	{runeRange{0x32CC, 0x32CF}, bcprON},
+	{runeRange{0xA806, 0xA806}, bcprNSM},
+	{runeRange{0xA9E5, 0xA9E5}, bcprNSM},
+	{runeRange{0xABE8, 0xABE8}, bcprNSM},
+	{runeRange{0xFDF0, 0xFDFC}, bcprAL},
+	{runeRange{0xFE68, 0xFE68}, bcprON},
+	{runeRange{0xFF5B, 0xFF65}, bcprON},
+	{runeRange{0x10800, 0x1091E}, bcprR},
+	{runeRange{0x10B40, 0x10CFF}, bcprR},
+	{runeRange{0x10D69, 0x10D6D}, bcprNSM},
+	{runeRange{0x10ED0, 0x10ED8}, bcprON},
+	{runeRange{0x10F82, 0x10F85}, bcprNSM},
+	{runeRange{0x110B3, 0x110B6}, bcprNSM},
+	{runeRange{0x111B6, 0x111BE}, bcprNSM},
+	{runeRange{0x112DF, 0x112DF}, bcprNSM},
+	{runeRange{0x113CE, 0x113CE}, bcprNSM},
+	{runeRange{0x114B3, 0x114B8}, bcprNSM},
+	{runeRange{0x11633, 0x1163A}, bcprNSM},
+	{runeRange{0x1171D, 0x1171D}, bcprNSM},
+	{runeRange{0x11943, 0x11943}, bcprNSM},
+	{runeRange{0x11A47, 0x11A47}, bcprNSM},
+	{runeRange{0x11C30, 0x11C36}, bcprNSM},
+	{runeRange{0x11D3C, 0x11D3D}, bcprNSM},
+	{runeRange{0x11F36, 0x11F3A}, bcprNSM},
+	{runeRange{0x13447, 0x13455}, bcprNSM},
+	{runeRange{0x16FE4, 0x16FE4}, bcprNSM},
+	{runeRange{0x1CEE0, 0x1CEF0}, bcprON},
+	{runeRange{0x1D1E9, 0x1D1EA}, bcprON},
+	{runeRange{0x1D715, 0x1D715}, bcprON},
+	{runeRange{0x1DA00, 0x1DA36}, bcprNSM},
+	{runeRange{0x1E01B, 0x1E021}, bcprNSM},
+	{runeRange{0x1E4EC, 0x1E4EF}, bcprNSM},
+	{runeRange{0x1E8D7, 0x1E943}, bcprR},
+	{runeRange{0x1EEF0, 0x1EEF1}, bcprON},
+	{runeRange{0x1F0D1, 0x1F0F5}, bcprON},
+	{runeRange{0x1F6DC, 0x1F6EC}, bcprON},
+	{runeRange{0x1F860, 0x1F887}, bcprON},
+	{runeRange{0x1FA80, 0x1FA8A}, bcprON},
+	{runeRange{0x1FBF0, 0x1FBF9}, bcprEN},
+	{runeRange{0x7FFFE, 0x7FFFF}, bcprBN},
+	{runeRange{0xE01F0, 0xE0FFF}, bcprBN},
+	{runeRange{0x000B, 0x000B}, bcprS},
+	{runeRange{0x0023, 0x0025}, bcprET},
+	{runeRange{0x003B, 0x0040}, bcprON},
+	{runeRange{0x00A2, 0x00A5}, bcprET},
+	{runeRange{0x00B6, 0x00B8}, bcprON},
+	{runeRange{0x02E5, 0x02ED}, bcprON},
+	{runeRange{0x0483, 0x0489}, bcprNSM},
+	{runeRange{0x05C0, 0x05C0}, bcprR},
+	{runeRange{0x0606, 0x0607}, bcprON},
+	{runeRange{0x061B, 0x064A}, bcprAL},
+	{runeRange{0x06D6, 0x06DC}, bcprNSM},
+	{runeRange{0x06EE, 0x06EF}, bcprAL},
+	{runeRange{0x07B1, 0x07BF}, bcprAL},
+	{runeRange{0x0816, 0x0819}, bcprNSM},
+	{runeRange{0x0859, 0x085B}, bcprNSM},
+	{runeRange{0x08E2, 0x08E2}, bcprAN},
+	{runeRange{0x0981, 0x0981}, bcprNSM},
+	{runeRange{0x0A01, 0x0A02}, bcprNSM},
+	{runeRange{0x0A81, 0x0A82}, bcprNSM},
+	{runeRange{0x0B01, 0x0B01}, bcprNSM},
+	{runeRange{0x0BC0, 0x0BC0}, bcprNSM},
+	{runeRange{0x0C3E, 0x0C40}, bcprNSM},
+	{runeRange{0x0CCC, 0x0CCD}, bcprNSM},
+	{runeRange{0x0DCA, 0x0DCA}, bcprNSM},
+	{runeRange{0x0EB4, 0x0EBC}, bcprNSM},
+	{runeRange{0x0F80, 0x0F84}, bcprNSM},
+	{runeRange{0x103D, 0x103E}, bcprNSM},
+	{runeRange{0x135D, 0x135F}, bcprNSM},
+	{runeRange{0x1772, 0x1773}, bcprNSM},
+	{runeRange{0x1800, 0x180A}, bcprON},
+	{runeRange{0x1932, 0x1932}, bcprNSM},
+	{runeRange{0x1A58, 0x1A5E}, bcprNSM},
+	{runeRange{0x1B00, 0x1B03}, bcprNSM},
+	{runeRange{0x1BA8, 0x1BA9}, bcprNSM},
+	{runeRange{0x1CD0, 0x1CD2}, bcprNSM},
+	{runeRange{0x1FBF, 0x1FC1}, bcprON},
+	{runeRange{0x2010, 0x2027}, bcprON},
+	{runeRange{0x202F, 0x202F}, bcprCS},
+	{runeRange{0x2067, 0x2067}, bcprRLI},
+	{runeRange{0x2080, 0x2089}, bcprEN},
+	{runeRange{0x2114, 0x2114}, bcprON},
+	{runeRange{0x2140, 0x2144}, bcprON},
+	{runeRange{0x237B, 0x2394}, bcprON},
+	{runeRange{0x2B76, 0x2BFF}, bcprON},
+	{runeRange{0x2E9B, 0x2EF3}, bcprON},
+	{runeRange{0x3036, 0x3037}, bcprON},
+	{runeRange{0x321D, 0x321E}, bcprON},
+	{runeRange{0x4DC0, 0x4DFF}, bcprON},
+	{runeRange{0xA6F0, 0xA6F1}, bcprNSM},
+	{runeRange{0xA82C, 0xA82C}, bcprNSM},
+	{runeRange{0xA980, 0xA982}, bcprNSM},
+	{runeRange{0xAA43, 0xAA43}, bcprNSM},
+	{runeRange{0xAAEC, 0xAAED}, bcprNSM},
+	{runeRange{0xFB1F, 0xFB28}, bcprR},
+	{runeRange{0xFD90, 0xFD91}, bcprON},
+	{runeRange{0xFE20, 0xFE2F}, bcprNSM},
+	{runeRange{0xFE5F, 0xFE5F}, bcprET},
+	{runeRange{0xFEFF, 0xFEFF}, bcprBN},
+	{runeRange{0xFF10, 0xFF19}, bcprEN},
+	{runeRange{0xFFE8, 0xFFEE}, bcprON},
+	{runeRange{0x101FD, 0x101FD}, bcprNSM},
+	{runeRange{0x10A04, 0x10A04}, bcprR},
+	{runeRange{0x10A40, 0x10AE4}, bcprR},
+	{runeRange{0x10D24, 0x10D27}, bcprNSM},
+	{runeRange{0x10D40, 0x10D49}, bcprAN},
+	{runeRange{0x10D6F, 0x10E5F}, bcprR},
+	{runeRange{0x10EAD, 0x10EBF}, bcprR},
+	{runeRange{0x10EFA, 0x10EFF}, bcprNSM},
+	{runeRange{0x10F51, 0x10F6F}, bcprAL},
+	{runeRange{0x11001, 0x11001}, bcprNSM},
+	{runeRange{0x11073, 0x11074}, bcprNSM},
+	{runeRange{0x110C2, 0x110C2}, bcprNSM},
+	{runeRange{0x11173, 0x11173}, bcprNSM},
+	{runeRange{0x111CF, 0x111CF}, bcprNSM},
+	{runeRange{0x1123E, 0x1123E}, bcprNSM},
+	{runeRange{0x11300, 0x11301}, bcprNSM},
+	{runeRange{0x11370, 0x11374}, bcprNSM},
+	{runeRange{0x113D2, 0x113D2}, bcprNSM},
+	{runeRange{0x11446, 0x11446}, bcprNSM},
+	{runeRange{0x114BF, 0x114C0}, bcprNSM},
+	{runeRange{0x115BF, 0x115C0}, bcprNSM},
+	{runeRange{0x1163F, 0x11640}, bcprNSM},
+	{runeRange{0x116B0, 0x116B5}, bcprNSM},
+	{runeRange{0x11722, 0x11725}, bcprNSM},
+	{runeRange{0x1193B, 0x1193C}, bcprNSM},
+	{runeRange{0x119DA, 0x119DB}, bcprNSM},
+	{runeRange{0x11A33, 0x11A38}, bcprNSM},
+	{runeRange{0x11A59, 0x11A5B}, bcprNSM},
+	{runeRange{0x11B62, 0x11B64}, bcprNSM},
+	{runeRange{0x11C92, 0x11CA7}, bcprNSM},
+	{runeRange{0x11D31, 0x11D36}, bcprNSM},
+	{runeRange{0x11D47, 0x11D47}, bcprNSM},
+	{runeRange{0x11EF3, 0x11EF4}, bcprNSM},
+	{runeRange{0x11F42, 0x11F42}, bcprNSM},
+	{runeRange{0x11FE1, 0x11FF1}, bcprON},
+	{runeRange{0x1612D, 0x1612F}, bcprNSM},
+	{runeRange{0x16F8F, 0x16F92}, bcprNSM},
+	{runeRange{0x1BCA0, 0x1BCA3}, bcprBN},
+	{runeRange{0x1CD00, 0x1CEB3}, bcprON},
+	{runeRange{0x1CF30, 0x1CF46}, bcprNSM},
+	{runeRange{0x1D185, 0x1D18B}, bcprNSM},
+	{runeRange{0x1D242, 0x1D244}, bcprNSM},
+	{runeRange{0x1D6DB, 0x1D6DB}, bcprON},
+	{runeRange{0x1D74F, 0x1D74F}, bcprON},
+	{runeRange{0x1D7C3, 0x1D7C3}, bcprON},
+	{runeRange{0x1DA75, 0x1DA75}, bcprNSM},
+	{runeRange{0x1E000, 0x1E006}, bcprNSM},
+	{runeRange{0x1E026, 0x1E02A}, bcprNSM},
+	{runeRange{0x1E2EC, 0x1E2EF}, bcprNSM},
+	{runeRange{0x1E6E3, 0x1E6E3}, bcprNSM},
+	{runeRange{0x1E800, 0x1E8CF}, bcprR},
+	{runeRange{0x1E94B, 0x1EC6F}, bcprR},
+	{runeRange{0x1ED50, 0x1EDFF}, bcprR},
+	{runeRange{0x1EF00, 0x1EFFF}, bcprR},
+	{runeRange{0x1F0B1, 0x1F0BF}, bcprON},
+	{runeRange{0x1F10B, 0x1F10F}, bcprON},
+	{runeRange{0x1F260, 0x1F265}, bcprON},
+	{runeRange{0x1F700, 0x1F7D9}, bcprON},
+	{runeRange{0x1F810, 0x1F847}, bcprON},
+	{runeRange{0x1F8B0, 0x1F8BB}, bcprON},
+	{runeRange{0x1FA60, 0x1FA6D}, bcprON},
+	{runeRange{0x1FAC8, 0x1FAC8}, bcprON},
+	{runeRange{0x1FB00, 0x1FB92}, bcprON},
+	{runeRange{0x1FFFE, 0x1FFFF}, bcprBN},
+	{runeRange{0x5FFFE, 0x5FFFF}, bcprBN},
+	{runeRange{0x9FFFE, 0x9FFFF}, bcprBN},
+	{runeRange{0xDFFFE, 0xE00FF}, bcprBN},
+	{runeRange{0xFFFFE, 0xFFFFF}, bcprBN},
+	{runeRange{0x0009, 0x0009}, bcprS},
+	{runeRange{0x000D, 0x000D}, bcprB},
+	{runeRange{0x0020, 0x0020}, bcprWS},
+	{runeRange{0x002B, 0x002B}, bcprES},
+	{runeRange{0x0030, 0x0039}, bcprEN},
+	{runeRange{0x007B, 0x007E}, bcprON},
+	{runeRange{0x00A0, 0x00A0}, bcprCS},
+	{runeRange{0x00AB, 0x00AC}, bcprON},
+	{runeRange{0x00B2, 0x00B3}, bcprEN},
+	{runeRange{0x00BB, 0x00BF}, bcprON},
+	{runeRange{0x02C2, 0x02CF}, bcprON},
+	{runeRange{0x0300, 0x036F}, bcprNSM},
+	{runeRange{0x0387, 0x0387}, bcprON},
+	{runeRange{0x058D, 0x058E}, bcprON},
+	{runeRange{0x05BE, 0x05BE}, bcprR},
+	{runeRange{0x05C3, 0x05C3}, bcprR},
+	{runeRange{0x05C8, 0x05FF}, bcprR},
+	{runeRange{0x0609, 0x060A}, bcprET},
+	{runeRange{0x060E, 0x060F}, bcprON},
+	{runeRange{0x0660, 0x0669}, bcprAN},
+	{runeRange{0x0670, 0x0670}, bcprNSM},
+	{runeRange{0x06DE, 0x06DE}, bcprON},
+	{runeRange{0x06E9, 0x06E9}, bcprON},
+	{runeRange{0x06FA, 0x0710}, bcprAL},
+	{runeRange{0x074B, 0x07A5}, bcprAL},
+	{runeRange{0x07EB, 0x07F3}, bcprNSM},
+	{runeRange{0x07FD, 0x07FD}, bcprNSM},
+	{runeRange{0x081B, 0x0823}, bcprNSM},
+	{runeRange{0x0829, 0x082D}, bcprNSM},
+	{runeRange{0x0860, 0x088F}, bcprAL},
+	{runeRange{0x08A0, 0x08C9}, bcprAL},
+	{runeRange{0x093A, 0x093A}, bcprNSM},
+	{runeRange{0x0951, 0x0957}, bcprNSM},
+	{runeRange{0x09C1, 0x09C4}, bcprNSM},
+	{runeRange{0x09FB, 0x09FB}, bcprET},
+	{runeRange{0x0A41, 0x0A42}, bcprNSM},
+	{runeRange{0x0A70, 0x0A71}, bcprNSM},
+	{runeRange{0x0AC1, 0x0AC5}, bcprNSM},
+	{runeRange{0x0AF1, 0x0AF1}, bcprET},
+	{runeRange{0x0B3F, 0x0B3F}, bcprNSM},
+	{runeRange{0x0B62, 0x0B63}, bcprNSM},
+	{runeRange{0x0BF3, 0x0BF8}, bcprON},
+	{runeRange{0x0C04, 0x0C04}, bcprNSM},
+	{runeRange{0x0C4A, 0x0C4D}, bcprNSM},
+	{runeRange{0x0C81, 0x0C81}, bcprNSM},
+	{runeRange{0x0D00, 0x0D01}, bcprNSM},
+	{runeRange{0x0D62, 0x0D63}, bcprNSM},
+	{runeRange{0x0DD6, 0x0DD6}, bcprNSM},
+	{runeRange{0x0E47, 0x0E4E}, bcprNSM},
+	{runeRange{0x0F18, 0x0F19}, bcprNSM},
+	{runeRange{0x0F3A, 0x0F3D}, bcprON},
+	{runeRange{0x0F8D, 0x0F97}, bcprNSM},
+	{runeRange{0x1032, 0x1037}, bcprNSM},
+	{runeRange{0x105E, 0x1060}, bcprNSM},
+	{runeRange{0x108D, 0x108D}, bcprNSM},
+	{runeRange{0x1400, 0x1400}, bcprON},
+	{runeRange{0x1732, 0x1733}, bcprNSM},
+	{runeRange{0x17B7, 0x17BD}, bcprNSM},
+	{runeRange{0x17DD, 0x17DD}, bcprNSM},
+	{runeRange{0x180E, 0x180E}, bcprBN},
+	{runeRange{0x1920, 0x1922}, bcprNSM},
+	{runeRange{0x1940, 0x1940}, bcprON},
+	{runeRange{0x1A1B, 0x1A1B}, bcprNSM},
+	{runeRange{0x1A62, 0x1A62}, bcprNSM},
+	{runeRange{0x1AB0, 0x1ADD}, bcprNSM},
+	{runeRange{0x1B36, 0x1B3A}, bcprNSM},
+	{runeRange{0x1B80, 0x1B81}, bcprNSM},
+	{runeRange{0x1BE6, 0x1BE6}, bcprNSM},
+	{runeRange{0x1C2C, 0x1C33}, bcprNSM},
+	{runeRange{0x1CE2, 0x1CE8}, bcprNSM},
+	{runeRange{0x1DC0, 0x1DFF}, bcprNSM},
+	{runeRange{0x1FDD, 0x1FDF}, bcprON},
+	{runeRange{0x200B, 0x200D}, bcprBN},
+	{runeRange{0x2029, 0x2029}, bcprB},
+	{runeRange{0x202D, 0x202D}, bcprLRO},
+	{runeRange{0x2035, 0x2043}, bcprON},
+	{runeRange{0x2060, 0x2065}, bcprBN},
+	{runeRange{0x2069, 0x2069}, bcprPDI},
+	{runeRange{0x207A, 0x207B}, bcprES},
+	{runeRange{0x208C, 0x208E}, bcprON},
+	{runeRange{0x2103, 0x2106}, bcprON},
+	{runeRange{0x211E, 0x2123}, bcprON},
+	{runeRange{0x212E, 0x212E}, bcprET},
+	{runeRange{0x2150, 0x215F}, bcprON},
+	{runeRange{0x2213, 0x2213}, bcprET},
+	{runeRange{0x2440, 0x244A}, bcprON},
+	{runeRange{0x26AD, 0x27FF}, bcprON},
+	{runeRange{0x2CEF, 0x2CF1}, bcprNSM},
+	{runeRange{0x2E00, 0x2E5D}, bcprON},
+	{runeRange{0x2FF0, 0x2FFF}, bcprON},
+	{runeRange{0x302A, 0x302D}, bcprNSM},
+	{runeRange{0x3099, 0x309A}, bcprNSM},
+	{runeRange{0x31C0, 0x31E5}, bcprON},
+	{runeRange{0x327C, 0x327E}, bcprON},
+	{runeRange{0x33DE, 0x33DF}, bcprON},
+	{runeRange{0xA60D, 0xA60F}, bcprON},
+	{runeRange{0xA67E, 0xA67F}, bcprON},
+	{runeRange{0xA788, 0xA788}, bcprON},
+	{runeRange{0xA825, 0xA826}, bcprNSM},
+	{runeRange{0xA874, 0xA877}, bcprON},
+	{runeRange{0xA926, 0xA92D}, bcprNSM},
+	{runeRange{0xA9B6, 0xA9B9}, bcprNSM},
+	{runeRange{0xAA31, 0xAA32}, bcprNSM},
+	{runeRange{0xAA7C, 0xAA7C}, bcprNSM},
+	{runeRange{0xAABE, 0xAABF}, bcprNSM},
+	{runeRange{0xAB6A, 0xAB6B}, bcprON},
+	{runeRange{0xFB1D, 0xFB1D}, bcprR},
+	{runeRange{0xFB2A, 0xFB4F}, bcprR},
+	{runeRange{0xFD3E, 0xFD4F}, bcprON},
+	{runeRange{0xFDC8, 0xFDCF}, bcprON},
+	{runeRange{0xFE00, 0xFE0F}, bcprNSM},
+	{runeRange{0xFE50, 0xFE50}, bcprCS},
+	{runeRange{0xFE55, 0xFE55}, bcprCS},
+	{runeRange{0xFE62, 0xFE63}, bcprES},
+	{runeRange{0xFE6B, 0xFE6B}, bcprON},
+	{runeRange{0xFF03, 0xFF05}, bcprET},
+	{runeRange{0xFF0D, 0xFF0D}, bcprES},
+	{runeRange{0xFF1B, 0xFF20}, bcprON},
+	{runeRange{0xFFE2, 0xFFE4}, bcprON},
+	{runeRange{0xFFF9, 0xFFFD}, bcprON},
+	{runeRange{0x10190, 0x1019C}, bcprON},
+	{runeRange{0x102E1, 0x102FB}, bcprEN},
+	{runeRange{0x10920, 0x10A00}, bcprR},
+	{runeRange{0x10A07, 0x10A0B}, bcprR},
+	{runeRange{0x10A3B, 0x10A3E}, bcprR},
+	{runeRange{0x10AE7, 0x10B38}, bcprR},
+	{runeRange{0x10D00, 0x10D23}, bcprAL},
+	{runeRange{0x10D28, 0x10D2F}, bcprAL},
+	{runeRange{0x10D3A, 0x10D3F}, bcprAL},
+	{runeRange{0x10D4A, 0x10D68}, bcprR},
+	{runeRange{0x10D6E, 0x10D6E}, bcprON},
+	{runeRange{0x10E60, 0x10E7E}, bcprAN},
+	{runeRange{0x10EAB, 0x10EAC}, bcprNSM},
+	{runeRange{0x10EC0, 0x10ECF}, bcprAL},
+	{runeRange{0x10ED9, 0x10EF9}, bcprAL},
+	{runeRange{0x10F00, 0x10F2F}, bcprR},
+	{runeRange{0x10F46, 0x10F50}, bcprNSM},
+	{runeRange{0x10F70, 0x10F81}, bcprR},
+	{runeRange{0x10F86, 0x10FFF}, bcprR},
+	{runeRange{0x11038, 0x11046}, bcprNSM},
+	{runeRange{0x11070, 0x11070}, bcprNSM},
+	{runeRange{0x1107F, 0x11081}, bcprNSM},
+	{runeRange{0x110B9, 0x110BA}, bcprNSM},
+	{runeRange{0x11100, 0x11102}, bcprNSM},
+	{runeRange{0x1112D, 0x11134}, bcprNSM},
+	{runeRange{0x11180, 0x11181}, bcprNSM},
+	{runeRange{0x111C9, 0x111CC}, bcprNSM},
+	{runeRange{0x1122F, 0x11231}, bcprNSM},
+	{runeRange{0x11236, 0x11237}, bcprNSM},
+	{runeRange{0x11241, 0x11241}, bcprNSM},
+	{runeRange{0x112E3, 0x112EA}, bcprNSM},
+	{runeRange{0x1133B, 0x1133C}, bcprNSM},
+	{runeRange{0x11366, 0x1136C}, bcprNSM},
+	{runeRange{0x113BB, 0x113C0}, bcprNSM},
+	{runeRange{0x113D0, 0x113D0}, bcprNSM},
+	{runeRange{0x113E1, 0x113E2}, bcprNSM},
+	{runeRange{0x11442, 0x11444}, bcprNSM},
+	{runeRange{0x1145E, 0x1145E}, bcprNSM},
+	{runeRange{0x114BA, 0x114BA}, bcprNSM},
+	{runeRange{0x114C2, 0x114C3}, bcprNSM},
+	{runeRange{0x115BC, 0x115BD}, bcprNSM},
+	{runeRange{0x115DC, 0x115DD}, bcprNSM},
+	{runeRange{0x1163D, 0x1163D}, bcprNSM},
+	{runeRange{0x11660, 0x1166C}, bcprON},
+	{runeRange{0x116AD, 0x116AD}, bcprNSM},
+	{runeRange{0x116B7, 0x116B7}, bcprNSM},
+	{runeRange{0x1171F, 0x1171F}, bcprNSM},
+	{runeRange{0x11727, 0x1172B}, bcprNSM},
+	{runeRange{0x11839, 0x1183A}, bcprNSM},
+	{runeRange{0x1193E, 0x1193E}, bcprNSM},
+	{runeRange{0x119D4, 0x119D7}, bcprNSM},
+	{runeRange{0x119E0, 0x119E0}, bcprNSM},
+	{runeRange{0x11A09, 0x11A0A}, bcprNSM},
+	{runeRange{0x11A3B, 0x11A3E}, bcprNSM},
+	{runeRange{0x11A51, 0x11A56}, bcprNSM},
+	{runeRange{0x11A8A, 0x11A96}, bcprNSM},
+	{runeRange{0x11B60, 0x11B60}, bcprNSM},
+	{runeRange{0x11B66, 0x11B66}, bcprNSM},
+	{runeRange{0x11C38, 0x11C3D}, bcprNSM},
+	{runeRange{0x11CAA, 0x11CB0}, bcprNSM},
+	{runeRange{0x11CB5, 0x11CB6}, bcprNSM},
+	{runeRange{0x11D3A, 0x11D3A}, bcprNSM},
+	{runeRange{0x11D3F, 0x11D45}, bcprNSM},
+	{runeRange{0x11D90, 0x11D91}, bcprNSM},
+	{runeRange{0x11D97, 0x11D97}, bcprNSM},
+	{runeRange{0x11F00, 0x11F01}, bcprNSM},
+	{runeRange{0x11F40, 0x11F40}, bcprNSM},
+	{runeRange{0x11F5A, 0x11F5A}, bcprNSM},
+	{runeRange{0x11FDD, 0x11FE0}, bcprET},
+	{runeRange{0x13440, 0x13440}, bcprNSM},
+	{runeRange{0x1611E, 0x16129}, bcprNSM},
+	{runeRange{0x16AF0, 0x16AF4}, bcprNSM},
+	{runeRange{0x16F4F, 0x16F4F}, bcprNSM},
+	{runeRange{0x16FE2, 0x16FE2}, bcprON},
+	{runeRange{0x1BC9D, 0x1BC9E}, bcprNSM},
+	{runeRange{0x1CC00, 0x1CCD5}, bcprON},
+	{runeRange{0x1CCFA, 0x1CCFC}, bcprON},
+	{runeRange{0x1CEBA, 0x1CED0}, bcprON},
+	{runeRange{0x1CF00, 0x1CF2D}, bcprNSM},
+	{runeRange{0x1D167, 0x1D169}, bcprNSM},
+	{runeRange{0x1D17B, 0x1D182}, bcprNSM},
+	{runeRange{0x1D1AA, 0x1D1AD}, bcprNSM},
+	{runeRange{0x1D200, 0x1D241}, bcprON},
+	{runeRange{0x1D245, 0x1D245}, bcprON},
+	{runeRange{0x1D6C1, 0x1D6C1}, bcprON},
+	{runeRange{0x1D6FB, 0x1D6FB}, bcprON},
+	{runeRange{0x1D735, 0x1D735}, bcprON},
+	{runeRange{0x1D76F, 0x1D76F}, bcprON},
+	{runeRange{0x1D7A9, 0x1D7A9}, bcprON},
+	{runeRange{0x1D7CE, 0x1D7FF}, bcprEN},
+	{runeRange{0x1DA3B, 0x1DA6C}, bcprNSM},
+	{runeRange{0x1DA84, 0x1DA84}, bcprNSM},
+	{runeRange{0x1DAA1, 0x1DAAF}, bcprNSM},
+	{runeRange{0x1E008, 0x1E018}, bcprNSM},
+	{runeRange{0x1E023, 0x1E024}, bcprNSM},
+	{runeRange{0x1E08F, 0x1E08F}, bcprNSM},
+	{runeRange{0x1E2AE, 0x1E2AE}, bcprNSM},
+	{runeRange{0x1E2FF, 0x1E2FF}, bcprET},
+	{runeRange{0x1E5EE, 0x1E5EF}, bcprNSM},
+	{runeRange{0x1E6E6, 0x1E6E6}, bcprNSM},
+	{runeRange{0x1E6F5, 0x1E6F5}, bcprNSM},
+	{runeRange{0x1E8D0, 0x1E8D6}, bcprNSM},
+	{runeRange{0x1E944, 0x1E94A}, bcprNSM},
+	{runeRange{0x1EC70, 0x1ECBF}, bcprAL},
+	{runeRange{0x1ED00, 0x1ED4F}, bcprAL},
+	{runeRange{0x1EE00, 0x1EEEF}, bcprAL},
+	{runeRange{0x1EEF2, 0x1EEFF}, bcprAL},
+	{runeRange{0x1F000, 0x1F02B}, bcprON},
+	{runeRange{0x1F0A0, 0x1F0AE}, bcprON},
+	{runeRange{0x1F0C1, 0x1F0CF}, bcprON},
+	{runeRange{0x1F100, 0x1F10A}, bcprEN},
+	{runeRange{0x1F12F, 0x1F12F}, bcprON},
+	{runeRange{0x1F1AD, 0x1F1AD}, bcprON},
+	{runeRange{0x1F300, 0x1F6D8}, bcprON},
+	{runeRange{0x1F6F0, 0x1F6FC}, bcprON},
+	{runeRange{0x1F7E0, 0x1F7EB}, bcprON},
+	{runeRange{0x1F800, 0x1F80B}, bcprON},
+	{runeRange{0x1F850, 0x1F859}, bcprON},
+	{runeRange{0x1F890, 0x1F8AD}, bcprON},
+	{runeRange{0x1F8C0, 0x1F8C1}, bcprON},
+	{runeRange{0x1F900, 0x1FA57}, bcprON},
+	{runeRange{0x1FA70, 0x1FA7C}, bcprON},
+	{runeRange{0x1FA8E, 0x1FAC6}, bcprON},
+	{runeRange{0x1FACD, 0x1FADC}, bcprON},
+	{runeRange{0x1FAEF, 0x1FAF8}, bcprON},
+	{runeRange{0x1FB94, 0x1FBEF}, bcprON},
+	{runeRange{0x1FBFA, 0x1FBFA}, bcprON},
+	{runeRange{0x2FFFE, 0x2FFFF}, bcprBN},
+	{runeRange{0x4FFFE, 0x4FFFF}, bcprBN},
+	{runeRange{0x6FFFE, 0x6FFFF}, bcprBN},
+	{runeRange{0x8FFFE, 0x8FFFF}, bcprBN},
+	{runeRange{0xAFFFE, 0xAFFFF}, bcprBN},
+	{runeRange{0xCFFFE, 0xCFFFF}, bcprBN},
+	{runeRange{0xE0100, 0xE01EF}, bcprNSM},
+	{runeRange{0xEFFFE, 0xEFFFF}, bcprBN},
+	{runeRange{0x10FFFE, 0x10FFFF}, bcprBN},
+	{runeRange{0x0000, 0x0008}, bcprBN},
+	{runeRange{0x000A, 0x000A}, bcprB},
+	{runeRange{0x000C, 0x000C}, bcprWS},
+	{runeRange{0x000E, 0x001B}, bcprBN},
+	{runeRange{0x001F, 0x001F}, bcprS},
+	{runeRange{0x0021, 0x0022}, bcprON},
+	{runeRange{0x0026, 0x002A}, bcprON},
+	{runeRange{0x002C, 0x002C}, bcprCS},
+	{runeRange{0x002E, 0x002F}, bcprCS},
+	{runeRange{0x003A, 0x003A}, bcprCS},
+	{runeRange{0x005B, 0x0060}, bcprON},
+	{runeRange{0x007F, 0x0084}, bcprBN},
+	{runeRange{0x0086, 0x009F}, bcprBN},
+	{runeRange{0x00A1, 0x00A1}, bcprON},
+	{runeRange{0x00A6, 0x00A9}, bcprON},
+	{runeRange{0x00AD, 0x00AD}, bcprBN},
+	{runeRange{0x00B0, 0x00B1}, bcprET},
+	{runeRange{0x00B4, 0x00B4}, bcprON},
+	{runeRange{0x00B9, 0x00B9}, bcprEN},
+	{runeRange{0x00D7, 0x00D7}, bcprON},
+	{runeRange{0x02B9, 0x02BA}, bcprON},
+	{runeRange{0x02D2, 0x02DF}, bcprON},
+	{runeRange{0x02EF, 0x02FF}, bcprON},
+	{runeRange{0x0374, 0x0375}, bcprON},
+	{runeRange{0x0384, 0x0385}, bcprON},
+	{runeRange{0x03F6, 0x03F6}, bcprON},
+	{runeRange{0x058A, 0x058A}, bcprON},
+	{runeRange{0x058F, 0x058F}, bcprET},
+	{runeRange{0x0591, 0x05BD}, bcprNSM},
+	{runeRange{0x05BF, 0x05BF}, bcprNSM},
+	{runeRange{0x05C1, 0x05C2}, bcprNSM},
+	{runeRange{0x05C4, 0x05C5}, bcprNSM},
+	{runeRange{0x05C7, 0x05C7}, bcprNSM},
+	{runeRange{0x0600, 0x0605}, bcprAN},
+	{runeRange{0x0608, 0x0608}, bcprAL},
+	{runeRange{0x060B, 0x060B}, bcprAL},
+	{runeRange{0x060D, 0x060D}, bcprAL},
+	{runeRange{0x0610, 0x061A}, bcprNSM},
+	{runeRange{0x064B, 0x065F}, bcprNSM},
+	{runeRange{0x066A, 0x066A}, bcprET},
+	{runeRange{0x066D, 0x066F}, bcprAL},
+	{runeRange{0x0671, 0x06D5}, bcprAL},
+	{runeRange{0x06DD, 0x06DD}, bcprAN},
+	{runeRange{0x06DF, 0x06E4}, bcprNSM},
+	{runeRange{0x06E7, 0x06E8}, bcprNSM},
+	{runeRange{0x06EA, 0x06ED}, bcprNSM},
+	{runeRange{0x06F0, 0x06F9}, bcprEN},
+	{runeRange{0x0711, 0x0711}, bcprNSM},
+	{runeRange{0x0730, 0x074A}, bcprNSM},
+	{runeRange{0x07A6, 0x07B0}, bcprNSM},
+	{runeRange{0x07C0, 0x07EA}, bcprR},
+	{runeRange{0x07F4, 0x07F5}, bcprR},
+	{runeRange{0x07FA, 0x07FC}, bcprR},
+	{runeRange{0x07FE, 0x0815}, bcprR},
+	{runeRange{0x081A, 0x081A}, bcprR},
+	{runeRange{0x0824, 0x0824}, bcprR},
+	{runeRange{0x0828, 0x0828}, bcprR},
+	{runeRange{0x082E, 0x0858}, bcprR},
+	{runeRange{0x085C, 0x085F}, bcprR},
+	{runeRange{0x0890, 0x0891}, bcprAN},
+	{runeRange{0x0897, 0x089F}, bcprNSM},
+	{runeRange{0x08CA, 0x08E1}, bcprNSM},
+	{runeRange{0x08E3, 0x0902}, bcprNSM},
+	{runeRange{0x093C, 0x093C}, bcprNSM},
+	{runeRange{0x094D, 0x094D}, bcprNSM},
+	{runeRange{0x0962, 0x0963}, bcprNSM},
+	{runeRange{0x09BC, 0x09BC}, bcprNSM},
+	{runeRange{0x09CD, 0x09CD}, bcprNSM},
+	{runeRange{0x09F2, 0x09F3}, bcprET},
+	{runeRange{0x09FE, 0x09FE}, bcprNSM},
+	{runeRange{0x0A3C, 0x0A3C}, bcprNSM},
+	{runeRange{0x0A47, 0x0A48}, bcprNSM},
+	{runeRange{0x0A51, 0x0A51}, bcprNSM},
+	{runeRange{0x0A75, 0x0A75}, bcprNSM},
+	{runeRange{0x0ABC, 0x0ABC}, bcprNSM},
+	{runeRange{0x0AC7, 0x0AC8}, bcprNSM},
+	{runeRange{0x0AE2, 0x0AE3}, bcprNSM},
+	{runeRange{0x0AFA, 0x0AFF}, bcprNSM},
+	{runeRange{0x0B3C, 0x0B3C}, bcprNSM},
+	{runeRange{0x0B41, 0x0B44}, bcprNSM},
+	{runeRange{0x0B55, 0x0B56}, bcprNSM},
+	{runeRange{0x0B82, 0x0B82}, bcprNSM},
+	{runeRange{0x0BCD, 0x0BCD}, bcprNSM},
+	{runeRange{0x0BF9, 0x0BF9}, bcprET},
+	{runeRange{0x0C00, 0x0C00}, bcprNSM},
+	{runeRange{0x0C3C, 0x0C3C}, bcprNSM},
+	{runeRange{0x0C46, 0x0C48}, bcprNSM},
+	{runeRange{0x0C55, 0x0C56}, bcprNSM},
+	{runeRange{0x0C78, 0x0C7E}, bcprON},
+	{runeRange{0x0CBC, 0x0CBC}, bcprNSM},
+	{runeRange{0x0CE2, 0x0CE3}, bcprNSM},
+	{runeRange{0x0D3B, 0x0D3C}, bcprNSM},
+	{runeRange{0x0D4D, 0x0D4D}, bcprNSM},
+	{runeRange{0x0D81, 0x0D81}, bcprNSM},
+	{runeRange{0x0DD2, 0x0DD4}, bcprNSM},
+	{runeRange{0x0E31, 0x0E31}, bcprNSM},
+	{runeRange{0x0E3F, 0x0E3F}, bcprET},
+	{runeRange{0x0EB1, 0x0EB1}, bcprNSM},
+	{runeRange{0x0EC8, 0x0ECE}, bcprNSM},
+	{runeRange{0x0F35, 0x0F35}, bcprNSM},
+	{runeRange{0x0F39, 0x0F39}, bcprNSM},
+	{runeRange{0x0F71, 0x0F7E}, bcprNSM},
+	{runeRange{0x0F86, 0x0F87}, bcprNSM},
+	{runeRange{0x0F99, 0x0FBC}, bcprNSM},
+	{runeRange{0x102D, 0x1030}, bcprNSM},
+	{runeRange{0x1039, 0x103A}, bcprNSM},
+	{runeRange{0x1058, 0x1059}, bcprNSM},
+	{runeRange{0x1071, 0x1074}, bcprNSM},
+	{runeRange{0x1085, 0x1086}, bcprNSM},
+	{runeRange{0x109D, 0x109D}, bcprNSM},
+	{runeRange{0x1390, 0x1399}, bcprON},
+	{runeRange{0x1680, 0x1680}, bcprWS},
+	{runeRange{0x1712, 0x1714}, bcprNSM},
+	{runeRange{0x1752, 0x1753}, bcprNSM},
+	{runeRange{0x17B4, 0x17B5}, bcprNSM},
+	{runeRange{0x17C6, 0x17C6}, bcprNSM},
+	{runeRange{0x17DB, 0x17DB}, bcprET},
+	{runeRange{0x17F0, 0x17F9}, bcprON},
+	{runeRange{0x180B, 0x180D}, bcprNSM},
+	{runeRange{0x180F, 0x180F}, bcprNSM},
+	{runeRange{0x18A9, 0x18A9}, bcprNSM},
+	{runeRange{0x1927, 0x1928}, bcprNSM},
+	{runeRange{0x1939, 0x193B}, bcprNSM},
+	{runeRange{0x1944, 0x1945}, bcprON},
+	{runeRange{0x1A17, 0x1A18}, bcprNSM},
+	{runeRange{0x1A56, 0x1A56}, bcprNSM},
+	{runeRange{0x1A60, 0x1A60}, bcprNSM},
+	{runeRange{0x1A65, 0x1A6C}, bcprNSM},
+	{runeRange{0x1A7F, 0x1A7F}, bcprNSM},
+	{runeRange{0x1AE0, 0x1AEB}, bcprNSM},
+	{runeRange{0x1B34, 0x1B34}, bcprNSM},
+	{runeRange{0x1B3C, 0x1B3C}, bcprNSM},
+	{runeRange{0x1B6B, 0x1B73}, bcprNSM},
+	{runeRange{0x1BA2, 0x1BA5}, bcprNSM},
+	{runeRange{0x1BAB, 0x1BAD}, bcprNSM},
+	{runeRange{0x1BE8, 0x1BE9}, bcprNSM},
+	{runeRange{0x1BEF, 0x1BF1}, bcprNSM},
+	{runeRange{0x1C36, 0x1C37}, bcprNSM},
+	{runeRange{0x1CD4, 0x1CE0}, bcprNSM},
+	{runeRange{0x1CED, 0x1CED}, bcprNSM},
+	{runeRange{0x1CF8, 0x1CF9}, bcprNSM},
+	{runeRange{0x1FBD, 0x1FBD}, bcprON},
+	{runeRange{0x1FCD, 0x1FCF}, bcprON},
+	{runeRange{0x1FED, 0x1FEF}, bcprON},
+	{runeRange{0x2000, 0x200A}, bcprWS},
+	{runeRange{0x200F, 0x200F}, bcprR},
+	{runeRange{0x2028, 0x2028}, bcprWS},
+	{runeRange{0x202A, 0x202A}, bcprLRE},
+	{runeRange{0x202C, 0x202C}, bcprPDF},
+	{runeRange{0x202E, 0x202E}, bcprRLO},
+	{runeRange{0x2030, 0x2034}, bcprET},
+	{runeRange{0x2044, 0x2044}, bcprCS},
+	{runeRange{0x205F, 0x205F}, bcprWS},
+	{runeRange{0x2066, 0x2066}, bcprLRI},
+	{runeRange{0x2068, 0x2068}, bcprFSI},
+	{runeRange{0x206A, 0x206F}, bcprBN},
+	{runeRange{0x2074, 0x2079}, bcprEN},
+	{runeRange{0x207C, 0x207E}, bcprON},
+	{runeRange{0x208A, 0x208B}, bcprES},
+	{runeRange{0x20A0, 0x20CF}, bcprET},
+	{runeRange{0x2100, 0x2101}, bcprON},
+	{runeRange{0x2108, 0x2109}, bcprON},
+	{runeRange{0x2116, 0x2118}, bcprON},
+	{runeRange{0x2125, 0x2125}, bcprON},
+	{runeRange{0x2129, 0x2129}, bcprON},
+	{runeRange{0x213A, 0x213B}, bcprON},
+	{runeRange{0x214A, 0x214D}, bcprON},
+	{runeRange{0x2189, 0x218B}, bcprON},
+	{runeRange{0x2212, 0x2212}, bcprES},
+	{runeRange{0x2214, 0x2335}, bcprON},
+	{runeRange{0x2396, 0x2429}, bcprON},
+	{runeRange{0x2460, 0x2487}, bcprON},
+	{runeRange{0x24EA, 0x26AB}, bcprON},
+	{runeRange{0x2900, 0x2B73}, bcprON},
+	{runeRange{0x2CE5, 0x2CEA}, bcprON},
+	{runeRange{0x2CF9, 0x2CFF}, bcprON},
+	{runeRange{0x2DE0, 0x2DFF}, bcprNSM},
+	{runeRange{0x2E80, 0x2E99}, bcprON},
+	{runeRange{0x2F00, 0x2FD5}, bcprON},
+	{runeRange{0x3000, 0x3000}, bcprWS},
+	{runeRange{0x3008, 0x3020}, bcprON},
+	{runeRange{0x3030, 0x3030}, bcprON},
+	{runeRange{0x303D, 0x303F}, bcprON},
+	{runeRange{0x309B, 0x309C}, bcprON},
+	{runeRange{0x30FB, 0x30FB}, bcprON},
+	{runeRange{0x31EF, 0x31EF}, bcprON},
+	{runeRange{0x3250, 0x325F}, bcprON},
+	{runeRange{0x32B1, 0x32BF}, bcprON},
+	{runeRange{0x3377, 0x337A}, bcprON},
+	{runeRange{0x33FF, 0x33FF}, bcprON},
+	{runeRange{0xA490, 0xA4C6}, bcprON},
+	{runeRange{0xA66F, 0xA672}, bcprNSM},
+	{runeRange{0xA674, 0xA67D}, bcprNSM},
+	{runeRange{0xA69E, 0xA69F}, bcprNSM},
+	{runeRange{0xA700, 0xA721}, bcprON},
+	{runeRange{0xA802, 0xA802}, bcprNSM},
+	{runeRange{0xA80B, 0xA80B}, bcprNSM},
+	{runeRange{0xA828, 0xA82B}, bcprON},
+	{runeRange{0xA838, 0xA839}, bcprET},
+	{runeRange{0xA8C4, 0xA8C5}, bcprNSM},
+	{runeRange{0xA8FF, 0xA8FF}, bcprNSM},
+	{runeRange{0xA947, 0xA951}, bcprNSM},
+	{runeRange{0xA9B3, 0xA9B3}, bcprNSM},
+	{runeRange{0xA9BC, 0xA9BD}, bcprNSM},
+	{runeRange{0xAA29, 0xAA2E}, bcprNSM},
+	{runeRange{0xAA35, 0xAA36}, bcprNSM},
+	{runeRange{0xAA4C, 0xAA4C}, bcprNSM},
+	{runeRange{0xAAB0, 0xAAB0}, bcprNSM},
+	{runeRange{0xAAB7, 0xAAB8}, bcprNSM},
+	{runeRange{0xAAC1, 0xAAC1}, bcprNSM},
+	{runeRange{0xAAF6, 0xAAF6}, bcprNSM},
+	{runeRange{0xABE5, 0xABE5}, bcprNSM},
+	{runeRange{0xABED, 0xABED}, bcprNSM},
+	{runeRange{0xFB1E, 0xFB1E}, bcprNSM},
+	{runeRange{0xFB29, 0xFB29}, bcprES},
+	{runeRange{0xFB50, 0xFBC2}, bcprAL},
+	{runeRange{0xFBD3, 0xFD3D}, bcprAL},
+	{runeRange{0xFD50, 0xFD8F}, bcprAL},
+	{runeRange{0xFD92, 0xFDC7}, bcprAL},
+	{runeRange{0xFDD0, 0xFDEF}, bcprBN},
+	{runeRange{0xFDFD, 0xFDFF}, bcprON},
+	{runeRange{0xFE10, 0xFE19}, bcprON},
+	{runeRange{0xFE30, 0xFE4F}, bcprON},
+	{runeRange{0xFE51, 0xFE51}, bcprON},
+	{runeRange{0xFE54, 0xFE54}, bcprON},
+	{runeRange{0xFE56, 0xFE5E}, bcprON},
+	{runeRange{0xFE60, 0xFE61}, bcprON},
+	{runeRange{0xFE64, 0xFE66}, bcprON},
+	{runeRange{0xFE69, 0xFE6A}, bcprET},
+	{runeRange{0xFE70, 0xFEFE}, bcprAL},
+	{runeRange{0xFF01, 0xFF02}, bcprON},
+	{runeRange{0xFF06, 0xFF0A}, bcprON},
+	{runeRange{0xFF0C, 0xFF0C}, bcprCS},
+	{runeRange{0xFF0E, 0xFF0F}, bcprCS},
+	{runeRange{0xFF1A, 0xFF1A}, bcprCS},
+	{runeRange{0xFF3B, 0xFF40}, bcprON},
+	{runeRange{0xFFE0, 0xFFE1}, bcprET},
+	{runeRange{0xFFE5, 0xFFE6}, bcprET},
+	{runeRange{0xFFF0, 0xFFF8}, bcprBN},
+	{runeRange{0xFFFE, 0xFFFF}, bcprBN},
+	{runeRange{0x10140, 0x1018C}, bcprON},
+	{runeRange{0x101A0, 0x101A0}, bcprON},
+	{runeRange{0x102E0, 0x102E0}, bcprNSM},
+	{runeRange{0x10376, 0x1037A}, bcprNSM},
+	{runeRange{0x1091F, 0x1091F}, bcprON},
+	{runeRange{0x10A01, 0x10A03}, bcprNSM},
+	{runeRange{0x10A05, 0x10A06}, bcprNSM},
+	{runeRange{0x10A0C, 0x10A0F}, bcprNSM},
+	{runeRange{0x10A38, 0x10A3A}, bcprNSM},
+	{runeRange{0x10A3F, 0x10A3F}, bcprNSM},
+	{runeRange{0x10AE5, 0x10AE6}, bcprNSM},
+	{runeRange{0x10B39, 0x10B3F}, bcprON},
+}
+
+// bidiBrackets are taken from
+// https://www.unicode.org/Public/17.0.0/ucd/BidiBrackets.txt
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+var bidiBrackets = dictionary[bidiBracket]{
+	{runeRange{0x2991, 0x2991}, bidiBracket{0x2992, true}},
+	{runeRange{0x2770, 0x2770}, bidiBracket{0x2771, true}},
+	{runeRange{0x300A, 0x300A}, bidiBracket{0x300B, true}},
+	{runeRange{0x208D, 0x208D}, bidiBracket{0x208E, true}},
+	{runeRange{0x27EE, 0x27EE}, bidiBracket{0x27EF, true}},
+	{runeRange{0x2E24, 0x2E24}, bidiBracket{0x2E25, true}},
+	{runeRange{0xFE59, 0xFE59}, bidiBracket{0xFE5A, true}},
+	{runeRange{0x0F3C, 0x0F3C}, bidiBracket{0x0F3D, true}},
+	{runeRange{0x2768, 0x2768}, bidiBracket{0x2769, true}},
+	{runeRange{0x27E6, 0x27E6}, bidiBracket{0x27E7, true}},
+	{runeRange{0x2989, 0x2989}, bidiBracket{0x298A, true}},
+	{runeRange{0x29D8, 0x29D8}, bidiBracket{0x29D9, true}},
+	{runeRange{0x2E57, 0x2E57}, bidiBracket{0x2E58, true}},
+	{runeRange{0x3014, 0x3014}, bidiBracket{0x3015, true}},
+	{runeRange{0xFF3B, 0xFF3B}, bidiBracket{0xFF3D, true}},
+	{runeRange{0x007B, 0x007B}, bidiBracket{0x007D, true}},
+	{runeRange{0x2045, 0x2045}, bidiBracket{0x2046, true}},
+	{runeRange{0x230A, 0x230A}, bidiBracket{0x230B, true}},
+	{runeRange{0x276C, 0x276C}, bidiBracket{0x276D, true}},
+	{runeRange{0x2774, 0x2774}, bidiBracket{0x2775, true}},
+	{runeRange{0x27EA, 0x27EA}, bidiBracket{0x27EB, true}},
+	{runeRange{0x2985, 0x2985}, bidiBracket{0x2986, true}},
+	{runeRange{0x298D, 0x298D}, bidiBracket{0x2990, true}},
+	{runeRange{0x2995, 0x2995}, bidiBracket{0x2996, true}},
+	{runeRange{0x29FC, 0x29FC}, bidiBracket{0x29FD, true}},
+	{runeRange{0x2E28, 0x2E28}, bidiBracket{0x2E29, true}},
+	{runeRange{0x2E5B, 0x2E5B}, bidiBracket{0x2E5C, true}},
+	{runeRange{0x300E, 0x300E}, bidiBracket{0x300F, true}},
+	{runeRange{0x3018, 0x3018}, bidiBracket{0x3019, true}},
+	{runeRange{0xFE5D, 0xFE5D}, bidiBracket{0xFE5E, true}},
+	{runeRange{0xFF5F, 0xFF5F}, bidiBracket{0xFF60, true}},
+	{runeRange{0x005B, 0x005B}, bidiBracket{0x005D, true}},
+	{runeRange{0x0F3A, 0x0F3A}, bidiBracket{0x0F3B, true}},
+	{runeRange{0x169B, 0x169B}, bidiBracket{0x169C, true}},
+	{runeRange{0x207D, 0x207D}, bidiBracket{0x207E, true}},
+	{runeRange{0x2308, 0x2308}, bidiBracket{0x2309, true}},
+	{runeRange{0x2329, 0x2329}, bidiBracket{0x232A, true}},
+	{runeRange{0x276A, 0x276A}, bidiBracket{0x276B, true}},
+	{runeRange{0x276E, 0x276E}, bidiBracket{0x276F, true}},
+	{runeRange{0x2772, 0x2772}, bidiBracket{0x2773, true}},
+	{runeRange{0x27C5, 0x27C5}, bidiBracket{0x27C6, true}},
+	{runeRange{0x27E8, 0x27E8}, bidiBracket{0x27E9, true}},
+	{runeRange{0x27EC, 0x27EC}, bidiBracket{0x27ED, true}},
+	{runeRange{0x2983, 0x2983}, bidiBracket{0x2984, true}},
+	{runeRange{0x2987, 0x2987}, bidiBracket{0x2988, true}},
+	{runeRange{0x298B, 0x298B}, bidiBracket{0x298C, true}},
+	{runeRange{0x298F, 0x298F}, bidiBracket{0x298E, true}},
+	{runeRange{0x2993, 0x2993}, bidiBracket{0x2994, true}},
+	{runeRange{0x2997, 0x2997}, bidiBracket{0x2998, true}},
+	{runeRange{0x29DA, 0x29DA}, bidiBracket{0x29DB, true}},
+	{runeRange{0x2E22, 0x2E22}, bidiBracket{0x2E23, true}},
+	{runeRange{0x2E26, 0x2E26}, bidiBracket{0x2E27, true}},
+	{runeRange{0x2E55, 0x2E55}, bidiBracket{0x2E56, true}},
+	{runeRange{0x2E59, 0x2E59}, bidiBracket{0x2E5A, true}},
+	{runeRange{0x3008, 0x3008}, bidiBracket{0x3009, true}},
+	{runeRange{0x300C, 0x300C}, bidiBracket{0x300D, true}},
+	{runeRange{0x3010, 0x3010}, bidiBracket{0x3011, true}},
+	{runeRange{0x3016, 0x3016}, bidiBracket{0x3017, true}},
+	{runeRange{0x301A, 0x301A}, bidiBracket{0x301B, true}},
+	{runeRange{0xFE5B, 0xFE5B}, bidiBracket{0xFE5C, true}},
+	{runeRange{0xFF08, 0xFF08}, bidiBracket{0xFF09, true}},
+	{runeRange{0xFF5B, 0xFF5B}, bidiBracket{0xFF5D, true}},
+	{runeRange{0xFF62, 0xFF62}, bidiBracket{0xFF63, true}},
+	{runeRange{0x0029, 0x0029}, bidiBracket{0x0028, false}},
+	{runeRange{0x005D, 0x005D}, bidiBracket{0x005B, false}},
+	{runeRange{0x007D, 0x007D}, bidiBracket{0x007B, false}},
+	{runeRange{0x0F3B, 0x0F3B}, bidiBracket{0x0F3A, false}},
+	{runeRange{0x0F3D, 0x0F3D}, bidiBracket{0x0F3C, false}},
+	{runeRange{0x169C, 0x169C}, bidiBracket{0x169B, false}},
+	{runeRange{0x2046, 0x2046}, bidiBracket{0x2045, false}},
+	{runeRange{0x207E, 0x207E}, bidiBracket{0x207D, false}},
+	{runeRange{0x208E, 0x208E}, bidiBracket{0x208D, false}},
+	{runeRange{0x2309, 0x2309}, bidiBracket{0x2308, false}},
+	{runeRange{0x230B, 0x230B}, bidiBracket{0x230A, false}},
+	{runeRange{0x232A, 0x232A}, bidiBracket{0x2329, false}},
+	{runeRange{0x2769, 0x2769}, bidiBracket{0x2768, false}},
+	{runeRange{0x276B, 0x276B}, bidiBracket{0x276A, false}},
+	{runeRange{0x276D, 0x276D}, bidiBracket{0x276C, false}},
+	{runeRange{0x276F, 0x276F}, bidiBracket{0x276E, false}},
+	{runeRange{0x2771, 0x2771}, bidiBracket{0x2770, false}},
+	{runeRange{0x2773, 0x2773}, bidiBracket{0x2772, false}},
+	{runeRange{0x2775, 0x2775}, bidiBracket{0x2774, false}},
+	{runeRange{0x27C6, 0x27C6}, bidiBracket{0x27C5, false}},
+	{runeRange{0x27E7, 0x27E7}, bidiBracket{0x27E6, false}},
+	{runeRange{0x27E9, 0x27E9}, bidiBracket{0x27E8, false}},
+	{runeRange{0x27EB, 0x27EB}, bidiBracket{0x27EA, false}},
+	{runeRange{0x27ED, 0x27ED}, bidiBracket{0x27EC, false}},
+	{runeRange{0x27EF, 0x27EF}, bidiBracket{0x27EE, false}},
+	{runeRange{0x2984, 0x2984}, bidiBracket{0x2983, false}},
+	{runeRange{0x2986, 0x2986}, bidiBracket{0x2985, false}},
+	{runeRange{0x2988, 0x2988}, bidiBracket{0x2987, false}},
+	{runeRange{0x298A, 0x298A}, bidiBracket{0x2989, false}},
+	{runeRange{0x298C, 0x298C}, bidiBracket{0x298B, false}},
+	{runeRange{0x298E, 0x298E}, bidiBracket{0x298F, false}},
+	{runeRange{0x2990, 0x2990}, bidiBracket{0x298D, false}},
+	{runeRange{0x2992, 0x2992}, bidiBracket{0x2991, false}},
+	{runeRange{0x2994, 0x2994}, bidiBracket{0x2993, false}},
+	{runeRange{0x2996, 0x2996}, bidiBracket{0x2995, false}},
+	{runeRange{0x2998, 0x2998}, bidiBracket{0x2997, false}},
+	{runeRange{0x29D9, 0x29D9}, bidiBracket{0x29D8, false}},
+	{runeRange{0x29DB, 0x29DB}, bidiBracket{0x29DA, false}},
+	{runeRange{0x29FD, 0x29FD}, bidiBracket{0x29FC, false}},
+	{runeRange{0x2E23, 0x2E23}, bidiBracket{0x2E22, false}},
+	{runeRange{0x2E25, 0x2E25}, bidiBracket{0x2E24, false}},
+	{runeRange{0x2E27, 0x2E27}, bidiBracket{0x2E26, false}},
+	{runeRange{0x2E29, 0x2E29}, bidiBracket{0x2E28, false}},
+	{runeRange{0x2E56, 0x2E56}, bidiBracket{0x2E55, false}},
+	{runeRange{0x2E58, 0x2E58}, bidiBracket{0x2E57, false}},
+	{runeRange{0x2E5A, 0x2E5A}, bidiBracket{0x2E59, false}},
+	{runeRange{0x2E5C, 0x2E5C}, bidiBracket{0x2E5B, false}},
+	{runeRange{0x3009, 0x3009}, bidiBracket{0x3008, false}},
+	{runeRange{0x300B, 0x300B}, bidiBracket{0x300A, false}},
+	{runeRange{0x300D, 0x300D}, bidiBracket{0x300C, false}},
+	{runeRange{0x300F, 0x300F}, bidiBracket{0x300E, false}},
+	{runeRange{0x3011, 0x3011}, bidiBracket{0x3010, false}},
+	{runeRange{0x3015, 0x3015}, bidiBracket{0x3014, false}},
+	{runeRange{0x3017, 0x3017}, bidiBracket{0x3016, false}},
+	{runeRange{0x3019, 0x3019}, bidiBracket{0x3018, false}},
+	{runeRange{0x301B, 0x301B}, bidiBracket{0x301A, false}},
+	{runeRange{0xFE5A, 0xFE5A}, bidiBracket{0xFE59, false}},
+	{runeRange{0xFE5C, 0xFE5C}, bidiBracket{0xFE5B, false}},
+	{runeRange{0xFE5E, 0xFE5E}, bidiBracket{0xFE5D, false}},
+	{runeRange{0xFF09, 0xFF09}, bidiBracket{0xFF08, false}},
+	{runeRange{0xFF3D, 0xFF3D}, bidiBracket{0xFF3B, false}},
+	{runeRange{0xFF5D, 0xFF5D}, bidiBracket{0xFF5B, false}},
+	{runeRange{0xFF60, 0xFF60}, bidiBracket{0xFF5F, false}},
+	{runeRange{0xFF63, 0xFF63}, bidiBracket{0xFF62, false}},
+	{runeRange{0x0028, 0x0028}, bidiBracket{0x0029, true}},
+}
+
+// bidiMirrors are taken from
+// https://www.unicode.org/Public/17.0.0/ucd/BidiMirroring.txt
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+var bidiMirrors = dictionary[rune]{
+	{runeRange{0x2A35, 0x2A35}, 0x2A34},
+	{runeRange{0x22E7, 0x22E7}, 0x22E6},
+	{runeRange{0x2E04, 0x2E04}, 0x2E05},
+	{runeRange{0x2278, 0x2278}, 0x2279},
+	{runeRange{0x27EE, 0x27EE}, 0x27EF},
+	{runeRange{0x2AB8, 0x2AB8}, 0x2AB7},
+	{runeRange{0x300E, 0x300E}, 0x300F},
+	{runeRange{0x221F, 0x221F}, 0x2BFE},
+	{runeRange{0x22B1, 0x22B1}, 0x22B0},
+	{runeRange{0x276F, 0x276F}, 0x276E},
+	{runeRange{0x29AB, 0x29AB}, 0x29AA},
+	{runeRange{0x2A94, 0x2A94}, 0x2A93},
+	{runeRange{0x2AD5, 0x2AD5}, 0x2AD6},
+	{runeRange{0x2E28, 0x2E28}, 0x2E29},
+	{runeRange{0xFE5D, 0xFE5D}, 0xFE5E},
+	{runeRange{0x169C, 0x169C}, 0x169B},
+	{runeRange{0x2266, 0x2266}, 0x2267},
+	{runeRange{0x2288, 0x2288}, 0x2289},
+	{runeRange{0x22D7, 0x22D7}, 0x22D6},
+	{runeRange{0x22FC, 0x22FC}, 0x22F4},
+	{runeRange{0x27D6, 0x27D6}, 0x27D5},
+	{runeRange{0x2991, 0x2991}, 0x2992},
+	{runeRange{0x29D8, 0x29D8}, 0x29D9},
+	{runeRange{0x2A84, 0x2A84}, 0x2A83},
+	{runeRange{0x2AA7, 0x2AA7}, 0x2AA6},
+	{runeRange{0x2AC8, 0x2AC8}, 0x2AC7},
+	{runeRange{0x2AEE, 0x2AEE}, 0x2224},
+	{runeRange{0x2E20, 0x2E20}, 0x2E21},
+	{runeRange{0x2E5B, 0x2E5B}, 0x2E5C},
+	{runeRange{0x3018, 0x3018}, 0x3019},
+	{runeRange{0xFF3B, 0xFF3B}, 0xFF3D},
+	{runeRange{0x007D, 0x007D}, 0x007B},
+	{runeRange{0x208E, 0x208E}, 0x208D},
+	{runeRange{0x2245, 0x2245}, 0x224C},
+	{runeRange{0x2270, 0x2270}, 0x2271},
+	{runeRange{0x2280, 0x2280}, 0x2281},
+	{runeRange{0x2298, 0x2298}, 0x29B8},
+	{runeRange{0x22C9, 0x22C9}, 0x22CA},
+	{runeRange{0x22DF, 0x22DF}, 0x22DE},
+	{runeRange{0x22F1, 0x22F1}, 0x22F0},
+	{runeRange{0x232A, 0x232A}, 0x2329},
+	{runeRange{0x27C4, 0x27C4}, 0x27C3},
+	{runeRange{0x27E6, 0x27E6}, 0x27E7},
+	{runeRange{0x2989, 0x2989}, 0x298A},
+	{runeRange{0x299B, 0x299B}, 0x2221},
+	{runeRange{0x29C4, 0x29C4}, 0x29C5},
+	{runeRange{0x29F9, 0x29F9}, 0x29F8},
+	{runeRange{0x2A7C, 0x2A7C}, 0x2A7B},
+	{runeRange{0x2A8C, 0x2A8C}, 0x2A8B},
+	{runeRange{0x2A9C, 0x2A9C}, 0x2A9B},
+	{runeRange{0x2AB0, 0x2AB0}, 0x2AAF},
+	{runeRange{0x2AC0, 0x2AC0}, 0x2ABF},
+	{runeRange{0x2AD0, 0x2AD0}, 0x2ACF},
+	{runeRange{0x2AE4, 0x2AE4}, 0x22A8},
+	{runeRange{0x2AFA, 0x2AFA}, 0x2AF9},
+	{runeRange{0x2E0C, 0x2E0C}, 0x2E0D},
+	{runeRange{0x2E24, 0x2E24}, 0x2E25},
+	{runeRange{0x2E57, 0x2E57}, 0x2E58},
+	{runeRange{0x300A, 0x300A}, 0x300B},
+	{runeRange{0x3014, 0x3014}, 0x3015},
+	{runeRange{0xFE59, 0xFE59}, 0xFE5A},
+	{runeRange{0xFF08, 0xFF08}, 0xFF09},
+	{runeRange{0xFF5F, 0xFF5F}, 0xFF60},
+	{runeRange{0x003E, 0x003E}, 0x003C},
+	{runeRange{0x0F3B, 0x0F3B}, 0x0F3A},
+	{runeRange{0x2046, 0x2046}, 0x2045},
+	{runeRange{0x220B, 0x220B}, 0x2208},
+	{runeRange{0x2224, 0x2224}, 0x2AEE},
+	{runeRange{0x2254, 0x2254}, 0x2255},
+	{runeRange{0x226A, 0x226A}, 0x226B},
+	{runeRange{0x2274, 0x2274}, 0x2275},
+	{runeRange{0x227C, 0x227C}, 0x227D},
+	{runeRange{0x2284, 0x2284}, 0x2285},
+	{runeRange{0x228F, 0x228F}, 0x2290},
+	{runeRange{0x22A8, 0x22A8}, 0x2AE4},
+	{runeRange{0x22B5, 0x22B5}, 0x22B4},
+	{runeRange{0x22CD, 0x22CD}, 0x2243},
+	{runeRange{0x22DB, 0x22DB}, 0x22DA},
+	{runeRange{0x22E3, 0x22E3}, 0x22E2},
+	{runeRange{0x22EB, 0x22EB}, 0x22EA},
+	{runeRange{0x22F6, 0x22F6}, 0x22FD},
+	{runeRange{0x2309, 0x2309}, 0x2308},
+	{runeRange{0x276B, 0x276B}, 0x276A},
+	{runeRange{0x2773, 0x2773}, 0x2772},
+	{runeRange{0x27C9, 0x27C9}, 0x27C8},
+	{runeRange{0x27E2, 0x27E2}, 0x27E3},
+	{runeRange{0x27EA, 0x27EA}, 0x27EB},
+	{runeRange{0x2985, 0x2985}, 0x2986},
+	{runeRange{0x298D, 0x298D}, 0x2990},
+	{runeRange{0x2995, 0x2995}, 0x2996},
+	{runeRange{0x29A5, 0x29A5}, 0x29A4},
+	{runeRange{0x29AF, 0x29AF}, 0x29AE},
+	{runeRange{0x29D1, 0x29D1}, 0x29D2},
+	{runeRange{0x29E8, 0x29E8}, 0x29E9},
+	{runeRange{0x2A2C, 0x2A2C}, 0x2A2B},
+	{runeRange{0x2A65, 0x2A65}, 0x2A64},
+	{runeRange{0x2A80, 0x2A80}, 0x2A7F},
+	{runeRange{0x2A88, 0x2A88}, 0x2A87},
+	{runeRange{0x2A90, 0x2A90}, 0x2A8F},
+	{runeRange{0x2A98, 0x2A98}, 0x2A97},
+	{runeRange{0x2AA0, 0x2AA0}, 0x2A9F},
+	{runeRange{0x2AAB, 0x2AAB}, 0x2AAA},
+	{runeRange{0x2AB4, 0x2AB4}, 0x2AB3},
+	{runeRange{0x2ABC, 0x2ABC}, 0x2ABB},
+	{runeRange{0x2AC4, 0x2AC4}, 0x2AC3},
+	{runeRange{0x2ACC, 0x2ACC}, 0x2ACB},
+	{runeRange{0x2AD3, 0x2AD3}, 0x2AD4},
+	{runeRange{0x2ADE, 0x2ADE}, 0x22A6},
+	{runeRange{0x2AEC, 0x2AEC}, 0x2AED},
+	{runeRange{0x2AF8, 0x2AF8}, 0x2AF7},
+	{runeRange{0x2E02, 0x2E02}, 0x2E03},
+	{runeRange{0x2E09, 0x2E09}, 0x2E0A},
+	{runeRange{0x2E1C, 0x2E1C}, 0x2E1D},
+	{runeRange{0x2E22, 0x2E22}, 0x2E23},
+	{runeRange{0x2E26, 0x2E26}, 0x2E27},
+	{runeRange{0x2E55, 0x2E55}, 0x2E56},
+	{runeRange{0x2E59, 0x2E59}, 0x2E5A},
+	{runeRange{0x3008, 0x3008}, 0x3009},
+	{runeRange{0x300C, 0x300C}, 0x300D},
+	{runeRange{0x3010, 0x3010}, 0x3011},
+	{runeRange{0x3016, 0x3016}, 0x3017},
+	{runeRange{0x301A, 0x301A}, 0x301B},
+	{runeRange{0xFE5B, 0xFE5B}, 0xFE5C},
+	{runeRange{0xFE64, 0xFE64}, 0xFE65},
+	{runeRange{0xFF1C, 0xFF1C}, 0xFF1E},
+	{runeRange{0xFF5B, 0xFF5B}, 0xFF5D},
+	{runeRange{0xFF62, 0xFF62}, 0xFF63},
+	{runeRange{0x0029, 0x0029}, 0x0028},
+	{runeRange{0x005D, 0x005D}, 0x005B},
+	{runeRange{0x00BB, 0x00BB}, 0x00AB},
+	{runeRange{0x0F3D, 0x0F3D}, 0x0F3C},
+	{runeRange{0x203A, 0x203A}, 0x2039},
+	{runeRange{0x207E, 0x207E}, 0x207D},
+	{runeRange{0x2209, 0x2209}, 0x220C},
+	{runeRange{0x220D, 0x220D}, 0x220A},
+	{runeRange{0x2221, 0x2221}, 0x299B},
+	{runeRange{0x223D, 0x223D}, 0x223C},
+	{runeRange{0x2252, 0x2252}, 0x2253},
+	{runeRange{0x2264, 0x2264}, 0x2265},
+	{runeRange{0x2268, 0x2268}, 0x2269},
+	{runeRange{0x226E, 0x226E}, 0x226F},
+	{runeRange{0x2272, 0x2272}, 0x2273},
+	{runeRange{0x2276, 0x2276}, 0x2277},
+	{runeRange{0x227A, 0x227A}, 0x227B},
+	{runeRange{0x227E, 0x227E}, 0x227F},
+	{runeRange{0x2282, 0x2282}, 0x2283},
+	{runeRange{0x2286, 0x2286}, 0x2287},
+	{runeRange{0x228A, 0x228A}, 0x228B},
+	{runeRange{0x2291, 0x2291}, 0x2292},
+	{runeRange{0x22A3, 0x22A3}, 0x22A2},
+	{runeRange{0x22AB, 0x22AB}, 0x2AE5},
+	{runeRange{0x22B3, 0x22B3}, 0x22B2},
+	{runeRange{0x22B7, 0x22B7}, 0x22B6},
+	{runeRange{0x22CB, 0x22CB}, 0x22CC},
+	{runeRange{0x22D1, 0x22D1}, 0x22D0},
+	{runeRange{0x22D9, 0x22D9}, 0x22D8},
+	{runeRange{0x22DD, 0x22DD}, 0x22DC},
+	{runeRange{0x22E1, 0x22E1}, 0x22E0},
+	{runeRange{0x22E5, 0x22E5}, 0x22E4},
+	{runeRange{0x22E9, 0x22E9}, 0x22E8},
+	{runeRange{0x22ED, 0x22ED}, 0x22EC},
+	{runeRange{0x22F3, 0x22F3}, 0x22FB},
+	{runeRange{0x22FA, 0x22FA}, 0x22F2},
+	{runeRange{0x22FE, 0x22FE}, 0x22F7},
+	{runeRange{0x230B, 0x230B}, 0x230A},
+	{runeRange{0x2769, 0x2769}, 0x2768},
+	{runeRange{0x276D, 0x276D}, 0x276C},
+	{runeRange{0x2771, 0x2771}, 0x2770},
+	{runeRange{0x2775, 0x2775}, 0x2774},
+	{runeRange{0x27C6, 0x27C6}, 0x27C5},
+	{runeRange{0x27CD, 0x27CD}, 0x27CB},
+	{runeRange{0x27DD, 0x27DD}, 0x27DE},
+	{runeRange{0x27E4, 0x27E4}, 0x27E5},
+	{runeRange{0x27E8, 0x27E8}, 0x27E9},
+	{runeRange{0x27EC, 0x27EC}, 0x27ED},
+	{runeRange{0x2983, 0x2983}, 0x2984},
+	{runeRange{0x2987, 0x2987}, 0x2988},
+	{runeRange{0x298B, 0x298B}, 0x298C},
+	{runeRange{0x298F, 0x298F}, 0x298E},
+	{runeRange{0x2993, 0x2993}, 0x2994},
+	{runeRange{0x2997, 0x2997}, 0x2998},
+	{runeRange{0x29A3, 0x29A3}, 0x2220},
+	{runeRange{0x29A9, 0x29A9}, 0x29A8},
+	{runeRange{0x29AD, 0x29AD}, 0x29AC},
+	{runeRange{0x29C0, 0x29C0}, 0x29C1},
+	{runeRange{0x29CF, 0x29CF}, 0x29D0},
+	{runeRange{0x29D4, 0x29D4}, 0x29D5},
+	{runeRange{0x29DA, 0x29DA}, 0x29DB},
+	{runeRange{0x29F5, 0x29F5}, 0x2215},
+	{runeRange{0x29FD, 0x29FD}, 0x29FC},
+	{runeRange{0x2A2E, 0x2A2E}, 0x2A2D},
+	{runeRange{0x2A3D, 0x2A3D}, 0x2A3C},
+	{runeRange{0x2A7A, 0x2A7A}, 0x2A79},
+	{runeRange{0x2A7E, 0x2A7E}, 0x2A7D},
+	{runeRange{0x2A82, 0x2A82}, 0x2A81},
+	{runeRange{0x2A86, 0x2A86}, 0x2A85},
+	{runeRange{0x2A8A, 0x2A8A}, 0x2A89},
+	{runeRange{0x2A8E, 0x2A8E}, 0x2A8D},
+	{runeRange{0x2A92, 0x2A92}, 0x2A91},
+	{runeRange{0x2A96, 0x2A96}, 0x2A95},
+	{runeRange{0x2A9A, 0x2A9A}, 0x2A99},
+	{runeRange{0x2A9E, 0x2A9E}, 0x2A9D},
+	{runeRange{0x2AA2, 0x2AA2}, 0x2AA1},
+	{runeRange{0x2AA9, 0x2AA9}, 0x2AA8},
+	{runeRange{0x2AAD, 0x2AAD}, 0x2AAC},
+	{runeRange{0x2AB2, 0x2AB2}, 0x2AB1},
+	{runeRange{0x2AB6, 0x2AB6}, 0x2AB5},
+	{runeRange{0x2ABA, 0x2ABA}, 0x2AB9},
+	{runeRange{0x2ABE, 0x2ABE}, 0x2ABD},
+	{runeRange{0x2AC2, 0x2AC2}, 0x2AC1},
+	{runeRange{0x2AC6, 0x2AC6}, 0x2AC5},
+	{runeRange{0x2ACA, 0x2ACA}, 0x2AC9},
+	{runeRange{0x2ACE, 0x2ACE}, 0x2ACD},
+	{runeRange{0x2AD2, 0x2AD2}, 0x2AD1},
+	{runeRange{0x2AD4, 0x2AD4}, 0x2AD3},
+	{runeRange{0x2AD6, 0x2AD6}, 0x2AD5},
+	{runeRange{0x2AE3, 0x2AE3}, 0x22A9},
+	{runeRange{0x2AE5, 0x2AE5}, 0x22AB},
+	{runeRange{0x2AED, 0x2AED}, 0x2AEC},
+	{runeRange{0x2AF7, 0x2AF7}, 0x2AF8},
+	{runeRange{0x2AF9, 0x2AF9}, 0x2AFA},
+	{runeRange{0x2BFE, 0x2BFE}, 0x221F},
+	{runeRange{0x2E03, 0x2E03}, 0x2E02},
+	{runeRange{0x2E05, 0x2E05}, 0x2E04},
+	{runeRange{0x2E0A, 0x2E0A}, 0x2E09},
+	{runeRange{0x2E0D, 0x2E0D}, 0x2E0C},
+	{runeRange{0x2E1D, 0x2E1D}, 0x2E1C},
+	{runeRange{0x2E21, 0x2E21}, 0x2E20},
+	{runeRange{0x2E23, 0x2E23}, 0x2E22},
+	{runeRange{0x2E25, 0x2E25}, 0x2E24},
+	{runeRange{0x2E27, 0x2E27}, 0x2E26},
+	{runeRange{0x2E29, 0x2E29}, 0x2E28},
+	{runeRange{0x2E56, 0x2E56}, 0x2E55},
+	{runeRange{0x2E58, 0x2E58}, 0x2E57},
+	{runeRange{0x2E5A, 0x2E5A}, 0x2E59},
+	{runeRange{0x2E5C, 0x2E5C}, 0x2E5B},
+	{runeRange{0x3009, 0x3009}, 0x3008},
+	{runeRange{0x300B, 0x300B}, 0x300A},
+	{runeRange{0x300D, 0x300D}, 0x300C},
+	{runeRange{0x300F, 0x300F}, 0x300E},
+	{runeRange{0x3011, 0x3011}, 0x3010},
+	{runeRange{0x3015, 0x3015}, 0x3014},
+	{runeRange{0x3017, 0x3017}, 0x3016},
+	{runeRange{0x3019, 0x3019}, 0x3018},
+	{runeRange{0x301B, 0x301B}, 0x301A},
+	{runeRange{0xFE5A, 0xFE5A}, 0xFE59},
+	{runeRange{0xFE5C, 0xFE5C}, 0xFE5B},
+	{runeRange{0xFE5E, 0xFE5E}, 0xFE5D},
+	{runeRange{0xFE65, 0xFE65}, 0xFE64},
+	{runeRange{0xFF09, 0xFF09}, 0xFF08},
+	{runeRange{0xFF1E, 0xFF1E}, 0xFF1C},
+	{runeRange{0xFF3D, 0xFF3D}, 0xFF3B},
+	{runeRange{0xFF5D, 0xFF5D}, 0xFF5B},
+	{runeRange{0xFF60, 0xFF60}, 0xFF5F},
+	{runeRange{0xFF63, 0xFF63}, 0xFF62},
+	{runeRange{0x0028, 0x0028}, 0x0029},
+	{runeRange{0x003C, 0x003C}, 0x003E},
+	{runeRange{0x005B, 0x005B}, 0x005D},
+	{runeRange{0x007B, 0x007B}, 0x007D},
+	{runeRange{0x00AB, 0x00AB}, 0x00BB},
+	{runeRange{0x0F3A, 0x0F3A}, 0x0F3B},
+	{runeRange{0x0F3C, 0x0F3C}, 0x0F3D},
+	{runeRange{0x169B, 0x169B}, 0x169C},
+	{runeRange{0x2039, 0x2039}, 0x203A},
+	{runeRange{0x2045, 0x2045}, 0x2046},
+	{runeRange{0x207D, 0x207D}, 0x207E},
+	{runeRange{0x208D, 0x208D}, 0x208E},
+	{runeRange{0x2208, 0x2208}, 0x220B},
+	{runeRange{0x220A, 0x220A}, 0x220D},
+	{runeRange{0x220C, 0x220C}, 0x2209},
+	{runeRange{0x2215, 0x2215}, 0x29F5},
+	{runeRange{0x2220, 0x2220}, 0x29A3},
+	{runeRange{0x2222, 0x2222}, 0x29A0},
+	{runeRange{0x223C, 0x223C}, 0x223D},
+	{runeRange{0x2243, 0x2243}, 0x22CD},
+	{runeRange{0x224C, 0x224C}, 0x2245},
+	{runeRange{0x2253, 0x2253}, 0x2252},
+	{runeRange{0x2255, 0x2255}, 0x2254},
+	{runeRange{0x2265, 0x2265}, 0x2264},
+	{runeRange{0x2267, 0x2267}, 0x2266},
+	{runeRange{0x2269, 0x2269}, 0x2268},
+	{runeRange{0x226B, 0x226B}, 0x226A},
+	{runeRange{0x226F, 0x226F}, 0x226E},
+	{runeRange{0x2271, 0x2271}, 0x2270},
+	{runeRange{0x2273, 0x2273}, 0x2272},
+	{runeRange{0x2275, 0x2275}, 0x2274},
+	{runeRange{0x2277, 0x2277}, 0x2276},
+	{runeRange{0x2279, 0x2279}, 0x2278},
+	{runeRange{0x227B, 0x227B}, 0x227A},
+	{runeRange{0x227D, 0x227D}, 0x227C},
+	{runeRange{0x227F, 0x227F}, 0x227E},
+	{runeRange{0x2281, 0x2281}, 0x2280},
+	{runeRange{0x2283, 0x2283}, 0x2282},
+	{runeRange{0x2285, 0x2285}, 0x2284},
+	{runeRange{0x2287, 0x2287}, 0x2286},
+	{runeRange{0x2289, 0x2289}, 0x2288},
+	{runeRange{0x228B, 0x228B}, 0x228A},
+	{runeRange{0x2290, 0x2290}, 0x228F},
+	{runeRange{0x2292, 0x2292}, 0x2291},
+	{runeRange{0x22A2, 0x22A2}, 0x22A3},
+	{runeRange{0x22A6, 0x22A6}, 0x2ADE},
+	{runeRange{0x22A9, 0x22A9}, 0x2AE3},
+	{runeRange{0x22B0, 0x22B0}, 0x22B1},
+	{runeRange{0x22B2, 0x22B2}, 0x22B3},
+	{runeRange{0x22B4, 0x22B4}, 0x22B5},
+	{runeRange{0x22B6, 0x22B6}, 0x22B7},
+	{runeRange{0x22B8, 0x22B8}, 0x27DC},
+	{runeRange{0x22CA, 0x22CA}, 0x22C9},
+	{runeRange{0x22CC, 0x22CC}, 0x22CB},
+	{runeRange{0x22D0, 0x22D0}, 0x22D1},
+	{runeRange{0x22D6, 0x22D6}, 0x22D7},
+	{runeRange{0x22D8, 0x22D8}, 0x22D9},
+	{runeRange{0x22DA, 0x22DA}, 0x22DB},
+	{runeRange{0x22DC, 0x22DC}, 0x22DD},
+	{runeRange{0x22DE, 0x22DE}, 0x22DF},
+	{runeRange{0x22E0, 0x22E0}, 0x22E1},
+	{runeRange{0x22E2, 0x22E2}, 0x22E3},
+	{runeRange{0x22E4, 0x22E4}, 0x22E5},
+	{runeRange{0x22E6, 0x22E6}, 0x22E7},
+	{runeRange{0x22E8, 0x22E8}, 0x22E9},
+	{runeRange{0x22EA, 0x22EA}, 0x22EB},
+	{runeRange{0x22EC, 0x22EC}, 0x22ED},
+	{runeRange{0x22F0, 0x22F0}, 0x22F1},
+	{runeRange{0x22F2, 0x22F2}, 0x22FA},
+	{runeRange{0x22F4, 0x22F4}, 0x22FC},
+	{runeRange{0x22F7, 0x22F7}, 0x22FE},
+	{runeRange{0x22FB, 0x22FB}, 0x22F3},
+	{runeRange{0x22FD, 0x22FD}, 0x22F6},
+	{runeRange{0x2308, 0x2308}, 0x2309},
+	{runeRange{0x230A, 0x230A}, 0x230B},
+	{runeRange{0x2329, 0x2329}, 0x232A},
+	{runeRange{0x2768, 0x2768}, 0x2769},
+	{runeRange{0x276A, 0x276A}, 0x276B},
+	{runeRange{0x276C, 0x276C}, 0x276D},
+	{runeRange{0x276E, 0x276E}, 0x276F},
+	{runeRange{0x2770, 0x2770}, 0x2771},
+	{runeRange{0x2772, 0x2772}, 0x2773},
+	{runeRange{0x2774, 0x2774}, 0x2775},
+	{runeRange{0x27C3, 0x27C3}, 0x27C4},
+	{runeRange{0x27C5, 0x27C5}, 0x27C6},
+	{runeRange{0x27C8, 0x27C8}, 0x27C9},
+	{runeRange{0x27CB, 0x27CB}, 0x27CD},
+	{runeRange{0x27D5, 0x27D5}, 0x27D6},
+	{runeRange{0x27DC, 0x27DC}, 0x22B8},
+	{runeRange{0x27DE, 0x27DE}, 0x27DD},
+	{runeRange{0x27E3, 0x27E3}, 0x27E2},
+	{runeRange{0x27E5, 0x27E5}, 0x27E4},
+	{runeRange{0x27E7, 0x27E7}, 0x27E6},
+	{runeRange{0x27E9, 0x27E9}, 0x27E8},
+	{runeRange{0x27EB, 0x27EB}, 0x27EA},
+	{runeRange{0x27ED, 0x27ED}, 0x27EC},
+	{runeRange{0x27EF, 0x27EF}, 0x27EE},
+	{runeRange{0x2984, 0x2984}, 0x2983},
+	{runeRange{0x2986, 0x2986}, 0x2985},
+	{runeRange{0x2988, 0x2988}, 0x2987},
+	{runeRange{0x298A, 0x298A}, 0x2989},
+	{runeRange{0x298C, 0x298C}, 0x298B},
+	{runeRange{0x298E, 0x298E}, 0x298F},
+	{runeRange{0x2990, 0x2990}, 0x298D},
+	{runeRange{0x2992, 0x2992}, 0x2991},
+	{runeRange{0x2994, 0x2994}, 0x2993},
+	{runeRange{0x2996, 0x2996}, 0x2995},
+	{runeRange{0x2998, 0x2998}, 0x2997},
+	{runeRange{0x29A0, 0x29A0}, 0x2222},
+	{runeRange{0x29A4, 0x29A4}, 0x29A5},
+	{runeRange{0x29A8, 0x29A8}, 0x29A9},
+	{runeRange{0x29AA, 0x29AA}, 0x29AB},
+	{runeRange{0x29AC, 0x29AC}, 0x29AD},
+	{runeRange{0x29AE, 0x29AE}, 0x29AF},
+	{runeRange{0x29B8, 0x29B8}, 0x2298},
+	{runeRange{0x29C1, 0x29C1}, 0x29C0},
+	{runeRange{0x29C5, 0x29C5}, 0x29C4},
+	{runeRange{0x29D0, 0x29D0}, 0x29CF},
+	{runeRange{0x29D2, 0x29D2}, 0x29D1},
+	{runeRange{0x29D5, 0x29D5}, 0x29D4},
+	{runeRange{0x29D9, 0x29D9}, 0x29D8},
+	{runeRange{0x29DB, 0x29DB}, 0x29DA},
+	{runeRange{0x29E9, 0x29E9}, 0x29E8},
+	{runeRange{0x29F8, 0x29F8}, 0x29F9},
+	{runeRange{0x29FC, 0x29FC}, 0x29FD},
+	{runeRange{0x2A2B, 0x2A2B}, 0x2A2C},
+	{runeRange{0x2A2D, 0x2A2D}, 0x2A2E},
+	{runeRange{0x2A34, 0x2A34}, 0x2A35},
+	{runeRange{0x2A3C, 0x2A3C}, 0x2A3D},
+	{runeRange{0x2A64, 0x2A64}, 0x2A65},
+	{runeRange{0x2A79, 0x2A79}, 0x2A7A},
+	{runeRange{0x2A7B, 0x2A7B}, 0x2A7C},
+	{runeRange{0x2A7D, 0x2A7D}, 0x2A7E},
+	{runeRange{0x2A7F, 0x2A7F}, 0x2A80},
+	{runeRange{0x2A81, 0x2A81}, 0x2A82},
+	{runeRange{0x2A83, 0x2A83}, 0x2A84},
+	{runeRange{0x2A85, 0x2A85}, 0x2A86},
+	{runeRange{0x2A87, 0x2A87}, 0x2A88},
+	{runeRange{0x2A89, 0x2A89}, 0x2A8A},
+	{runeRange{0x2A8B, 0x2A8B}, 0x2A8C},
+	{runeRange{0x2A8D, 0x2A8D}, 0x2A8E},
+	{runeRange{0x2A8F, 0x2A8F}, 0x2A90},
+	{runeRange{0x2A91, 0x2A91}, 0x2A92},
+	{runeRange{0x2A93, 0x2A93}, 0x2A94},
+	{runeRange{0x2A95, 0x2A95}, 0x2A96},
+	{runeRange{0x2A97, 0x2A97}, 0x2A98},
+	{runeRange{0x2A99, 0x2A99}, 0x2A9A},
+	{runeRange{0x2A9B, 0x2A9B}, 0x2A9C},
+	{runeRange{0x2A9D, 0x2A9D}, 0x2A9E},
+	{runeRange{0x2A9F, 0x2A9F}, 0x2AA0},
+	{runeRange{0x2AA1, 0x2AA1}, 0x2AA2},
+	{runeRange{0x2AA6, 0x2AA6}, 0x2AA7},
+	{runeRange{0x2AA8, 0x2AA8}, 0x2AA9},
+	{runeRange{0x2AAA, 0x2AAA}, 0x2AAB},
+	{runeRange{0x2AAC, 0x2AAC}, 0x2AAD},
+	{runeRange{0x2AAF, 0x2AAF}, 0x2AB0},
+	{runeRange{0x2AB1, 0x2AB1}, 0x2AB2},
+	{runeRange{0x2AB3, 0x2AB3}, 0x2AB4},
+	{runeRange{0x2AB5, 0x2AB5}, 0x2AB6},
+	{runeRange{0x2AB7, 0x2AB7}, 0x2AB8},
+	{runeRange{0x2AB9, 0x2AB9}, 0x2ABA},
+	{runeRange{0x2ABB, 0x2ABB}, 0x2ABC},
+	{runeRange{0x2ABD, 0x2ABD}, 0x2ABE},
+	{runeRange{0x2ABF, 0x2ABF}, 0x2AC0},
+	{runeRange{0x2AC1, 0x2AC1}, 0x2AC2},
+	{runeRange{0x2AC3, 0x2AC3}, 0x2AC4},
+	{runeRange{0x2AC5, 0x2AC5}, 0x2AC6},
+	{runeRange{0x2AC7, 0x2AC7}, 0x2AC8},
+	{runeRange{0x2AC9, 0x2AC9}, 0x2ACA},
+	{runeRange{0x2ACB, 0x2ACB}, 0x2ACC},
+	{runeRange{0x2ACD, 0x2ACD}, 0x2ACE},
+	{runeRange{0x2ACF, 0x2ACF}, 0x2AD0},
+	{runeRange{0x2AD1, 0x2AD1}, 0x2AD2},
+}
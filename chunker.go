@@ -0,0 +1,203 @@
+package uniseg
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// ChunkUnit selects how the size of text is measured by [ChunkText].
+type ChunkUnit int
+
+// The units of chunk sizes.
+const (
+	ChunkUnitBytes     ChunkUnit = iota // The number of bytes.
+	ChunkUnitGraphemes                  // The number of grapheme clusters.
+	ChunkUnitCells                      // The monospace width, see [StringWidth].
+)
+
+// ChunkOptions configures [ChunkText].
+type ChunkOptions struct {
+	// MaxSize is the maximum size of a chunk. A grapheme cluster larger than
+	// MaxSize becomes a chunk of its own. If MaxSize is not positive, the
+	// whole text is one chunk.
+	MaxSize int
+
+	// Unit is the unit of MaxSize and Overlap. It is ignored if Cost is set.
+	Unit ChunkUnit
+
+	// Cost, if not nil, returns the size of a grapheme cluster, for example
+	// the number of tokens it contributes to a language model prompt or the
+	// time needed to speak it.
+	Cost func(cluster string) int
+
+	// Overlap is the maximum size of the text at the end of a chunk which is
+	// repeated at the beginning of the next chunk. The repeated text starts at
+	// the best boundary available, in the same order of preference as chunk
+	// ends, but at least at a word boundary, and it doesn't start with white
+	// space. Overlap is limited to half of MaxSize. If zero, chunks don't
+	// overlap.
+	Overlap int
+}
+
+// Chunk is a chunk of text returned by [ChunkText], given as the byte offsets
+// of its first byte and of the first byte after it.
+type Chunk struct {
+	Start, End int
+}
+
+// The boundaries at which chunks are cut, in ascending order of preference.
+const (
+	chunkGrapheme  = iota // A grapheme cluster boundary.
+	chunkWord             // A word boundary.
+	chunkLine             // A line break opportunity.
+	chunkSentence         // A sentence boundary or a mandatory line break.
+	chunkParagraph        // The end of an empty line or a paragraph separator.
+)
+
+// chunkCluster is a grapheme cluster considered by [ChunkText].
+type chunkCluster struct {
+	end      int  // The byte offset after the cluster.
+	cost     int  // The size of the cluster.
+	boundary int  // The boundary after the cluster, one of the chunk* constants.
+	space    bool // Whether the cluster is white space.
+}
+
+// ChunkText splits s into chunks of at most opts.MaxSize units each, for
+// example for language model or text-to-speech pipelines with input limits.
+// Each chunk ends at the best boundary available: preferably a paragraph
+// break (after an empty line or U+2029 PARAGRAPH SEPARATOR), then a sentence
+// boundary, then a line break opportunity, then a word boundary, and only as a
+// last resort a grapheme cluster boundary. Among boundaries of the same kind,
+// the last one which fits is chosen.
+//
+// A paragraph break here is a mandatory line break after a line which is empty
+// or only contains white space, or U+2029 PARAGRAPH SEPARATOR. Other
+// mandatory line breaks rank with sentence boundaries, because text is often
+// wrapped with single newlines. This differs from [Paragraphs], which ends a
+// paragraph at every newline, and from [Bidi].
+//
+// Without overlap, the chunks are consecutive and cover all of s. The text is
+// parsed only once with [Step], which finds all kinds of boundaries together.
+// An empty string results in no chunks.
+func ChunkText(s string, opts ChunkOptions) []Chunk {
+	return DefaultParser.ChunkText(s, opts)
+}
+
+// ChunkText is like [ChunkText] but uses the given parser.
+func (p *Parser) ChunkText(s string, opts ChunkOptions) []Chunk {
+	if len(s) == 0 {
+		return nil
+	}
+	if opts.MaxSize <= 0 {
+		return []Chunk{{0, len(s)}}
+	}
+	overlap := min(opts.Overlap, opts.MaxSize/2)
+
+	var (
+		chunks     []Chunk
+		window     []chunkCluster // The clusters from "start" on.
+		size       int            // The size of the clusters in "window".
+		start, end int            // The start of the current chunk and the end of the previous one.
+		offset     int            // The byte offset after the last cluster.
+		blank      = true         // Whether the current hard line is empty.
+		cluster    string
+		boundaries Boundaries
+		state      State
+	)
+	for rest := s; len(rest) > 0; {
+		cluster, rest, boundaries, state = step(p, rest, state, nil, utf8.DecodeRuneInString)
+		offset += len(cluster)
+
+		// Determine the size of the cluster and the boundary after it.
+		var cost int
+		switch {
+		case opts.Cost != nil:
+			cost = opts.Cost(cluster)
+		case opts.Unit == ChunkUnitGraphemes:
+			cost = 1
+		case opts.Unit == ChunkUnitCells:
+			cost = boundaries.Width()
+		default:
+			cost = len(cluster)
+		}
+		r, _ := utf8.DecodeRuneInString(cluster)
+		space := unicode.IsSpace(r)
+		boundary := chunkGrapheme
+		if boundaries.Word() {
+			boundary = chunkWord
+		}
+		if boundaries.Line() == LineCanBreak {
+			boundary = chunkLine
+		}
+		if boundaries.Sentence() {
+			boundary = chunkSentence
+		}
+		if boundaries.Line() == LineMustBreak {
+			boundary = chunkSentence
+			if blank || r == '\u2029' {
+				boundary = chunkParagraph
+			}
+			blank = true
+		} else if !space {
+			blank = false
+		}
+		window = append(window, chunkCluster{end: offset, cost: cost, boundary: boundary, space: space})
+		size += cost
+
+		// Cut chunks while the window is too large.
+		for size > opts.MaxSize && len(window) > 0 {
+			// Find the best end among the clusters which fit. The chunk must
+			// extend beyond the end of the previous one.
+			cut := -1
+			var fitting int
+			for i, c := range window[:len(window)-1] {
+				if fitting += c.cost; fitting > opts.MaxSize {
+					break
+				}
+				if c.end > end && (cut < 0 || c.boundary >= window[cut].boundary) {
+					cut = i
+				}
+			}
+			if cut < 0 && start < end {
+				// Nothing fits after the overlap. Drop it.
+				for len(window) > 0 && window[0].end <= end {
+					size -= window[0].cost
+					window = window[1:]
+				}
+				start = end
+				continue
+			}
+			if cut < 0 {
+				cut = 0 // The first cluster is too large.
+			}
+			end = window[cut].end
+			chunks = append(chunks, Chunk{start, end})
+
+			// Start the next chunk, including the overlap.
+			next := cut + 1
+			var overlapSize int
+			for i := cut; i > 0 && overlap > 0; i-- {
+				if overlapSize += window[i].cost; overlapSize > overlap {
+					break
+				}
+				if window[i].space || window[i-1].boundary < chunkWord {
+					continue
+				}
+				if next > cut || window[i-1].boundary >= window[next-1].boundary {
+					next = i
+				}
+			}
+			start = window[next-1].end
+			for _, c := range window[:next] {
+				size -= c.cost
+			}
+			window = append(window[:0], window[next:]...)
+		}
+	}
+
+	// Add the last chunk.
+	if offset > end {
+		chunks = append(chunks, Chunk{start, offset})
+	}
+	return chunks
+}
@@ -0,0 +1,110 @@
+package uniseg
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// chunkTexts returns the texts of the given chunks of s.
+func chunkTexts(s string, chunks []Chunk) []string {
+	var texts []string
+	for _, chunk := range chunks {
+		texts = append(texts, s[chunk.Start:chunk.End])
+	}
+	return texts
+}
+
+// chunkTestCases are texts with their expected chunks.
+var chunkTestCases = []struct {
+	name     string
+	original string
+	options  ChunkOptions
+	expected []string
+}{
+	{"empty", "", ChunkOptions{MaxSize: 10}, nil},
+	{"fits", "Hello, world!", ChunkOptions{MaxSize: 20}, []string{"Hello, world!"}},
+	{"no limit", "Hello, world!", ChunkOptions{}, []string{"Hello, world!"}},
+	{"paragraphs", "One. Two.\n\nThree. Four.", ChunkOptions{MaxSize: 20}, []string{"One. Two.\n\n", "Three. Four."}},
+	{"paragraph separator", "One. Two.\u2029Three. Four.", ChunkOptions{MaxSize: 20}, []string{"One. Two.\u2029", "Three. Four."}},
+	{"blank line with spaces", "One. Two.\n \nThree. Four.", ChunkOptions{MaxSize: 20}, []string{"One. Two.\n \n", "Three. Four."}},
+	{"sentences", "One two. Three four. Five six.", ChunkOptions{MaxSize: 24}, []string{"One two. Three four. ", "Five six."}},
+	{"hard lines", "one two\nthree four", ChunkOptions{MaxSize: 12}, []string{"one two\n", "three four"}},
+	{"line breaks", "one-two three-four", ChunkOptions{MaxSize: 11}, []string{"one-two ", "three-four"}},
+	{"line break before word", "alpha-beta", ChunkOptions{MaxSize: 8}, []string{"alpha-", "beta"}},
+	{"words", "Hello, world", ChunkOptions{MaxSize: 6}, []string{"Hello,", " world"}},
+	{"graphemes", "abcdefgh", ChunkOptions{MaxSize: 3}, []string{"abc", "def", "gh"}},
+	{"oversized cluster", "a🏳️\u200d🌈b", ChunkOptions{MaxSize: 4}, []string{"a", "🏳️\u200d🌈", "b"}},
+	{"grapheme units", "🇩🇪🇫🇷 🇯🇵🇮🇹", ChunkOptions{MaxSize: 3, Unit: ChunkUnitGraphemes}, []string{"🇩🇪🇫🇷 ", "🇯🇵🇮🇹"}},
+	{"cell units", "日本語 日本語", ChunkOptions{MaxSize: 7, Unit: ChunkUnitCells}, []string{"日本語 ", "日本語"}},
+	{"cost", "aa bb cc dd", ChunkOptions{MaxSize: 2, Cost: func(cluster string) int {
+		if cluster == " " {
+			return 0
+		}
+		return 1
+	}}, []string{"aa ", "bb ", "cc ", "dd"}},
+	{"overlap", "One two three four five six", ChunkOptions{MaxSize: 14, Overlap: 6}, []string{"One two three ", "three four ", "four five six"}},
+	{"overlap too small", "One two three four five six", ChunkOptions{MaxSize: 14, Overlap: 5}, []string{"One two three ", "four five six"}},
+	{"overlap sentence", "A b c. D e f g h i j.", ChunkOptions{MaxSize: 12, Overlap: 6}, []string{"A b c. ", "b c. D e f ", "D e f g h i ", "g h i j."}},
+	{"overlap limit", "aaaa bbbb cccc", ChunkOptions{MaxSize: 6, Overlap: 100}, []string{"aaaa ", "bbbb ", "cccc"}},
+	{"overlap dropped", "ab cdefgh", ChunkOptions{MaxSize: 6, Overlap: 3}, []string{"ab ", "cdefgh"}},
+}
+
+func TestChunkText(t *testing.T) {
+	for _, tt := range chunkTestCases {
+		chunks := ChunkText(tt.original, tt.options)
+		if got := chunkTexts(tt.original, chunks); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%s: ChunkText(%q) = %q, want %q", tt.name, tt.original, got, tt.expected)
+			continue
+		}
+
+		// Chunks must be ordered and cover the whole text.
+		var end int
+		for _, chunk := range chunks {
+			if chunk.Start > end || chunk.End <= end {
+				t.Errorf("%s: chunk %v doesn't continue at %d", tt.name, chunk, end)
+			}
+			end = chunk.End
+		}
+		if end != len(tt.original) {
+			t.Errorf("%s: chunks end at %d, want %d", tt.name, end, len(tt.original))
+		}
+		if tt.options.Overlap == 0 && strings.Join(chunkTexts(tt.original, chunks), "") != tt.original {
+			t.Errorf("%s: chunks don't add up to the original text", tt.name)
+		}
+	}
+}
+
+func TestChunkTextLong(t *testing.T) {
+	// All chunks fit and the text is covered.
+	text := strings.Repeat(benchmarkStr+"\n\n", 50)
+	for _, options := range []ChunkOptions{
+		{MaxSize: 100},
+		{MaxSize: 100, Overlap: 30},
+		{MaxSize: 40, Unit: ChunkUnitCells, Overlap: 10},
+		{MaxSize: 7, Unit: ChunkUnitGraphemes},
+	} {
+		var end int
+		for _, chunk := range ChunkText(text, options) {
+			if chunk.Start > end || chunk.End <= end {
+				t.Fatalf("%+v: chunk %v doesn't continue at %d", options, chunk, end)
+			}
+			end = chunk.End
+			var size int
+			switch options.Unit {
+			case ChunkUnitCells:
+				size = StringWidth(text[chunk.Start:chunk.End])
+			case ChunkUnitGraphemes:
+				size = GraphemeClusterCount(text[chunk.Start:chunk.End])
+			default:
+				size = chunk.End - chunk.Start
+			}
+			if size > options.MaxSize {
+				t.Errorf("%+v: chunk %q has size %d", options, text[chunk.Start:chunk.End], size)
+			}
+		}
+		if end != len(text) {
+			t.Errorf("%+v: chunks end at %d, want %d", options, end, len(text))
+		}
+	}
+}
@@ -0,0 +1,627 @@
+// Gen_properties generates Go source code containing Unicode property tables
+// from the Unicode Character Database (UCD).
+//
+// It is used to generate the built-in tables of the uniseg package, and it can
+// be used to generate custom tables that tailor the East_Asian_Width and
+// Line_Break properties of individual code points, e.g. to match the fonts or
+// locales of an application, without forking the package.
+//
+// Usage:
+//
+//	gen_properties [flags] <output file> <variable name>
+//
+// The flags are:
+//
+//	-property <name>
+//		The name of the UCD data file to parse, without extension, e.g.
+//		"LineBreak" or "auxiliary/GraphemeBreakProperty".
+//	-only <value>
+//		Include only the code points with the given property value, e.g.
+//		"Default_Ignorable_Code_Point" for DerivedCoreProperties.
+//	-emojis <property>
+//		Include the code points with the given property from emoji-data.txt,
+//		e.g. "Extended_Pictographic".
+//	-gencat
+//		Include the General_Category of each code point.
+//	-prefix <prefix>
+//		The prefix of the Go constants for the property values (default "pr").
+//	-type <name>
+//		The name of the Go type of the property values (default "property").
+//	-overrides <file>
+//		Merge the property values in the given file into the UCD data.
+//	-ucd <directory>
+//		Read the UCD files from the given local directory instead of
+//		downloading them from unicode.org.
+//	-package <name>
+//		Generate a table set for use outside of the uniseg package (see below).
+//	-logprefix <prefix>
+//		The prefix of log messages.
+//
+// # Overrides
+//
+// An override file uses the same format as the UCD data files, with an
+// additional field naming the property. Empty lines and comments starting
+// with "#" are ignored:
+//
+//	# Render these symbols as wide.
+//	2460..24FF ; EastAsianWidth ; W
+//	00B7       ; LineBreak      ; AL
+//
+// The property names are the names of the UCD files ("EastAsianWidth" and
+// "LineBreak") and the values are the short aliases used in those files. An
+// override replaces the value of all code points in its range, including code
+// points which are not listed in the UCD. Later lines take precedence over
+// earlier ones.
+//
+// # Custom Table Sets
+//
+// If the -package flag names a package other than "uniseg", gen_properties
+// generates the East_Asian_Width and Line_Break tables, with overrides applied,
+// and a variable of type *uniseg.Tables with the given name in that package.
+// The -property, -emojis, -gencat, -prefix, and -type flags are ignored in
+// this mode. Assign the variable to the Tables field of a uniseg.Parser to use
+// it:
+//
+//	//go:generate go run github.com/shogo82148/uniseg/cmd/gen_properties -package=fonts -overrides=overrides.txt tables.go Tables
+//
+//	p := &uniseg.Parser{Tables: fonts.Tables}
+//	width := p.StringWidth(s)
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// We want to test against a specific version rather than the latest. When the
+// package is upgraded to a new version, change these to generate new tests.
+const (
+	unicodeVersion    = "17.0.0"
+	propertyURLFormat = `https://www.unicode.org/Public/%s/ucd/%s.txt`
+	emojiURLFormat    = `https://unicode.org/Public/%s/ucd/emoji/emoji-data.txt`
+)
+
+// The regular expression for a line containing a code point range property.
+var propertyPattern = regexp.MustCompile(`^([0-9A-F]{4,6})(\.\.([0-9A-F]{4,6}))?\s*;\s*([A-Za-z0-9_]+)\s*#\s(.+)$`)
+
+// The regular expression for a line of an override file.
+var overridePattern = regexp.MustCompile(`^([0-9A-Fa-f]{4,6})(\.\.([0-9A-Fa-f]{4,6}))?\s*;\s*([A-Za-z0-9_]+)\s*;\s*([A-Za-z0-9_&]+)$`)
+
+type Options struct {
+	propertyName   string
+	only           string
+	emojis         string
+	gencat         bool
+	dictionaryName string
+	prefix         string
+	typeName       string
+	ucdDir         string
+	overrides      []override
+}
+
+// override is a property value from an override file.
+type override struct {
+	from, to uint64
+	property string
+	value    string
+	source   string
+}
+
+func main() {
+	var propertyName string
+	var only string
+	var emojis string
+	var gencat bool
+	var logPrefix string
+	var prefix string
+	var typeName string
+	var overridesFilename string
+	var ucdDir string
+	var packageName string
+	flag.StringVar(&propertyName, "property", "", "name of the property")
+	flag.StringVar(&only, "only", "", "include only this property value")
+	flag.StringVar(&emojis, "emojis", "", "emoji properties to include")
+	flag.BoolVar(&gencat, "gencat", false, "include general category properties")
+	flag.StringVar(&logPrefix, "logprefix", "", "prefix for log messages")
+	flag.StringVar(&prefix, "prefix", "pr", "prefix for property names")
+	flag.StringVar(&typeName, "type", "property", "name of the property type")
+	flag.StringVar(&overridesFilename, "overrides", "", "file with property values overriding the UCD")
+	flag.StringVar(&ucdDir, "ucd", "", "local directory containing the UCD files")
+	flag.StringVar(&packageName, "package", "uniseg", "name of the generated package")
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		fmt.Fprintln(os.Stderr, "usage: gen_properties [flags] <output file> <variable name>")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	outputFilename := flag.Arg(0)
+	dictionaryName := flag.Arg(1)
+
+	log.SetPrefix("gen_properties (" + logPrefix + "): ")
+	log.SetFlags(0)
+
+	var overrides []override
+	if overridesFilename != "" {
+		var err error
+		overrides, err = parseOverrides(overridesFilename)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	var (
+		src string
+		err error
+	)
+	if packageName == "uniseg" {
+		src, err = parse(&Options{
+			propertyName:   propertyName,
+			only:           only,
+			emojis:         emojis,
+			gencat:         gencat,
+			dictionaryName: dictionaryName,
+			prefix:         prefix,
+			typeName:       typeName,
+			ucdDir:         ucdDir,
+			overrides:      overrides,
+		})
+	} else {
+		src, err = parseTables(packageName, dictionaryName, ucdDir, overrides)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Format the Go code.
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		log.Fatal("gofmt:", err)
+	}
+
+	// Save it to the (local) target file.
+	log.Print("Writing to ", outputFilename)
+	if err := os.WriteFile(outputFilename, formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse parses the Unicode Properties text files located at the given URLs and
+// returns their equivalent Go source code to be used in the uniseg package. If
+// "emojiProperty" is not an empty string, emoji code points for that emoji
+// property (e.g. "Extended_Pictographic") will be included. In those cases, you
+// may pass an empty "propertyURL" to skip parsing the main properties file. If
+// "includeGeneralCategory" is true, the Unicode General Category property will
+// be extracted from the comments and included in the output.
+func parse(opts *Options) (string, error) {
+	if opts.propertyName == "" && opts.emojis == "" {
+		return "", errors.New("no properties to parse")
+	}
+	var propertyURL string
+	var emojiURL string
+
+	// Temporary buffer to hold properties.
+	var properties [][4]string
+
+	// Open the first URL.
+	if opts.propertyName != "" {
+		var err error
+		propertyURL = fmt.Sprintf(propertyURLFormat, unicodeVersion, opts.propertyName)
+		properties, err = parseProperties(opts.ucdDir, propertyURL, opts.only)
+		if err != nil {
+			return "", err
+		}
+		properties, err = applyOverrides(properties, opts.overrides, path.Base(opts.propertyName), opts.gencat)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// Open the second URL.
+	if opts.emojis != "" {
+		emojiURL = fmt.Sprintf(emojiURLFormat, unicodeVersion)
+		emojiProperties, err := parseProperties(opts.ucdDir, emojiURL, opts.emojis)
+		if err != nil {
+			return "", err
+		}
+		properties = append(properties, emojiProperties...)
+	}
+
+	// Sort properties.
+	sortProperties(properties)
+	properties = eytzinger(properties)
+
+	// Header.
+	var (
+		buf          bytes.Buffer
+		onlyComment  string
+		emojiComment string
+	)
+	if opts.only != "" {
+		onlyComment = `
+// ("` + opts.only + `" only)`
+	}
+	if emojiURL != "" {
+		emojiComment = `
+// and
+// ` + emojiURL + `
+// ("Extended_Pictographic" only)`
+	}
+
+	buf.WriteString(`// Code generated by ./cmd/gen_properties/gen_properties.go; DO NOT EDIT.
+
+package uniseg
+
+// ` + opts.dictionaryName + ` are taken from
+// ` + propertyURL + onlyComment + emojiComment + overridesComment(opts.overrides) + `
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+var ` + opts.dictionaryName + ` = dictionary[` + opts.typeName + `]{
+`)
+
+	// Properties.
+	for _, prop := range properties {
+		if opts.gencat {
+			generalCategory := "0"
+			if len(prop[3]) >= 2 {
+				generalCategory = "gc" + prop[3][:2]
+				if generalCategory == "gcL&" {
+					generalCategory = "gcLC"
+				}
+				prop[3] = prop[3][3:]
+			}
+			fmt.Fprintf(
+				&buf,
+				"{runeRange{%s,%s}, propertyGeneralCategory{%s, %s}}, // %s\n",
+				formatRune(prop[0]), formatRune(prop[1]), translateProperty(opts.prefix, prop[2]), generalCategory, prop[3],
+			)
+		} else {
+			fmt.Fprintf(
+				&buf,
+				"{runeRange{%s,%s}, %s}, // %s\n",
+				formatRune(prop[0]), formatRune(prop[1]), translateProperty(opts.prefix, prop[2]), prop[3],
+			)
+		}
+	}
+
+	// Tail.
+	buf.WriteString("}")
+
+	return buf.String(), nil
+}
+
+// parseTables parses the East_Asian_Width and Line_Break properties, applies
+// the overrides, and returns Go source code for the package with the given
+// name, defining a *uniseg.Tables variable with the given name.
+func parseTables(packageName, name, ucdDir string, overrides []override) (string, error) {
+	eawURL := fmt.Sprintf(propertyURLFormat, unicodeVersion, "EastAsianWidth")
+	eaw, err := parseProperties(ucdDir, eawURL, "")
+	if err != nil {
+		return "", err
+	}
+	if eaw, err = applyOverrides(eaw, overrides, "EastAsianWidth", false); err != nil {
+		return "", err
+	}
+	lbURL := fmt.Sprintf(propertyURLFormat, unicodeVersion, "LineBreak")
+	lb, err := parseProperties(ucdDir, lbURL, "")
+	if err != nil {
+		return "", err
+	}
+	if lb, err = applyOverrides(lb, overrides, "LineBreak", true); err != nil {
+		return "", err
+	}
+	for _, o := range overrides {
+		if o.property != "EastAsianWidth" && o.property != "LineBreak" {
+			return "", fmt.Errorf("%s: property %q cannot be overridden in a table set", o.source, o.property)
+		}
+	}
+	sortProperties(eaw)
+	sortProperties(lb)
+
+	var buf bytes.Buffer
+	buf.WriteString(`// Code generated by github.com/shogo82148/uniseg/cmd/gen_properties; DO NOT EDIT.
+
+package ` + packageName + `
+
+import "github.com/shogo82148/uniseg"
+
+// ` + name + ` are Unicode property tables taken from
+// ` + eawURL + `
+// and
+// ` + lbURL + overridesComment(overrides) + `
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+var ` + name + ` = func() *uniseg.Tables {
+	t, err := uniseg.NewTables(` + name + `EastAsianWidth, ` + name + `LineBreak)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}()
+
+var ` + name + `EastAsianWidth = []uniseg.PropertyRange{
+`)
+	for _, prop := range eaw {
+		fmt.Fprintf(&buf, "{Lo: %s, Hi: %s, Value: %q}, // %s\n", formatRune(prop[0]), formatRune(prop[1]), prop[2], prop[3])
+	}
+	buf.WriteString(`}
+
+var ` + name + `LineBreak = []uniseg.PropertyRange{
+`)
+	for _, prop := range lb {
+		var generalCategory string
+		if len(prop[3]) >= 2 {
+			generalCategory = prop[3][:2]
+			prop[3] = prop[3][3:]
+		}
+		fmt.Fprintf(&buf, "{Lo: %s, Hi: %s, Value: %q, GeneralCategory: %q}, // %s\n", formatRune(prop[0]), formatRune(prop[1]), prop[2], generalCategory, prop[3])
+	}
+	buf.WriteString("}\n")
+
+	return buf.String(), nil
+}
+
+// parseProperties reads the UCD file at the given URL (or its copy in the local
+// directory "ucdDir", if not empty) and returns its code point ranges with
+// their properties and comments. If "only" is not empty, only the entries with
+// that property are returned.
+func parseProperties(ucdDir, url, only string) ([][4]string, error) {
+	log.Printf("Parsing %s", url)
+	in, err := open(ucdDir, url)
+	if err != nil {
+		return nil, err
+	}
+	defer in.Close()
+
+	// Parse it.
+	var properties [][4]string
+	scanner := bufio.NewScanner(in)
+	num := 0
+	for scanner.Scan() {
+		num++
+		line := strings.TrimSpace(scanner.Text())
+
+		// Skip comments and empty lines.
+		if strings.HasPrefix(line, "#") || line == "" {
+			continue
+		}
+
+		// Skip other properties, they may have a different number of fields.
+		if only != "" {
+			_, rest, _ := strings.Cut(line, ";")
+			rest, _, _ = strings.Cut(rest, "#")
+			if strings.TrimSpace(rest) != only {
+				continue
+			}
+		}
+
+		// Everything else must be a code point range, a property and a comment.
+		from, to, property, comment, err := parseProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: %v", path.Base(url), num, err)
+		}
+		properties = append(properties, [4]string{from, to, property, comment})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return properties, nil
+}
+
+// open opens the UCD file at the given URL. If "ucdDir" is not empty, the file
+// is read from that local directory instead, using the path of the URL
+// relative to the "ucd" directory.
+func open(ucdDir, url string) (io.ReadCloser, error) {
+	if ucdDir != "" {
+		name := path.Base(url)
+		if i := strings.Index(url, "/ucd/"); i >= 0 {
+			name = url[i+len("/ucd/"):]
+		}
+		return os.Open(filepath.Join(ucdDir, filepath.FromSlash(name)))
+	}
+	res, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("GET %s: %s", url, res.Status)
+	}
+	return res.Body, nil
+}
+
+// parseOverrides reads the override file with the given name.
+func parseOverrides(filename string) ([]override, error) {
+	log.Printf("Parsing overrides %s", filename)
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var overrides []override
+	scanner := bufio.NewScanner(f)
+	num := 0
+	for scanner.Scan() {
+		num++
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		fields := overridePattern.FindStringSubmatch(line)
+		if fields == nil {
+			return nil, fmt.Errorf("%s line %d: invalid override %q", filename, num, line)
+		}
+		from, _ := strconv.ParseUint(fields[1], 16, 64)
+		to := from
+		if fields[3] != "" {
+			to, _ = strconv.ParseUint(fields[3], 16, 64)
+		}
+		if from > to || to > 0x10ffff {
+			return nil, fmt.Errorf("%s line %d: invalid code point range %q", filename, num, line)
+		}
+		overrides = append(overrides, override{
+			from:     from,
+			to:       to,
+			property: fields[4],
+			value:    fields[5],
+			source:   fmt.Sprintf("%s line %d", filepath.Base(filename), num),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// applyOverrides replaces the property values of the given code point ranges
+// with the values of the overrides for the property "propertyName". Ranges are
+// split where necessary. Code points not covered by the properties are added.
+// If "gencat" is true, the General Category stored at the start of the
+// comments is preserved.
+func applyOverrides(properties [][4]string, overrides []override, propertyName string, gencat bool) ([][4]string, error) {
+	sortProperties(properties)
+	for _, o := range overrides {
+		if o.property != propertyName {
+			continue
+		}
+		result := make([][4]string, 0, len(properties)+3)
+		next := o.from // The first code point of the override not yet covered.
+		addMissing := func(to uint64) {
+			if next > to || next > o.to {
+				return
+			}
+			comment := "<unlisted> (" + o.source + ")"
+			if gencat {
+				comment = "Cn " + comment
+			}
+			result = append(result, [4]string{formatHex(next), formatHex(min(to, o.to)), o.value, comment})
+			next = min(to, o.to) + 1
+		}
+		for _, prop := range properties {
+			from, _ := strconv.ParseUint(prop[0], 16, 64)
+			to, _ := strconv.ParseUint(prop[1], 16, 64)
+			if to < o.from || from > o.to {
+				if from > o.to {
+					addMissing(o.to)
+				}
+				result = append(result, prop)
+				continue
+			}
+			if from < o.from {
+				result = append(result, [4]string{prop[0], formatHex(o.from - 1), prop[2], prop[3]})
+				from = o.from
+			}
+			if from > 0 {
+				addMissing(from - 1)
+			}
+			result = append(result, [4]string{formatHex(from), formatHex(min(to, o.to)), o.value, prop[3] + " (" + o.source + ")"})
+			next = min(to, o.to) + 1
+			if to > o.to {
+				result = append(result, [4]string{formatHex(o.to + 1), prop[1], prop[2], prop[3]})
+			}
+		}
+		addMissing(o.to)
+		sortProperties(result)
+		properties = result
+	}
+	return properties, nil
+}
+
+// overridesComment returns a comment line listing the sources of the given
+// overrides, or an empty string if there are none.
+func overridesComment(overrides []override) string {
+	if len(overrides) == 0 {
+		return ""
+	}
+	source, _, _ := strings.Cut(overrides[0].source, " line ")
+	return `
+// with overrides from ` + source
+}
+
+// sortProperties sorts the given properties by their first code point.
+func sortProperties(properties [][4]string) {
+	sort.SliceStable(properties, func(i, j int) bool {
+		left, _ := strconv.ParseUint(properties[i][0], 16, 64)
+		right, _ := strconv.ParseUint(properties[j][0], 16, 64)
+		return left < right
+	})
+}
+
+func formatRune(s string) string {
+	if s == "" {
+		return "0"
+	}
+	return "0x" + s
+}
+
+// formatHex formats a code point the way the UCD files do.
+func formatHex(r uint64) string {
+	return fmt.Sprintf("%04X", r)
+}
+
+// parseProperty parses a line of the Unicode properties text file containing a
+// property for a code point range and returns it along with its comment.
+func parseProperty(line string) (from, to, property, comment string, err error) {
+	fields := propertyPattern.FindStringSubmatch(line)
+	if fields == nil {
+		err = errors.New("no property found")
+		return
+	}
+	from = fields[1]
+	to = fields[3]
+	if to == "" {
+		to = from
+	}
+	property = fields[4]
+	comment = fields[5]
+	return
+}
+
+// translateProperty translates a property name as used in the Unicode data file
+// to a variable used in the Go code.
+func translateProperty(prefix, property string) string {
+	if property == "" {
+		return "0"
+	}
+	return prefix + strings.ReplaceAll(property, "_", "")
+}
+
+func eytzinger[S ~[]E, E any](a S) S {
+	b := make(S, len(a))
+	ctx := &eytzingerContext[S, E]{a: a, b: b}
+	ctx.eytzinger(0, 0)
+	return b
+}
+
+type eytzingerContext[S ~[]E, E any] struct {
+	a S // input slice
+	b S // output slice
+}
+
+func (ctx *eytzingerContext[S, E]) eytzinger(i, k int) int {
+	if k < len(ctx.a) {
+		i = ctx.eytzinger(i, 2*k+1)
+		ctx.b[k] = ctx.a[i]
+		i++
+		i = ctx.eytzinger(i, 2*k+2)
+	}
+	return i
+}
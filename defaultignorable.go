@@ -0,0 +1,37 @@
+// Code generated by ./cmd/gen_properties/gen_properties.go; DO NOT EDIT.
+
+package uniseg
+
+// defaultIgnorable are taken from
+// https://www.unicode.org/Public/17.0.0/ucd/DerivedCoreProperties.txt
+// ("Default_Ignorable_Code_Point" only)
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+var defaultIgnorable = dictionary[derivedCoreProperty]{
+	{runeRange{0xFEFF, 0xFEFF}, prDefaultIgnorableCodePoint},   // Cf       ZERO WIDTH NO-BREAK SPACE
+	{runeRange{0x180F, 0x180F}, prDefaultIgnorableCodePoint},   // Mn       MONGOLIAN FREE VARIATION SELECTOR FOUR
+	{runeRange{0xE0020, 0xE007F}, prDefaultIgnorableCodePoint}, // Cf  [96] TAG SPACE..CANCEL TAG
+	{runeRange{0x115F, 0x1160}, prDefaultIgnorableCodePoint},   // Lo   [2] HANGUL CHOSEONG FILLER..HANGUL JUNGSEONG FILLER
+	{runeRange{0x2065, 0x2065}, prDefaultIgnorableCodePoint},   // Cn       <reserved-2065>
+	{runeRange{0x1D173, 0x1D17A}, prDefaultIgnorableCodePoint}, // Cf   [8] MUSICAL SYMBOL BEGIN BEAM..MUSICAL SYMBOL END PHRASE
+	{runeRange{0xE0100, 0xE01EF}, prDefaultIgnorableCodePoint}, // Mn [240] VARIATION SELECTOR-17..VARIATION SELECTOR-256
+	{runeRange{0x034F, 0x034F}, prDefaultIgnorableCodePoint},   // Mn       COMBINING GRAPHEME JOINER
+	{runeRange{0x180B, 0x180D}, prDefaultIgnorableCodePoint},   // Mn   [3] MONGOLIAN FREE VARIATION SELECTOR ONE..MONGOLIAN FREE VARIATION SELECTOR THREE
+	{runeRange{0x202A, 0x202E}, prDefaultIgnorableCodePoint},   // Cf   [5] LEFT-TO-RIGHT EMBEDDING..RIGHT-TO-LEFT OVERRIDE
+	{runeRange{0x3164, 0x3164}, prDefaultIgnorableCodePoint},   // Lo       HANGUL FILLER
+	{runeRange{0xFFF0, 0xFFF8}, prDefaultIgnorableCodePoint},   // Cn   [9] <reserved-FFF0>..<reserved-FFF8>
+	{runeRange{0xE0001, 0xE0001}, prDefaultIgnorableCodePoint}, // Cf       LANGUAGE TAG
+	{runeRange{0xE0080, 0xE00FF}, prDefaultIgnorableCodePoint}, // Cn [128] <reserved-E0080>..<reserved-E00FF>
+	{runeRange{0xE01F0, 0xE0FFF}, prDefaultIgnorableCodePoint}, // Cn [3600] <reserved-E01F0>..<reserved-E0FFF>
+	{runeRange{0x00AD, 0x00AD}, prDefaultIgnorableCodePoint},   // Cf       SOFT HYPHEN
+	{runeRange{0x061C, 0x061C}, prDefaultIgnorableCodePoint},   // Cf       ARABIC LETTER MARK
+	{runeRange{0x17B4, 0x17B5}, prDefaultIgnorableCodePoint},   // Mn   [2] KHMER VOWEL INHERENT AQ..KHMER VOWEL INHERENT AA
+	{runeRange{0x180E, 0x180E}, prDefaultIgnorableCodePoint},   // Cf       MONGOLIAN VOWEL SEPARATOR
+	{runeRange{0x200B, 0x200F}, prDefaultIgnorableCodePoint},   // Cf   [5] ZERO WIDTH SPACE..RIGHT-TO-LEFT MARK
+	{runeRange{0x2060, 0x2064}, prDefaultIgnorableCodePoint},   // Cf   [5] WORD JOINER..INVISIBLE PLUS
+	{runeRange{0x2066, 0x206F}, prDefaultIgnorableCodePoint},   // Cf  [10] LEFT-TO-RIGHT ISOLATE..NOMINAL DIGIT SHAPES
+	{runeRange{0xFE00, 0xFE0F}, prDefaultIgnorableCodePoint},   // Mn  [16] VARIATION SELECTOR-1..VARIATION SELECTOR-16
+	{runeRange{0xFFA0, 0xFFA0}, prDefaultIgnorableCodePoint},   // Lo       HALFWIDTH HANGUL FILLER
+	{runeRange{0x1BCA0, 0x1BCA3}, prDefaultIgnorableCodePoint}, // Cf   [4] SHORTHAND FORMAT LETTER OVERLAP..SHORTHAND FORMAT UP STEP
+	{runeRange{0xE0000, 0xE0000}, prDefaultIgnorableCodePoint}, // Cn       <reserved-E0000>
+	{runeRange{0xE0002, 0xE001F}, prDefaultIgnorableCodePoint}, // Cn  [30] <reserved-E0002>..<reserved-E001F>
+}
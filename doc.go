@@ -40,6 +40,19 @@ utf8.RuneCountInString("🏳️‍🌈") and len([]rune("🏳️‍🌈")) will
 The [GraphemeClusterCount] function will return 1 for the rainbow flag emoji.
 The Graphemes class and a variety of functions in this package will allow you to
 split strings into its grapheme clusters.
+[SubstringGraphemes], [GraphemeAt], and [GraphemeOffsets] index strings by
+grapheme clusters instead of bytes or runes.
+[TruncateBytes] and [ChunkBytes] cut strings to a byte budget without splitting
+grapheme clusters.
+[ChunkText] splits long documents into chunks of a maximum size at the best
+boundaries available, from paragraph breaks down to grapheme clusters.
+The [Editor] class implements the cursor movements, deletions, and selections of
+a text editor on grapheme cluster, word, and sentence boundaries.
+
+These are extended grapheme clusters, which keep spacing marks, prepended
+characters, and Indic consonant conjuncts together. Set
+[Parser.LegacyGraphemeClusters] to split text into the legacy grapheme clusters
+used by some older software instead.
 
 # Word Boundaries
 
@@ -49,6 +62,20 @@ next word" control-arrow keys), and the dialog option "Whole Word Search" for
 search and replace. This package provides methods for determining word
 boundaries.
 
+Some scripts, such as Thai, Lao, Khmer, and Myanmar, don't separate words with
+spaces. The rules of Unicode Standard Annex #29 can't find the words in such
+text and break between nearly all of its characters instead. Set
+[Parser.ComplexContext] to a [WordSegmenter], for example a [WordDictionary]
+built from a word list, to split these runs into words. Similarly, Chinese and
+Japanese text is split into single ideographs and Hiragana characters unless
+[Parser.CJK] is set, for example to a [Lexicon] with word frequencies.
+Segmenters are used by [Parser.FirstWord], [Parser.Step], and the [Graphemes]
+class.
+
+URLs, email addresses, hashtags, and mentions are split into several words by
+the rules of Unicode Standard Annex #29. Set [Parser.Tokens] to keep them
+together, and use [Parser.FirstToken] to find out which kind of token a word is.
+
 # Sentence Boundaries
 
 Sentence boundaries are often used for triple-click or some other method of
@@ -57,6 +84,12 @@ They are also used to determine whether words occur within the same sentence in
 database queries. This package provides methods for determining sentence
 boundaries.
 
+The rules of Unicode Standard Annex #29 end a sentence after "Mr." in
+"Mr. Smith arrived." because a full stop followed by a space and an uppercase
+letter usually ends a sentence. Set [Parser.Abbreviations] to a list of
+abbreviations, for example one returned by [LocaleAbbreviations], to suppress
+such boundaries.
+
 # Line Breaking
 
 Line breaking, also known as word wrapping, is the process of breaking a section
@@ -65,6 +98,59 @@ window or other display area. This package provides methods to determine the
 positions in a string where a line must be broken, may be broken, or must not be
 broken.
 
+[HardLines] and [Paragraphs] split text at mandatory line breaks and paragraph
+separators, including CR LF, U+0085 NEXT LINE, U+2028 LINE SEPARATOR, and
+U+2029 PARAGRAPH SEPARATOR, and [NormalizeNewlines] replaces all kinds of line
+breaks with a single newline sequence.
+
+Text in scripts such as Thai may only be broken between words, which the rules
+of Unicode Standard Annex #14 can't find. Such text is not broken at all unless
+[Parser.ComplexContextBreaker] or [Parser.ComplexContext] is set to a
+[WordSegmenter].
+
+For Japanese and Chinese typography, [Parser.LineBreakStrictness] relaxes the
+rules for small kana, iteration marks, and some punctuation in the same way as
+the CSS line-break property. Similarly, [Parser.WordBreak] can keep words
+together in Korean text or allow breaks between any two letters, like the CSS
+word-break property.
+
+The default rules also break URLs after hyphens and question marks. Set
+[Parser.URLLineBreaks] to break them only after slashes and before question
+marks, number signs, and ampersands instead.
+
+A line may also be broken after a soft hyphen (U+00AD), which is then displayed
+as a hyphen. Set [Parser.Hyphens] to have such breaks reported as
+[LineHyphenBreak], and assign a [Hyphenator] with TeX hyphenation patterns to
+[Parser.Hyphenator] to hyphenate words automatically.
+
+[WrapGreedy] and [WrapOptimal] break paragraphs into lines of a maximum width.
+The latter uses the algorithm of Knuth and Plass to fill the lines as evenly
+as possible. [WrapBalanced] makes all lines of roughly equal width, like the
+CSS "text-wrap: balance" property. All of them measure monospace widths by default
+but accept a function which measures text in a proportional font instead.
+Line segments wider than a line, such as long URLs, stick out unless
+[WrapOptions.Overflow] selects another [Overflow] policy.
+
+# Bidirectional Text
+
+Text in right-to-left scripts such as Arabic and Hebrew, possibly mixed with
+left-to-right text and numbers, is stored in logical order but displayed in a
+different, visual order. [Bidi] resolves the embedding levels of a text
+according to [Unicode Standard Annex #9] and reorders the lines returned by the
+wrapping functions. Grapheme clusters are never split, and brackets and other
+mirrored characters in right-to-left runs are replaced by their mirror images.
+
+# Script Runs
+
+Many tasks, such as choosing a font or a segmentation dictionary, need text
+split into runs of a single script (Latin, Greek, Han, and so on). The
+[ScriptRuns] class splits a string into such runs according to [Unicode
+Standard Annex #24]. It resolves punctuation, digits, combining marks, and other
+characters shared between scripts from their context, and it never splits a
+grapheme cluster. [Graphemes.Script] returns the script of the current grapheme
+cluster, and [RuneScript] and [RuneScriptExtensions] return the script
+properties of individual code points.
+
 # Monospace Width
 
 Monospace width, as referred to in this package, is the width of a string in a
@@ -80,29 +166,45 @@ To start, we assume that every code point has a width of 1, with the following
 exceptions:
 
   - Code points with grapheme cluster break properties Control, CR, LF, Extend,
-    and ZWJ have a width of 0.
+    and ZWJ have a width of 0, except for the Emoji Modifiers (skin tones,
+    U+1F3FB to U+1F3FF) which have a width of 2.
+  - Code points with the Default_Ignorable_Code_Point property, e.g. U+3164
+    Hangul Filler, have a width of 0.
   - U+2E3A, Two-Em Dash, has a width of 3.
   - U+2E3B, Three-Em Dash, has a width of 4.
   - Characters with the East-Asian Width properties "Fullwidth" (F) and "Wide"
     (W) have a width of 2. (Properties "Ambiguous" (A) and "Neutral" (N) both
-    have a width of 1.)
+    have a width of 1, unless [Parser.EastAsianWidth] is set, in which case
+    "Ambiguous" has a width of 2.)
   - Code points with grapheme cluster break property Regional Indicator have a
     width of 2.
   - Code points with grapheme cluster break property Extended Pictographic have
     a width of 2, unless their Emoji Presentation flag is "No", in which case
-    the width is 1.
+    the width is 1 (or 2 if [Parser.WideEmoji] is set).
 
 For Hangul grapheme clusters composed of conjoining Jamo and for Regional
-Indicators (flags), all code points except the first one have a width of 0. For
-grapheme clusters starting with an Extended Pictographic, any additional code
-point will force a total width of 2, except if the Variation Selector-15
-(U+FE0E) is included, in which case the total width is always 1. Grapheme
-clusters ending with Variation Selector-16 (U+FE0F) have a width of 2.
+Indicators (flags), all code points except the first one have a width of 0. (A
+Hangul cluster starting with U+115F Hangul Choseong Filler has a width of 2 if
+it contains any other visible Jamo.) For
+grapheme clusters starting with an Extended Pictographic, an Emoji Modifier, a
+ZWJ followed by another Extended Pictographic, or the Variation Selector-16
+(U+FE0F) force a total width of 2, whereas the Variation Selector-15 (U+FE0E)
+forces a total width of 1. Other code points don't change the width of such
+clusters. Emoji keycap sequences (U+FE0F followed by U+20E3) have a width of 2.
+
+As a result, all emoji sequences listed as "fully-qualified",
+"minimally-qualified", or "component" in the Unicode [emoji-test.txt] file have
+a width of 2. Unqualified emoji, i.e. single code points and keycap sequences
+without U+FE0F, are displayed in text presentation by default and measured like
+their first code point.
 
 Note that whether these widths appear correct depends on your application's
 render engine, to which extent it conforms to the Unicode Standard, and its
 choice of font.
 
+[Unicode Standard Annex #9]: https://www.unicode.org/reports/tr9/
+[Unicode Standard Annex #24]: https://www.unicode.org/reports/tr24/
 [wcswidth()]: https://man7.org/linux/man-pages/man3/wcswidth.3.html
+[emoji-test.txt]: https://www.unicode.org/Public/emoji/latest/emoji-test.txt
 */
 package uniseg
@@ -0,0 +1,455 @@
+package uniseg
+
+import (
+	"slices"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Editor implements the cursor movements and edits of a text editor on the
+// boundaries of [Unicode Standard Annex #29]: moving by grapheme cluster, by
+// word, and by sentence, deleting grapheme clusters, and selecting the word or
+// sentence at a position. It never places the cursor inside a grapheme
+// cluster, so flags, emoji sequences, and characters with combining marks are
+// treated as single characters.
+//
+// The text is held in a byte slice, and positions are byte offsets into it.
+// The editor also maintains a selection between an anchor and the cursor,
+// which is empty if both are at the same position.
+//
+// Grapheme cluster operations only examine the few characters around the
+// cursor. Word and sentence operations parse the paragraph around the cursor,
+// that is the text between two mandatory line breaks, as boundaries never
+// depend on text beyond them. Its boundaries are kept until the next edit, so
+// moving through a paragraph word by word parses it only once.
+//
+// [Unicode Standard Annex #29]: https://www.unicode.org/reports/tr29/tr29-45.html
+type Editor struct {
+	parser *Parser
+
+	// The text being edited.
+	text []byte
+
+	// The byte offsets of the cursor and of the other end of the selection.
+	pos, anchor int
+
+	// The word and sentence segments of the paragraphs examined last.
+	wordSegments, sentenceSegments editorSegments
+}
+
+// editorSegments are the word or sentence segments of one paragraph of the
+// text of an [Editor].
+type editorSegments struct {
+	// The byte offsets of the paragraph. If both are equal, nothing is cached.
+	start, end int
+
+	// The end of each segment. Each segment starts at the end of the previous
+	// one.
+	ends []int
+
+	// For word segments, whether each segment is a word and not white space
+	// or punctuation.
+	words []bool
+}
+
+// NewEditor returns a new editor for a copy of the given text, with the
+// cursor at the beginning.
+func NewEditor(text string) *Editor {
+	return DefaultParser.NewEditor(text)
+}
+
+// NewEditor is like [NewEditor] but uses the given parser.
+func (p *Parser) NewEditor(text string) *Editor {
+	return &Editor{parser: p, text: []byte(text)}
+}
+
+// NewEditorBytes returns a new editor for the given text, with the cursor at
+// the beginning. The editor takes ownership of the slice and modifies it.
+func NewEditorBytes(text []byte) *Editor {
+	return DefaultParser.NewEditorBytes(text)
+}
+
+// NewEditorBytes is like [NewEditorBytes] but uses the given parser.
+func (p *Parser) NewEditorBytes(text []byte) *Editor {
+	return &Editor{parser: p, text: text}
+}
+
+// Text returns the current text.
+func (e *Editor) Text() string {
+	return string(e.text)
+}
+
+// Bytes returns the current text. The returned slice is shared with the editor
+// and is only valid until the next edit.
+func (e *Editor) Bytes() []byte {
+	return e.text
+}
+
+// Pos returns the byte offset of the cursor.
+func (e *Editor) Pos() int {
+	return e.pos
+}
+
+// Selection returns the byte offsets of the beginning and the end of the
+// selection. Both are equal if nothing is selected.
+func (e *Editor) Selection() (start, end int) {
+	return min(e.pos, e.anchor), max(e.pos, e.anchor)
+}
+
+// SetPos moves the cursor to the given byte offset, or to the beginning of the
+// grapheme cluster containing it. Offsets outside the text are clamped. If
+// "extend" is true, the selection is extended to the new position, like with
+// a mouse click while holding the Shift key. Otherwise, the selection is
+// cleared.
+func (e *Editor) SetPos(pos int, extend bool) {
+	pos = min(max(pos, 0), len(e.text))
+	start, _ := e.cluster(pos)
+	e.moveTo(start, extend)
+}
+
+// Left moves the cursor to the previous grapheme cluster boundary. If
+// "extend" is false and text is selected, the cursor moves to the beginning
+// of the selection instead.
+func (e *Editor) Left(extend bool) {
+	if e.collapse(extend, false) {
+		return
+	}
+	start, _ := e.cluster(e.pos - 1)
+	e.moveTo(start, extend)
+}
+
+// Right moves the cursor to the next grapheme cluster boundary. If "extend"
+// is false and text is selected, the cursor moves to the end of the selection
+// instead.
+func (e *Editor) Right(extend bool) {
+	if e.collapse(extend, true) {
+		return
+	}
+	_, end := e.cluster(e.pos)
+	e.moveTo(end, extend)
+}
+
+// WordLeft moves the cursor to the beginning of the current word or, if it is
+// already there, of the previous word, like Ctrl+Left. White space and
+// punctuation are skipped: words contain at least one letter or digit, or
+// they are tokens of the kinds set in [Parser.Tokens].
+func (e *Editor) WordLeft(extend bool) {
+	if e.collapse(extend, false) {
+		return
+	}
+	e.moveTo(e.previous(e.pos, true), extend)
+}
+
+// WordRight moves the cursor to the end of the current word or, if it is
+// already there, of the next word, like Ctrl+Right. White space and
+// punctuation are skipped as with [Editor.WordLeft].
+func (e *Editor) WordRight(extend bool) {
+	if e.collapse(extend, true) {
+		return
+	}
+	pos := len(e.text)
+	e.words(e.pos, func(start, end int, word bool) bool {
+		if word {
+			pos = end
+			return false
+		}
+		return true
+	})
+	e.moveTo(pos, extend)
+}
+
+// SentenceLeft moves the cursor to the beginning of the current sentence or,
+// if it is already there, of the previous sentence.
+func (e *Editor) SentenceLeft(extend bool) {
+	if e.collapse(extend, false) {
+		return
+	}
+	e.moveTo(e.previous(e.pos, false), extend)
+}
+
+// SentenceRight moves the cursor to the end of the current sentence, which is
+// the beginning of the next sentence. Spaces after a sentence belong to it.
+func (e *Editor) SentenceRight(extend bool) {
+	if e.collapse(extend, true) {
+		return
+	}
+	pos := len(e.text)
+	e.sentences(e.pos, func(start, end int) bool {
+		pos = end
+		return false
+	})
+	e.moveTo(pos, extend)
+}
+
+// Backspace deletes the selected text or, if nothing is selected, the
+// grapheme cluster before the cursor.
+func (e *Editor) Backspace() {
+	if e.pos == e.anchor {
+		e.anchor, _ = e.cluster(e.pos - 1)
+	}
+	e.Insert("")
+}
+
+// Delete deletes the selected text or, if nothing is selected, the grapheme
+// cluster after the cursor.
+func (e *Editor) Delete() {
+	if e.pos == e.anchor {
+		_, e.anchor = e.cluster(e.pos)
+	}
+	e.Insert("")
+}
+
+// Insert replaces the selected text with the given text and places the cursor
+// after it. If the inserted text combines with the following text, for
+// example if it is followed by a combining mark, the cursor is placed after
+// the combined grapheme cluster.
+func (e *Editor) Insert(text string) {
+	start, end := e.Selection()
+	e.text = slices.Replace(e.text, start, end, []byte(text)...)
+	e.wordSegments.end = e.wordSegments.start
+	e.sentenceSegments.end = e.sentenceSegments.start
+	e.pos = start + len(text)
+	if clusterStart, clusterEnd := e.cluster(e.pos); clusterStart < e.pos {
+		e.pos = clusterEnd
+	}
+	e.anchor = e.pos
+}
+
+// SelectWord selects the word segment containing the given byte offset, like
+// a double click. The segment may also consist of white space or
+// punctuation. At the end of the text, the last segment is selected.
+func (e *Editor) SelectWord(pos int) {
+	pos = min(max(pos, 0), len(e.text)-1)
+	e.words(pos, func(start, end int, word bool) bool {
+		e.anchor, e.pos = start, end
+		return false
+	})
+}
+
+// SelectSentence selects the sentence containing the given byte offset,
+// including the spaces after it, like a triple click. At the end of the text,
+// the last sentence is selected.
+func (e *Editor) SelectSentence(pos int) {
+	pos = min(max(pos, 0), len(e.text)-1)
+	e.sentences(pos, func(start, end int) bool {
+		e.anchor, e.pos = start, end
+		return false
+	})
+}
+
+// moveTo moves the cursor to the given position, extending the selection or
+// clearing it.
+func (e *Editor) moveTo(pos int, extend bool) {
+	e.pos = pos
+	if !extend {
+		e.anchor = pos
+	}
+}
+
+// collapse clears a selection when moving without extending it, placing the
+// cursor at its end if "forward" is true or at its beginning otherwise. It
+// returns true if there was a selection.
+func (e *Editor) collapse(extend, forward bool) bool {
+	if extend || e.pos == e.anchor {
+		return false
+	}
+	start, end := e.Selection()
+	if forward {
+		e.moveTo(end, false)
+	} else {
+		e.moveTo(start, false)
+	}
+	return true
+}
+
+// cluster returns the byte offsets of the grapheme cluster containing the
+// given position. At the end of the text, the end is returned twice. Before
+// the beginning, 0 is returned twice.
+func (e *Editor) cluster(pos int) (start, end int) {
+	if pos < 0 {
+		return 0, 0
+	}
+	if pos >= len(e.text) {
+		return len(e.text), len(e.text)
+	}
+	var (
+		cluster []byte
+		state   GraphemeBreakState
+	)
+	for end = e.clusterBoundary(pos); end <= pos; {
+		start = end
+		cluster, _, _, state = firstGraphemeCluster(e.parser, e.text[end:], state, utf8.DecodeRune)
+		end += len(cluster)
+	}
+	return
+}
+
+// clusterBoundary returns a grapheme cluster boundary at or before the given
+// position, which must be inside the text. It looks back for two adjacent
+// code points which never belong to the same grapheme cluster, which usually
+// takes only a few code points.
+func (e *Editor) clusterBoundary(pos int) int {
+	for pos > 0 && !utf8.RuneStart(e.text[pos]) {
+		pos--
+	}
+	next, _ := utf8.DecodeRune(e.text[pos:])
+	for pos > 0 {
+		r, length := utf8.DecodeLastRune(e.text[:pos])
+		if isClusterBoundary(r, next) {
+			return pos
+		}
+		pos -= length
+		next = r
+	}
+	return 0
+}
+
+// isClusterBoundary returns true if there is always a grapheme cluster
+// boundary between the two given code points, regardless of the text before
+// them. This is the case if neither of them has a grapheme cluster break
+// property which combines with other characters.
+func isClusterBoundary(r, next rune) bool {
+	switch graphemeCodePoints.search(r) {
+	case prAny, prExtendedPictographic, prControl, prLF:
+	case prCR:
+		if next == '\n' {
+			return false
+		}
+	default:
+		return false
+	}
+	switch graphemeCodePoints.search(next) {
+	case prAny, prExtendedPictographic, prControl, prCR, prLF:
+		return true
+	}
+	return false
+}
+
+// previous returns the beginning of the word (if "word" is true) or sentence
+// which contains the character before the given position or, if there is no
+// such word, of the previous one.
+func (e *Editor) previous(pos int, word bool) int {
+	for pos > 0 {
+		c := e.segments(pos-1, word)
+		for i := sort.SearchInts(c.ends, pos); i >= 0; i-- {
+			if !word || c.words[i] {
+				return c.segmentStart(i)
+			}
+		}
+		pos = c.start
+	}
+	return 0
+}
+
+// words calls the given function for the word segments from the one
+// containing the given position on, until it returns false. "word" is true
+// if the segment is a word and not white space or punctuation.
+func (e *Editor) words(pos int, f func(start, end int, word bool) bool) {
+	for pos = max(pos, 0); pos < len(e.text); {
+		c := e.segments(pos, true)
+		for i := sort.SearchInts(c.ends, pos+1); i < len(c.ends); i++ {
+			if !f(c.segmentStart(i), c.ends[i], c.words[i]) {
+				return
+			}
+		}
+		pos = c.end
+	}
+}
+
+// sentences calls the given function for the sentences from the one
+// containing the given position on, until it returns false.
+func (e *Editor) sentences(pos int, f func(start, end int) bool) {
+	for pos = max(pos, 0); pos < len(e.text); {
+		c := e.segments(pos, false)
+		for i := sort.SearchInts(c.ends, pos+1); i < len(c.ends); i++ {
+			if !f(c.segmentStart(i), c.ends[i]) {
+				return
+			}
+		}
+		pos = c.end
+	}
+}
+
+// segments returns the word segments (if "words" is true) or the sentences of
+// the paragraph containing the given position, which must be inside the
+// text. They are parsed if they are not cached yet.
+func (e *Editor) segments(pos int, words bool) *editorSegments {
+	c := &e.sentenceSegments
+	if words {
+		c = &e.wordSegments
+	}
+	if c.start <= pos && pos < c.end {
+		return c
+	}
+	c.start = e.paragraph(pos)
+	c.ends, c.words = c.ends[:0], c.words[:0]
+	var (
+		wordState     WordBreakState
+		sentenceState SentenceBreakState
+	)
+	for c.end = c.start; c.end < len(e.text) && (c.end == c.start || !e.isParagraphEnd(c.end)); {
+		var (
+			segment []byte
+			kind    TokenKind
+		)
+		if words {
+			segment, _, kind, wordState = firstWord(e.parser, e.text[c.end:], wordState, utf8.DecodeRune)
+			c.words = append(c.words, kind != TokenNone || isWordSegment(segment))
+		} else {
+			segment, _, sentenceState = firstSentence(e.parser, e.text[c.end:], sentenceState, utf8.DecodeRune)
+		}
+		c.end += len(segment)
+		c.ends = append(c.ends, c.end)
+	}
+	return c
+}
+
+// segmentStart returns the byte offset of the beginning of the segment with
+// the given index.
+func (c *editorSegments) segmentStart(i int) int {
+	if i == 0 {
+		return c.start
+	}
+	return c.ends[i-1]
+}
+
+// paragraph returns the beginning of the paragraph containing the given
+// position, that is the position after the last paragraph separator before
+// it. Grapheme cluster, word, and sentence boundaries always occur after
+// these, so parsing may start there.
+func (e *Editor) paragraph(pos int) int {
+	for pos > 0 && !e.isParagraphEnd(pos) {
+		pos--
+	}
+	return pos
+}
+
+// isParagraphEnd returns true if the given position follows a paragraph
+// separator (CR, LF, CRLF, NEL, LS, or PS).
+func (e *Editor) isParagraphEnd(pos int) bool {
+	switch c := e.text[pos-1]; {
+	case c == '\n':
+		return true
+	case c == '\r' && (pos == len(e.text) || e.text[pos] != '\n'):
+		return true
+	case c == 0x85 && pos >= 2 && e.text[pos-2] == 0xc2: // NEL
+		return true
+	case (c == 0xa8 || c == 0xa9) && pos >= 3 && e.text[pos-3] == 0xe2 && e.text[pos-2] == 0x80: // LS, PS
+		return true
+	}
+	return false
+}
+
+// isWordSegment returns true if the given word segment contains a letter or a
+// digit.
+func isWordSegment(segment []byte) bool {
+	for len(segment) > 0 {
+		r, l := utf8.DecodeRune(segment)
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return true
+		}
+		segment = segment[l:]
+	}
+	return false
+}
@@ -0,0 +1,380 @@
+package uniseg
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// segmentBounds returns the byte offsets of the boundaries between the
+// expected segments of a test case, including 0 and the length of the text.
+func segmentBounds(segments [][]rune) []int {
+	bounds := []int{0}
+	for _, segment := range segments {
+		bounds = append(bounds, bounds[len(bounds)-1]+len(string(segment)))
+	}
+	return bounds
+}
+
+// Test cursor movements by grapheme cluster with the conformance test cases.
+func TestEditorGraphemeConformance(t *testing.T) {
+	for _, tt := range append(testCases, graphemeBreakTestCases...) {
+		expected := segmentBounds(tt.expected)
+
+		// Moving right.
+		e := NewEditor(tt.original)
+		got := []int{e.Pos()}
+		for e.Pos() < len(tt.original) {
+			e.Right(false)
+			got = append(got, e.Pos())
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s: Right positions %v, want %v", tt.name, got, expected)
+			continue
+		}
+
+		// Moving left.
+		e.SetPos(len(tt.original), false)
+		got = []int{e.Pos()}
+		for e.Pos() > 0 {
+			e.Left(false)
+			got = append([]int{e.Pos()}, got...)
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s: Left positions %v, want %v", tt.name, got, expected)
+		}
+
+		// Setting positions inside clusters.
+		for i := 1; i < len(expected); i++ {
+			for pos := expected[i-1]; pos < expected[i]; pos++ {
+				if e.SetPos(pos, false); e.Pos() != expected[i-1] {
+					t.Errorf("%s: SetPos(%d) moved to %d, want %d", tt.name, pos, e.Pos(), expected[i-1])
+				}
+			}
+		}
+
+		// Deleting backward.
+		if len(tt.original) > 0 {
+			e.SetPos(len(tt.original), false)
+			e.Backspace()
+			if got, expected := e.Text(), tt.original[:expected[len(expected)-2]]; got != expected {
+				t.Errorf("%s: Backspace results in %q, want %q", tt.name, got, expected)
+			}
+		}
+	}
+}
+
+// Test word selections with the conformance test cases.
+func TestEditorWordConformance(t *testing.T) {
+	for _, tt := range wordBreakTestCases {
+		expected := segmentBounds(tt.expected)
+		e := NewEditor(tt.original)
+		for i := 1; i < len(expected); i++ {
+			for pos := expected[i-1]; pos < expected[i]; pos++ {
+				e.SelectWord(pos)
+				if start, end := e.Selection(); start != expected[i-1] || end != expected[i] {
+					t.Errorf("%s: SelectWord(%d) = %d, %d, want %d, %d", tt.name, pos, start, end, expected[i-1], expected[i])
+				}
+			}
+		}
+	}
+}
+
+// Test sentence selections with the conformance test cases.
+func TestEditorSentenceConformance(t *testing.T) {
+	for _, tt := range sentenceBreakTestCases {
+		expected := segmentBounds(tt.expected)
+		e := NewEditor(tt.original)
+		for i := 1; i < len(expected); i++ {
+			for pos := expected[i-1]; pos < expected[i]; pos++ {
+				e.SelectSentence(pos)
+				if start, end := e.Selection(); start != expected[i-1] || end != expected[i] {
+					t.Errorf("%s: SelectSentence(%d) = %d, %d, want %d, %d", tt.name, pos, start, end, expected[i-1], expected[i])
+				}
+			}
+		}
+	}
+}
+
+// editorTestCases are texts with the expected cursor positions when moving
+// from the beginning to the end and back.
+var editorTestCases = []struct {
+	name        string
+	original    string
+	wordRight   []int
+	wordLeft    []int
+	sentenceFwd []int
+	sentenceBwd []int
+}{
+	{
+		name:        "words",
+		original:    "Hello, world! How are you?",
+		wordRight:   []int{5, 12, 17, 21, 25, 26},
+		wordLeft:    []int{22, 18, 14, 7, 0},
+		sentenceFwd: []int{14, 26},
+		sentenceBwd: []int{14, 0},
+	},
+	{
+		name:        "punctuation only",
+		original:    " ... ",
+		wordRight:   []int{5},
+		wordLeft:    []int{0},
+		sentenceFwd: []int{5},
+		sentenceBwd: []int{0},
+	},
+	{
+		name:        "contractions and numbers",
+		original:    "can't 3.14 foo_bar",
+		wordRight:   []int{5, 10, 18},
+		wordLeft:    []int{11, 6, 0},
+		sentenceFwd: []int{18},
+		sentenceBwd: []int{0},
+	},
+	{
+		name:        "paragraphs",
+		original:    "One two.\n\nThree.",
+		wordRight:   []int{3, 7, 15, 16},
+		wordLeft:    []int{10, 4, 0},
+		sentenceFwd: []int{9, 10, 16},
+		sentenceBwd: []int{10, 9, 0},
+	},
+	{
+		name:        "ideographs",
+		original:    "日本語。テスト。",
+		wordRight:   []int{3, 6, 9, 21, 24},
+		wordLeft:    []int{12, 6, 3, 0},
+		sentenceFwd: []int{12, 24},
+		sentenceBwd: []int{12, 0},
+	},
+}
+
+func TestEditorMovements(t *testing.T) {
+	for _, tt := range editorTestCases {
+		for _, move := range []struct {
+			name     string
+			start    int
+			move     func(*Editor, bool)
+			expected []int
+		}{
+			{"WordRight", 0, (*Editor).WordRight, tt.wordRight},
+			{"WordLeft", len(tt.original), (*Editor).WordLeft, tt.wordLeft},
+			{"SentenceRight", 0, (*Editor).SentenceRight, tt.sentenceFwd},
+			{"SentenceLeft", len(tt.original), (*Editor).SentenceLeft, tt.sentenceBwd},
+		} {
+			e := NewEditor(tt.original)
+			e.SetPos(move.start, false)
+			var got []int
+			for range len(move.expected) + 1 {
+				pos := e.Pos()
+				move.move(e, false)
+				if e.Pos() == pos {
+					break
+				}
+				got = append(got, e.Pos())
+			}
+			if !reflect.DeepEqual(got, move.expected) {
+				t.Errorf("%s: %s positions %v, want %v", tt.name, move.name, got, move.expected)
+			}
+		}
+	}
+}
+
+// Test that the editor finds the same boundaries in a long paragraph as the
+// segmentation functions, without parsing the paragraph for every movement.
+func TestEditorLongParagraph(t *testing.T) {
+	text := strings.Repeat("Lorem ipsum, dolor. 🇩🇪🇫🇷 e\u0301 ", 2000)
+	var clusters, words []int
+	g := NewGraphemes(text)
+	for g.Next() {
+		_, end := g.Positions()
+		clusters = append(clusters, end)
+	}
+	var (
+		state WordBreakState
+		end   int
+	)
+	for str := text; len(str) > 0; {
+		var word string
+		word, str, state = FirstWordInString(str, state)
+		if end += len(word); isWordSegment([]byte(word)) {
+			words = append(words, end)
+		}
+	}
+	words = append(words, len(text)) // After the last word.
+
+	e := NewEditor(text)
+	var got []int
+	for e.Pos() < len(text) {
+		e.Right(false)
+		got = append(got, e.Pos())
+	}
+	if !reflect.DeepEqual(got, clusters) {
+		t.Error("Right positions differ from the grapheme cluster boundaries")
+	}
+	for got = nil; e.Pos() > 0; {
+		got = append(got, e.Pos())
+		e.Left(false)
+	}
+	slices.Reverse(got)
+	if !reflect.DeepEqual(got, clusters) {
+		t.Error("Left positions differ from the grapheme cluster boundaries")
+	}
+	for got = nil; e.Pos() < len(text); {
+		e.WordRight(false)
+		got = append(got, e.Pos())
+	}
+	if !reflect.DeepEqual(got, words) {
+		t.Error("WordRight positions differ from the word boundaries")
+	}
+
+	// Edits invalidate the boundaries.
+	e.SetPos(len(text)/2, false)
+	e.SelectWord(e.Pos())
+	e.Insert("x y")
+	e.WordLeft(false)
+	e.WordRight(false)
+	if start, end := e.Selection(); end-start != 0 || e.Text()[e.Pos()-3:e.Pos()] != "x y" {
+		t.Errorf("WordRight after an edit moved to %d", e.Pos())
+	}
+}
+
+func TestEditorTokens(t *testing.T) {
+	// Tokens are single words.
+	p := &Parser{Tokens: TokenURL}
+	e := p.NewEditor("see https://example.com/a?b now")
+	e.WordRight(false)
+	e.WordRight(false)
+	if e.Pos() != 27 {
+		t.Errorf("WordRight over a URL moved to %d, want 27", e.Pos())
+	}
+	e.SelectWord(10)
+	if start, end := e.Selection(); start != 4 || end != 27 {
+		t.Errorf("SelectWord in a URL = %d, %d, want 4, 27", start, end)
+	}
+}
+
+func TestEditorEdits(t *testing.T) {
+	// Regional indicators are deleted in pairs.
+	e := NewEditor("🇩🇪🇫🇷🇯🇵")
+	e.SetPos(len(e.Text()), false)
+	e.Left(false)
+	e.Backspace()
+	if got, expected := e.Text(), "🇩🇪🇯🇵"; got != expected {
+		t.Errorf("Backspace results in %q, want %q", got, expected)
+	}
+	if e.Pos() != 8 {
+		t.Errorf("Backspace moved to %d, want 8", e.Pos())
+	}
+	e.Delete()
+	if got, expected := e.Text(), "🇩🇪"; got != expected {
+		t.Errorf("Delete results in %q, want %q", got, expected)
+	}
+
+	// ZWJ sequences are deleted as one.
+	e = NewEditorBytes([]byte("a👩\u200d👩\u200d👧b"))
+	e.SetPos(5, false) // Inside the sequence.
+	if e.Pos() != 1 {
+		t.Errorf("SetPos inside a ZWJ sequence moved to %d, want 1", e.Pos())
+	}
+	e.Delete()
+	if got, expected := string(e.Bytes()), "ab"; got != expected {
+		t.Errorf("Delete results in %q, want %q", got, expected)
+	}
+
+	// Combining marks.
+	e = NewEditor("e\u0301x")
+	e.Right(false)
+	if e.Pos() != 3 {
+		t.Errorf("Right over a combining mark moved to %d, want 3", e.Pos())
+	}
+	e.Backspace()
+	if got, expected := e.Text(), "x"; got != expected {
+		t.Errorf("Backspace results in %q, want %q", got, expected)
+	}
+	e.Insert("a")
+	e.Insert("\u0308")
+	if got, expected := e.Text(), "a\u0308x"; got != expected || e.Pos() != 3 {
+		t.Errorf("Insert results in %q at %d, want %q at 3", got, e.Pos(), expected)
+	}
+	e.SetPos(0, false)
+	e.Insert("o")
+	if e.Pos() != 1 {
+		t.Errorf("Insert moved to %d, want 1", e.Pos())
+	}
+	e = NewEditor("\u0301x")
+	e.Insert("a")
+	if e.Pos() != 3 {
+		t.Errorf("Insert before a combining mark moved to %d, want 3", e.Pos())
+	}
+
+	// Deleting at the edges.
+	e = NewEditor("ab")
+	e.Backspace()
+	e.SetPos(2, false)
+	e.Delete()
+	if got := e.Text(); got != "ab" {
+		t.Errorf("Deleting at the edges results in %q, want %q", got, "ab")
+	}
+
+	// An empty text.
+	e = NewEditor("")
+	e.Left(false)
+	e.Right(false)
+	e.WordLeft(false)
+	e.WordRight(false)
+	e.SentenceLeft(false)
+	e.SentenceRight(false)
+	e.Backspace()
+	e.Delete()
+	e.SelectWord(0)
+	e.SelectSentence(0)
+	if start, end := e.Selection(); e.Text() != "" || start != 0 || end != 0 {
+		t.Errorf("Editing an empty text results in %q, %d, %d", e.Text(), start, end)
+	}
+}
+
+func TestEditorSelection(t *testing.T) {
+	e := NewEditor("Hello, world! How are you?")
+	e.SelectWord(8)
+	if start, end := e.Selection(); start != 7 || end != 12 {
+		t.Errorf("SelectWord = %d, %d, want 7, 12", start, end)
+	}
+	e.SelectWord(len(e.Text()))
+	if start, end := e.Selection(); start != 25 || end != 26 {
+		t.Errorf("SelectWord at the end = %d, %d, want 25, 26", start, end)
+	}
+	e.SelectSentence(20)
+	if start, end := e.Selection(); start != 14 || end != 26 {
+		t.Errorf("SelectSentence = %d, %d, want 14, 26", start, end)
+	}
+
+	// Moving without extending collapses the selection.
+	e.Left(false)
+	if start, end := e.Selection(); start != 14 || end != 14 {
+		t.Errorf("Left collapsed the selection to %d, %d, want 14, 14", start, end)
+	}
+	e.SelectWord(8)
+	e.Right(false)
+	if start, end := e.Selection(); start != 12 || end != 12 {
+		t.Errorf("Right collapsed the selection to %d, %d, want 12, 12", start, end)
+	}
+
+	// Extending the selection.
+	e.WordLeft(true)
+	e.WordLeft(true)
+	if start, end := e.Selection(); start != 0 || end != 12 || e.Pos() != 0 {
+		t.Errorf("WordLeft extended the selection to %d, %d, want 0, 12", start, end)
+	}
+
+	// Replacing the selection.
+	e.Insert("Goodbye, cruel world")
+	if got, expected := e.Text(), "Goodbye, cruel world! How are you?"; got != expected {
+		t.Errorf("Insert results in %q, want %q", got, expected)
+	}
+	e.SelectSentence(0)
+	e.Backspace()
+	if got, expected := e.Text(), "How are you?"; got != expected || e.Pos() != 0 {
+		t.Errorf("Backspace results in %q at %d, want %q at 0", got, e.Pos(), expected)
+	}
+}
@@ -0,0 +1,25179 @@
+// Code generated by ./internal/cmd/gen_emojitest/gen_emojitest.go; DO NOT EDIT.
+
+package uniseg
+
+// emojiTestCases are emoji testcases taken from
+// emoji-test-15.1.txt (Version: 15.1)
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+var emojiTestCases = []emojiTestCase{
+	{
+		name:     "E1.0 grinning face",
+		original: "\U0001F600",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 grinning face with big eyes",
+		original: "\U0001F603",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 grinning face with smiling eyes",
+		original: "\U0001F604",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 beaming face with smiling eyes",
+		original: "\U0001F601",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 grinning squinting face",
+		original: "\U0001F606",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 grinning face with sweat",
+		original: "\U0001F605",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 rolling on the floor laughing",
+		original: "\U0001F923",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 face with tears of joy",
+		original: "\U0001F602",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 slightly smiling face",
+		original: "\U0001F642",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 upside-down face",
+		original: "\U0001F643",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 melting face",
+		original: "\U0001FAE0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 winking face",
+		original: "\U0001F609",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 smiling face with smiling eyes",
+		original: "\U0001F60A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 smiling face with halo",
+		original: "\U0001F607",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 smiling face with hearts",
+		original: "\U0001F970",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 smiling face with heart-eyes",
+		original: "\U0001F60D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 star-struck",
+		original: "\U0001F929",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 face blowing a kiss",
+		original: "\U0001F618",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 kissing face",
+		original: "\U0001F617",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 smiling face",
+		original: "\u263A\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 smiling face",
+		original: "\u263A",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 kissing face with closed eyes",
+		original: "\U0001F61A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 kissing face with smiling eyes",
+		original: "\U0001F619",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 smiling face with tear",
+		original: "\U0001F972",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 face savoring food",
+		original: "\U0001F60B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 face with tongue",
+		original: "\U0001F61B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 winking face with tongue",
+		original: "\U0001F61C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 zany face",
+		original: "\U0001F92A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 squinting face with tongue",
+		original: "\U0001F61D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 money-mouth face",
+		original: "\U0001F911",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 smiling face with open hands",
+		original: "\U0001F917",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 face with hand over mouth",
+		original: "\U0001F92D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 face with open eyes and hand over mouth",
+		original: "\U0001FAE2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 face with peeking eye",
+		original: "\U0001FAE3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 shushing face",
+		original: "\U0001F92B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 thinking face",
+		original: "\U0001F914",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 saluting face",
+		original: "\U0001FAE1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 zipper-mouth face",
+		original: "\U0001F910",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 face with raised eyebrow",
+		original: "\U0001F928",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 neutral face",
+		original: "\U0001F610",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 expressionless face",
+		original: "\U0001F611",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 face without mouth",
+		original: "\U0001F636",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 dotted line face",
+		original: "\U0001FAE5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 face in clouds",
+		original: "\U0001F636\u200D\U0001F32B\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 face in clouds",
+		original: "\U0001F636\u200D\U0001F32B",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 smirking face",
+		original: "\U0001F60F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 unamused face",
+		original: "\U0001F612",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 face with rolling eyes",
+		original: "\U0001F644",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 grimacing face",
+		original: "\U0001F62C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 face exhaling",
+		original: "\U0001F62E\u200D\U0001F4A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 lying face",
+		original: "\U0001F925",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 shaking face",
+		original: "\U0001FAE8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 head shaking horizontally",
+		original: "\U0001F642\u200D\u2194\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 head shaking horizontally",
+		original: "\U0001F642\u200D\u2194",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 head shaking vertically",
+		original: "\U0001F642\u200D\u2195\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 head shaking vertically",
+		original: "\U0001F642\u200D\u2195",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 relieved face",
+		original: "\U0001F60C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 pensive face",
+		original: "\U0001F614",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 sleepy face",
+		original: "\U0001F62A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 drooling face",
+		original: "\U0001F924",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 sleeping face",
+		original: "\U0001F634",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 face with medical mask",
+		original: "\U0001F637",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 face with thermometer",
+		original: "\U0001F912",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 face with head-bandage",
+		original: "\U0001F915",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 nauseated face",
+		original: "\U0001F922",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 face vomiting",
+		original: "\U0001F92E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 sneezing face",
+		original: "\U0001F927",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 hot face",
+		original: "\U0001F975",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 cold face",
+		original: "\U0001F976",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woozy face",
+		original: "\U0001F974",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 face with crossed-out eyes",
+		original: "\U0001F635",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 face with spiral eyes",
+		original: "\U0001F635\u200D\U0001F4AB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 exploding head",
+		original: "\U0001F92F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 cowboy hat face",
+		original: "\U0001F920",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 partying face",
+		original: "\U0001F973",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 disguised face",
+		original: "\U0001F978",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 smiling face with sunglasses",
+		original: "\U0001F60E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 nerd face",
+		original: "\U0001F913",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 face with monocle",
+		original: "\U0001F9D0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 confused face",
+		original: "\U0001F615",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 face with diagonal mouth",
+		original: "\U0001FAE4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 worried face",
+		original: "\U0001F61F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 slightly frowning face",
+		original: "\U0001F641",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 frowning face",
+		original: "\u2639\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 frowning face",
+		original: "\u2639",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 face with open mouth",
+		original: "\U0001F62E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 hushed face",
+		original: "\U0001F62F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 astonished face",
+		original: "\U0001F632",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 flushed face",
+		original: "\U0001F633",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 pleading face",
+		original: "\U0001F97A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 face holding back tears",
+		original: "\U0001F979",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 frowning face with open mouth",
+		original: "\U0001F626",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 anguished face",
+		original: "\U0001F627",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 fearful face",
+		original: "\U0001F628",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 anxious face with sweat",
+		original: "\U0001F630",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 sad but relieved face",
+		original: "\U0001F625",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 crying face",
+		original: "\U0001F622",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 loudly crying face",
+		original: "\U0001F62D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 face screaming in fear",
+		original: "\U0001F631",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 confounded face",
+		original: "\U0001F616",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 persevering face",
+		original: "\U0001F623",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 disappointed face",
+		original: "\U0001F61E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 downcast face with sweat",
+		original: "\U0001F613",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 weary face",
+		original: "\U0001F629",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 tired face",
+		original: "\U0001F62B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 yawning face",
+		original: "\U0001F971",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 face with steam from nose",
+		original: "\U0001F624",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 enraged face",
+		original: "\U0001F621",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 angry face",
+		original: "\U0001F620",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 face with symbols on mouth",
+		original: "\U0001F92C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 smiling face with horns",
+		original: "\U0001F608",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 angry face with horns",
+		original: "\U0001F47F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 skull",
+		original: "\U0001F480",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 skull and crossbones",
+		original: "\u2620\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 skull and crossbones",
+		original: "\u2620",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 pile of poo",
+		original: "\U0001F4A9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 clown face",
+		original: "\U0001F921",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 ogre",
+		original: "\U0001F479",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 goblin",
+		original: "\U0001F47A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 ghost",
+		original: "\U0001F47B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 alien",
+		original: "\U0001F47D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 alien monster",
+		original: "\U0001F47E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 robot",
+		original: "\U0001F916",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 grinning cat",
+		original: "\U0001F63A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 grinning cat with smiling eyes",
+		original: "\U0001F638",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 cat with tears of joy",
+		original: "\U0001F639",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 smiling cat with heart-eyes",
+		original: "\U0001F63B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 cat with wry smile",
+		original: "\U0001F63C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 kissing cat",
+		original: "\U0001F63D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 weary cat",
+		original: "\U0001F640",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 crying cat",
+		original: "\U0001F63F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 pouting cat",
+		original: "\U0001F63E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 see-no-evil monkey",
+		original: "\U0001F648",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 hear-no-evil monkey",
+		original: "\U0001F649",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 speak-no-evil monkey",
+		original: "\U0001F64A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 love letter",
+		original: "\U0001F48C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 heart with arrow",
+		original: "\U0001F498",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 heart with ribbon",
+		original: "\U0001F49D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 sparkling heart",
+		original: "\U0001F496",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 growing heart",
+		original: "\U0001F497",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 beating heart",
+		original: "\U0001F493",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 revolving hearts",
+		original: "\U0001F49E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 two hearts",
+		original: "\U0001F495",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 heart decoration",
+		original: "\U0001F49F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 heart exclamation",
+		original: "\u2763\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 heart exclamation",
+		original: "\u2763",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 broken heart",
+		original: "\U0001F494",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 heart on fire",
+		original: "\u2764\uFE0F\u200D\U0001F525",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 heart on fire",
+		original: "\u2764\u200D\U0001F525",
+		status:   "unqualified",
+	},
+	{
+		name:     "E13.1 mending heart",
+		original: "\u2764\uFE0F\u200D\U0001FA79",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 mending heart",
+		original: "\u2764\u200D\U0001FA79",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 red heart",
+		original: "\u2764\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 red heart",
+		original: "\u2764",
+		status:   "unqualified",
+	},
+	{
+		name:     "E15.0 pink heart",
+		original: "\U0001FA77",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 orange heart",
+		original: "\U0001F9E1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 yellow heart",
+		original: "\U0001F49B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 green heart",
+		original: "\U0001F49A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 blue heart",
+		original: "\U0001F499",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 light blue heart",
+		original: "\U0001FA75",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 purple heart",
+		original: "\U0001F49C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 brown heart",
+		original: "\U0001F90E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 black heart",
+		original: "\U0001F5A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 grey heart",
+		original: "\U0001FA76",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 white heart",
+		original: "\U0001F90D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 kiss mark",
+		original: "\U0001F48B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 hundred points",
+		original: "\U0001F4AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 anger symbol",
+		original: "\U0001F4A2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 collision",
+		original: "\U0001F4A5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 dizzy",
+		original: "\U0001F4AB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 sweat droplets",
+		original: "\U0001F4A6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 dashing away",
+		original: "\U0001F4A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 hole",
+		original: "\U0001F573\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 hole",
+		original: "\U0001F573",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 speech balloon",
+		original: "\U0001F4AC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 eye in speech bubble",
+		original: "\U0001F441\uFE0F\u200D\U0001F5E8\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 eye in speech bubble",
+		original: "\U0001F441\u200D\U0001F5E8\uFE0F",
+		status:   "unqualified",
+	},
+	{
+		name:     "E2.0 eye in speech bubble",
+		original: "\U0001F441\uFE0F\u200D\U0001F5E8",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E2.0 eye in speech bubble",
+		original: "\U0001F441\u200D\U0001F5E8",
+		status:   "unqualified",
+	},
+	{
+		name:     "E2.0 left speech bubble",
+		original: "\U0001F5E8\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 left speech bubble",
+		original: "\U0001F5E8",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 right anger bubble",
+		original: "\U0001F5EF\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 right anger bubble",
+		original: "\U0001F5EF",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 thought balloon",
+		original: "\U0001F4AD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 ZZZ",
+		original: "\U0001F4A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 waving hand",
+		original: "\U0001F44B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 waving hand: light skin tone",
+		original: "\U0001F44B\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 waving hand: medium-light skin tone",
+		original: "\U0001F44B\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 waving hand: medium skin tone",
+		original: "\U0001F44B\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 waving hand: medium-dark skin tone",
+		original: "\U0001F44B\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 waving hand: dark skin tone",
+		original: "\U0001F44B\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 raised back of hand",
+		original: "\U0001F91A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 raised back of hand: light skin tone",
+		original: "\U0001F91A\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 raised back of hand: medium-light skin tone",
+		original: "\U0001F91A\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 raised back of hand: medium skin tone",
+		original: "\U0001F91A\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 raised back of hand: medium-dark skin tone",
+		original: "\U0001F91A\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 raised back of hand: dark skin tone",
+		original: "\U0001F91A\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 hand with fingers splayed",
+		original: "\U0001F590\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 hand with fingers splayed",
+		original: "\U0001F590",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 hand with fingers splayed: light skin tone",
+		original: "\U0001F590\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 hand with fingers splayed: medium-light skin tone",
+		original: "\U0001F590\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 hand with fingers splayed: medium skin tone",
+		original: "\U0001F590\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 hand with fingers splayed: medium-dark skin tone",
+		original: "\U0001F590\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 hand with fingers splayed: dark skin tone",
+		original: "\U0001F590\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 raised hand",
+		original: "\u270B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 raised hand: light skin tone",
+		original: "\u270B\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 raised hand: medium-light skin tone",
+		original: "\u270B\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 raised hand: medium skin tone",
+		original: "\u270B\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 raised hand: medium-dark skin tone",
+		original: "\u270B\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 raised hand: dark skin tone",
+		original: "\u270B\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 vulcan salute",
+		original: "\U0001F596",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 vulcan salute: light skin tone",
+		original: "\U0001F596\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 vulcan salute: medium-light skin tone",
+		original: "\U0001F596\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 vulcan salute: medium skin tone",
+		original: "\U0001F596\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 vulcan salute: medium-dark skin tone",
+		original: "\U0001F596\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 vulcan salute: dark skin tone",
+		original: "\U0001F596\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 rightwards hand",
+		original: "\U0001FAF1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 rightwards hand: light skin tone",
+		original: "\U0001FAF1\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 rightwards hand: medium-light skin tone",
+		original: "\U0001FAF1\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 rightwards hand: medium skin tone",
+		original: "\U0001FAF1\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 rightwards hand: medium-dark skin tone",
+		original: "\U0001FAF1\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 rightwards hand: dark skin tone",
+		original: "\U0001FAF1\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 leftwards hand",
+		original: "\U0001FAF2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 leftwards hand: light skin tone",
+		original: "\U0001FAF2\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 leftwards hand: medium-light skin tone",
+		original: "\U0001FAF2\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 leftwards hand: medium skin tone",
+		original: "\U0001FAF2\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 leftwards hand: medium-dark skin tone",
+		original: "\U0001FAF2\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 leftwards hand: dark skin tone",
+		original: "\U0001FAF2\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 palm down hand",
+		original: "\U0001FAF3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 palm down hand: light skin tone",
+		original: "\U0001FAF3\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 palm down hand: medium-light skin tone",
+		original: "\U0001FAF3\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 palm down hand: medium skin tone",
+		original: "\U0001FAF3\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 palm down hand: medium-dark skin tone",
+		original: "\U0001FAF3\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 palm down hand: dark skin tone",
+		original: "\U0001FAF3\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 palm up hand",
+		original: "\U0001FAF4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 palm up hand: light skin tone",
+		original: "\U0001FAF4\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 palm up hand: medium-light skin tone",
+		original: "\U0001FAF4\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 palm up hand: medium skin tone",
+		original: "\U0001FAF4\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 palm up hand: medium-dark skin tone",
+		original: "\U0001FAF4\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 palm up hand: dark skin tone",
+		original: "\U0001FAF4\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 leftwards pushing hand",
+		original: "\U0001FAF7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 leftwards pushing hand: light skin tone",
+		original: "\U0001FAF7\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 leftwards pushing hand: medium-light skin tone",
+		original: "\U0001FAF7\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 leftwards pushing hand: medium skin tone",
+		original: "\U0001FAF7\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 leftwards pushing hand: medium-dark skin tone",
+		original: "\U0001FAF7\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 leftwards pushing hand: dark skin tone",
+		original: "\U0001FAF7\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 rightwards pushing hand",
+		original: "\U0001FAF8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 rightwards pushing hand: light skin tone",
+		original: "\U0001FAF8\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 rightwards pushing hand: medium-light skin tone",
+		original: "\U0001FAF8\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 rightwards pushing hand: medium skin tone",
+		original: "\U0001FAF8\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 rightwards pushing hand: medium-dark skin tone",
+		original: "\U0001FAF8\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 rightwards pushing hand: dark skin tone",
+		original: "\U0001FAF8\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 OK hand",
+		original: "\U0001F44C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 OK hand: light skin tone",
+		original: "\U0001F44C\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 OK hand: medium-light skin tone",
+		original: "\U0001F44C\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 OK hand: medium skin tone",
+		original: "\U0001F44C\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 OK hand: medium-dark skin tone",
+		original: "\U0001F44C\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 OK hand: dark skin tone",
+		original: "\U0001F44C\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 pinched fingers",
+		original: "\U0001F90C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 pinched fingers: light skin tone",
+		original: "\U0001F90C\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 pinched fingers: medium-light skin tone",
+		original: "\U0001F90C\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 pinched fingers: medium skin tone",
+		original: "\U0001F90C\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 pinched fingers: medium-dark skin tone",
+		original: "\U0001F90C\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 pinched fingers: dark skin tone",
+		original: "\U0001F90C\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 pinching hand",
+		original: "\U0001F90F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 pinching hand: light skin tone",
+		original: "\U0001F90F\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 pinching hand: medium-light skin tone",
+		original: "\U0001F90F\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 pinching hand: medium skin tone",
+		original: "\U0001F90F\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 pinching hand: medium-dark skin tone",
+		original: "\U0001F90F\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 pinching hand: dark skin tone",
+		original: "\U0001F90F\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 victory hand",
+		original: "\u270C\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 victory hand",
+		original: "\u270C",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 victory hand: light skin tone",
+		original: "\u270C\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 victory hand: medium-light skin tone",
+		original: "\u270C\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 victory hand: medium skin tone",
+		original: "\u270C\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 victory hand: medium-dark skin tone",
+		original: "\u270C\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 victory hand: dark skin tone",
+		original: "\u270C\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 crossed fingers",
+		original: "\U0001F91E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 crossed fingers: light skin tone",
+		original: "\U0001F91E\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 crossed fingers: medium-light skin tone",
+		original: "\U0001F91E\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 crossed fingers: medium skin tone",
+		original: "\U0001F91E\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 crossed fingers: medium-dark skin tone",
+		original: "\U0001F91E\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 crossed fingers: dark skin tone",
+		original: "\U0001F91E\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 hand with index finger and thumb crossed",
+		original: "\U0001FAF0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 hand with index finger and thumb crossed: light skin tone",
+		original: "\U0001FAF0\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 hand with index finger and thumb crossed: medium-light skin tone",
+		original: "\U0001FAF0\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 hand with index finger and thumb crossed: medium skin tone",
+		original: "\U0001FAF0\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 hand with index finger and thumb crossed: medium-dark skin tone",
+		original: "\U0001FAF0\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 hand with index finger and thumb crossed: dark skin tone",
+		original: "\U0001FAF0\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 love-you gesture",
+		original: "\U0001F91F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 love-you gesture: light skin tone",
+		original: "\U0001F91F\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 love-you gesture: medium-light skin tone",
+		original: "\U0001F91F\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 love-you gesture: medium skin tone",
+		original: "\U0001F91F\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 love-you gesture: medium-dark skin tone",
+		original: "\U0001F91F\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 love-you gesture: dark skin tone",
+		original: "\U0001F91F\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 sign of the horns",
+		original: "\U0001F918",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 sign of the horns: light skin tone",
+		original: "\U0001F918\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 sign of the horns: medium-light skin tone",
+		original: "\U0001F918\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 sign of the horns: medium skin tone",
+		original: "\U0001F918\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 sign of the horns: medium-dark skin tone",
+		original: "\U0001F918\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 sign of the horns: dark skin tone",
+		original: "\U0001F918\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 call me hand",
+		original: "\U0001F919",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 call me hand: light skin tone",
+		original: "\U0001F919\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 call me hand: medium-light skin tone",
+		original: "\U0001F919\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 call me hand: medium skin tone",
+		original: "\U0001F919\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 call me hand: medium-dark skin tone",
+		original: "\U0001F919\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 call me hand: dark skin tone",
+		original: "\U0001F919\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 backhand index pointing left",
+		original: "\U0001F448",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing left: light skin tone",
+		original: "\U0001F448\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing left: medium-light skin tone",
+		original: "\U0001F448\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing left: medium skin tone",
+		original: "\U0001F448\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing left: medium-dark skin tone",
+		original: "\U0001F448\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing left: dark skin tone",
+		original: "\U0001F448\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 backhand index pointing right",
+		original: "\U0001F449",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing right: light skin tone",
+		original: "\U0001F449\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing right: medium-light skin tone",
+		original: "\U0001F449\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing right: medium skin tone",
+		original: "\U0001F449\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing right: medium-dark skin tone",
+		original: "\U0001F449\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing right: dark skin tone",
+		original: "\U0001F449\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 backhand index pointing up",
+		original: "\U0001F446",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing up: light skin tone",
+		original: "\U0001F446\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing up: medium-light skin tone",
+		original: "\U0001F446\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing up: medium skin tone",
+		original: "\U0001F446\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing up: medium-dark skin tone",
+		original: "\U0001F446\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing up: dark skin tone",
+		original: "\U0001F446\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 middle finger",
+		original: "\U0001F595",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 middle finger: light skin tone",
+		original: "\U0001F595\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 middle finger: medium-light skin tone",
+		original: "\U0001F595\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 middle finger: medium skin tone",
+		original: "\U0001F595\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 middle finger: medium-dark skin tone",
+		original: "\U0001F595\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 middle finger: dark skin tone",
+		original: "\U0001F595\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 backhand index pointing down",
+		original: "\U0001F447",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing down: light skin tone",
+		original: "\U0001F447\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing down: medium-light skin tone",
+		original: "\U0001F447\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing down: medium skin tone",
+		original: "\U0001F447\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing down: medium-dark skin tone",
+		original: "\U0001F447\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 backhand index pointing down: dark skin tone",
+		original: "\U0001F447\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 index pointing up",
+		original: "\u261D\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 index pointing up",
+		original: "\u261D",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 index pointing up: light skin tone",
+		original: "\u261D\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 index pointing up: medium-light skin tone",
+		original: "\u261D\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 index pointing up: medium skin tone",
+		original: "\u261D\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 index pointing up: medium-dark skin tone",
+		original: "\u261D\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 index pointing up: dark skin tone",
+		original: "\u261D\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 index pointing at the viewer",
+		original: "\U0001FAF5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 index pointing at the viewer: light skin tone",
+		original: "\U0001FAF5\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 index pointing at the viewer: medium-light skin tone",
+		original: "\U0001FAF5\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 index pointing at the viewer: medium skin tone",
+		original: "\U0001FAF5\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 index pointing at the viewer: medium-dark skin tone",
+		original: "\U0001FAF5\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 index pointing at the viewer: dark skin tone",
+		original: "\U0001FAF5\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 thumbs up",
+		original: "\U0001F44D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 thumbs up: light skin tone",
+		original: "\U0001F44D\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 thumbs up: medium-light skin tone",
+		original: "\U0001F44D\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 thumbs up: medium skin tone",
+		original: "\U0001F44D\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 thumbs up: medium-dark skin tone",
+		original: "\U0001F44D\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 thumbs up: dark skin tone",
+		original: "\U0001F44D\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 thumbs down",
+		original: "\U0001F44E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 thumbs down: light skin tone",
+		original: "\U0001F44E\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 thumbs down: medium-light skin tone",
+		original: "\U0001F44E\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 thumbs down: medium skin tone",
+		original: "\U0001F44E\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 thumbs down: medium-dark skin tone",
+		original: "\U0001F44E\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 thumbs down: dark skin tone",
+		original: "\U0001F44E\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 raised fist",
+		original: "\u270A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 raised fist: light skin tone",
+		original: "\u270A\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 raised fist: medium-light skin tone",
+		original: "\u270A\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 raised fist: medium skin tone",
+		original: "\u270A\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 raised fist: medium-dark skin tone",
+		original: "\u270A\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 raised fist: dark skin tone",
+		original: "\u270A\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 oncoming fist",
+		original: "\U0001F44A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 oncoming fist: light skin tone",
+		original: "\U0001F44A\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 oncoming fist: medium-light skin tone",
+		original: "\U0001F44A\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 oncoming fist: medium skin tone",
+		original: "\U0001F44A\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 oncoming fist: medium-dark skin tone",
+		original: "\U0001F44A\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 oncoming fist: dark skin tone",
+		original: "\U0001F44A\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 left-facing fist",
+		original: "\U0001F91B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 left-facing fist: light skin tone",
+		original: "\U0001F91B\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 left-facing fist: medium-light skin tone",
+		original: "\U0001F91B\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 left-facing fist: medium skin tone",
+		original: "\U0001F91B\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 left-facing fist: medium-dark skin tone",
+		original: "\U0001F91B\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 left-facing fist: dark skin tone",
+		original: "\U0001F91B\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 right-facing fist",
+		original: "\U0001F91C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 right-facing fist: light skin tone",
+		original: "\U0001F91C\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 right-facing fist: medium-light skin tone",
+		original: "\U0001F91C\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 right-facing fist: medium skin tone",
+		original: "\U0001F91C\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 right-facing fist: medium-dark skin tone",
+		original: "\U0001F91C\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 right-facing fist: dark skin tone",
+		original: "\U0001F91C\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 clapping hands",
+		original: "\U0001F44F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 clapping hands: light skin tone",
+		original: "\U0001F44F\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 clapping hands: medium-light skin tone",
+		original: "\U0001F44F\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 clapping hands: medium skin tone",
+		original: "\U0001F44F\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 clapping hands: medium-dark skin tone",
+		original: "\U0001F44F\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 clapping hands: dark skin tone",
+		original: "\U0001F44F\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 raising hands",
+		original: "\U0001F64C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 raising hands: light skin tone",
+		original: "\U0001F64C\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 raising hands: medium-light skin tone",
+		original: "\U0001F64C\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 raising hands: medium skin tone",
+		original: "\U0001F64C\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 raising hands: medium-dark skin tone",
+		original: "\U0001F64C\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 raising hands: dark skin tone",
+		original: "\U0001F64C\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 heart hands",
+		original: "\U0001FAF6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 heart hands: light skin tone",
+		original: "\U0001FAF6\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 heart hands: medium-light skin tone",
+		original: "\U0001FAF6\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 heart hands: medium skin tone",
+		original: "\U0001FAF6\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 heart hands: medium-dark skin tone",
+		original: "\U0001FAF6\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 heart hands: dark skin tone",
+		original: "\U0001FAF6\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 open hands",
+		original: "\U0001F450",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 open hands: light skin tone",
+		original: "\U0001F450\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 open hands: medium-light skin tone",
+		original: "\U0001F450\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 open hands: medium skin tone",
+		original: "\U0001F450\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 open hands: medium-dark skin tone",
+		original: "\U0001F450\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 open hands: dark skin tone",
+		original: "\U0001F450\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 palms up together",
+		original: "\U0001F932",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 palms up together: light skin tone",
+		original: "\U0001F932\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 palms up together: medium-light skin tone",
+		original: "\U0001F932\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 palms up together: medium skin tone",
+		original: "\U0001F932\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 palms up together: medium-dark skin tone",
+		original: "\U0001F932\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 palms up together: dark skin tone",
+		original: "\U0001F932\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 handshake",
+		original: "\U0001F91D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: light skin tone",
+		original: "\U0001F91D\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: medium-light skin tone",
+		original: "\U0001F91D\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: medium skin tone",
+		original: "\U0001F91D\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: medium-dark skin tone",
+		original: "\U0001F91D\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: dark skin tone",
+		original: "\U0001F91D\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: light skin tone, medium-light skin tone",
+		original: "\U0001FAF1\U0001F3FB\u200D\U0001FAF2\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: light skin tone, medium skin tone",
+		original: "\U0001FAF1\U0001F3FB\u200D\U0001FAF2\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: light skin tone, medium-dark skin tone",
+		original: "\U0001FAF1\U0001F3FB\u200D\U0001FAF2\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: light skin tone, dark skin tone",
+		original: "\U0001FAF1\U0001F3FB\u200D\U0001FAF2\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: medium-light skin tone, light skin tone",
+		original: "\U0001FAF1\U0001F3FC\u200D\U0001FAF2\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: medium-light skin tone, medium skin tone",
+		original: "\U0001FAF1\U0001F3FC\u200D\U0001FAF2\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: medium-light skin tone, medium-dark skin tone",
+		original: "\U0001FAF1\U0001F3FC\u200D\U0001FAF2\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: medium-light skin tone, dark skin tone",
+		original: "\U0001FAF1\U0001F3FC\u200D\U0001FAF2\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: medium skin tone, light skin tone",
+		original: "\U0001FAF1\U0001F3FD\u200D\U0001FAF2\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: medium skin tone, medium-light skin tone",
+		original: "\U0001FAF1\U0001F3FD\u200D\U0001FAF2\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: medium skin tone, medium-dark skin tone",
+		original: "\U0001FAF1\U0001F3FD\u200D\U0001FAF2\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: medium skin tone, dark skin tone",
+		original: "\U0001FAF1\U0001F3FD\u200D\U0001FAF2\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: medium-dark skin tone, light skin tone",
+		original: "\U0001FAF1\U0001F3FE\u200D\U0001FAF2\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: medium-dark skin tone, medium-light skin tone",
+		original: "\U0001FAF1\U0001F3FE\u200D\U0001FAF2\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: medium-dark skin tone, medium skin tone",
+		original: "\U0001FAF1\U0001F3FE\u200D\U0001FAF2\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: medium-dark skin tone, dark skin tone",
+		original: "\U0001FAF1\U0001F3FE\u200D\U0001FAF2\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: dark skin tone, light skin tone",
+		original: "\U0001FAF1\U0001F3FF\u200D\U0001FAF2\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: dark skin tone, medium-light skin tone",
+		original: "\U0001FAF1\U0001F3FF\u200D\U0001FAF2\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: dark skin tone, medium skin tone",
+		original: "\U0001FAF1\U0001F3FF\u200D\U0001FAF2\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 handshake: dark skin tone, medium-dark skin tone",
+		original: "\U0001FAF1\U0001F3FF\u200D\U0001FAF2\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 folded hands",
+		original: "\U0001F64F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 folded hands: light skin tone",
+		original: "\U0001F64F\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 folded hands: medium-light skin tone",
+		original: "\U0001F64F\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 folded hands: medium skin tone",
+		original: "\U0001F64F\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 folded hands: medium-dark skin tone",
+		original: "\U0001F64F\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 folded hands: dark skin tone",
+		original: "\U0001F64F\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 writing hand",
+		original: "\u270D\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 writing hand",
+		original: "\u270D",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 writing hand: light skin tone",
+		original: "\u270D\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 writing hand: medium-light skin tone",
+		original: "\u270D\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 writing hand: medium skin tone",
+		original: "\u270D\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 writing hand: medium-dark skin tone",
+		original: "\u270D\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 writing hand: dark skin tone",
+		original: "\u270D\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 nail polish",
+		original: "\U0001F485",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 nail polish: light skin tone",
+		original: "\U0001F485\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 nail polish: medium-light skin tone",
+		original: "\U0001F485\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 nail polish: medium skin tone",
+		original: "\U0001F485\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 nail polish: medium-dark skin tone",
+		original: "\U0001F485\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 nail polish: dark skin tone",
+		original: "\U0001F485\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 selfie",
+		original: "\U0001F933",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 selfie: light skin tone",
+		original: "\U0001F933\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 selfie: medium-light skin tone",
+		original: "\U0001F933\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 selfie: medium skin tone",
+		original: "\U0001F933\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 selfie: medium-dark skin tone",
+		original: "\U0001F933\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 selfie: dark skin tone",
+		original: "\U0001F933\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 flexed biceps",
+		original: "\U0001F4AA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 flexed biceps: light skin tone",
+		original: "\U0001F4AA\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 flexed biceps: medium-light skin tone",
+		original: "\U0001F4AA\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 flexed biceps: medium skin tone",
+		original: "\U0001F4AA\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 flexed biceps: medium-dark skin tone",
+		original: "\U0001F4AA\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 flexed biceps: dark skin tone",
+		original: "\U0001F4AA\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 mechanical arm",
+		original: "\U0001F9BE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 mechanical leg",
+		original: "\U0001F9BF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 leg",
+		original: "\U0001F9B5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 leg: light skin tone",
+		original: "\U0001F9B5\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 leg: medium-light skin tone",
+		original: "\U0001F9B5\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 leg: medium skin tone",
+		original: "\U0001F9B5\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 leg: medium-dark skin tone",
+		original: "\U0001F9B5\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 leg: dark skin tone",
+		original: "\U0001F9B5\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 foot",
+		original: "\U0001F9B6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 foot: light skin tone",
+		original: "\U0001F9B6\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 foot: medium-light skin tone",
+		original: "\U0001F9B6\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 foot: medium skin tone",
+		original: "\U0001F9B6\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 foot: medium-dark skin tone",
+		original: "\U0001F9B6\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 foot: dark skin tone",
+		original: "\U0001F9B6\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 ear",
+		original: "\U0001F442",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 ear: light skin tone",
+		original: "\U0001F442\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 ear: medium-light skin tone",
+		original: "\U0001F442\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 ear: medium skin tone",
+		original: "\U0001F442\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 ear: medium-dark skin tone",
+		original: "\U0001F442\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 ear: dark skin tone",
+		original: "\U0001F442\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 ear with hearing aid",
+		original: "\U0001F9BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 ear with hearing aid: light skin tone",
+		original: "\U0001F9BB\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 ear with hearing aid: medium-light skin tone",
+		original: "\U0001F9BB\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 ear with hearing aid: medium skin tone",
+		original: "\U0001F9BB\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 ear with hearing aid: medium-dark skin tone",
+		original: "\U0001F9BB\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 ear with hearing aid: dark skin tone",
+		original: "\U0001F9BB\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 nose",
+		original: "\U0001F443",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 nose: light skin tone",
+		original: "\U0001F443\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 nose: medium-light skin tone",
+		original: "\U0001F443\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 nose: medium skin tone",
+		original: "\U0001F443\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 nose: medium-dark skin tone",
+		original: "\U0001F443\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 nose: dark skin tone",
+		original: "\U0001F443\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 brain",
+		original: "\U0001F9E0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 anatomical heart",
+		original: "\U0001FAC0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 lungs",
+		original: "\U0001FAC1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 tooth",
+		original: "\U0001F9B7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 bone",
+		original: "\U0001F9B4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 eyes",
+		original: "\U0001F440",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 eye",
+		original: "\U0001F441\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 eye",
+		original: "\U0001F441",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 tongue",
+		original: "\U0001F445",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 mouth",
+		original: "\U0001F444",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 biting lip",
+		original: "\U0001FAE6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 baby",
+		original: "\U0001F476",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 baby: light skin tone",
+		original: "\U0001F476\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 baby: medium-light skin tone",
+		original: "\U0001F476\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 baby: medium skin tone",
+		original: "\U0001F476\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 baby: medium-dark skin tone",
+		original: "\U0001F476\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 baby: dark skin tone",
+		original: "\U0001F476\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 child",
+		original: "\U0001F9D2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 child: light skin tone",
+		original: "\U0001F9D2\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 child: medium-light skin tone",
+		original: "\U0001F9D2\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 child: medium skin tone",
+		original: "\U0001F9D2\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 child: medium-dark skin tone",
+		original: "\U0001F9D2\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 child: dark skin tone",
+		original: "\U0001F9D2\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 boy",
+		original: "\U0001F466",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 boy: light skin tone",
+		original: "\U0001F466\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 boy: medium-light skin tone",
+		original: "\U0001F466\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 boy: medium skin tone",
+		original: "\U0001F466\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 boy: medium-dark skin tone",
+		original: "\U0001F466\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 boy: dark skin tone",
+		original: "\U0001F466\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 girl",
+		original: "\U0001F467",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 girl: light skin tone",
+		original: "\U0001F467\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 girl: medium-light skin tone",
+		original: "\U0001F467\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 girl: medium skin tone",
+		original: "\U0001F467\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 girl: medium-dark skin tone",
+		original: "\U0001F467\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 girl: dark skin tone",
+		original: "\U0001F467\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person",
+		original: "\U0001F9D1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person: light skin tone",
+		original: "\U0001F9D1\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 person: blond hair",
+		original: "\U0001F471",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person: light skin tone, blond hair",
+		original: "\U0001F471\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person: medium-light skin tone, blond hair",
+		original: "\U0001F471\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person: medium skin tone, blond hair",
+		original: "\U0001F471\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person: medium-dark skin tone, blond hair",
+		original: "\U0001F471\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person: dark skin tone, blond hair",
+		original: "\U0001F471\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 man",
+		original: "\U0001F468",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 man: light skin tone",
+		original: "\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 man: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 man: medium skin tone",
+		original: "\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 man: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 man: dark skin tone",
+		original: "\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person: beard",
+		original: "\U0001F9D4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person: light skin tone, beard",
+		original: "\U0001F9D4\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person: medium-light skin tone, beard",
+		original: "\U0001F9D4\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person: medium skin tone, beard",
+		original: "\U0001F9D4\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person: medium-dark skin tone, beard",
+		original: "\U0001F9D4\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person: dark skin tone, beard",
+		original: "\U0001F9D4\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 man: beard",
+		original: "\U0001F9D4\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 man: beard",
+		original: "\U0001F9D4\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 man: light skin tone, beard",
+		original: "\U0001F9D4\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 man: light skin tone, beard",
+		original: "\U0001F9D4\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 man: medium-light skin tone, beard",
+		original: "\U0001F9D4\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 man: medium-light skin tone, beard",
+		original: "\U0001F9D4\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 man: medium skin tone, beard",
+		original: "\U0001F9D4\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 man: medium skin tone, beard",
+		original: "\U0001F9D4\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 man: medium-dark skin tone, beard",
+		original: "\U0001F9D4\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 man: medium-dark skin tone, beard",
+		original: "\U0001F9D4\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 man: dark skin tone, beard",
+		original: "\U0001F9D4\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 man: dark skin tone, beard",
+		original: "\U0001F9D4\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 woman: beard",
+		original: "\U0001F9D4\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 woman: beard",
+		original: "\U0001F9D4\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 woman: light skin tone, beard",
+		original: "\U0001F9D4\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 woman: light skin tone, beard",
+		original: "\U0001F9D4\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 woman: medium-light skin tone, beard",
+		original: "\U0001F9D4\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 woman: medium-light skin tone, beard",
+		original: "\U0001F9D4\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 woman: medium skin tone, beard",
+		original: "\U0001F9D4\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 woman: medium skin tone, beard",
+		original: "\U0001F9D4\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 woman: medium-dark skin tone, beard",
+		original: "\U0001F9D4\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 woman: medium-dark skin tone, beard",
+		original: "\U0001F9D4\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 woman: dark skin tone, beard",
+		original: "\U0001F9D4\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 woman: dark skin tone, beard",
+		original: "\U0001F9D4\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 man: red hair",
+		original: "\U0001F468\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: light skin tone, red hair",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: medium-light skin tone, red hair",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: medium skin tone, red hair",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: medium-dark skin tone, red hair",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: dark skin tone, red hair",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: curly hair",
+		original: "\U0001F468\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: light skin tone, curly hair",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: medium-light skin tone, curly hair",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: medium skin tone, curly hair",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: medium-dark skin tone, curly hair",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: dark skin tone, curly hair",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: white hair",
+		original: "\U0001F468\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: light skin tone, white hair",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: medium-light skin tone, white hair",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: medium skin tone, white hair",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: medium-dark skin tone, white hair",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: dark skin tone, white hair",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: bald",
+		original: "\U0001F468\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: light skin tone, bald",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: medium-light skin tone, bald",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: medium skin tone, bald",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: medium-dark skin tone, bald",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man: dark skin tone, bald",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 woman",
+		original: "\U0001F469",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 woman: light skin tone",
+		original: "\U0001F469\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 woman: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 woman: medium skin tone",
+		original: "\U0001F469\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 woman: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 woman: dark skin tone",
+		original: "\U0001F469\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: red hair",
+		original: "\U0001F469\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: light skin tone, red hair",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: medium-light skin tone, red hair",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: medium skin tone, red hair",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: medium-dark skin tone, red hair",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: dark skin tone, red hair",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: red hair",
+		original: "\U0001F9D1\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: light skin tone, red hair",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: medium-light skin tone, red hair",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: medium skin tone, red hair",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: medium-dark skin tone, red hair",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: dark skin tone, red hair",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F9B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: curly hair",
+		original: "\U0001F469\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: light skin tone, curly hair",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: medium-light skin tone, curly hair",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: medium skin tone, curly hair",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: medium-dark skin tone, curly hair",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: dark skin tone, curly hair",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: curly hair",
+		original: "\U0001F9D1\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: light skin tone, curly hair",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: medium-light skin tone, curly hair",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: medium skin tone, curly hair",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: medium-dark skin tone, curly hair",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: dark skin tone, curly hair",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F9B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: white hair",
+		original: "\U0001F469\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: light skin tone, white hair",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: medium-light skin tone, white hair",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: medium skin tone, white hair",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: medium-dark skin tone, white hair",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: dark skin tone, white hair",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: white hair",
+		original: "\U0001F9D1\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: light skin tone, white hair",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: medium-light skin tone, white hair",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: medium skin tone, white hair",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: medium-dark skin tone, white hair",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: dark skin tone, white hair",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F9B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: bald",
+		original: "\U0001F469\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: light skin tone, bald",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: medium-light skin tone, bald",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: medium skin tone, bald",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: medium-dark skin tone, bald",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman: dark skin tone, bald",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: bald",
+		original: "\U0001F9D1\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: light skin tone, bald",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: medium-light skin tone, bald",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: medium skin tone, bald",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: medium-dark skin tone, bald",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person: dark skin tone, bald",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F9B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman: blond hair",
+		original: "\U0001F471\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman: blond hair",
+		original: "\U0001F471\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman: light skin tone, blond hair",
+		original: "\U0001F471\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman: light skin tone, blond hair",
+		original: "\U0001F471\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman: medium-light skin tone, blond hair",
+		original: "\U0001F471\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman: medium-light skin tone, blond hair",
+		original: "\U0001F471\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman: medium skin tone, blond hair",
+		original: "\U0001F471\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman: medium skin tone, blond hair",
+		original: "\U0001F471\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman: medium-dark skin tone, blond hair",
+		original: "\U0001F471\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman: medium-dark skin tone, blond hair",
+		original: "\U0001F471\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman: dark skin tone, blond hair",
+		original: "\U0001F471\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman: dark skin tone, blond hair",
+		original: "\U0001F471\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man: blond hair",
+		original: "\U0001F471\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man: blond hair",
+		original: "\U0001F471\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man: light skin tone, blond hair",
+		original: "\U0001F471\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man: light skin tone, blond hair",
+		original: "\U0001F471\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man: medium-light skin tone, blond hair",
+		original: "\U0001F471\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man: medium-light skin tone, blond hair",
+		original: "\U0001F471\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man: medium skin tone, blond hair",
+		original: "\U0001F471\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man: medium skin tone, blond hair",
+		original: "\U0001F471\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man: medium-dark skin tone, blond hair",
+		original: "\U0001F471\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man: medium-dark skin tone, blond hair",
+		original: "\U0001F471\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man: dark skin tone, blond hair",
+		original: "\U0001F471\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man: dark skin tone, blond hair",
+		original: "\U0001F471\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 older person",
+		original: "\U0001F9D3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 older person: light skin tone",
+		original: "\U0001F9D3\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 older person: medium-light skin tone",
+		original: "\U0001F9D3\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 older person: medium skin tone",
+		original: "\U0001F9D3\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 older person: medium-dark skin tone",
+		original: "\U0001F9D3\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 older person: dark skin tone",
+		original: "\U0001F9D3\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 old man",
+		original: "\U0001F474",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 old man: light skin tone",
+		original: "\U0001F474\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 old man: medium-light skin tone",
+		original: "\U0001F474\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 old man: medium skin tone",
+		original: "\U0001F474\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 old man: medium-dark skin tone",
+		original: "\U0001F474\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 old man: dark skin tone",
+		original: "\U0001F474\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 old woman",
+		original: "\U0001F475",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 old woman: light skin tone",
+		original: "\U0001F475\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 old woman: medium-light skin tone",
+		original: "\U0001F475\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 old woman: medium skin tone",
+		original: "\U0001F475\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 old woman: medium-dark skin tone",
+		original: "\U0001F475\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 old woman: dark skin tone",
+		original: "\U0001F475\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 person frowning",
+		original: "\U0001F64D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person frowning: light skin tone",
+		original: "\U0001F64D\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person frowning: medium-light skin tone",
+		original: "\U0001F64D\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person frowning: medium skin tone",
+		original: "\U0001F64D\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person frowning: medium-dark skin tone",
+		original: "\U0001F64D\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person frowning: dark skin tone",
+		original: "\U0001F64D\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man frowning",
+		original: "\U0001F64D\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man frowning",
+		original: "\U0001F64D\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man frowning: light skin tone",
+		original: "\U0001F64D\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man frowning: light skin tone",
+		original: "\U0001F64D\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man frowning: medium-light skin tone",
+		original: "\U0001F64D\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man frowning: medium-light skin tone",
+		original: "\U0001F64D\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man frowning: medium skin tone",
+		original: "\U0001F64D\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man frowning: medium skin tone",
+		original: "\U0001F64D\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man frowning: medium-dark skin tone",
+		original: "\U0001F64D\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man frowning: medium-dark skin tone",
+		original: "\U0001F64D\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man frowning: dark skin tone",
+		original: "\U0001F64D\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man frowning: dark skin tone",
+		original: "\U0001F64D\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman frowning",
+		original: "\U0001F64D\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman frowning",
+		original: "\U0001F64D\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman frowning: light skin tone",
+		original: "\U0001F64D\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman frowning: light skin tone",
+		original: "\U0001F64D\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman frowning: medium-light skin tone",
+		original: "\U0001F64D\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman frowning: medium-light skin tone",
+		original: "\U0001F64D\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman frowning: medium skin tone",
+		original: "\U0001F64D\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman frowning: medium skin tone",
+		original: "\U0001F64D\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman frowning: medium-dark skin tone",
+		original: "\U0001F64D\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman frowning: medium-dark skin tone",
+		original: "\U0001F64D\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman frowning: dark skin tone",
+		original: "\U0001F64D\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman frowning: dark skin tone",
+		original: "\U0001F64D\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 person pouting",
+		original: "\U0001F64E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person pouting: light skin tone",
+		original: "\U0001F64E\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person pouting: medium-light skin tone",
+		original: "\U0001F64E\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person pouting: medium skin tone",
+		original: "\U0001F64E\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person pouting: medium-dark skin tone",
+		original: "\U0001F64E\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person pouting: dark skin tone",
+		original: "\U0001F64E\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man pouting",
+		original: "\U0001F64E\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man pouting",
+		original: "\U0001F64E\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man pouting: light skin tone",
+		original: "\U0001F64E\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man pouting: light skin tone",
+		original: "\U0001F64E\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man pouting: medium-light skin tone",
+		original: "\U0001F64E\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man pouting: medium-light skin tone",
+		original: "\U0001F64E\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man pouting: medium skin tone",
+		original: "\U0001F64E\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man pouting: medium skin tone",
+		original: "\U0001F64E\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man pouting: medium-dark skin tone",
+		original: "\U0001F64E\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man pouting: medium-dark skin tone",
+		original: "\U0001F64E\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man pouting: dark skin tone",
+		original: "\U0001F64E\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man pouting: dark skin tone",
+		original: "\U0001F64E\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman pouting",
+		original: "\U0001F64E\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman pouting",
+		original: "\U0001F64E\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman pouting: light skin tone",
+		original: "\U0001F64E\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman pouting: light skin tone",
+		original: "\U0001F64E\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman pouting: medium-light skin tone",
+		original: "\U0001F64E\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman pouting: medium-light skin tone",
+		original: "\U0001F64E\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman pouting: medium skin tone",
+		original: "\U0001F64E\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman pouting: medium skin tone",
+		original: "\U0001F64E\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman pouting: medium-dark skin tone",
+		original: "\U0001F64E\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman pouting: medium-dark skin tone",
+		original: "\U0001F64E\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman pouting: dark skin tone",
+		original: "\U0001F64E\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman pouting: dark skin tone",
+		original: "\U0001F64E\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 person gesturing NO",
+		original: "\U0001F645",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person gesturing NO: light skin tone",
+		original: "\U0001F645\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person gesturing NO: medium-light skin tone",
+		original: "\U0001F645\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person gesturing NO: medium skin tone",
+		original: "\U0001F645\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person gesturing NO: medium-dark skin tone",
+		original: "\U0001F645\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person gesturing NO: dark skin tone",
+		original: "\U0001F645\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing NO",
+		original: "\U0001F645\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing NO",
+		original: "\U0001F645\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing NO: light skin tone",
+		original: "\U0001F645\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing NO: light skin tone",
+		original: "\U0001F645\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing NO: medium-light skin tone",
+		original: "\U0001F645\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing NO: medium-light skin tone",
+		original: "\U0001F645\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing NO: medium skin tone",
+		original: "\U0001F645\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing NO: medium skin tone",
+		original: "\U0001F645\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing NO: medium-dark skin tone",
+		original: "\U0001F645\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing NO: medium-dark skin tone",
+		original: "\U0001F645\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing NO: dark skin tone",
+		original: "\U0001F645\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing NO: dark skin tone",
+		original: "\U0001F645\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing NO",
+		original: "\U0001F645\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing NO",
+		original: "\U0001F645\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing NO: light skin tone",
+		original: "\U0001F645\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing NO: light skin tone",
+		original: "\U0001F645\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing NO: medium-light skin tone",
+		original: "\U0001F645\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing NO: medium-light skin tone",
+		original: "\U0001F645\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing NO: medium skin tone",
+		original: "\U0001F645\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing NO: medium skin tone",
+		original: "\U0001F645\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing NO: medium-dark skin tone",
+		original: "\U0001F645\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing NO: medium-dark skin tone",
+		original: "\U0001F645\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing NO: dark skin tone",
+		original: "\U0001F645\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing NO: dark skin tone",
+		original: "\U0001F645\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 person gesturing OK",
+		original: "\U0001F646",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person gesturing OK: light skin tone",
+		original: "\U0001F646\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person gesturing OK: medium-light skin tone",
+		original: "\U0001F646\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person gesturing OK: medium skin tone",
+		original: "\U0001F646\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person gesturing OK: medium-dark skin tone",
+		original: "\U0001F646\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person gesturing OK: dark skin tone",
+		original: "\U0001F646\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing OK",
+		original: "\U0001F646\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing OK",
+		original: "\U0001F646\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing OK: light skin tone",
+		original: "\U0001F646\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing OK: light skin tone",
+		original: "\U0001F646\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing OK: medium-light skin tone",
+		original: "\U0001F646\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing OK: medium-light skin tone",
+		original: "\U0001F646\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing OK: medium skin tone",
+		original: "\U0001F646\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing OK: medium skin tone",
+		original: "\U0001F646\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing OK: medium-dark skin tone",
+		original: "\U0001F646\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing OK: medium-dark skin tone",
+		original: "\U0001F646\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing OK: dark skin tone",
+		original: "\U0001F646\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man gesturing OK: dark skin tone",
+		original: "\U0001F646\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing OK",
+		original: "\U0001F646\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing OK",
+		original: "\U0001F646\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing OK: light skin tone",
+		original: "\U0001F646\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing OK: light skin tone",
+		original: "\U0001F646\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing OK: medium-light skin tone",
+		original: "\U0001F646\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing OK: medium-light skin tone",
+		original: "\U0001F646\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing OK: medium skin tone",
+		original: "\U0001F646\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing OK: medium skin tone",
+		original: "\U0001F646\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing OK: medium-dark skin tone",
+		original: "\U0001F646\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing OK: medium-dark skin tone",
+		original: "\U0001F646\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing OK: dark skin tone",
+		original: "\U0001F646\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman gesturing OK: dark skin tone",
+		original: "\U0001F646\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 person tipping hand",
+		original: "\U0001F481",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person tipping hand: light skin tone",
+		original: "\U0001F481\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person tipping hand: medium-light skin tone",
+		original: "\U0001F481\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person tipping hand: medium skin tone",
+		original: "\U0001F481\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person tipping hand: medium-dark skin tone",
+		original: "\U0001F481\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person tipping hand: dark skin tone",
+		original: "\U0001F481\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man tipping hand",
+		original: "\U0001F481\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man tipping hand",
+		original: "\U0001F481\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man tipping hand: light skin tone",
+		original: "\U0001F481\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man tipping hand: light skin tone",
+		original: "\U0001F481\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man tipping hand: medium-light skin tone",
+		original: "\U0001F481\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man tipping hand: medium-light skin tone",
+		original: "\U0001F481\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man tipping hand: medium skin tone",
+		original: "\U0001F481\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man tipping hand: medium skin tone",
+		original: "\U0001F481\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man tipping hand: medium-dark skin tone",
+		original: "\U0001F481\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man tipping hand: medium-dark skin tone",
+		original: "\U0001F481\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man tipping hand: dark skin tone",
+		original: "\U0001F481\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man tipping hand: dark skin tone",
+		original: "\U0001F481\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman tipping hand",
+		original: "\U0001F481\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman tipping hand",
+		original: "\U0001F481\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman tipping hand: light skin tone",
+		original: "\U0001F481\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman tipping hand: light skin tone",
+		original: "\U0001F481\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman tipping hand: medium-light skin tone",
+		original: "\U0001F481\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman tipping hand: medium-light skin tone",
+		original: "\U0001F481\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman tipping hand: medium skin tone",
+		original: "\U0001F481\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman tipping hand: medium skin tone",
+		original: "\U0001F481\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman tipping hand: medium-dark skin tone",
+		original: "\U0001F481\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman tipping hand: medium-dark skin tone",
+		original: "\U0001F481\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman tipping hand: dark skin tone",
+		original: "\U0001F481\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman tipping hand: dark skin tone",
+		original: "\U0001F481\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 person raising hand",
+		original: "\U0001F64B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person raising hand: light skin tone",
+		original: "\U0001F64B\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person raising hand: medium-light skin tone",
+		original: "\U0001F64B\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person raising hand: medium skin tone",
+		original: "\U0001F64B\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person raising hand: medium-dark skin tone",
+		original: "\U0001F64B\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person raising hand: dark skin tone",
+		original: "\U0001F64B\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man raising hand",
+		original: "\U0001F64B\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man raising hand",
+		original: "\U0001F64B\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man raising hand: light skin tone",
+		original: "\U0001F64B\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man raising hand: light skin tone",
+		original: "\U0001F64B\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man raising hand: medium-light skin tone",
+		original: "\U0001F64B\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man raising hand: medium-light skin tone",
+		original: "\U0001F64B\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man raising hand: medium skin tone",
+		original: "\U0001F64B\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man raising hand: medium skin tone",
+		original: "\U0001F64B\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man raising hand: medium-dark skin tone",
+		original: "\U0001F64B\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man raising hand: medium-dark skin tone",
+		original: "\U0001F64B\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man raising hand: dark skin tone",
+		original: "\U0001F64B\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man raising hand: dark skin tone",
+		original: "\U0001F64B\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman raising hand",
+		original: "\U0001F64B\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman raising hand",
+		original: "\U0001F64B\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman raising hand: light skin tone",
+		original: "\U0001F64B\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman raising hand: light skin tone",
+		original: "\U0001F64B\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman raising hand: medium-light skin tone",
+		original: "\U0001F64B\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman raising hand: medium-light skin tone",
+		original: "\U0001F64B\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman raising hand: medium skin tone",
+		original: "\U0001F64B\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman raising hand: medium skin tone",
+		original: "\U0001F64B\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman raising hand: medium-dark skin tone",
+		original: "\U0001F64B\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman raising hand: medium-dark skin tone",
+		original: "\U0001F64B\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman raising hand: dark skin tone",
+		original: "\U0001F64B\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman raising hand: dark skin tone",
+		original: "\U0001F64B\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 deaf person",
+		original: "\U0001F9CF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf person: light skin tone",
+		original: "\U0001F9CF\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf person: medium-light skin tone",
+		original: "\U0001F9CF\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf person: medium skin tone",
+		original: "\U0001F9CF\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf person: medium-dark skin tone",
+		original: "\U0001F9CF\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf person: dark skin tone",
+		original: "\U0001F9CF\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf man",
+		original: "\U0001F9CF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf man",
+		original: "\U0001F9CF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 deaf man: light skin tone",
+		original: "\U0001F9CF\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf man: light skin tone",
+		original: "\U0001F9CF\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 deaf man: medium-light skin tone",
+		original: "\U0001F9CF\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf man: medium-light skin tone",
+		original: "\U0001F9CF\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 deaf man: medium skin tone",
+		original: "\U0001F9CF\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf man: medium skin tone",
+		original: "\U0001F9CF\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 deaf man: medium-dark skin tone",
+		original: "\U0001F9CF\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf man: medium-dark skin tone",
+		original: "\U0001F9CF\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 deaf man: dark skin tone",
+		original: "\U0001F9CF\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf man: dark skin tone",
+		original: "\U0001F9CF\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 deaf woman",
+		original: "\U0001F9CF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf woman",
+		original: "\U0001F9CF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 deaf woman: light skin tone",
+		original: "\U0001F9CF\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf woman: light skin tone",
+		original: "\U0001F9CF\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 deaf woman: medium-light skin tone",
+		original: "\U0001F9CF\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf woman: medium-light skin tone",
+		original: "\U0001F9CF\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 deaf woman: medium skin tone",
+		original: "\U0001F9CF\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf woman: medium skin tone",
+		original: "\U0001F9CF\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 deaf woman: medium-dark skin tone",
+		original: "\U0001F9CF\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf woman: medium-dark skin tone",
+		original: "\U0001F9CF\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 deaf woman: dark skin tone",
+		original: "\U0001F9CF\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 deaf woman: dark skin tone",
+		original: "\U0001F9CF\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 person bowing",
+		original: "\U0001F647",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person bowing: light skin tone",
+		original: "\U0001F647\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person bowing: medium-light skin tone",
+		original: "\U0001F647\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person bowing: medium skin tone",
+		original: "\U0001F647\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person bowing: medium-dark skin tone",
+		original: "\U0001F647\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person bowing: dark skin tone",
+		original: "\U0001F647\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man bowing",
+		original: "\U0001F647\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man bowing",
+		original: "\U0001F647\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man bowing: light skin tone",
+		original: "\U0001F647\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man bowing: light skin tone",
+		original: "\U0001F647\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man bowing: medium-light skin tone",
+		original: "\U0001F647\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man bowing: medium-light skin tone",
+		original: "\U0001F647\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man bowing: medium skin tone",
+		original: "\U0001F647\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man bowing: medium skin tone",
+		original: "\U0001F647\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man bowing: medium-dark skin tone",
+		original: "\U0001F647\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man bowing: medium-dark skin tone",
+		original: "\U0001F647\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man bowing: dark skin tone",
+		original: "\U0001F647\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man bowing: dark skin tone",
+		original: "\U0001F647\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman bowing",
+		original: "\U0001F647\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman bowing",
+		original: "\U0001F647\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman bowing: light skin tone",
+		original: "\U0001F647\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman bowing: light skin tone",
+		original: "\U0001F647\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman bowing: medium-light skin tone",
+		original: "\U0001F647\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman bowing: medium-light skin tone",
+		original: "\U0001F647\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman bowing: medium skin tone",
+		original: "\U0001F647\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman bowing: medium skin tone",
+		original: "\U0001F647\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman bowing: medium-dark skin tone",
+		original: "\U0001F647\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman bowing: medium-dark skin tone",
+		original: "\U0001F647\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman bowing: dark skin tone",
+		original: "\U0001F647\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman bowing: dark skin tone",
+		original: "\U0001F647\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E3.0 person facepalming",
+		original: "\U0001F926",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person facepalming: light skin tone",
+		original: "\U0001F926\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person facepalming: medium-light skin tone",
+		original: "\U0001F926\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person facepalming: medium skin tone",
+		original: "\U0001F926\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person facepalming: medium-dark skin tone",
+		original: "\U0001F926\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person facepalming: dark skin tone",
+		original: "\U0001F926\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man facepalming",
+		original: "\U0001F926\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man facepalming",
+		original: "\U0001F926\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man facepalming: light skin tone",
+		original: "\U0001F926\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man facepalming: light skin tone",
+		original: "\U0001F926\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man facepalming: medium-light skin tone",
+		original: "\U0001F926\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man facepalming: medium-light skin tone",
+		original: "\U0001F926\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man facepalming: medium skin tone",
+		original: "\U0001F926\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man facepalming: medium skin tone",
+		original: "\U0001F926\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man facepalming: medium-dark skin tone",
+		original: "\U0001F926\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man facepalming: medium-dark skin tone",
+		original: "\U0001F926\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man facepalming: dark skin tone",
+		original: "\U0001F926\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man facepalming: dark skin tone",
+		original: "\U0001F926\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman facepalming",
+		original: "\U0001F926\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman facepalming",
+		original: "\U0001F926\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman facepalming: light skin tone",
+		original: "\U0001F926\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman facepalming: light skin tone",
+		original: "\U0001F926\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman facepalming: medium-light skin tone",
+		original: "\U0001F926\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman facepalming: medium-light skin tone",
+		original: "\U0001F926\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman facepalming: medium skin tone",
+		original: "\U0001F926\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman facepalming: medium skin tone",
+		original: "\U0001F926\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman facepalming: medium-dark skin tone",
+		original: "\U0001F926\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman facepalming: medium-dark skin tone",
+		original: "\U0001F926\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman facepalming: dark skin tone",
+		original: "\U0001F926\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman facepalming: dark skin tone",
+		original: "\U0001F926\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E3.0 person shrugging",
+		original: "\U0001F937",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person shrugging: light skin tone",
+		original: "\U0001F937\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person shrugging: medium-light skin tone",
+		original: "\U0001F937\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person shrugging: medium skin tone",
+		original: "\U0001F937\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person shrugging: medium-dark skin tone",
+		original: "\U0001F937\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person shrugging: dark skin tone",
+		original: "\U0001F937\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man shrugging",
+		original: "\U0001F937\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man shrugging",
+		original: "\U0001F937\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man shrugging: light skin tone",
+		original: "\U0001F937\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man shrugging: light skin tone",
+		original: "\U0001F937\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man shrugging: medium-light skin tone",
+		original: "\U0001F937\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man shrugging: medium-light skin tone",
+		original: "\U0001F937\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man shrugging: medium skin tone",
+		original: "\U0001F937\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man shrugging: medium skin tone",
+		original: "\U0001F937\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man shrugging: medium-dark skin tone",
+		original: "\U0001F937\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man shrugging: medium-dark skin tone",
+		original: "\U0001F937\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man shrugging: dark skin tone",
+		original: "\U0001F937\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man shrugging: dark skin tone",
+		original: "\U0001F937\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman shrugging",
+		original: "\U0001F937\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman shrugging",
+		original: "\U0001F937\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman shrugging: light skin tone",
+		original: "\U0001F937\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman shrugging: light skin tone",
+		original: "\U0001F937\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman shrugging: medium-light skin tone",
+		original: "\U0001F937\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman shrugging: medium-light skin tone",
+		original: "\U0001F937\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman shrugging: medium skin tone",
+		original: "\U0001F937\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman shrugging: medium skin tone",
+		original: "\U0001F937\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman shrugging: medium-dark skin tone",
+		original: "\U0001F937\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman shrugging: medium-dark skin tone",
+		original: "\U0001F937\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman shrugging: dark skin tone",
+		original: "\U0001F937\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman shrugging: dark skin tone",
+		original: "\U0001F937\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 health worker",
+		original: "\U0001F9D1\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 health worker",
+		original: "\U0001F9D1\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 health worker: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 health worker: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 health worker: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 health worker: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 health worker: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 health worker: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 health worker: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 health worker: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 health worker: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 health worker: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man health worker",
+		original: "\U0001F468\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man health worker",
+		original: "\U0001F468\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man health worker: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man health worker: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man health worker: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man health worker: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man health worker: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man health worker: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man health worker: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man health worker: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man health worker: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man health worker: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman health worker",
+		original: "\U0001F469\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman health worker",
+		original: "\U0001F469\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman health worker: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman health worker: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman health worker: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman health worker: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman health worker: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman health worker: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman health worker: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman health worker: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman health worker: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman health worker: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2695",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 student",
+		original: "\U0001F9D1\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 student: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 student: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 student: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 student: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 student: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man student",
+		original: "\U0001F468\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man student: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man student: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man student: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man student: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man student: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman student",
+		original: "\U0001F469\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman student: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman student: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman student: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman student: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman student: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 teacher",
+		original: "\U0001F9D1\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 teacher: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 teacher: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 teacher: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 teacher: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 teacher: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man teacher",
+		original: "\U0001F468\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man teacher: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man teacher: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man teacher: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man teacher: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man teacher: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman teacher",
+		original: "\U0001F469\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman teacher: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman teacher: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman teacher: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman teacher: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman teacher: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 judge",
+		original: "\U0001F9D1\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 judge",
+		original: "\U0001F9D1\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 judge: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 judge: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 judge: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 judge: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 judge: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 judge: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 judge: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 judge: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 judge: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 judge: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man judge",
+		original: "\U0001F468\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man judge",
+		original: "\U0001F468\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man judge: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man judge: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man judge: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man judge: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man judge: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man judge: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man judge: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man judge: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man judge: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man judge: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman judge",
+		original: "\U0001F469\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman judge",
+		original: "\U0001F469\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman judge: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman judge: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman judge: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman judge: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman judge: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman judge: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman judge: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman judge: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman judge: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman judge: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2696",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 farmer",
+		original: "\U0001F9D1\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 farmer: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 farmer: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 farmer: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 farmer: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 farmer: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man farmer",
+		original: "\U0001F468\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man farmer: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man farmer: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man farmer: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man farmer: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man farmer: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman farmer",
+		original: "\U0001F469\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman farmer: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman farmer: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman farmer: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman farmer: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman farmer: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 cook",
+		original: "\U0001F9D1\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 cook: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 cook: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 cook: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 cook: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 cook: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man cook",
+		original: "\U0001F468\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man cook: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man cook: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man cook: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man cook: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man cook: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman cook",
+		original: "\U0001F469\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman cook: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman cook: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman cook: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman cook: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman cook: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 mechanic",
+		original: "\U0001F9D1\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 mechanic: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 mechanic: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 mechanic: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 mechanic: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 mechanic: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man mechanic",
+		original: "\U0001F468\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man mechanic: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man mechanic: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man mechanic: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man mechanic: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man mechanic: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman mechanic",
+		original: "\U0001F469\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman mechanic: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman mechanic: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman mechanic: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman mechanic: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman mechanic: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 factory worker",
+		original: "\U0001F9D1\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 factory worker: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 factory worker: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 factory worker: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 factory worker: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 factory worker: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man factory worker",
+		original: "\U0001F468\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man factory worker: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man factory worker: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man factory worker: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man factory worker: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man factory worker: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman factory worker",
+		original: "\U0001F469\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman factory worker: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman factory worker: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman factory worker: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman factory worker: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman factory worker: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 office worker",
+		original: "\U0001F9D1\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 office worker: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 office worker: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 office worker: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 office worker: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 office worker: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man office worker",
+		original: "\U0001F468\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man office worker: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man office worker: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man office worker: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man office worker: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man office worker: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman office worker",
+		original: "\U0001F469\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman office worker: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman office worker: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman office worker: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman office worker: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman office worker: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 scientist",
+		original: "\U0001F9D1\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 scientist: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 scientist: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 scientist: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 scientist: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 scientist: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man scientist",
+		original: "\U0001F468\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man scientist: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man scientist: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man scientist: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man scientist: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man scientist: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman scientist",
+		original: "\U0001F469\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman scientist: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman scientist: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman scientist: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman scientist: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman scientist: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 technologist",
+		original: "\U0001F9D1\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 technologist: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 technologist: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 technologist: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 technologist: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 technologist: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man technologist",
+		original: "\U0001F468\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man technologist: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man technologist: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man technologist: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man technologist: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man technologist: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman technologist",
+		original: "\U0001F469\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman technologist: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman technologist: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman technologist: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman technologist: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman technologist: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 singer",
+		original: "\U0001F9D1\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 singer: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 singer: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 singer: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 singer: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 singer: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man singer",
+		original: "\U0001F468\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man singer: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man singer: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man singer: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man singer: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man singer: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman singer",
+		original: "\U0001F469\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman singer: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman singer: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman singer: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman singer: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman singer: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 artist",
+		original: "\U0001F9D1\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 artist: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 artist: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 artist: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 artist: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 artist: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man artist",
+		original: "\U0001F468\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man artist: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man artist: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man artist: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man artist: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man artist: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman artist",
+		original: "\U0001F469\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman artist: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman artist: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman artist: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman artist: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman artist: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 pilot",
+		original: "\U0001F9D1\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 pilot",
+		original: "\U0001F9D1\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 pilot: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 pilot: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 pilot: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 pilot: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 pilot: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 pilot: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 pilot: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 pilot: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 pilot: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 pilot: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man pilot",
+		original: "\U0001F468\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man pilot",
+		original: "\U0001F468\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man pilot: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man pilot: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man pilot: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man pilot: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man pilot: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man pilot: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man pilot: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man pilot: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man pilot: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man pilot: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman pilot",
+		original: "\U0001F469\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman pilot",
+		original: "\U0001F469\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman pilot: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman pilot: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman pilot: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman pilot: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman pilot: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman pilot: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman pilot: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman pilot: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman pilot: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman pilot: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2708",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 astronaut",
+		original: "\U0001F9D1\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 astronaut: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 astronaut: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 astronaut: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 astronaut: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 astronaut: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man astronaut",
+		original: "\U0001F468\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man astronaut: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man astronaut: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man astronaut: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man astronaut: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man astronaut: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman astronaut",
+		original: "\U0001F469\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman astronaut: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman astronaut: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman astronaut: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman astronaut: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman astronaut: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 firefighter",
+		original: "\U0001F9D1\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 firefighter: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 firefighter: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 firefighter: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 firefighter: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 firefighter: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man firefighter",
+		original: "\U0001F468\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man firefighter: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man firefighter: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man firefighter: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man firefighter: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man firefighter: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman firefighter",
+		original: "\U0001F469\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman firefighter: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman firefighter: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman firefighter: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman firefighter: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman firefighter: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 police officer",
+		original: "\U0001F46E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 police officer: light skin tone",
+		original: "\U0001F46E\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 police officer: medium-light skin tone",
+		original: "\U0001F46E\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 police officer: medium skin tone",
+		original: "\U0001F46E\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 police officer: medium-dark skin tone",
+		original: "\U0001F46E\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 police officer: dark skin tone",
+		original: "\U0001F46E\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man police officer",
+		original: "\U0001F46E\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man police officer",
+		original: "\U0001F46E\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man police officer: light skin tone",
+		original: "\U0001F46E\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man police officer: light skin tone",
+		original: "\U0001F46E\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man police officer: medium-light skin tone",
+		original: "\U0001F46E\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man police officer: medium-light skin tone",
+		original: "\U0001F46E\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man police officer: medium skin tone",
+		original: "\U0001F46E\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man police officer: medium skin tone",
+		original: "\U0001F46E\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man police officer: medium-dark skin tone",
+		original: "\U0001F46E\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man police officer: medium-dark skin tone",
+		original: "\U0001F46E\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man police officer: dark skin tone",
+		original: "\U0001F46E\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man police officer: dark skin tone",
+		original: "\U0001F46E\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman police officer",
+		original: "\U0001F46E\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman police officer",
+		original: "\U0001F46E\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman police officer: light skin tone",
+		original: "\U0001F46E\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman police officer: light skin tone",
+		original: "\U0001F46E\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman police officer: medium-light skin tone",
+		original: "\U0001F46E\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman police officer: medium-light skin tone",
+		original: "\U0001F46E\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman police officer: medium skin tone",
+		original: "\U0001F46E\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman police officer: medium skin tone",
+		original: "\U0001F46E\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman police officer: medium-dark skin tone",
+		original: "\U0001F46E\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman police officer: medium-dark skin tone",
+		original: "\U0001F46E\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman police officer: dark skin tone",
+		original: "\U0001F46E\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman police officer: dark skin tone",
+		original: "\U0001F46E\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.7 detective",
+		original: "\U0001F575\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 detective",
+		original: "\U0001F575",
+		status:   "unqualified",
+	},
+	{
+		name:     "E2.0 detective: light skin tone",
+		original: "\U0001F575\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 detective: medium-light skin tone",
+		original: "\U0001F575\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 detective: medium skin tone",
+		original: "\U0001F575\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 detective: medium-dark skin tone",
+		original: "\U0001F575\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 detective: dark skin tone",
+		original: "\U0001F575\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man detective",
+		original: "\U0001F575\uFE0F\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man detective",
+		original: "\U0001F575\u200D\u2642\uFE0F",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 man detective",
+		original: "\U0001F575\uFE0F\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man detective",
+		original: "\U0001F575\u200D\u2642",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 man detective: light skin tone",
+		original: "\U0001F575\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man detective: light skin tone",
+		original: "\U0001F575\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man detective: medium-light skin tone",
+		original: "\U0001F575\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man detective: medium-light skin tone",
+		original: "\U0001F575\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man detective: medium skin tone",
+		original: "\U0001F575\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man detective: medium skin tone",
+		original: "\U0001F575\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man detective: medium-dark skin tone",
+		original: "\U0001F575\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man detective: medium-dark skin tone",
+		original: "\U0001F575\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man detective: dark skin tone",
+		original: "\U0001F575\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man detective: dark skin tone",
+		original: "\U0001F575\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman detective",
+		original: "\U0001F575\uFE0F\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman detective",
+		original: "\U0001F575\u200D\u2640\uFE0F",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 woman detective",
+		original: "\U0001F575\uFE0F\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman detective",
+		original: "\U0001F575\u200D\u2640",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 woman detective: light skin tone",
+		original: "\U0001F575\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman detective: light skin tone",
+		original: "\U0001F575\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman detective: medium-light skin tone",
+		original: "\U0001F575\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman detective: medium-light skin tone",
+		original: "\U0001F575\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman detective: medium skin tone",
+		original: "\U0001F575\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman detective: medium skin tone",
+		original: "\U0001F575\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman detective: medium-dark skin tone",
+		original: "\U0001F575\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman detective: medium-dark skin tone",
+		original: "\U0001F575\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman detective: dark skin tone",
+		original: "\U0001F575\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman detective: dark skin tone",
+		original: "\U0001F575\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 guard",
+		original: "\U0001F482",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 guard: light skin tone",
+		original: "\U0001F482\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 guard: medium-light skin tone",
+		original: "\U0001F482\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 guard: medium skin tone",
+		original: "\U0001F482\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 guard: medium-dark skin tone",
+		original: "\U0001F482\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 guard: dark skin tone",
+		original: "\U0001F482\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man guard",
+		original: "\U0001F482\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man guard",
+		original: "\U0001F482\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man guard: light skin tone",
+		original: "\U0001F482\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man guard: light skin tone",
+		original: "\U0001F482\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man guard: medium-light skin tone",
+		original: "\U0001F482\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man guard: medium-light skin tone",
+		original: "\U0001F482\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man guard: medium skin tone",
+		original: "\U0001F482\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man guard: medium skin tone",
+		original: "\U0001F482\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man guard: medium-dark skin tone",
+		original: "\U0001F482\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man guard: medium-dark skin tone",
+		original: "\U0001F482\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man guard: dark skin tone",
+		original: "\U0001F482\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man guard: dark skin tone",
+		original: "\U0001F482\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman guard",
+		original: "\U0001F482\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman guard",
+		original: "\U0001F482\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman guard: light skin tone",
+		original: "\U0001F482\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman guard: light skin tone",
+		original: "\U0001F482\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman guard: medium-light skin tone",
+		original: "\U0001F482\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman guard: medium-light skin tone",
+		original: "\U0001F482\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman guard: medium skin tone",
+		original: "\U0001F482\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman guard: medium skin tone",
+		original: "\U0001F482\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman guard: medium-dark skin tone",
+		original: "\U0001F482\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman guard: medium-dark skin tone",
+		original: "\U0001F482\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman guard: dark skin tone",
+		original: "\U0001F482\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman guard: dark skin tone",
+		original: "\U0001F482\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 ninja",
+		original: "\U0001F977",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 ninja: light skin tone",
+		original: "\U0001F977\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 ninja: medium-light skin tone",
+		original: "\U0001F977\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 ninja: medium skin tone",
+		original: "\U0001F977\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 ninja: medium-dark skin tone",
+		original: "\U0001F977\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 ninja: dark skin tone",
+		original: "\U0001F977\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 construction worker",
+		original: "\U0001F477",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 construction worker: light skin tone",
+		original: "\U0001F477\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 construction worker: medium-light skin tone",
+		original: "\U0001F477\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 construction worker: medium skin tone",
+		original: "\U0001F477\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 construction worker: medium-dark skin tone",
+		original: "\U0001F477\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 construction worker: dark skin tone",
+		original: "\U0001F477\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man construction worker",
+		original: "\U0001F477\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man construction worker",
+		original: "\U0001F477\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man construction worker: light skin tone",
+		original: "\U0001F477\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man construction worker: light skin tone",
+		original: "\U0001F477\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man construction worker: medium-light skin tone",
+		original: "\U0001F477\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man construction worker: medium-light skin tone",
+		original: "\U0001F477\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man construction worker: medium skin tone",
+		original: "\U0001F477\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man construction worker: medium skin tone",
+		original: "\U0001F477\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man construction worker: medium-dark skin tone",
+		original: "\U0001F477\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man construction worker: medium-dark skin tone",
+		original: "\U0001F477\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man construction worker: dark skin tone",
+		original: "\U0001F477\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man construction worker: dark skin tone",
+		original: "\U0001F477\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman construction worker",
+		original: "\U0001F477\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman construction worker",
+		original: "\U0001F477\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman construction worker: light skin tone",
+		original: "\U0001F477\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman construction worker: light skin tone",
+		original: "\U0001F477\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman construction worker: medium-light skin tone",
+		original: "\U0001F477\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman construction worker: medium-light skin tone",
+		original: "\U0001F477\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman construction worker: medium skin tone",
+		original: "\U0001F477\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman construction worker: medium skin tone",
+		original: "\U0001F477\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman construction worker: medium-dark skin tone",
+		original: "\U0001F477\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman construction worker: medium-dark skin tone",
+		original: "\U0001F477\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman construction worker: dark skin tone",
+		original: "\U0001F477\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman construction worker: dark skin tone",
+		original: "\U0001F477\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E14.0 person with crown",
+		original: "\U0001FAC5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 person with crown: light skin tone",
+		original: "\U0001FAC5\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 person with crown: medium-light skin tone",
+		original: "\U0001FAC5\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 person with crown: medium skin tone",
+		original: "\U0001FAC5\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 person with crown: medium-dark skin tone",
+		original: "\U0001FAC5\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 person with crown: dark skin tone",
+		original: "\U0001FAC5\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 prince",
+		original: "\U0001F934",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 prince: light skin tone",
+		original: "\U0001F934\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 prince: medium-light skin tone",
+		original: "\U0001F934\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 prince: medium skin tone",
+		original: "\U0001F934\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 prince: medium-dark skin tone",
+		original: "\U0001F934\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 prince: dark skin tone",
+		original: "\U0001F934\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 princess",
+		original: "\U0001F478",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 princess: light skin tone",
+		original: "\U0001F478\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 princess: medium-light skin tone",
+		original: "\U0001F478\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 princess: medium skin tone",
+		original: "\U0001F478\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 princess: medium-dark skin tone",
+		original: "\U0001F478\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 princess: dark skin tone",
+		original: "\U0001F478\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 person wearing turban",
+		original: "\U0001F473",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person wearing turban: light skin tone",
+		original: "\U0001F473\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person wearing turban: medium-light skin tone",
+		original: "\U0001F473\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person wearing turban: medium skin tone",
+		original: "\U0001F473\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person wearing turban: medium-dark skin tone",
+		original: "\U0001F473\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person wearing turban: dark skin tone",
+		original: "\U0001F473\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man wearing turban",
+		original: "\U0001F473\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man wearing turban",
+		original: "\U0001F473\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man wearing turban: light skin tone",
+		original: "\U0001F473\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man wearing turban: light skin tone",
+		original: "\U0001F473\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man wearing turban: medium-light skin tone",
+		original: "\U0001F473\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man wearing turban: medium-light skin tone",
+		original: "\U0001F473\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man wearing turban: medium skin tone",
+		original: "\U0001F473\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man wearing turban: medium skin tone",
+		original: "\U0001F473\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man wearing turban: medium-dark skin tone",
+		original: "\U0001F473\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man wearing turban: medium-dark skin tone",
+		original: "\U0001F473\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man wearing turban: dark skin tone",
+		original: "\U0001F473\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man wearing turban: dark skin tone",
+		original: "\U0001F473\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman wearing turban",
+		original: "\U0001F473\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman wearing turban",
+		original: "\U0001F473\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman wearing turban: light skin tone",
+		original: "\U0001F473\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman wearing turban: light skin tone",
+		original: "\U0001F473\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman wearing turban: medium-light skin tone",
+		original: "\U0001F473\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman wearing turban: medium-light skin tone",
+		original: "\U0001F473\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman wearing turban: medium skin tone",
+		original: "\U0001F473\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman wearing turban: medium skin tone",
+		original: "\U0001F473\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman wearing turban: medium-dark skin tone",
+		original: "\U0001F473\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman wearing turban: medium-dark skin tone",
+		original: "\U0001F473\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman wearing turban: dark skin tone",
+		original: "\U0001F473\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman wearing turban: dark skin tone",
+		original: "\U0001F473\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 person with skullcap",
+		original: "\U0001F472",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person with skullcap: light skin tone",
+		original: "\U0001F472\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person with skullcap: medium-light skin tone",
+		original: "\U0001F472\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person with skullcap: medium skin tone",
+		original: "\U0001F472\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person with skullcap: medium-dark skin tone",
+		original: "\U0001F472\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person with skullcap: dark skin tone",
+		original: "\U0001F472\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman with headscarf",
+		original: "\U0001F9D5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman with headscarf: light skin tone",
+		original: "\U0001F9D5\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman with headscarf: medium-light skin tone",
+		original: "\U0001F9D5\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman with headscarf: medium skin tone",
+		original: "\U0001F9D5\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman with headscarf: medium-dark skin tone",
+		original: "\U0001F9D5\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman with headscarf: dark skin tone",
+		original: "\U0001F9D5\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person in tuxedo",
+		original: "\U0001F935",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person in tuxedo: light skin tone",
+		original: "\U0001F935\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person in tuxedo: medium-light skin tone",
+		original: "\U0001F935\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person in tuxedo: medium skin tone",
+		original: "\U0001F935\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person in tuxedo: medium-dark skin tone",
+		original: "\U0001F935\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person in tuxedo: dark skin tone",
+		original: "\U0001F935\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man in tuxedo",
+		original: "\U0001F935\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man in tuxedo",
+		original: "\U0001F935\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 man in tuxedo: light skin tone",
+		original: "\U0001F935\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man in tuxedo: light skin tone",
+		original: "\U0001F935\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 man in tuxedo: medium-light skin tone",
+		original: "\U0001F935\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man in tuxedo: medium-light skin tone",
+		original: "\U0001F935\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 man in tuxedo: medium skin tone",
+		original: "\U0001F935\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man in tuxedo: medium skin tone",
+		original: "\U0001F935\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 man in tuxedo: medium-dark skin tone",
+		original: "\U0001F935\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man in tuxedo: medium-dark skin tone",
+		original: "\U0001F935\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 man in tuxedo: dark skin tone",
+		original: "\U0001F935\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man in tuxedo: dark skin tone",
+		original: "\U0001F935\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 woman in tuxedo",
+		original: "\U0001F935\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman in tuxedo",
+		original: "\U0001F935\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 woman in tuxedo: light skin tone",
+		original: "\U0001F935\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman in tuxedo: light skin tone",
+		original: "\U0001F935\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 woman in tuxedo: medium-light skin tone",
+		original: "\U0001F935\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman in tuxedo: medium-light skin tone",
+		original: "\U0001F935\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 woman in tuxedo: medium skin tone",
+		original: "\U0001F935\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman in tuxedo: medium skin tone",
+		original: "\U0001F935\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 woman in tuxedo: medium-dark skin tone",
+		original: "\U0001F935\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman in tuxedo: medium-dark skin tone",
+		original: "\U0001F935\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 woman in tuxedo: dark skin tone",
+		original: "\U0001F935\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman in tuxedo: dark skin tone",
+		original: "\U0001F935\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 person with veil",
+		original: "\U0001F470",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person with veil: light skin tone",
+		original: "\U0001F470\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person with veil: medium-light skin tone",
+		original: "\U0001F470\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person with veil: medium skin tone",
+		original: "\U0001F470\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person with veil: medium-dark skin tone",
+		original: "\U0001F470\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person with veil: dark skin tone",
+		original: "\U0001F470\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man with veil",
+		original: "\U0001F470\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man with veil",
+		original: "\U0001F470\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 man with veil: light skin tone",
+		original: "\U0001F470\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man with veil: light skin tone",
+		original: "\U0001F470\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 man with veil: medium-light skin tone",
+		original: "\U0001F470\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man with veil: medium-light skin tone",
+		original: "\U0001F470\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 man with veil: medium skin tone",
+		original: "\U0001F470\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man with veil: medium skin tone",
+		original: "\U0001F470\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 man with veil: medium-dark skin tone",
+		original: "\U0001F470\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man with veil: medium-dark skin tone",
+		original: "\U0001F470\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 man with veil: dark skin tone",
+		original: "\U0001F470\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man with veil: dark skin tone",
+		original: "\U0001F470\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 woman with veil",
+		original: "\U0001F470\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman with veil",
+		original: "\U0001F470\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 woman with veil: light skin tone",
+		original: "\U0001F470\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman with veil: light skin tone",
+		original: "\U0001F470\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 woman with veil: medium-light skin tone",
+		original: "\U0001F470\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman with veil: medium-light skin tone",
+		original: "\U0001F470\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 woman with veil: medium skin tone",
+		original: "\U0001F470\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman with veil: medium skin tone",
+		original: "\U0001F470\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 woman with veil: medium-dark skin tone",
+		original: "\U0001F470\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman with veil: medium-dark skin tone",
+		original: "\U0001F470\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 woman with veil: dark skin tone",
+		original: "\U0001F470\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman with veil: dark skin tone",
+		original: "\U0001F470\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E3.0 pregnant woman",
+		original: "\U0001F930",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 pregnant woman: light skin tone",
+		original: "\U0001F930\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 pregnant woman: medium-light skin tone",
+		original: "\U0001F930\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 pregnant woman: medium skin tone",
+		original: "\U0001F930\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 pregnant woman: medium-dark skin tone",
+		original: "\U0001F930\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 pregnant woman: dark skin tone",
+		original: "\U0001F930\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 pregnant man",
+		original: "\U0001FAC3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 pregnant man: light skin tone",
+		original: "\U0001FAC3\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 pregnant man: medium-light skin tone",
+		original: "\U0001FAC3\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 pregnant man: medium skin tone",
+		original: "\U0001FAC3\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 pregnant man: medium-dark skin tone",
+		original: "\U0001FAC3\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 pregnant man: dark skin tone",
+		original: "\U0001FAC3\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 pregnant person",
+		original: "\U0001FAC4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 pregnant person: light skin tone",
+		original: "\U0001FAC4\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 pregnant person: medium-light skin tone",
+		original: "\U0001FAC4\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 pregnant person: medium skin tone",
+		original: "\U0001FAC4\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 pregnant person: medium-dark skin tone",
+		original: "\U0001FAC4\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 pregnant person: dark skin tone",
+		original: "\U0001FAC4\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 breast-feeding",
+		original: "\U0001F931",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 breast-feeding: light skin tone",
+		original: "\U0001F931\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 breast-feeding: medium-light skin tone",
+		original: "\U0001F931\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 breast-feeding: medium skin tone",
+		original: "\U0001F931\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 breast-feeding: medium-dark skin tone",
+		original: "\U0001F931\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 breast-feeding: dark skin tone",
+		original: "\U0001F931\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman feeding baby",
+		original: "\U0001F469\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman feeding baby: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman feeding baby: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman feeding baby: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman feeding baby: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 woman feeding baby: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man feeding baby",
+		original: "\U0001F468\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man feeding baby: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man feeding baby: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man feeding baby: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man feeding baby: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 man feeding baby: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 person feeding baby",
+		original: "\U0001F9D1\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 person feeding baby: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 person feeding baby: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 person feeding baby: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 person feeding baby: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 person feeding baby: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 baby angel",
+		original: "\U0001F47C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 baby angel: light skin tone",
+		original: "\U0001F47C\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 baby angel: medium-light skin tone",
+		original: "\U0001F47C\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 baby angel: medium skin tone",
+		original: "\U0001F47C\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 baby angel: medium-dark skin tone",
+		original: "\U0001F47C\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 baby angel: dark skin tone",
+		original: "\U0001F47C\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Santa Claus",
+		original: "\U0001F385",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 Santa Claus: light skin tone",
+		original: "\U0001F385\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 Santa Claus: medium-light skin tone",
+		original: "\U0001F385\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 Santa Claus: medium skin tone",
+		original: "\U0001F385\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 Santa Claus: medium-dark skin tone",
+		original: "\U0001F385\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 Santa Claus: dark skin tone",
+		original: "\U0001F385\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 Mrs. Claus",
+		original: "\U0001F936",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 Mrs. Claus: light skin tone",
+		original: "\U0001F936\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 Mrs. Claus: medium-light skin tone",
+		original: "\U0001F936\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 Mrs. Claus: medium skin tone",
+		original: "\U0001F936\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 Mrs. Claus: medium-dark skin tone",
+		original: "\U0001F936\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 Mrs. Claus: dark skin tone",
+		original: "\U0001F936\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 mx claus",
+		original: "\U0001F9D1\u200D\U0001F384",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 mx claus: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F384",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 mx claus: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F384",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 mx claus: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F384",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 mx claus: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F384",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 mx claus: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F384",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 superhero",
+		original: "\U0001F9B8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 superhero: light skin tone",
+		original: "\U0001F9B8\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 superhero: medium-light skin tone",
+		original: "\U0001F9B8\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 superhero: medium skin tone",
+		original: "\U0001F9B8\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 superhero: medium-dark skin tone",
+		original: "\U0001F9B8\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 superhero: dark skin tone",
+		original: "\U0001F9B8\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man superhero",
+		original: "\U0001F9B8\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man superhero",
+		original: "\U0001F9B8\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 man superhero: light skin tone",
+		original: "\U0001F9B8\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man superhero: light skin tone",
+		original: "\U0001F9B8\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 man superhero: medium-light skin tone",
+		original: "\U0001F9B8\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man superhero: medium-light skin tone",
+		original: "\U0001F9B8\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 man superhero: medium skin tone",
+		original: "\U0001F9B8\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man superhero: medium skin tone",
+		original: "\U0001F9B8\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 man superhero: medium-dark skin tone",
+		original: "\U0001F9B8\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man superhero: medium-dark skin tone",
+		original: "\U0001F9B8\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 man superhero: dark skin tone",
+		original: "\U0001F9B8\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man superhero: dark skin tone",
+		original: "\U0001F9B8\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 woman superhero",
+		original: "\U0001F9B8\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman superhero",
+		original: "\U0001F9B8\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 woman superhero: light skin tone",
+		original: "\U0001F9B8\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman superhero: light skin tone",
+		original: "\U0001F9B8\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 woman superhero: medium-light skin tone",
+		original: "\U0001F9B8\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman superhero: medium-light skin tone",
+		original: "\U0001F9B8\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 woman superhero: medium skin tone",
+		original: "\U0001F9B8\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman superhero: medium skin tone",
+		original: "\U0001F9B8\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 woman superhero: medium-dark skin tone",
+		original: "\U0001F9B8\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman superhero: medium-dark skin tone",
+		original: "\U0001F9B8\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 woman superhero: dark skin tone",
+		original: "\U0001F9B8\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman superhero: dark skin tone",
+		original: "\U0001F9B8\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 supervillain",
+		original: "\U0001F9B9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 supervillain: light skin tone",
+		original: "\U0001F9B9\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 supervillain: medium-light skin tone",
+		original: "\U0001F9B9\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 supervillain: medium skin tone",
+		original: "\U0001F9B9\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 supervillain: medium-dark skin tone",
+		original: "\U0001F9B9\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 supervillain: dark skin tone",
+		original: "\U0001F9B9\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man supervillain",
+		original: "\U0001F9B9\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man supervillain",
+		original: "\U0001F9B9\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 man supervillain: light skin tone",
+		original: "\U0001F9B9\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man supervillain: light skin tone",
+		original: "\U0001F9B9\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 man supervillain: medium-light skin tone",
+		original: "\U0001F9B9\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man supervillain: medium-light skin tone",
+		original: "\U0001F9B9\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 man supervillain: medium skin tone",
+		original: "\U0001F9B9\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man supervillain: medium skin tone",
+		original: "\U0001F9B9\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 man supervillain: medium-dark skin tone",
+		original: "\U0001F9B9\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man supervillain: medium-dark skin tone",
+		original: "\U0001F9B9\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 man supervillain: dark skin tone",
+		original: "\U0001F9B9\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 man supervillain: dark skin tone",
+		original: "\U0001F9B9\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 woman supervillain",
+		original: "\U0001F9B9\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman supervillain",
+		original: "\U0001F9B9\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 woman supervillain: light skin tone",
+		original: "\U0001F9B9\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman supervillain: light skin tone",
+		original: "\U0001F9B9\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 woman supervillain: medium-light skin tone",
+		original: "\U0001F9B9\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman supervillain: medium-light skin tone",
+		original: "\U0001F9B9\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 woman supervillain: medium skin tone",
+		original: "\U0001F9B9\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman supervillain: medium skin tone",
+		original: "\U0001F9B9\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 woman supervillain: medium-dark skin tone",
+		original: "\U0001F9B9\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman supervillain: medium-dark skin tone",
+		original: "\U0001F9B9\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E11.0 woman supervillain: dark skin tone",
+		original: "\U0001F9B9\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 woman supervillain: dark skin tone",
+		original: "\U0001F9B9\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 mage",
+		original: "\U0001F9D9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 mage: light skin tone",
+		original: "\U0001F9D9\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 mage: medium-light skin tone",
+		original: "\U0001F9D9\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 mage: medium skin tone",
+		original: "\U0001F9D9\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 mage: medium-dark skin tone",
+		original: "\U0001F9D9\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 mage: dark skin tone",
+		original: "\U0001F9D9\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man mage",
+		original: "\U0001F9D9\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man mage",
+		original: "\U0001F9D9\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man mage: light skin tone",
+		original: "\U0001F9D9\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man mage: light skin tone",
+		original: "\U0001F9D9\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man mage: medium-light skin tone",
+		original: "\U0001F9D9\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man mage: medium-light skin tone",
+		original: "\U0001F9D9\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man mage: medium skin tone",
+		original: "\U0001F9D9\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man mage: medium skin tone",
+		original: "\U0001F9D9\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man mage: medium-dark skin tone",
+		original: "\U0001F9D9\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man mage: medium-dark skin tone",
+		original: "\U0001F9D9\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man mage: dark skin tone",
+		original: "\U0001F9D9\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man mage: dark skin tone",
+		original: "\U0001F9D9\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman mage",
+		original: "\U0001F9D9\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman mage",
+		original: "\U0001F9D9\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman mage: light skin tone",
+		original: "\U0001F9D9\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman mage: light skin tone",
+		original: "\U0001F9D9\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman mage: medium-light skin tone",
+		original: "\U0001F9D9\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman mage: medium-light skin tone",
+		original: "\U0001F9D9\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman mage: medium skin tone",
+		original: "\U0001F9D9\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman mage: medium skin tone",
+		original: "\U0001F9D9\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman mage: medium-dark skin tone",
+		original: "\U0001F9D9\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman mage: medium-dark skin tone",
+		original: "\U0001F9D9\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman mage: dark skin tone",
+		original: "\U0001F9D9\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman mage: dark skin tone",
+		original: "\U0001F9D9\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 fairy",
+		original: "\U0001F9DA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 fairy: light skin tone",
+		original: "\U0001F9DA\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 fairy: medium-light skin tone",
+		original: "\U0001F9DA\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 fairy: medium skin tone",
+		original: "\U0001F9DA\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 fairy: medium-dark skin tone",
+		original: "\U0001F9DA\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 fairy: dark skin tone",
+		original: "\U0001F9DA\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man fairy",
+		original: "\U0001F9DA\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man fairy",
+		original: "\U0001F9DA\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man fairy: light skin tone",
+		original: "\U0001F9DA\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man fairy: light skin tone",
+		original: "\U0001F9DA\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man fairy: medium-light skin tone",
+		original: "\U0001F9DA\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man fairy: medium-light skin tone",
+		original: "\U0001F9DA\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man fairy: medium skin tone",
+		original: "\U0001F9DA\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man fairy: medium skin tone",
+		original: "\U0001F9DA\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man fairy: medium-dark skin tone",
+		original: "\U0001F9DA\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man fairy: medium-dark skin tone",
+		original: "\U0001F9DA\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man fairy: dark skin tone",
+		original: "\U0001F9DA\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man fairy: dark skin tone",
+		original: "\U0001F9DA\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman fairy",
+		original: "\U0001F9DA\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman fairy",
+		original: "\U0001F9DA\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman fairy: light skin tone",
+		original: "\U0001F9DA\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman fairy: light skin tone",
+		original: "\U0001F9DA\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman fairy: medium-light skin tone",
+		original: "\U0001F9DA\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman fairy: medium-light skin tone",
+		original: "\U0001F9DA\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman fairy: medium skin tone",
+		original: "\U0001F9DA\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman fairy: medium skin tone",
+		original: "\U0001F9DA\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman fairy: medium-dark skin tone",
+		original: "\U0001F9DA\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman fairy: medium-dark skin tone",
+		original: "\U0001F9DA\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman fairy: dark skin tone",
+		original: "\U0001F9DA\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman fairy: dark skin tone",
+		original: "\U0001F9DA\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 vampire",
+		original: "\U0001F9DB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 vampire: light skin tone",
+		original: "\U0001F9DB\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 vampire: medium-light skin tone",
+		original: "\U0001F9DB\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 vampire: medium skin tone",
+		original: "\U0001F9DB\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 vampire: medium-dark skin tone",
+		original: "\U0001F9DB\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 vampire: dark skin tone",
+		original: "\U0001F9DB\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man vampire",
+		original: "\U0001F9DB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man vampire",
+		original: "\U0001F9DB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man vampire: light skin tone",
+		original: "\U0001F9DB\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man vampire: light skin tone",
+		original: "\U0001F9DB\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man vampire: medium-light skin tone",
+		original: "\U0001F9DB\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man vampire: medium-light skin tone",
+		original: "\U0001F9DB\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man vampire: medium skin tone",
+		original: "\U0001F9DB\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man vampire: medium skin tone",
+		original: "\U0001F9DB\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man vampire: medium-dark skin tone",
+		original: "\U0001F9DB\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man vampire: medium-dark skin tone",
+		original: "\U0001F9DB\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man vampire: dark skin tone",
+		original: "\U0001F9DB\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man vampire: dark skin tone",
+		original: "\U0001F9DB\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman vampire",
+		original: "\U0001F9DB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman vampire",
+		original: "\U0001F9DB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman vampire: light skin tone",
+		original: "\U0001F9DB\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman vampire: light skin tone",
+		original: "\U0001F9DB\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman vampire: medium-light skin tone",
+		original: "\U0001F9DB\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman vampire: medium-light skin tone",
+		original: "\U0001F9DB\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman vampire: medium skin tone",
+		original: "\U0001F9DB\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman vampire: medium skin tone",
+		original: "\U0001F9DB\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman vampire: medium-dark skin tone",
+		original: "\U0001F9DB\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman vampire: medium-dark skin tone",
+		original: "\U0001F9DB\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman vampire: dark skin tone",
+		original: "\U0001F9DB\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman vampire: dark skin tone",
+		original: "\U0001F9DB\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 merperson",
+		original: "\U0001F9DC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 merperson: light skin tone",
+		original: "\U0001F9DC\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 merperson: medium-light skin tone",
+		original: "\U0001F9DC\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 merperson: medium skin tone",
+		original: "\U0001F9DC\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 merperson: medium-dark skin tone",
+		original: "\U0001F9DC\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 merperson: dark skin tone",
+		original: "\U0001F9DC\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 merman",
+		original: "\U0001F9DC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 merman",
+		original: "\U0001F9DC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 merman: light skin tone",
+		original: "\U0001F9DC\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 merman: light skin tone",
+		original: "\U0001F9DC\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 merman: medium-light skin tone",
+		original: "\U0001F9DC\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 merman: medium-light skin tone",
+		original: "\U0001F9DC\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 merman: medium skin tone",
+		original: "\U0001F9DC\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 merman: medium skin tone",
+		original: "\U0001F9DC\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 merman: medium-dark skin tone",
+		original: "\U0001F9DC\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 merman: medium-dark skin tone",
+		original: "\U0001F9DC\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 merman: dark skin tone",
+		original: "\U0001F9DC\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 merman: dark skin tone",
+		original: "\U0001F9DC\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 mermaid",
+		original: "\U0001F9DC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 mermaid",
+		original: "\U0001F9DC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 mermaid: light skin tone",
+		original: "\U0001F9DC\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 mermaid: light skin tone",
+		original: "\U0001F9DC\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 mermaid: medium-light skin tone",
+		original: "\U0001F9DC\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 mermaid: medium-light skin tone",
+		original: "\U0001F9DC\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 mermaid: medium skin tone",
+		original: "\U0001F9DC\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 mermaid: medium skin tone",
+		original: "\U0001F9DC\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 mermaid: medium-dark skin tone",
+		original: "\U0001F9DC\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 mermaid: medium-dark skin tone",
+		original: "\U0001F9DC\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 mermaid: dark skin tone",
+		original: "\U0001F9DC\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 mermaid: dark skin tone",
+		original: "\U0001F9DC\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 elf",
+		original: "\U0001F9DD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 elf: light skin tone",
+		original: "\U0001F9DD\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 elf: medium-light skin tone",
+		original: "\U0001F9DD\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 elf: medium skin tone",
+		original: "\U0001F9DD\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 elf: medium-dark skin tone",
+		original: "\U0001F9DD\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 elf: dark skin tone",
+		original: "\U0001F9DD\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man elf",
+		original: "\U0001F9DD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man elf",
+		original: "\U0001F9DD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man elf: light skin tone",
+		original: "\U0001F9DD\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man elf: light skin tone",
+		original: "\U0001F9DD\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man elf: medium-light skin tone",
+		original: "\U0001F9DD\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man elf: medium-light skin tone",
+		original: "\U0001F9DD\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man elf: medium skin tone",
+		original: "\U0001F9DD\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man elf: medium skin tone",
+		original: "\U0001F9DD\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man elf: medium-dark skin tone",
+		original: "\U0001F9DD\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man elf: medium-dark skin tone",
+		original: "\U0001F9DD\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man elf: dark skin tone",
+		original: "\U0001F9DD\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man elf: dark skin tone",
+		original: "\U0001F9DD\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman elf",
+		original: "\U0001F9DD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman elf",
+		original: "\U0001F9DD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman elf: light skin tone",
+		original: "\U0001F9DD\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman elf: light skin tone",
+		original: "\U0001F9DD\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman elf: medium-light skin tone",
+		original: "\U0001F9DD\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman elf: medium-light skin tone",
+		original: "\U0001F9DD\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman elf: medium skin tone",
+		original: "\U0001F9DD\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman elf: medium skin tone",
+		original: "\U0001F9DD\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman elf: medium-dark skin tone",
+		original: "\U0001F9DD\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman elf: medium-dark skin tone",
+		original: "\U0001F9DD\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman elf: dark skin tone",
+		original: "\U0001F9DD\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman elf: dark skin tone",
+		original: "\U0001F9DD\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 genie",
+		original: "\U0001F9DE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man genie",
+		original: "\U0001F9DE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man genie",
+		original: "\U0001F9DE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman genie",
+		original: "\U0001F9DE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman genie",
+		original: "\U0001F9DE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 zombie",
+		original: "\U0001F9DF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man zombie",
+		original: "\U0001F9DF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man zombie",
+		original: "\U0001F9DF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman zombie",
+		original: "\U0001F9DF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman zombie",
+		original: "\U0001F9DF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E14.0 troll",
+		original: "\U0001F9CC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 person getting massage",
+		original: "\U0001F486",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person getting massage: light skin tone",
+		original: "\U0001F486\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person getting massage: medium-light skin tone",
+		original: "\U0001F486\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person getting massage: medium skin tone",
+		original: "\U0001F486\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person getting massage: medium-dark skin tone",
+		original: "\U0001F486\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person getting massage: dark skin tone",
+		original: "\U0001F486\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man getting massage",
+		original: "\U0001F486\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man getting massage",
+		original: "\U0001F486\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man getting massage: light skin tone",
+		original: "\U0001F486\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man getting massage: light skin tone",
+		original: "\U0001F486\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man getting massage: medium-light skin tone",
+		original: "\U0001F486\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man getting massage: medium-light skin tone",
+		original: "\U0001F486\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man getting massage: medium skin tone",
+		original: "\U0001F486\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man getting massage: medium skin tone",
+		original: "\U0001F486\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man getting massage: medium-dark skin tone",
+		original: "\U0001F486\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man getting massage: medium-dark skin tone",
+		original: "\U0001F486\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man getting massage: dark skin tone",
+		original: "\U0001F486\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man getting massage: dark skin tone",
+		original: "\U0001F486\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman getting massage",
+		original: "\U0001F486\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman getting massage",
+		original: "\U0001F486\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman getting massage: light skin tone",
+		original: "\U0001F486\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman getting massage: light skin tone",
+		original: "\U0001F486\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman getting massage: medium-light skin tone",
+		original: "\U0001F486\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman getting massage: medium-light skin tone",
+		original: "\U0001F486\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman getting massage: medium skin tone",
+		original: "\U0001F486\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman getting massage: medium skin tone",
+		original: "\U0001F486\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman getting massage: medium-dark skin tone",
+		original: "\U0001F486\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman getting massage: medium-dark skin tone",
+		original: "\U0001F486\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman getting massage: dark skin tone",
+		original: "\U0001F486\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman getting massage: dark skin tone",
+		original: "\U0001F486\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 person getting haircut",
+		original: "\U0001F487",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person getting haircut: light skin tone",
+		original: "\U0001F487\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person getting haircut: medium-light skin tone",
+		original: "\U0001F487\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person getting haircut: medium skin tone",
+		original: "\U0001F487\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person getting haircut: medium-dark skin tone",
+		original: "\U0001F487\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person getting haircut: dark skin tone",
+		original: "\U0001F487\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man getting haircut",
+		original: "\U0001F487\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man getting haircut",
+		original: "\U0001F487\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man getting haircut: light skin tone",
+		original: "\U0001F487\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man getting haircut: light skin tone",
+		original: "\U0001F487\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man getting haircut: medium-light skin tone",
+		original: "\U0001F487\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man getting haircut: medium-light skin tone",
+		original: "\U0001F487\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man getting haircut: medium skin tone",
+		original: "\U0001F487\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man getting haircut: medium skin tone",
+		original: "\U0001F487\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man getting haircut: medium-dark skin tone",
+		original: "\U0001F487\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man getting haircut: medium-dark skin tone",
+		original: "\U0001F487\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man getting haircut: dark skin tone",
+		original: "\U0001F487\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man getting haircut: dark skin tone",
+		original: "\U0001F487\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman getting haircut",
+		original: "\U0001F487\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman getting haircut",
+		original: "\U0001F487\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman getting haircut: light skin tone",
+		original: "\U0001F487\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman getting haircut: light skin tone",
+		original: "\U0001F487\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman getting haircut: medium-light skin tone",
+		original: "\U0001F487\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman getting haircut: medium-light skin tone",
+		original: "\U0001F487\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman getting haircut: medium skin tone",
+		original: "\U0001F487\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman getting haircut: medium skin tone",
+		original: "\U0001F487\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman getting haircut: medium-dark skin tone",
+		original: "\U0001F487\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman getting haircut: medium-dark skin tone",
+		original: "\U0001F487\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman getting haircut: dark skin tone",
+		original: "\U0001F487\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman getting haircut: dark skin tone",
+		original: "\U0001F487\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 person walking",
+		original: "\U0001F6B6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person walking: light skin tone",
+		original: "\U0001F6B6\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person walking: medium-light skin tone",
+		original: "\U0001F6B6\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person walking: medium skin tone",
+		original: "\U0001F6B6\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person walking: medium-dark skin tone",
+		original: "\U0001F6B6\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person walking: dark skin tone",
+		original: "\U0001F6B6\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man walking",
+		original: "\U0001F6B6\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man walking",
+		original: "\U0001F6B6\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man walking: light skin tone",
+		original: "\U0001F6B6\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man walking: light skin tone",
+		original: "\U0001F6B6\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man walking: medium-light skin tone",
+		original: "\U0001F6B6\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man walking: medium-light skin tone",
+		original: "\U0001F6B6\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man walking: medium skin tone",
+		original: "\U0001F6B6\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man walking: medium skin tone",
+		original: "\U0001F6B6\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man walking: medium-dark skin tone",
+		original: "\U0001F6B6\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man walking: medium-dark skin tone",
+		original: "\U0001F6B6\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man walking: dark skin tone",
+		original: "\U0001F6B6\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man walking: dark skin tone",
+		original: "\U0001F6B6\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman walking",
+		original: "\U0001F6B6\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman walking",
+		original: "\U0001F6B6\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman walking: light skin tone",
+		original: "\U0001F6B6\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman walking: light skin tone",
+		original: "\U0001F6B6\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman walking: medium-light skin tone",
+		original: "\U0001F6B6\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman walking: medium-light skin tone",
+		original: "\U0001F6B6\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman walking: medium skin tone",
+		original: "\U0001F6B6\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman walking: medium skin tone",
+		original: "\U0001F6B6\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman walking: medium-dark skin tone",
+		original: "\U0001F6B6\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman walking: medium-dark skin tone",
+		original: "\U0001F6B6\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman walking: dark skin tone",
+		original: "\U0001F6B6\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman walking: dark skin tone",
+		original: "\U0001F6B6\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person walking facing right",
+		original: "\U0001F6B6\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person walking facing right",
+		original: "\U0001F6B6\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person walking facing right: light skin tone",
+		original: "\U0001F6B6\U0001F3FB\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person walking facing right: light skin tone",
+		original: "\U0001F6B6\U0001F3FB\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person walking facing right: medium-light skin tone",
+		original: "\U0001F6B6\U0001F3FC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person walking facing right: medium-light skin tone",
+		original: "\U0001F6B6\U0001F3FC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person walking facing right: medium skin tone",
+		original: "\U0001F6B6\U0001F3FD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person walking facing right: medium skin tone",
+		original: "\U0001F6B6\U0001F3FD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person walking facing right: medium-dark skin tone",
+		original: "\U0001F6B6\U0001F3FE\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person walking facing right: medium-dark skin tone",
+		original: "\U0001F6B6\U0001F3FE\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person walking facing right: dark skin tone",
+		original: "\U0001F6B6\U0001F3FF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person walking facing right: dark skin tone",
+		original: "\U0001F6B6\U0001F3FF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right",
+		original: "\U0001F6B6\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right",
+		original: "\U0001F6B6\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right",
+		original: "\U0001F6B6\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right",
+		original: "\U0001F6B6\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: light skin tone",
+		original: "\U0001F6B6\U0001F3FB\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: light skin tone",
+		original: "\U0001F6B6\U0001F3FB\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: light skin tone",
+		original: "\U0001F6B6\U0001F3FB\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: light skin tone",
+		original: "\U0001F6B6\U0001F3FB\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: medium-light skin tone",
+		original: "\U0001F6B6\U0001F3FC\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: medium-light skin tone",
+		original: "\U0001F6B6\U0001F3FC\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: medium-light skin tone",
+		original: "\U0001F6B6\U0001F3FC\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: medium-light skin tone",
+		original: "\U0001F6B6\U0001F3FC\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: medium skin tone",
+		original: "\U0001F6B6\U0001F3FD\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: medium skin tone",
+		original: "\U0001F6B6\U0001F3FD\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: medium skin tone",
+		original: "\U0001F6B6\U0001F3FD\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: medium skin tone",
+		original: "\U0001F6B6\U0001F3FD\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: medium-dark skin tone",
+		original: "\U0001F6B6\U0001F3FE\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: medium-dark skin tone",
+		original: "\U0001F6B6\U0001F3FE\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: medium-dark skin tone",
+		original: "\U0001F6B6\U0001F3FE\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: medium-dark skin tone",
+		original: "\U0001F6B6\U0001F3FE\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: dark skin tone",
+		original: "\U0001F6B6\U0001F3FF\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: dark skin tone",
+		original: "\U0001F6B6\U0001F3FF\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: dark skin tone",
+		original: "\U0001F6B6\U0001F3FF\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman walking facing right: dark skin tone",
+		original: "\U0001F6B6\U0001F3FF\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right",
+		original: "\U0001F6B6\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right",
+		original: "\U0001F6B6\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right",
+		original: "\U0001F6B6\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right",
+		original: "\U0001F6B6\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: light skin tone",
+		original: "\U0001F6B6\U0001F3FB\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: light skin tone",
+		original: "\U0001F6B6\U0001F3FB\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: light skin tone",
+		original: "\U0001F6B6\U0001F3FB\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: light skin tone",
+		original: "\U0001F6B6\U0001F3FB\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: medium-light skin tone",
+		original: "\U0001F6B6\U0001F3FC\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: medium-light skin tone",
+		original: "\U0001F6B6\U0001F3FC\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: medium-light skin tone",
+		original: "\U0001F6B6\U0001F3FC\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: medium-light skin tone",
+		original: "\U0001F6B6\U0001F3FC\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: medium skin tone",
+		original: "\U0001F6B6\U0001F3FD\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: medium skin tone",
+		original: "\U0001F6B6\U0001F3FD\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: medium skin tone",
+		original: "\U0001F6B6\U0001F3FD\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: medium skin tone",
+		original: "\U0001F6B6\U0001F3FD\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: medium-dark skin tone",
+		original: "\U0001F6B6\U0001F3FE\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: medium-dark skin tone",
+		original: "\U0001F6B6\U0001F3FE\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: medium-dark skin tone",
+		original: "\U0001F6B6\U0001F3FE\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: medium-dark skin tone",
+		original: "\U0001F6B6\U0001F3FE\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: dark skin tone",
+		original: "\U0001F6B6\U0001F3FF\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: dark skin tone",
+		original: "\U0001F6B6\U0001F3FF\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: dark skin tone",
+		original: "\U0001F6B6\U0001F3FF\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man walking facing right: dark skin tone",
+		original: "\U0001F6B6\U0001F3FF\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 person standing",
+		original: "\U0001F9CD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 person standing: light skin tone",
+		original: "\U0001F9CD\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 person standing: medium-light skin tone",
+		original: "\U0001F9CD\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 person standing: medium skin tone",
+		original: "\U0001F9CD\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 person standing: medium-dark skin tone",
+		original: "\U0001F9CD\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 person standing: dark skin tone",
+		original: "\U0001F9CD\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man standing",
+		original: "\U0001F9CD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man standing",
+		original: "\U0001F9CD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 man standing: light skin tone",
+		original: "\U0001F9CD\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man standing: light skin tone",
+		original: "\U0001F9CD\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 man standing: medium-light skin tone",
+		original: "\U0001F9CD\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man standing: medium-light skin tone",
+		original: "\U0001F9CD\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 man standing: medium skin tone",
+		original: "\U0001F9CD\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man standing: medium skin tone",
+		original: "\U0001F9CD\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 man standing: medium-dark skin tone",
+		original: "\U0001F9CD\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man standing: medium-dark skin tone",
+		original: "\U0001F9CD\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 man standing: dark skin tone",
+		original: "\U0001F9CD\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man standing: dark skin tone",
+		original: "\U0001F9CD\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 woman standing",
+		original: "\U0001F9CD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman standing",
+		original: "\U0001F9CD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 woman standing: light skin tone",
+		original: "\U0001F9CD\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman standing: light skin tone",
+		original: "\U0001F9CD\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 woman standing: medium-light skin tone",
+		original: "\U0001F9CD\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman standing: medium-light skin tone",
+		original: "\U0001F9CD\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 woman standing: medium skin tone",
+		original: "\U0001F9CD\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman standing: medium skin tone",
+		original: "\U0001F9CD\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 woman standing: medium-dark skin tone",
+		original: "\U0001F9CD\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman standing: medium-dark skin tone",
+		original: "\U0001F9CD\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 woman standing: dark skin tone",
+		original: "\U0001F9CD\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman standing: dark skin tone",
+		original: "\U0001F9CD\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 person kneeling",
+		original: "\U0001F9CE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 person kneeling: light skin tone",
+		original: "\U0001F9CE\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 person kneeling: medium-light skin tone",
+		original: "\U0001F9CE\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 person kneeling: medium skin tone",
+		original: "\U0001F9CE\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 person kneeling: medium-dark skin tone",
+		original: "\U0001F9CE\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 person kneeling: dark skin tone",
+		original: "\U0001F9CE\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man kneeling",
+		original: "\U0001F9CE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man kneeling",
+		original: "\U0001F9CE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 man kneeling: light skin tone",
+		original: "\U0001F9CE\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man kneeling: light skin tone",
+		original: "\U0001F9CE\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 man kneeling: medium-light skin tone",
+		original: "\U0001F9CE\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man kneeling: medium-light skin tone",
+		original: "\U0001F9CE\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 man kneeling: medium skin tone",
+		original: "\U0001F9CE\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man kneeling: medium skin tone",
+		original: "\U0001F9CE\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 man kneeling: medium-dark skin tone",
+		original: "\U0001F9CE\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man kneeling: medium-dark skin tone",
+		original: "\U0001F9CE\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 man kneeling: dark skin tone",
+		original: "\U0001F9CE\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man kneeling: dark skin tone",
+		original: "\U0001F9CE\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 woman kneeling",
+		original: "\U0001F9CE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman kneeling",
+		original: "\U0001F9CE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 woman kneeling: light skin tone",
+		original: "\U0001F9CE\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman kneeling: light skin tone",
+		original: "\U0001F9CE\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 woman kneeling: medium-light skin tone",
+		original: "\U0001F9CE\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman kneeling: medium-light skin tone",
+		original: "\U0001F9CE\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 woman kneeling: medium skin tone",
+		original: "\U0001F9CE\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman kneeling: medium skin tone",
+		original: "\U0001F9CE\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 woman kneeling: medium-dark skin tone",
+		original: "\U0001F9CE\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman kneeling: medium-dark skin tone",
+		original: "\U0001F9CE\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 woman kneeling: dark skin tone",
+		original: "\U0001F9CE\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman kneeling: dark skin tone",
+		original: "\U0001F9CE\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person kneeling facing right",
+		original: "\U0001F9CE\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person kneeling facing right",
+		original: "\U0001F9CE\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person kneeling facing right: light skin tone",
+		original: "\U0001F9CE\U0001F3FB\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person kneeling facing right: light skin tone",
+		original: "\U0001F9CE\U0001F3FB\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person kneeling facing right: medium-light skin tone",
+		original: "\U0001F9CE\U0001F3FC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person kneeling facing right: medium-light skin tone",
+		original: "\U0001F9CE\U0001F3FC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person kneeling facing right: medium skin tone",
+		original: "\U0001F9CE\U0001F3FD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person kneeling facing right: medium skin tone",
+		original: "\U0001F9CE\U0001F3FD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person kneeling facing right: medium-dark skin tone",
+		original: "\U0001F9CE\U0001F3FE\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person kneeling facing right: medium-dark skin tone",
+		original: "\U0001F9CE\U0001F3FE\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person kneeling facing right: dark skin tone",
+		original: "\U0001F9CE\U0001F3FF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person kneeling facing right: dark skin tone",
+		original: "\U0001F9CE\U0001F3FF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right",
+		original: "\U0001F9CE\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right",
+		original: "\U0001F9CE\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right",
+		original: "\U0001F9CE\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right",
+		original: "\U0001F9CE\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: light skin tone",
+		original: "\U0001F9CE\U0001F3FB\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: light skin tone",
+		original: "\U0001F9CE\U0001F3FB\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: light skin tone",
+		original: "\U0001F9CE\U0001F3FB\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: light skin tone",
+		original: "\U0001F9CE\U0001F3FB\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: medium-light skin tone",
+		original: "\U0001F9CE\U0001F3FC\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: medium-light skin tone",
+		original: "\U0001F9CE\U0001F3FC\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: medium-light skin tone",
+		original: "\U0001F9CE\U0001F3FC\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: medium-light skin tone",
+		original: "\U0001F9CE\U0001F3FC\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: medium skin tone",
+		original: "\U0001F9CE\U0001F3FD\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: medium skin tone",
+		original: "\U0001F9CE\U0001F3FD\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: medium skin tone",
+		original: "\U0001F9CE\U0001F3FD\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: medium skin tone",
+		original: "\U0001F9CE\U0001F3FD\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: medium-dark skin tone",
+		original: "\U0001F9CE\U0001F3FE\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: medium-dark skin tone",
+		original: "\U0001F9CE\U0001F3FE\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: medium-dark skin tone",
+		original: "\U0001F9CE\U0001F3FE\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: medium-dark skin tone",
+		original: "\U0001F9CE\U0001F3FE\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: dark skin tone",
+		original: "\U0001F9CE\U0001F3FF\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: dark skin tone",
+		original: "\U0001F9CE\U0001F3FF\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: dark skin tone",
+		original: "\U0001F9CE\U0001F3FF\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman kneeling facing right: dark skin tone",
+		original: "\U0001F9CE\U0001F3FF\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right",
+		original: "\U0001F9CE\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right",
+		original: "\U0001F9CE\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right",
+		original: "\U0001F9CE\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right",
+		original: "\U0001F9CE\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: light skin tone",
+		original: "\U0001F9CE\U0001F3FB\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: light skin tone",
+		original: "\U0001F9CE\U0001F3FB\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: light skin tone",
+		original: "\U0001F9CE\U0001F3FB\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: light skin tone",
+		original: "\U0001F9CE\U0001F3FB\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: medium-light skin tone",
+		original: "\U0001F9CE\U0001F3FC\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: medium-light skin tone",
+		original: "\U0001F9CE\U0001F3FC\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: medium-light skin tone",
+		original: "\U0001F9CE\U0001F3FC\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: medium-light skin tone",
+		original: "\U0001F9CE\U0001F3FC\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: medium skin tone",
+		original: "\U0001F9CE\U0001F3FD\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: medium skin tone",
+		original: "\U0001F9CE\U0001F3FD\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: medium skin tone",
+		original: "\U0001F9CE\U0001F3FD\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: medium skin tone",
+		original: "\U0001F9CE\U0001F3FD\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: medium-dark skin tone",
+		original: "\U0001F9CE\U0001F3FE\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: medium-dark skin tone",
+		original: "\U0001F9CE\U0001F3FE\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: medium-dark skin tone",
+		original: "\U0001F9CE\U0001F3FE\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: medium-dark skin tone",
+		original: "\U0001F9CE\U0001F3FE\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: dark skin tone",
+		original: "\U0001F9CE\U0001F3FF\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: dark skin tone",
+		original: "\U0001F9CE\U0001F3FF\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: dark skin tone",
+		original: "\U0001F9CE\U0001F3FF\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man kneeling facing right: dark skin tone",
+		original: "\U0001F9CE\U0001F3FF\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 person with white cane",
+		original: "\U0001F9D1\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person with white cane: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person with white cane: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person with white cane: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person with white cane: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person with white cane: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person with white cane facing right",
+		original: "\U0001F9D1\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person with white cane facing right",
+		original: "\U0001F9D1\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person with white cane facing right: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person with white cane facing right: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person with white cane facing right: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person with white cane facing right: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person with white cane facing right: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person with white cane facing right: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person with white cane facing right: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person with white cane facing right: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person with white cane facing right: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person with white cane facing right: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 man with white cane",
+		original: "\U0001F468\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man with white cane: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man with white cane: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man with white cane: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man with white cane: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man with white cane: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man with white cane facing right",
+		original: "\U0001F468\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man with white cane facing right",
+		original: "\U0001F468\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man with white cane facing right: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man with white cane facing right: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man with white cane facing right: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man with white cane facing right: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man with white cane facing right: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man with white cane facing right: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man with white cane facing right: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man with white cane facing right: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man with white cane facing right: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man with white cane facing right: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 woman with white cane",
+		original: "\U0001F469\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman with white cane: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman with white cane: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman with white cane: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman with white cane: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman with white cane: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman with white cane facing right",
+		original: "\U0001F469\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman with white cane facing right",
+		original: "\U0001F469\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman with white cane facing right: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman with white cane facing right: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman with white cane facing right: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman with white cane facing right: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman with white cane facing right: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman with white cane facing right: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman with white cane facing right: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman with white cane facing right: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman with white cane facing right: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F9AF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman with white cane facing right: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F9AF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 person in motorized wheelchair",
+		original: "\U0001F9D1\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person in motorized wheelchair: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person in motorized wheelchair: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person in motorized wheelchair: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person in motorized wheelchair: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person in motorized wheelchair: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person in motorized wheelchair facing right",
+		original: "\U0001F9D1\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person in motorized wheelchair facing right",
+		original: "\U0001F9D1\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person in motorized wheelchair facing right: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person in motorized wheelchair facing right: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person in motorized wheelchair facing right: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person in motorized wheelchair facing right: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person in motorized wheelchair facing right: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person in motorized wheelchair facing right: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person in motorized wheelchair facing right: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person in motorized wheelchair facing right: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person in motorized wheelchair facing right: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person in motorized wheelchair facing right: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 man in motorized wheelchair",
+		original: "\U0001F468\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man in motorized wheelchair: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man in motorized wheelchair: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man in motorized wheelchair: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man in motorized wheelchair: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man in motorized wheelchair: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man in motorized wheelchair facing right",
+		original: "\U0001F468\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man in motorized wheelchair facing right",
+		original: "\U0001F468\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man in motorized wheelchair facing right: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man in motorized wheelchair facing right: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man in motorized wheelchair facing right: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man in motorized wheelchair facing right: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man in motorized wheelchair facing right: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man in motorized wheelchair facing right: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man in motorized wheelchair facing right: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man in motorized wheelchair facing right: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man in motorized wheelchair facing right: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man in motorized wheelchair facing right: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 woman in motorized wheelchair",
+		original: "\U0001F469\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman in motorized wheelchair: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman in motorized wheelchair: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman in motorized wheelchair: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman in motorized wheelchair: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman in motorized wheelchair: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman in motorized wheelchair facing right",
+		original: "\U0001F469\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman in motorized wheelchair facing right",
+		original: "\U0001F469\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman in motorized wheelchair facing right: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman in motorized wheelchair facing right: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman in motorized wheelchair facing right: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman in motorized wheelchair facing right: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman in motorized wheelchair facing right: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman in motorized wheelchair facing right: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman in motorized wheelchair facing right: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman in motorized wheelchair facing right: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman in motorized wheelchair facing right: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F9BC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman in motorized wheelchair facing right: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F9BC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.1 person in manual wheelchair",
+		original: "\U0001F9D1\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person in manual wheelchair: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person in manual wheelchair: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person in manual wheelchair: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person in manual wheelchair: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 person in manual wheelchair: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person in manual wheelchair facing right",
+		original: "\U0001F9D1\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person in manual wheelchair facing right",
+		original: "\U0001F9D1\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person in manual wheelchair facing right: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person in manual wheelchair facing right: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person in manual wheelchair facing right: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person in manual wheelchair facing right: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person in manual wheelchair facing right: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person in manual wheelchair facing right: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person in manual wheelchair facing right: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person in manual wheelchair facing right: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person in manual wheelchair facing right: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person in manual wheelchair facing right: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 man in manual wheelchair",
+		original: "\U0001F468\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man in manual wheelchair: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man in manual wheelchair: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man in manual wheelchair: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man in manual wheelchair: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 man in manual wheelchair: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man in manual wheelchair facing right",
+		original: "\U0001F468\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man in manual wheelchair facing right",
+		original: "\U0001F468\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man in manual wheelchair facing right: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man in manual wheelchair facing right: light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man in manual wheelchair facing right: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man in manual wheelchair facing right: medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man in manual wheelchair facing right: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man in manual wheelchair facing right: medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man in manual wheelchair facing right: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man in manual wheelchair facing right: medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man in manual wheelchair facing right: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man in manual wheelchair facing right: dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E12.0 woman in manual wheelchair",
+		original: "\U0001F469\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman in manual wheelchair: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman in manual wheelchair: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman in manual wheelchair: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman in manual wheelchair: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman in manual wheelchair: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman in manual wheelchair facing right",
+		original: "\U0001F469\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman in manual wheelchair facing right",
+		original: "\U0001F469\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman in manual wheelchair facing right: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman in manual wheelchair facing right: light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman in manual wheelchair facing right: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman in manual wheelchair facing right: medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman in manual wheelchair facing right: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman in manual wheelchair facing right: medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman in manual wheelchair facing right: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman in manual wheelchair facing right: medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman in manual wheelchair facing right: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F9BD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman in manual wheelchair facing right: dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F9BD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 person running",
+		original: "\U0001F3C3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person running: light skin tone",
+		original: "\U0001F3C3\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person running: medium-light skin tone",
+		original: "\U0001F3C3\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person running: medium skin tone",
+		original: "\U0001F3C3\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person running: medium-dark skin tone",
+		original: "\U0001F3C3\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person running: dark skin tone",
+		original: "\U0001F3C3\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man running",
+		original: "\U0001F3C3\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man running",
+		original: "\U0001F3C3\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man running: light skin tone",
+		original: "\U0001F3C3\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man running: light skin tone",
+		original: "\U0001F3C3\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man running: medium-light skin tone",
+		original: "\U0001F3C3\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man running: medium-light skin tone",
+		original: "\U0001F3C3\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man running: medium skin tone",
+		original: "\U0001F3C3\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man running: medium skin tone",
+		original: "\U0001F3C3\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man running: medium-dark skin tone",
+		original: "\U0001F3C3\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man running: medium-dark skin tone",
+		original: "\U0001F3C3\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man running: dark skin tone",
+		original: "\U0001F3C3\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man running: dark skin tone",
+		original: "\U0001F3C3\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman running",
+		original: "\U0001F3C3\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman running",
+		original: "\U0001F3C3\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman running: light skin tone",
+		original: "\U0001F3C3\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman running: light skin tone",
+		original: "\U0001F3C3\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman running: medium-light skin tone",
+		original: "\U0001F3C3\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman running: medium-light skin tone",
+		original: "\U0001F3C3\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman running: medium skin tone",
+		original: "\U0001F3C3\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman running: medium skin tone",
+		original: "\U0001F3C3\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman running: medium-dark skin tone",
+		original: "\U0001F3C3\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman running: medium-dark skin tone",
+		original: "\U0001F3C3\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman running: dark skin tone",
+		original: "\U0001F3C3\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman running: dark skin tone",
+		original: "\U0001F3C3\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person running facing right",
+		original: "\U0001F3C3\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person running facing right",
+		original: "\U0001F3C3\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person running facing right: light skin tone",
+		original: "\U0001F3C3\U0001F3FB\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person running facing right: light skin tone",
+		original: "\U0001F3C3\U0001F3FB\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person running facing right: medium-light skin tone",
+		original: "\U0001F3C3\U0001F3FC\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person running facing right: medium-light skin tone",
+		original: "\U0001F3C3\U0001F3FC\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person running facing right: medium skin tone",
+		original: "\U0001F3C3\U0001F3FD\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person running facing right: medium skin tone",
+		original: "\U0001F3C3\U0001F3FD\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person running facing right: medium-dark skin tone",
+		original: "\U0001F3C3\U0001F3FE\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person running facing right: medium-dark skin tone",
+		original: "\U0001F3C3\U0001F3FE\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 person running facing right: dark skin tone",
+		original: "\U0001F3C3\U0001F3FF\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 person running facing right: dark skin tone",
+		original: "\U0001F3C3\U0001F3FF\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right",
+		original: "\U0001F3C3\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right",
+		original: "\U0001F3C3\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right",
+		original: "\U0001F3C3\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right",
+		original: "\U0001F3C3\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: light skin tone",
+		original: "\U0001F3C3\U0001F3FB\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: light skin tone",
+		original: "\U0001F3C3\U0001F3FB\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: light skin tone",
+		original: "\U0001F3C3\U0001F3FB\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: light skin tone",
+		original: "\U0001F3C3\U0001F3FB\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: medium-light skin tone",
+		original: "\U0001F3C3\U0001F3FC\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: medium-light skin tone",
+		original: "\U0001F3C3\U0001F3FC\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: medium-light skin tone",
+		original: "\U0001F3C3\U0001F3FC\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: medium-light skin tone",
+		original: "\U0001F3C3\U0001F3FC\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: medium skin tone",
+		original: "\U0001F3C3\U0001F3FD\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: medium skin tone",
+		original: "\U0001F3C3\U0001F3FD\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: medium skin tone",
+		original: "\U0001F3C3\U0001F3FD\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: medium skin tone",
+		original: "\U0001F3C3\U0001F3FD\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: medium-dark skin tone",
+		original: "\U0001F3C3\U0001F3FE\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: medium-dark skin tone",
+		original: "\U0001F3C3\U0001F3FE\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: medium-dark skin tone",
+		original: "\U0001F3C3\U0001F3FE\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: medium-dark skin tone",
+		original: "\U0001F3C3\U0001F3FE\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: dark skin tone",
+		original: "\U0001F3C3\U0001F3FF\u200D\u2640\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: dark skin tone",
+		original: "\U0001F3C3\U0001F3FF\u200D\u2640\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: dark skin tone",
+		original: "\U0001F3C3\U0001F3FF\u200D\u2640\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 woman running facing right: dark skin tone",
+		original: "\U0001F3C3\U0001F3FF\u200D\u2640\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right",
+		original: "\U0001F3C3\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right",
+		original: "\U0001F3C3\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right",
+		original: "\U0001F3C3\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right",
+		original: "\U0001F3C3\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: light skin tone",
+		original: "\U0001F3C3\U0001F3FB\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: light skin tone",
+		original: "\U0001F3C3\U0001F3FB\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: light skin tone",
+		original: "\U0001F3C3\U0001F3FB\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: light skin tone",
+		original: "\U0001F3C3\U0001F3FB\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: medium-light skin tone",
+		original: "\U0001F3C3\U0001F3FC\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: medium-light skin tone",
+		original: "\U0001F3C3\U0001F3FC\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: medium-light skin tone",
+		original: "\U0001F3C3\U0001F3FC\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: medium-light skin tone",
+		original: "\U0001F3C3\U0001F3FC\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: medium skin tone",
+		original: "\U0001F3C3\U0001F3FD\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: medium skin tone",
+		original: "\U0001F3C3\U0001F3FD\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: medium skin tone",
+		original: "\U0001F3C3\U0001F3FD\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: medium skin tone",
+		original: "\U0001F3C3\U0001F3FD\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: medium-dark skin tone",
+		original: "\U0001F3C3\U0001F3FE\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: medium-dark skin tone",
+		original: "\U0001F3C3\U0001F3FE\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: medium-dark skin tone",
+		original: "\U0001F3C3\U0001F3FE\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: medium-dark skin tone",
+		original: "\U0001F3C3\U0001F3FE\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: dark skin tone",
+		original: "\U0001F3C3\U0001F3FF\u200D\u2642\uFE0F\u200D\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: dark skin tone",
+		original: "\U0001F3C3\U0001F3FF\u200D\u2642\u200D\u27A1\uFE0F",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: dark skin tone",
+		original: "\U0001F3C3\U0001F3FF\u200D\u2642\uFE0F\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E15.1 man running facing right: dark skin tone",
+		original: "\U0001F3C3\U0001F3FF\u200D\u2642\u200D\u27A1",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 woman dancing",
+		original: "\U0001F483",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 woman dancing: light skin tone",
+		original: "\U0001F483\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 woman dancing: medium-light skin tone",
+		original: "\U0001F483\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 woman dancing: medium skin tone",
+		original: "\U0001F483\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 woman dancing: medium-dark skin tone",
+		original: "\U0001F483\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 woman dancing: dark skin tone",
+		original: "\U0001F483\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 man dancing",
+		original: "\U0001F57A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 man dancing: light skin tone",
+		original: "\U0001F57A\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 man dancing: medium-light skin tone",
+		original: "\U0001F57A\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 man dancing: medium skin tone",
+		original: "\U0001F57A\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 man dancing: medium-dark skin tone",
+		original: "\U0001F57A\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 man dancing: dark skin tone",
+		original: "\U0001F57A\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 person in suit levitating",
+		original: "\U0001F574\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 person in suit levitating",
+		original: "\U0001F574",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 person in suit levitating: light skin tone",
+		original: "\U0001F574\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 person in suit levitating: medium-light skin tone",
+		original: "\U0001F574\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 person in suit levitating: medium skin tone",
+		original: "\U0001F574\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 person in suit levitating: medium-dark skin tone",
+		original: "\U0001F574\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 person in suit levitating: dark skin tone",
+		original: "\U0001F574\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 people with bunny ears",
+		original: "\U0001F46F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 men with bunny ears",
+		original: "\U0001F46F\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 men with bunny ears",
+		original: "\U0001F46F\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 women with bunny ears",
+		original: "\U0001F46F\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 women with bunny ears",
+		original: "\U0001F46F\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 person in steamy room",
+		original: "\U0001F9D6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person in steamy room: light skin tone",
+		original: "\U0001F9D6\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person in steamy room: medium-light skin tone",
+		original: "\U0001F9D6\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person in steamy room: medium skin tone",
+		original: "\U0001F9D6\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person in steamy room: medium-dark skin tone",
+		original: "\U0001F9D6\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person in steamy room: dark skin tone",
+		original: "\U0001F9D6\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man in steamy room",
+		original: "\U0001F9D6\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man in steamy room",
+		original: "\U0001F9D6\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man in steamy room: light skin tone",
+		original: "\U0001F9D6\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man in steamy room: light skin tone",
+		original: "\U0001F9D6\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man in steamy room: medium-light skin tone",
+		original: "\U0001F9D6\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man in steamy room: medium-light skin tone",
+		original: "\U0001F9D6\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man in steamy room: medium skin tone",
+		original: "\U0001F9D6\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man in steamy room: medium skin tone",
+		original: "\U0001F9D6\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man in steamy room: medium-dark skin tone",
+		original: "\U0001F9D6\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man in steamy room: medium-dark skin tone",
+		original: "\U0001F9D6\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man in steamy room: dark skin tone",
+		original: "\U0001F9D6\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man in steamy room: dark skin tone",
+		original: "\U0001F9D6\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman in steamy room",
+		original: "\U0001F9D6\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman in steamy room",
+		original: "\U0001F9D6\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman in steamy room: light skin tone",
+		original: "\U0001F9D6\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman in steamy room: light skin tone",
+		original: "\U0001F9D6\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman in steamy room: medium-light skin tone",
+		original: "\U0001F9D6\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman in steamy room: medium-light skin tone",
+		original: "\U0001F9D6\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman in steamy room: medium skin tone",
+		original: "\U0001F9D6\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman in steamy room: medium skin tone",
+		original: "\U0001F9D6\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman in steamy room: medium-dark skin tone",
+		original: "\U0001F9D6\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman in steamy room: medium-dark skin tone",
+		original: "\U0001F9D6\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman in steamy room: dark skin tone",
+		original: "\U0001F9D6\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman in steamy room: dark skin tone",
+		original: "\U0001F9D6\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 person climbing",
+		original: "\U0001F9D7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person climbing: light skin tone",
+		original: "\U0001F9D7\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person climbing: medium-light skin tone",
+		original: "\U0001F9D7\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person climbing: medium skin tone",
+		original: "\U0001F9D7\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person climbing: medium-dark skin tone",
+		original: "\U0001F9D7\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person climbing: dark skin tone",
+		original: "\U0001F9D7\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man climbing",
+		original: "\U0001F9D7\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man climbing",
+		original: "\U0001F9D7\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man climbing: light skin tone",
+		original: "\U0001F9D7\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man climbing: light skin tone",
+		original: "\U0001F9D7\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man climbing: medium-light skin tone",
+		original: "\U0001F9D7\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man climbing: medium-light skin tone",
+		original: "\U0001F9D7\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man climbing: medium skin tone",
+		original: "\U0001F9D7\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man climbing: medium skin tone",
+		original: "\U0001F9D7\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man climbing: medium-dark skin tone",
+		original: "\U0001F9D7\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man climbing: medium-dark skin tone",
+		original: "\U0001F9D7\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man climbing: dark skin tone",
+		original: "\U0001F9D7\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man climbing: dark skin tone",
+		original: "\U0001F9D7\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman climbing",
+		original: "\U0001F9D7\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman climbing",
+		original: "\U0001F9D7\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman climbing: light skin tone",
+		original: "\U0001F9D7\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman climbing: light skin tone",
+		original: "\U0001F9D7\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman climbing: medium-light skin tone",
+		original: "\U0001F9D7\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman climbing: medium-light skin tone",
+		original: "\U0001F9D7\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman climbing: medium skin tone",
+		original: "\U0001F9D7\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman climbing: medium skin tone",
+		original: "\U0001F9D7\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman climbing: medium-dark skin tone",
+		original: "\U0001F9D7\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman climbing: medium-dark skin tone",
+		original: "\U0001F9D7\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman climbing: dark skin tone",
+		original: "\U0001F9D7\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman climbing: dark skin tone",
+		original: "\U0001F9D7\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E3.0 person fencing",
+		original: "\U0001F93A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 horse racing",
+		original: "\U0001F3C7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 horse racing: light skin tone",
+		original: "\U0001F3C7\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 horse racing: medium-light skin tone",
+		original: "\U0001F3C7\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 horse racing: medium skin tone",
+		original: "\U0001F3C7\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 horse racing: medium-dark skin tone",
+		original: "\U0001F3C7\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 horse racing: dark skin tone",
+		original: "\U0001F3C7\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 skier",
+		original: "\u26F7\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 skier",
+		original: "\u26F7",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 snowboarder",
+		original: "\U0001F3C2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 snowboarder: light skin tone",
+		original: "\U0001F3C2\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 snowboarder: medium-light skin tone",
+		original: "\U0001F3C2\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 snowboarder: medium skin tone",
+		original: "\U0001F3C2\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 snowboarder: medium-dark skin tone",
+		original: "\U0001F3C2\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 snowboarder: dark skin tone",
+		original: "\U0001F3C2\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 person golfing",
+		original: "\U0001F3CC\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 person golfing",
+		original: "\U0001F3CC",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 person golfing: light skin tone",
+		original: "\U0001F3CC\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 person golfing: medium-light skin tone",
+		original: "\U0001F3CC\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 person golfing: medium skin tone",
+		original: "\U0001F3CC\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 person golfing: medium-dark skin tone",
+		original: "\U0001F3CC\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 person golfing: dark skin tone",
+		original: "\U0001F3CC\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man golfing",
+		original: "\U0001F3CC\uFE0F\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man golfing",
+		original: "\U0001F3CC\u200D\u2642\uFE0F",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 man golfing",
+		original: "\U0001F3CC\uFE0F\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man golfing",
+		original: "\U0001F3CC\u200D\u2642",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 man golfing: light skin tone",
+		original: "\U0001F3CC\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man golfing: light skin tone",
+		original: "\U0001F3CC\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man golfing: medium-light skin tone",
+		original: "\U0001F3CC\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man golfing: medium-light skin tone",
+		original: "\U0001F3CC\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man golfing: medium skin tone",
+		original: "\U0001F3CC\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man golfing: medium skin tone",
+		original: "\U0001F3CC\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man golfing: medium-dark skin tone",
+		original: "\U0001F3CC\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man golfing: medium-dark skin tone",
+		original: "\U0001F3CC\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man golfing: dark skin tone",
+		original: "\U0001F3CC\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man golfing: dark skin tone",
+		original: "\U0001F3CC\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman golfing",
+		original: "\U0001F3CC\uFE0F\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman golfing",
+		original: "\U0001F3CC\u200D\u2640\uFE0F",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 woman golfing",
+		original: "\U0001F3CC\uFE0F\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman golfing",
+		original: "\U0001F3CC\u200D\u2640",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 woman golfing: light skin tone",
+		original: "\U0001F3CC\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman golfing: light skin tone",
+		original: "\U0001F3CC\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman golfing: medium-light skin tone",
+		original: "\U0001F3CC\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman golfing: medium-light skin tone",
+		original: "\U0001F3CC\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman golfing: medium skin tone",
+		original: "\U0001F3CC\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman golfing: medium skin tone",
+		original: "\U0001F3CC\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman golfing: medium-dark skin tone",
+		original: "\U0001F3CC\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman golfing: medium-dark skin tone",
+		original: "\U0001F3CC\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman golfing: dark skin tone",
+		original: "\U0001F3CC\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman golfing: dark skin tone",
+		original: "\U0001F3CC\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 person surfing",
+		original: "\U0001F3C4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person surfing: light skin tone",
+		original: "\U0001F3C4\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person surfing: medium-light skin tone",
+		original: "\U0001F3C4\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person surfing: medium skin tone",
+		original: "\U0001F3C4\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person surfing: medium-dark skin tone",
+		original: "\U0001F3C4\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person surfing: dark skin tone",
+		original: "\U0001F3C4\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man surfing",
+		original: "\U0001F3C4\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man surfing",
+		original: "\U0001F3C4\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man surfing: light skin tone",
+		original: "\U0001F3C4\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man surfing: light skin tone",
+		original: "\U0001F3C4\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man surfing: medium-light skin tone",
+		original: "\U0001F3C4\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man surfing: medium-light skin tone",
+		original: "\U0001F3C4\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man surfing: medium skin tone",
+		original: "\U0001F3C4\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man surfing: medium skin tone",
+		original: "\U0001F3C4\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man surfing: medium-dark skin tone",
+		original: "\U0001F3C4\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man surfing: medium-dark skin tone",
+		original: "\U0001F3C4\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man surfing: dark skin tone",
+		original: "\U0001F3C4\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man surfing: dark skin tone",
+		original: "\U0001F3C4\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman surfing",
+		original: "\U0001F3C4\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman surfing",
+		original: "\U0001F3C4\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman surfing: light skin tone",
+		original: "\U0001F3C4\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman surfing: light skin tone",
+		original: "\U0001F3C4\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman surfing: medium-light skin tone",
+		original: "\U0001F3C4\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman surfing: medium-light skin tone",
+		original: "\U0001F3C4\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman surfing: medium skin tone",
+		original: "\U0001F3C4\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman surfing: medium skin tone",
+		original: "\U0001F3C4\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman surfing: medium-dark skin tone",
+		original: "\U0001F3C4\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman surfing: medium-dark skin tone",
+		original: "\U0001F3C4\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman surfing: dark skin tone",
+		original: "\U0001F3C4\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman surfing: dark skin tone",
+		original: "\U0001F3C4\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E1.0 person rowing boat",
+		original: "\U0001F6A3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person rowing boat: light skin tone",
+		original: "\U0001F6A3\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person rowing boat: medium-light skin tone",
+		original: "\U0001F6A3\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person rowing boat: medium skin tone",
+		original: "\U0001F6A3\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person rowing boat: medium-dark skin tone",
+		original: "\U0001F6A3\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person rowing boat: dark skin tone",
+		original: "\U0001F6A3\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man rowing boat",
+		original: "\U0001F6A3\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man rowing boat",
+		original: "\U0001F6A3\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man rowing boat: light skin tone",
+		original: "\U0001F6A3\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man rowing boat: light skin tone",
+		original: "\U0001F6A3\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man rowing boat: medium-light skin tone",
+		original: "\U0001F6A3\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man rowing boat: medium-light skin tone",
+		original: "\U0001F6A3\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man rowing boat: medium skin tone",
+		original: "\U0001F6A3\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man rowing boat: medium skin tone",
+		original: "\U0001F6A3\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man rowing boat: medium-dark skin tone",
+		original: "\U0001F6A3\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man rowing boat: medium-dark skin tone",
+		original: "\U0001F6A3\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man rowing boat: dark skin tone",
+		original: "\U0001F6A3\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man rowing boat: dark skin tone",
+		original: "\U0001F6A3\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman rowing boat",
+		original: "\U0001F6A3\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman rowing boat",
+		original: "\U0001F6A3\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman rowing boat: light skin tone",
+		original: "\U0001F6A3\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman rowing boat: light skin tone",
+		original: "\U0001F6A3\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman rowing boat: medium-light skin tone",
+		original: "\U0001F6A3\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman rowing boat: medium-light skin tone",
+		original: "\U0001F6A3\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman rowing boat: medium skin tone",
+		original: "\U0001F6A3\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman rowing boat: medium skin tone",
+		original: "\U0001F6A3\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman rowing boat: medium-dark skin tone",
+		original: "\U0001F6A3\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman rowing boat: medium-dark skin tone",
+		original: "\U0001F6A3\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman rowing boat: dark skin tone",
+		original: "\U0001F6A3\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman rowing boat: dark skin tone",
+		original: "\U0001F6A3\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 person swimming",
+		original: "\U0001F3CA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person swimming: light skin tone",
+		original: "\U0001F3CA\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person swimming: medium-light skin tone",
+		original: "\U0001F3CA\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person swimming: medium skin tone",
+		original: "\U0001F3CA\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person swimming: medium-dark skin tone",
+		original: "\U0001F3CA\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person swimming: dark skin tone",
+		original: "\U0001F3CA\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man swimming",
+		original: "\U0001F3CA\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man swimming",
+		original: "\U0001F3CA\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man swimming: light skin tone",
+		original: "\U0001F3CA\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man swimming: light skin tone",
+		original: "\U0001F3CA\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man swimming: medium-light skin tone",
+		original: "\U0001F3CA\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man swimming: medium-light skin tone",
+		original: "\U0001F3CA\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man swimming: medium skin tone",
+		original: "\U0001F3CA\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man swimming: medium skin tone",
+		original: "\U0001F3CA\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man swimming: medium-dark skin tone",
+		original: "\U0001F3CA\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man swimming: medium-dark skin tone",
+		original: "\U0001F3CA\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man swimming: dark skin tone",
+		original: "\U0001F3CA\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man swimming: dark skin tone",
+		original: "\U0001F3CA\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman swimming",
+		original: "\U0001F3CA\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman swimming",
+		original: "\U0001F3CA\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman swimming: light skin tone",
+		original: "\U0001F3CA\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman swimming: light skin tone",
+		original: "\U0001F3CA\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman swimming: medium-light skin tone",
+		original: "\U0001F3CA\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman swimming: medium-light skin tone",
+		original: "\U0001F3CA\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman swimming: medium skin tone",
+		original: "\U0001F3CA\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman swimming: medium skin tone",
+		original: "\U0001F3CA\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman swimming: medium-dark skin tone",
+		original: "\U0001F3CA\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman swimming: medium-dark skin tone",
+		original: "\U0001F3CA\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman swimming: dark skin tone",
+		original: "\U0001F3CA\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman swimming: dark skin tone",
+		original: "\U0001F3CA\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.7 person bouncing ball",
+		original: "\u26F9\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 person bouncing ball",
+		original: "\u26F9",
+		status:   "unqualified",
+	},
+	{
+		name:     "E2.0 person bouncing ball: light skin tone",
+		original: "\u26F9\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 person bouncing ball: medium-light skin tone",
+		original: "\u26F9\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 person bouncing ball: medium skin tone",
+		original: "\u26F9\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 person bouncing ball: medium-dark skin tone",
+		original: "\u26F9\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 person bouncing ball: dark skin tone",
+		original: "\u26F9\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man bouncing ball",
+		original: "\u26F9\uFE0F\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man bouncing ball",
+		original: "\u26F9\u200D\u2642\uFE0F",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 man bouncing ball",
+		original: "\u26F9\uFE0F\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man bouncing ball",
+		original: "\u26F9\u200D\u2642",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 man bouncing ball: light skin tone",
+		original: "\u26F9\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man bouncing ball: light skin tone",
+		original: "\u26F9\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man bouncing ball: medium-light skin tone",
+		original: "\u26F9\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man bouncing ball: medium-light skin tone",
+		original: "\u26F9\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man bouncing ball: medium skin tone",
+		original: "\u26F9\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man bouncing ball: medium skin tone",
+		original: "\u26F9\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man bouncing ball: medium-dark skin tone",
+		original: "\u26F9\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man bouncing ball: medium-dark skin tone",
+		original: "\u26F9\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man bouncing ball: dark skin tone",
+		original: "\u26F9\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man bouncing ball: dark skin tone",
+		original: "\u26F9\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman bouncing ball",
+		original: "\u26F9\uFE0F\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman bouncing ball",
+		original: "\u26F9\u200D\u2640\uFE0F",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 woman bouncing ball",
+		original: "\u26F9\uFE0F\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman bouncing ball",
+		original: "\u26F9\u200D\u2640",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 woman bouncing ball: light skin tone",
+		original: "\u26F9\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman bouncing ball: light skin tone",
+		original: "\u26F9\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman bouncing ball: medium-light skin tone",
+		original: "\u26F9\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman bouncing ball: medium-light skin tone",
+		original: "\u26F9\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman bouncing ball: medium skin tone",
+		original: "\u26F9\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman bouncing ball: medium skin tone",
+		original: "\u26F9\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman bouncing ball: medium-dark skin tone",
+		original: "\u26F9\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman bouncing ball: medium-dark skin tone",
+		original: "\u26F9\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman bouncing ball: dark skin tone",
+		original: "\u26F9\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman bouncing ball: dark skin tone",
+		original: "\u26F9\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.7 person lifting weights",
+		original: "\U0001F3CB\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 person lifting weights",
+		original: "\U0001F3CB",
+		status:   "unqualified",
+	},
+	{
+		name:     "E2.0 person lifting weights: light skin tone",
+		original: "\U0001F3CB\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 person lifting weights: medium-light skin tone",
+		original: "\U0001F3CB\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 person lifting weights: medium skin tone",
+		original: "\U0001F3CB\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 person lifting weights: medium-dark skin tone",
+		original: "\U0001F3CB\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 person lifting weights: dark skin tone",
+		original: "\U0001F3CB\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man lifting weights",
+		original: "\U0001F3CB\uFE0F\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man lifting weights",
+		original: "\U0001F3CB\u200D\u2642\uFE0F",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 man lifting weights",
+		original: "\U0001F3CB\uFE0F\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man lifting weights",
+		original: "\U0001F3CB\u200D\u2642",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 man lifting weights: light skin tone",
+		original: "\U0001F3CB\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man lifting weights: light skin tone",
+		original: "\U0001F3CB\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man lifting weights: medium-light skin tone",
+		original: "\U0001F3CB\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man lifting weights: medium-light skin tone",
+		original: "\U0001F3CB\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man lifting weights: medium skin tone",
+		original: "\U0001F3CB\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man lifting weights: medium skin tone",
+		original: "\U0001F3CB\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man lifting weights: medium-dark skin tone",
+		original: "\U0001F3CB\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man lifting weights: medium-dark skin tone",
+		original: "\U0001F3CB\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man lifting weights: dark skin tone",
+		original: "\U0001F3CB\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man lifting weights: dark skin tone",
+		original: "\U0001F3CB\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman lifting weights",
+		original: "\U0001F3CB\uFE0F\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman lifting weights",
+		original: "\U0001F3CB\u200D\u2640\uFE0F",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 woman lifting weights",
+		original: "\U0001F3CB\uFE0F\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman lifting weights",
+		original: "\U0001F3CB\u200D\u2640",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 woman lifting weights: light skin tone",
+		original: "\U0001F3CB\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman lifting weights: light skin tone",
+		original: "\U0001F3CB\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman lifting weights: medium-light skin tone",
+		original: "\U0001F3CB\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman lifting weights: medium-light skin tone",
+		original: "\U0001F3CB\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman lifting weights: medium skin tone",
+		original: "\U0001F3CB\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman lifting weights: medium skin tone",
+		original: "\U0001F3CB\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman lifting weights: medium-dark skin tone",
+		original: "\U0001F3CB\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman lifting weights: medium-dark skin tone",
+		original: "\U0001F3CB\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman lifting weights: dark skin tone",
+		original: "\U0001F3CB\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman lifting weights: dark skin tone",
+		original: "\U0001F3CB\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E1.0 person biking",
+		original: "\U0001F6B4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person biking: light skin tone",
+		original: "\U0001F6B4\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person biking: medium-light skin tone",
+		original: "\U0001F6B4\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person biking: medium skin tone",
+		original: "\U0001F6B4\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person biking: medium-dark skin tone",
+		original: "\U0001F6B4\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person biking: dark skin tone",
+		original: "\U0001F6B4\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man biking",
+		original: "\U0001F6B4\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man biking",
+		original: "\U0001F6B4\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man biking: light skin tone",
+		original: "\U0001F6B4\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man biking: light skin tone",
+		original: "\U0001F6B4\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man biking: medium-light skin tone",
+		original: "\U0001F6B4\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man biking: medium-light skin tone",
+		original: "\U0001F6B4\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man biking: medium skin tone",
+		original: "\U0001F6B4\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man biking: medium skin tone",
+		original: "\U0001F6B4\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man biking: medium-dark skin tone",
+		original: "\U0001F6B4\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man biking: medium-dark skin tone",
+		original: "\U0001F6B4\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man biking: dark skin tone",
+		original: "\U0001F6B4\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man biking: dark skin tone",
+		original: "\U0001F6B4\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman biking",
+		original: "\U0001F6B4\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman biking",
+		original: "\U0001F6B4\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman biking: light skin tone",
+		original: "\U0001F6B4\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman biking: light skin tone",
+		original: "\U0001F6B4\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman biking: medium-light skin tone",
+		original: "\U0001F6B4\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman biking: medium-light skin tone",
+		original: "\U0001F6B4\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman biking: medium skin tone",
+		original: "\U0001F6B4\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman biking: medium skin tone",
+		original: "\U0001F6B4\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman biking: medium-dark skin tone",
+		original: "\U0001F6B4\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman biking: medium-dark skin tone",
+		original: "\U0001F6B4\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman biking: dark skin tone",
+		original: "\U0001F6B4\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman biking: dark skin tone",
+		original: "\U0001F6B4\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E1.0 person mountain biking",
+		original: "\U0001F6B5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person mountain biking: light skin tone",
+		original: "\U0001F6B5\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person mountain biking: medium-light skin tone",
+		original: "\U0001F6B5\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person mountain biking: medium skin tone",
+		original: "\U0001F6B5\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person mountain biking: medium-dark skin tone",
+		original: "\U0001F6B5\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person mountain biking: dark skin tone",
+		original: "\U0001F6B5\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man mountain biking",
+		original: "\U0001F6B5\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man mountain biking",
+		original: "\U0001F6B5\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man mountain biking: light skin tone",
+		original: "\U0001F6B5\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man mountain biking: light skin tone",
+		original: "\U0001F6B5\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man mountain biking: medium-light skin tone",
+		original: "\U0001F6B5\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man mountain biking: medium-light skin tone",
+		original: "\U0001F6B5\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man mountain biking: medium skin tone",
+		original: "\U0001F6B5\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man mountain biking: medium skin tone",
+		original: "\U0001F6B5\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man mountain biking: medium-dark skin tone",
+		original: "\U0001F6B5\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man mountain biking: medium-dark skin tone",
+		original: "\U0001F6B5\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man mountain biking: dark skin tone",
+		original: "\U0001F6B5\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man mountain biking: dark skin tone",
+		original: "\U0001F6B5\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman mountain biking",
+		original: "\U0001F6B5\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman mountain biking",
+		original: "\U0001F6B5\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman mountain biking: light skin tone",
+		original: "\U0001F6B5\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman mountain biking: light skin tone",
+		original: "\U0001F6B5\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman mountain biking: medium-light skin tone",
+		original: "\U0001F6B5\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman mountain biking: medium-light skin tone",
+		original: "\U0001F6B5\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman mountain biking: medium skin tone",
+		original: "\U0001F6B5\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman mountain biking: medium skin tone",
+		original: "\U0001F6B5\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman mountain biking: medium-dark skin tone",
+		original: "\U0001F6B5\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman mountain biking: medium-dark skin tone",
+		original: "\U0001F6B5\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman mountain biking: dark skin tone",
+		original: "\U0001F6B5\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman mountain biking: dark skin tone",
+		original: "\U0001F6B5\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E3.0 person cartwheeling",
+		original: "\U0001F938",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person cartwheeling: light skin tone",
+		original: "\U0001F938\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person cartwheeling: medium-light skin tone",
+		original: "\U0001F938\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person cartwheeling: medium skin tone",
+		original: "\U0001F938\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person cartwheeling: medium-dark skin tone",
+		original: "\U0001F938\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person cartwheeling: dark skin tone",
+		original: "\U0001F938\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man cartwheeling",
+		original: "\U0001F938\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man cartwheeling",
+		original: "\U0001F938\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man cartwheeling: light skin tone",
+		original: "\U0001F938\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man cartwheeling: light skin tone",
+		original: "\U0001F938\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man cartwheeling: medium-light skin tone",
+		original: "\U0001F938\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man cartwheeling: medium-light skin tone",
+		original: "\U0001F938\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man cartwheeling: medium skin tone",
+		original: "\U0001F938\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man cartwheeling: medium skin tone",
+		original: "\U0001F938\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man cartwheeling: medium-dark skin tone",
+		original: "\U0001F938\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man cartwheeling: medium-dark skin tone",
+		original: "\U0001F938\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man cartwheeling: dark skin tone",
+		original: "\U0001F938\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man cartwheeling: dark skin tone",
+		original: "\U0001F938\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman cartwheeling",
+		original: "\U0001F938\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman cartwheeling",
+		original: "\U0001F938\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman cartwheeling: light skin tone",
+		original: "\U0001F938\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman cartwheeling: light skin tone",
+		original: "\U0001F938\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman cartwheeling: medium-light skin tone",
+		original: "\U0001F938\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman cartwheeling: medium-light skin tone",
+		original: "\U0001F938\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman cartwheeling: medium skin tone",
+		original: "\U0001F938\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman cartwheeling: medium skin tone",
+		original: "\U0001F938\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman cartwheeling: medium-dark skin tone",
+		original: "\U0001F938\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman cartwheeling: medium-dark skin tone",
+		original: "\U0001F938\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman cartwheeling: dark skin tone",
+		original: "\U0001F938\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman cartwheeling: dark skin tone",
+		original: "\U0001F938\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E3.0 people wrestling",
+		original: "\U0001F93C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 men wrestling",
+		original: "\U0001F93C\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 men wrestling",
+		original: "\U0001F93C\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 women wrestling",
+		original: "\U0001F93C\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 women wrestling",
+		original: "\U0001F93C\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E3.0 person playing water polo",
+		original: "\U0001F93D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person playing water polo: light skin tone",
+		original: "\U0001F93D\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person playing water polo: medium-light skin tone",
+		original: "\U0001F93D\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person playing water polo: medium skin tone",
+		original: "\U0001F93D\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person playing water polo: medium-dark skin tone",
+		original: "\U0001F93D\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person playing water polo: dark skin tone",
+		original: "\U0001F93D\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man playing water polo",
+		original: "\U0001F93D\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man playing water polo",
+		original: "\U0001F93D\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man playing water polo: light skin tone",
+		original: "\U0001F93D\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man playing water polo: light skin tone",
+		original: "\U0001F93D\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man playing water polo: medium-light skin tone",
+		original: "\U0001F93D\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man playing water polo: medium-light skin tone",
+		original: "\U0001F93D\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man playing water polo: medium skin tone",
+		original: "\U0001F93D\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man playing water polo: medium skin tone",
+		original: "\U0001F93D\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man playing water polo: medium-dark skin tone",
+		original: "\U0001F93D\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man playing water polo: medium-dark skin tone",
+		original: "\U0001F93D\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man playing water polo: dark skin tone",
+		original: "\U0001F93D\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man playing water polo: dark skin tone",
+		original: "\U0001F93D\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman playing water polo",
+		original: "\U0001F93D\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman playing water polo",
+		original: "\U0001F93D\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman playing water polo: light skin tone",
+		original: "\U0001F93D\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman playing water polo: light skin tone",
+		original: "\U0001F93D\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman playing water polo: medium-light skin tone",
+		original: "\U0001F93D\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman playing water polo: medium-light skin tone",
+		original: "\U0001F93D\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman playing water polo: medium skin tone",
+		original: "\U0001F93D\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman playing water polo: medium skin tone",
+		original: "\U0001F93D\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman playing water polo: medium-dark skin tone",
+		original: "\U0001F93D\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman playing water polo: medium-dark skin tone",
+		original: "\U0001F93D\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman playing water polo: dark skin tone",
+		original: "\U0001F93D\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman playing water polo: dark skin tone",
+		original: "\U0001F93D\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E3.0 person playing handball",
+		original: "\U0001F93E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person playing handball: light skin tone",
+		original: "\U0001F93E\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person playing handball: medium-light skin tone",
+		original: "\U0001F93E\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person playing handball: medium skin tone",
+		original: "\U0001F93E\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person playing handball: medium-dark skin tone",
+		original: "\U0001F93E\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person playing handball: dark skin tone",
+		original: "\U0001F93E\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man playing handball",
+		original: "\U0001F93E\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man playing handball",
+		original: "\U0001F93E\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man playing handball: light skin tone",
+		original: "\U0001F93E\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man playing handball: light skin tone",
+		original: "\U0001F93E\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man playing handball: medium-light skin tone",
+		original: "\U0001F93E\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man playing handball: medium-light skin tone",
+		original: "\U0001F93E\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man playing handball: medium skin tone",
+		original: "\U0001F93E\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man playing handball: medium skin tone",
+		original: "\U0001F93E\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man playing handball: medium-dark skin tone",
+		original: "\U0001F93E\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man playing handball: medium-dark skin tone",
+		original: "\U0001F93E\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man playing handball: dark skin tone",
+		original: "\U0001F93E\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man playing handball: dark skin tone",
+		original: "\U0001F93E\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman playing handball",
+		original: "\U0001F93E\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman playing handball",
+		original: "\U0001F93E\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman playing handball: light skin tone",
+		original: "\U0001F93E\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman playing handball: light skin tone",
+		original: "\U0001F93E\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman playing handball: medium-light skin tone",
+		original: "\U0001F93E\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman playing handball: medium-light skin tone",
+		original: "\U0001F93E\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman playing handball: medium skin tone",
+		original: "\U0001F93E\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman playing handball: medium skin tone",
+		original: "\U0001F93E\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman playing handball: medium-dark skin tone",
+		original: "\U0001F93E\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman playing handball: medium-dark skin tone",
+		original: "\U0001F93E\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman playing handball: dark skin tone",
+		original: "\U0001F93E\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman playing handball: dark skin tone",
+		original: "\U0001F93E\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E3.0 person juggling",
+		original: "\U0001F939",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person juggling: light skin tone",
+		original: "\U0001F939\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person juggling: medium-light skin tone",
+		original: "\U0001F939\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person juggling: medium skin tone",
+		original: "\U0001F939\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person juggling: medium-dark skin tone",
+		original: "\U0001F939\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 person juggling: dark skin tone",
+		original: "\U0001F939\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man juggling",
+		original: "\U0001F939\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man juggling",
+		original: "\U0001F939\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man juggling: light skin tone",
+		original: "\U0001F939\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man juggling: light skin tone",
+		original: "\U0001F939\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man juggling: medium-light skin tone",
+		original: "\U0001F939\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man juggling: medium-light skin tone",
+		original: "\U0001F939\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man juggling: medium skin tone",
+		original: "\U0001F939\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man juggling: medium skin tone",
+		original: "\U0001F939\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man juggling: medium-dark skin tone",
+		original: "\U0001F939\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man juggling: medium-dark skin tone",
+		original: "\U0001F939\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 man juggling: dark skin tone",
+		original: "\U0001F939\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 man juggling: dark skin tone",
+		original: "\U0001F939\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman juggling",
+		original: "\U0001F939\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman juggling",
+		original: "\U0001F939\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman juggling: light skin tone",
+		original: "\U0001F939\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman juggling: light skin tone",
+		original: "\U0001F939\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman juggling: medium-light skin tone",
+		original: "\U0001F939\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman juggling: medium-light skin tone",
+		original: "\U0001F939\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman juggling: medium skin tone",
+		original: "\U0001F939\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman juggling: medium skin tone",
+		original: "\U0001F939\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman juggling: medium-dark skin tone",
+		original: "\U0001F939\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman juggling: medium-dark skin tone",
+		original: "\U0001F939\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E4.0 woman juggling: dark skin tone",
+		original: "\U0001F939\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 woman juggling: dark skin tone",
+		original: "\U0001F939\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 person in lotus position",
+		original: "\U0001F9D8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person in lotus position: light skin tone",
+		original: "\U0001F9D8\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person in lotus position: medium-light skin tone",
+		original: "\U0001F9D8\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person in lotus position: medium skin tone",
+		original: "\U0001F9D8\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person in lotus position: medium-dark skin tone",
+		original: "\U0001F9D8\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 person in lotus position: dark skin tone",
+		original: "\U0001F9D8\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man in lotus position",
+		original: "\U0001F9D8\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man in lotus position",
+		original: "\U0001F9D8\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man in lotus position: light skin tone",
+		original: "\U0001F9D8\U0001F3FB\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man in lotus position: light skin tone",
+		original: "\U0001F9D8\U0001F3FB\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man in lotus position: medium-light skin tone",
+		original: "\U0001F9D8\U0001F3FC\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man in lotus position: medium-light skin tone",
+		original: "\U0001F9D8\U0001F3FC\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man in lotus position: medium skin tone",
+		original: "\U0001F9D8\U0001F3FD\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man in lotus position: medium skin tone",
+		original: "\U0001F9D8\U0001F3FD\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man in lotus position: medium-dark skin tone",
+		original: "\U0001F9D8\U0001F3FE\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man in lotus position: medium-dark skin tone",
+		original: "\U0001F9D8\U0001F3FE\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 man in lotus position: dark skin tone",
+		original: "\U0001F9D8\U0001F3FF\u200D\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 man in lotus position: dark skin tone",
+		original: "\U0001F9D8\U0001F3FF\u200D\u2642",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman in lotus position",
+		original: "\U0001F9D8\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman in lotus position",
+		original: "\U0001F9D8\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman in lotus position: light skin tone",
+		original: "\U0001F9D8\U0001F3FB\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman in lotus position: light skin tone",
+		original: "\U0001F9D8\U0001F3FB\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman in lotus position: medium-light skin tone",
+		original: "\U0001F9D8\U0001F3FC\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman in lotus position: medium-light skin tone",
+		original: "\U0001F9D8\U0001F3FC\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman in lotus position: medium skin tone",
+		original: "\U0001F9D8\U0001F3FD\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman in lotus position: medium skin tone",
+		original: "\U0001F9D8\U0001F3FD\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman in lotus position: medium-dark skin tone",
+		original: "\U0001F9D8\U0001F3FE\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman in lotus position: medium-dark skin tone",
+		original: "\U0001F9D8\U0001F3FE\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E5.0 woman in lotus position: dark skin tone",
+		original: "\U0001F9D8\U0001F3FF\u200D\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 woman in lotus position: dark skin tone",
+		original: "\U0001F9D8\U0001F3FF\u200D\u2640",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 person taking bath",
+		original: "\U0001F6C0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person taking bath: light skin tone",
+		original: "\U0001F6C0\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person taking bath: medium-light skin tone",
+		original: "\U0001F6C0\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person taking bath: medium skin tone",
+		original: "\U0001F6C0\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person taking bath: medium-dark skin tone",
+		original: "\U0001F6C0\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person taking bath: dark skin tone",
+		original: "\U0001F6C0\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 person in bed",
+		original: "\U0001F6CC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 person in bed: light skin tone",
+		original: "\U0001F6CC\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 person in bed: medium-light skin tone",
+		original: "\U0001F6CC\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 person in bed: medium skin tone",
+		original: "\U0001F6CC\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 person in bed: medium-dark skin tone",
+		original: "\U0001F6CC\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 person in bed: dark skin tone",
+		original: "\U0001F6CC\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 people holding hands",
+		original: "\U0001F9D1\u200D\U0001F91D\u200D\U0001F9D1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 people holding hands: light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 people holding hands: light skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 people holding hands: light skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 people holding hands: light skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 people holding hands: light skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 people holding hands: medium-light skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 people holding hands: medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 people holding hands: medium-light skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 people holding hands: medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 people holding hands: medium-light skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 people holding hands: medium skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 people holding hands: medium skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 people holding hands: medium skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 people holding hands: medium skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 people holding hands: medium skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 people holding hands: medium-dark skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 people holding hands: medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 people holding hands: medium-dark skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 people holding hands: medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 people holding hands: medium-dark skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 people holding hands: dark skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 people holding hands: dark skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 people holding hands: dark skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 people holding hands: dark skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 people holding hands: dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\U0001F91D\u200D\U0001F9D1\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 women holding hands",
+		original: "\U0001F46D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 women holding hands: light skin tone",
+		original: "\U0001F46D\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 women holding hands: light skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F91D\u200D\U0001F469\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 women holding hands: light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F91D\u200D\U0001F469\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 women holding hands: light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F91D\u200D\U0001F469\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 women holding hands: light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F91D\u200D\U0001F469\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 women holding hands: medium-light skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F91D\u200D\U0001F469\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 women holding hands: medium-light skin tone",
+		original: "\U0001F46D\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 women holding hands: medium-light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F91D\u200D\U0001F469\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 women holding hands: medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F91D\u200D\U0001F469\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 women holding hands: medium-light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F91D\u200D\U0001F469\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 women holding hands: medium skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F91D\u200D\U0001F469\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 women holding hands: medium skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F91D\u200D\U0001F469\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 women holding hands: medium skin tone",
+		original: "\U0001F46D\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 women holding hands: medium skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F91D\u200D\U0001F469\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 women holding hands: medium skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F91D\u200D\U0001F469\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 women holding hands: medium-dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F91D\u200D\U0001F469\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 women holding hands: medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F91D\u200D\U0001F469\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 women holding hands: medium-dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F91D\u200D\U0001F469\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 women holding hands: medium-dark skin tone",
+		original: "\U0001F46D\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 women holding hands: medium-dark skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F91D\u200D\U0001F469\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 women holding hands: dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F91D\u200D\U0001F469\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 women holding hands: dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F91D\u200D\U0001F469\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 women holding hands: dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F91D\u200D\U0001F469\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 women holding hands: dark skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F91D\u200D\U0001F469\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 women holding hands: dark skin tone",
+		original: "\U0001F46D\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 woman and man holding hands",
+		original: "\U0001F46B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: light skin tone",
+		original: "\U0001F46B\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: light skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F91D\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F91D\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F91D\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\U0001F91D\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: medium-light skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F91D\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: medium-light skin tone",
+		original: "\U0001F46B\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: medium-light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F91D\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F91D\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: medium-light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\U0001F91D\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: medium skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F91D\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: medium skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F91D\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: medium skin tone",
+		original: "\U0001F46B\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: medium skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F91D\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: medium skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\U0001F91D\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: medium-dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F91D\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F91D\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: medium-dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F91D\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: medium-dark skin tone",
+		original: "\U0001F46B\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: medium-dark skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\U0001F91D\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F91D\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F91D\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F91D\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: dark skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\U0001F91D\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 woman and man holding hands: dark skin tone",
+		original: "\U0001F46B\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 men holding hands",
+		original: "\U0001F46C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 men holding hands: light skin tone",
+		original: "\U0001F46C\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 men holding hands: light skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F91D\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 men holding hands: light skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F91D\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 men holding hands: light skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F91D\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 men holding hands: light skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\U0001F91D\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 men holding hands: medium-light skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F91D\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 men holding hands: medium-light skin tone",
+		original: "\U0001F46C\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 men holding hands: medium-light skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F91D\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 men holding hands: medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F91D\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 men holding hands: medium-light skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\U0001F91D\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 men holding hands: medium skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F91D\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 men holding hands: medium skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F91D\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 men holding hands: medium skin tone",
+		original: "\U0001F46C\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 men holding hands: medium skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F91D\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 men holding hands: medium skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\U0001F91D\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 men holding hands: medium-dark skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F91D\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 men holding hands: medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F91D\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 men holding hands: medium-dark skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F91D\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 men holding hands: medium-dark skin tone",
+		original: "\U0001F46C\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.1 men holding hands: medium-dark skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\U0001F91D\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 men holding hands: dark skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F91D\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 men holding hands: dark skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F91D\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 men holding hands: dark skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F91D\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 men holding hands: dark skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\U0001F91D\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 men holding hands: dark skin tone",
+		original: "\U0001F46C\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 kiss",
+		original: "\U0001F48F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: light skin tone",
+		original: "\U0001F48F\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: medium-light skin tone",
+		original: "\U0001F48F\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: medium skin tone",
+		original: "\U0001F48F\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: medium-dark skin tone",
+		original: "\U0001F48F\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: dark skin tone",
+		original: "\U0001F48F\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, light skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, light skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, light skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, light skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, light skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, light skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, light skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, light skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium-light skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium-light skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium-light skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium-light skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium-light skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium-light skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium-dark skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium-dark skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium-dark skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium-dark skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium-dark skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, medium-dark skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, dark skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, dark skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, dark skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, dark skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, dark skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, dark skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, dark skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: person, person, dark skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F9D1\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E2.0 kiss: woman, man",
+		original: "\U0001F469\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 kiss: woman, man",
+		original: "\U0001F469\u200D\u2764\u200D\U0001F48B\u200D\U0001F468",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, light skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, light skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-light skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-light skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-dark skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, medium-dark skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, dark skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, dark skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, man, dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E2.0 kiss: man, man",
+		original: "\U0001F468\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 kiss: man, man",
+		original: "\U0001F468\u200D\u2764\u200D\U0001F48B\u200D\U0001F468",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, light skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, light skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, light skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, light skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, light skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, light skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, light skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, light skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-light skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-light skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-light skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-light skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-light skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-light skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-dark skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-dark skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-dark skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-dark skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-dark skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, medium-dark skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, dark skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, dark skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, dark skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, dark skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, dark skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, dark skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, dark skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, dark skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: man, man, dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E2.0 kiss: woman, woman",
+		original: "\U0001F469\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 kiss: woman, woman",
+		original: "\U0001F469\u200D\u2764\u200D\U0001F48B\u200D\U0001F469",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, light skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, light skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-light skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-light skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-dark skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, medium-dark skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, dark skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, dark skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F48B\u200D\U0001F469\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 kiss: woman, woman, dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F48B\u200D\U0001F469\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 couple with heart",
+		original: "\U0001F491",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: light skin tone",
+		original: "\U0001F491\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: medium-light skin tone",
+		original: "\U0001F491\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: medium skin tone",
+		original: "\U0001F491\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: medium-dark skin tone",
+		original: "\U0001F491\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: dark skin tone",
+		original: "\U0001F491\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, light skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, light skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2764\u200D\U0001F9D1\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, light skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, light skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2764\u200D\U0001F9D1\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, light skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, light skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2764\u200D\U0001F9D1\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, light skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, light skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FB\u200D\u2764\u200D\U0001F9D1\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium-light skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium-light skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2764\u200D\U0001F9D1\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium-light skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium-light skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2764\u200D\U0001F9D1\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2764\u200D\U0001F9D1\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium-light skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium-light skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FC\u200D\u2764\u200D\U0001F9D1\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2764\u200D\U0001F9D1\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2764\u200D\U0001F9D1\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2764\u200D\U0001F9D1\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FD\u200D\u2764\u200D\U0001F9D1\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium-dark skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium-dark skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2764\u200D\U0001F9D1\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2764\u200D\U0001F9D1\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium-dark skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium-dark skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2764\u200D\U0001F9D1\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium-dark skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, medium-dark skin tone, dark skin tone",
+		original: "\U0001F9D1\U0001F3FE\u200D\u2764\u200D\U0001F9D1\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, dark skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, dark skin tone, light skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2764\u200D\U0001F9D1\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, dark skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, dark skin tone, medium-light skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2764\u200D\U0001F9D1\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, dark skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, dark skin tone, medium skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2764\u200D\U0001F9D1\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, dark skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F9D1\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: person, person, dark skin tone, medium-dark skin tone",
+		original: "\U0001F9D1\U0001F3FF\u200D\u2764\u200D\U0001F9D1\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E2.0 couple with heart: woman, man",
+		original: "\U0001F469\u200D\u2764\uFE0F\u200D\U0001F468",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 couple with heart: woman, man",
+		original: "\U0001F469\u200D\u2764\u200D\U0001F468",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, light skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, light skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-light skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-light skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-dark skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, medium-dark skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, dark skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, dark skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, man, dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E2.0 couple with heart: man, man",
+		original: "\U0001F468\u200D\u2764\uFE0F\u200D\U0001F468",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 couple with heart: man, man",
+		original: "\U0001F468\u200D\u2764\u200D\U0001F468",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, light skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, light skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, light skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, light skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, light skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, light skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, light skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, light skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FB\u200D\u2764\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-light skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-light skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-light skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-light skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-light skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-light skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-light skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FC\u200D\u2764\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FD\u200D\u2764\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-dark skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-dark skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-dark skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-dark skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-dark skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, medium-dark skin tone, dark skin tone",
+		original: "\U0001F468\U0001F3FE\u200D\u2764\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, dark skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, dark skin tone, light skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\u200D\U0001F468\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, dark skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, dark skin tone, medium-light skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\u200D\U0001F468\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, dark skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, dark skin tone, medium skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\u200D\U0001F468\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, dark skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, dark skin tone, medium-dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\u200D\U0001F468\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F468\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: man, man, dark skin tone",
+		original: "\U0001F468\U0001F3FF\u200D\u2764\u200D\U0001F468\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E2.0 couple with heart: woman, woman",
+		original: "\U0001F469\u200D\u2764\uFE0F\u200D\U0001F469",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 couple with heart: woman, woman",
+		original: "\U0001F469\u200D\u2764\u200D\U0001F469",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F469\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, light skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, light skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F469\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F469\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F469\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FB\u200D\u2764\u200D\U0001F469\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-light skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-light skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F469\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-light skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F469\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-light skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F469\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-light skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F469\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-light skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FC\u200D\u2764\u200D\U0001F469\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F469\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F469\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F469\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F469\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FD\u200D\u2764\u200D\U0001F469\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F469\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F469\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F469\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F469\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-dark skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, medium-dark skin tone, dark skin tone",
+		original: "\U0001F469\U0001F3FE\u200D\u2764\u200D\U0001F469\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, dark skin tone, light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F469\U0001F3FB",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, dark skin tone, medium-light skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F469\U0001F3FC",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, dark skin tone, medium skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F469\U0001F3FD",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, dark skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, dark skin tone, medium-dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F469\U0001F3FE",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\uFE0F\u200D\U0001F469\U0001F3FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.1 couple with heart: woman, woman, dark skin tone",
+		original: "\U0001F469\U0001F3FF\u200D\u2764\u200D\U0001F469\U0001F3FF",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E2.0 family: man, woman, boy",
+		original: "\U0001F468\u200D\U0001F469\u200D\U0001F466",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 family: man, woman, girl",
+		original: "\U0001F468\u200D\U0001F469\u200D\U0001F467",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 family: man, woman, girl, boy",
+		original: "\U0001F468\u200D\U0001F469\u200D\U0001F467\u200D\U0001F466",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 family: man, woman, boy, boy",
+		original: "\U0001F468\u200D\U0001F469\u200D\U0001F466\u200D\U0001F466",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 family: man, woman, girl, girl",
+		original: "\U0001F468\u200D\U0001F469\u200D\U0001F467\u200D\U0001F467",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 family: man, man, boy",
+		original: "\U0001F468\u200D\U0001F468\u200D\U0001F466",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 family: man, man, girl",
+		original: "\U0001F468\u200D\U0001F468\u200D\U0001F467",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 family: man, man, girl, boy",
+		original: "\U0001F468\u200D\U0001F468\u200D\U0001F467\u200D\U0001F466",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 family: man, man, boy, boy",
+		original: "\U0001F468\u200D\U0001F468\u200D\U0001F466\u200D\U0001F466",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 family: man, man, girl, girl",
+		original: "\U0001F468\u200D\U0001F468\u200D\U0001F467\u200D\U0001F467",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 family: woman, woman, boy",
+		original: "\U0001F469\u200D\U0001F469\u200D\U0001F466",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 family: woman, woman, girl",
+		original: "\U0001F469\u200D\U0001F469\u200D\U0001F467",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 family: woman, woman, girl, boy",
+		original: "\U0001F469\u200D\U0001F469\u200D\U0001F467\u200D\U0001F466",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 family: woman, woman, boy, boy",
+		original: "\U0001F469\u200D\U0001F469\u200D\U0001F466\u200D\U0001F466",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 family: woman, woman, girl, girl",
+		original: "\U0001F469\u200D\U0001F469\u200D\U0001F467\u200D\U0001F467",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 family: man, boy",
+		original: "\U0001F468\u200D\U0001F466",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 family: man, boy, boy",
+		original: "\U0001F468\u200D\U0001F466\u200D\U0001F466",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 family: man, girl",
+		original: "\U0001F468\u200D\U0001F467",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 family: man, girl, boy",
+		original: "\U0001F468\u200D\U0001F467\u200D\U0001F466",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 family: man, girl, girl",
+		original: "\U0001F468\u200D\U0001F467\u200D\U0001F467",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 family: woman, boy",
+		original: "\U0001F469\u200D\U0001F466",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 family: woman, boy, boy",
+		original: "\U0001F469\u200D\U0001F466\u200D\U0001F466",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 family: woman, girl",
+		original: "\U0001F469\u200D\U0001F467",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 family: woman, girl, boy",
+		original: "\U0001F469\u200D\U0001F467\u200D\U0001F466",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 family: woman, girl, girl",
+		original: "\U0001F469\u200D\U0001F467\u200D\U0001F467",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 speaking head",
+		original: "\U0001F5E3\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 speaking head",
+		original: "\U0001F5E3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 bust in silhouette",
+		original: "\U0001F464",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 busts in silhouette",
+		original: "\U0001F465",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 people hugging",
+		original: "\U0001FAC2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 family",
+		original: "\U0001F46A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 family: adult, adult, child",
+		original: "\U0001F9D1\u200D\U0001F9D1\u200D\U0001F9D2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 family: adult, adult, child, child",
+		original: "\U0001F9D1\u200D\U0001F9D1\u200D\U0001F9D2\u200D\U0001F9D2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 family: adult, child",
+		original: "\U0001F9D1\u200D\U0001F9D2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 family: adult, child, child",
+		original: "\U0001F9D1\u200D\U0001F9D2\u200D\U0001F9D2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 footprints",
+		original: "\U0001F463",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 light skin tone",
+		original: "\U0001F3FB",
+		status:   "component",
+	},
+	{
+		name:     "E1.0 medium-light skin tone",
+		original: "\U0001F3FC",
+		status:   "component",
+	},
+	{
+		name:     "E1.0 medium skin tone",
+		original: "\U0001F3FD",
+		status:   "component",
+	},
+	{
+		name:     "E1.0 medium-dark skin tone",
+		original: "\U0001F3FE",
+		status:   "component",
+	},
+	{
+		name:     "E1.0 dark skin tone",
+		original: "\U0001F3FF",
+		status:   "component",
+	},
+	{
+		name:     "E11.0 red hair",
+		original: "\U0001F9B0",
+		status:   "component",
+	},
+	{
+		name:     "E11.0 curly hair",
+		original: "\U0001F9B1",
+		status:   "component",
+	},
+	{
+		name:     "E11.0 white hair",
+		original: "\U0001F9B3",
+		status:   "component",
+	},
+	{
+		name:     "E11.0 bald",
+		original: "\U0001F9B2",
+		status:   "component",
+	},
+	{
+		name:     "E0.6 monkey face",
+		original: "\U0001F435",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 monkey",
+		original: "\U0001F412",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 gorilla",
+		original: "\U0001F98D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 orangutan",
+		original: "\U0001F9A7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 dog face",
+		original: "\U0001F436",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 dog",
+		original: "\U0001F415",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 guide dog",
+		original: "\U0001F9AE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 service dog",
+		original: "\U0001F415\u200D\U0001F9BA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 poodle",
+		original: "\U0001F429",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 wolf",
+		original: "\U0001F43A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 fox",
+		original: "\U0001F98A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 raccoon",
+		original: "\U0001F99D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 cat face",
+		original: "\U0001F431",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 cat",
+		original: "\U0001F408",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 black cat",
+		original: "\U0001F408\u200D\u2B1B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 lion",
+		original: "\U0001F981",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 tiger face",
+		original: "\U0001F42F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 tiger",
+		original: "\U0001F405",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 leopard",
+		original: "\U0001F406",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 horse face",
+		original: "\U0001F434",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 moose",
+		original: "\U0001FACE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 donkey",
+		original: "\U0001FACF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 horse",
+		original: "\U0001F40E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 unicorn",
+		original: "\U0001F984",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 zebra",
+		original: "\U0001F993",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 deer",
+		original: "\U0001F98C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 bison",
+		original: "\U0001F9AC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 cow face",
+		original: "\U0001F42E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 ox",
+		original: "\U0001F402",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 water buffalo",
+		original: "\U0001F403",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 cow",
+		original: "\U0001F404",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 pig face",
+		original: "\U0001F437",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 pig",
+		original: "\U0001F416",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 boar",
+		original: "\U0001F417",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 pig nose",
+		original: "\U0001F43D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 ram",
+		original: "\U0001F40F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 ewe",
+		original: "\U0001F411",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 goat",
+		original: "\U0001F410",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 camel",
+		original: "\U0001F42A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 two-hump camel",
+		original: "\U0001F42B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 llama",
+		original: "\U0001F999",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 giraffe",
+		original: "\U0001F992",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 elephant",
+		original: "\U0001F418",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 mammoth",
+		original: "\U0001F9A3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 rhinoceros",
+		original: "\U0001F98F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 hippopotamus",
+		original: "\U0001F99B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 mouse face",
+		original: "\U0001F42D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 mouse",
+		original: "\U0001F401",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 rat",
+		original: "\U0001F400",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 hamster",
+		original: "\U0001F439",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 rabbit face",
+		original: "\U0001F430",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 rabbit",
+		original: "\U0001F407",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 chipmunk",
+		original: "\U0001F43F\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 chipmunk",
+		original: "\U0001F43F",
+		status:   "unqualified",
+	},
+	{
+		name:     "E13.0 beaver",
+		original: "\U0001F9AB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 hedgehog",
+		original: "\U0001F994",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 bat",
+		original: "\U0001F987",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bear",
+		original: "\U0001F43B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 polar bear",
+		original: "\U0001F43B\u200D\u2744\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 polar bear",
+		original: "\U0001F43B\u200D\u2744",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E0.6 koala",
+		original: "\U0001F428",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 panda",
+		original: "\U0001F43C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 sloth",
+		original: "\U0001F9A5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 otter",
+		original: "\U0001F9A6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 skunk",
+		original: "\U0001F9A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 kangaroo",
+		original: "\U0001F998",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 badger",
+		original: "\U0001F9A1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 paw prints",
+		original: "\U0001F43E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 turkey",
+		original: "\U0001F983",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 chicken",
+		original: "\U0001F414",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 rooster",
+		original: "\U0001F413",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 hatching chick",
+		original: "\U0001F423",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 baby chick",
+		original: "\U0001F424",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 front-facing baby chick",
+		original: "\U0001F425",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bird",
+		original: "\U0001F426",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 penguin",
+		original: "\U0001F427",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 dove",
+		original: "\U0001F54A\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 dove",
+		original: "\U0001F54A",
+		status:   "unqualified",
+	},
+	{
+		name:     "E3.0 eagle",
+		original: "\U0001F985",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 duck",
+		original: "\U0001F986",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 swan",
+		original: "\U0001F9A2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 owl",
+		original: "\U0001F989",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 dodo",
+		original: "\U0001F9A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 feather",
+		original: "\U0001FAB6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 flamingo",
+		original: "\U0001F9A9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 peacock",
+		original: "\U0001F99A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 parrot",
+		original: "\U0001F99C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 wing",
+		original: "\U0001FABD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 black bird",
+		original: "\U0001F426\u200D\u2B1B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 goose",
+		original: "\U0001FABF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 phoenix",
+		original: "\U0001F426\u200D\U0001F525",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 frog",
+		original: "\U0001F438",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 crocodile",
+		original: "\U0001F40A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 turtle",
+		original: "\U0001F422",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 lizard",
+		original: "\U0001F98E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 snake",
+		original: "\U0001F40D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 dragon face",
+		original: "\U0001F432",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 dragon",
+		original: "\U0001F409",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 sauropod",
+		original: "\U0001F995",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 T-Rex",
+		original: "\U0001F996",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 spouting whale",
+		original: "\U0001F433",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 whale",
+		original: "\U0001F40B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 dolphin",
+		original: "\U0001F42C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 seal",
+		original: "\U0001F9AD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 fish",
+		original: "\U0001F41F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 tropical fish",
+		original: "\U0001F420",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 blowfish",
+		original: "\U0001F421",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 shark",
+		original: "\U0001F988",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 octopus",
+		original: "\U0001F419",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 spiral shell",
+		original: "\U0001F41A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 coral",
+		original: "\U0001FAB8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 jellyfish",
+		original: "\U0001FABC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 snail",
+		original: "\U0001F40C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 butterfly",
+		original: "\U0001F98B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bug",
+		original: "\U0001F41B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 ant",
+		original: "\U0001F41C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 honeybee",
+		original: "\U0001F41D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 beetle",
+		original: "\U0001FAB2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 lady beetle",
+		original: "\U0001F41E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 cricket",
+		original: "\U0001F997",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 cockroach",
+		original: "\U0001FAB3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 spider",
+		original: "\U0001F577\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 spider",
+		original: "\U0001F577",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 spider web",
+		original: "\U0001F578\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 spider web",
+		original: "\U0001F578",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 scorpion",
+		original: "\U0001F982",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 mosquito",
+		original: "\U0001F99F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 fly",
+		original: "\U0001FAB0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 worm",
+		original: "\U0001FAB1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 microbe",
+		original: "\U0001F9A0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bouquet",
+		original: "\U0001F490",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 cherry blossom",
+		original: "\U0001F338",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 white flower",
+		original: "\U0001F4AE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 lotus",
+		original: "\U0001FAB7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 rosette",
+		original: "\U0001F3F5\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 rosette",
+		original: "\U0001F3F5",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 rose",
+		original: "\U0001F339",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 wilted flower",
+		original: "\U0001F940",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 hibiscus",
+		original: "\U0001F33A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 sunflower",
+		original: "\U0001F33B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 blossom",
+		original: "\U0001F33C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 tulip",
+		original: "\U0001F337",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 hyacinth",
+		original: "\U0001FABB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 seedling",
+		original: "\U0001F331",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 potted plant",
+		original: "\U0001FAB4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 evergreen tree",
+		original: "\U0001F332",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 deciduous tree",
+		original: "\U0001F333",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 palm tree",
+		original: "\U0001F334",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 cactus",
+		original: "\U0001F335",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 sheaf of rice",
+		original: "\U0001F33E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 herb",
+		original: "\U0001F33F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 shamrock",
+		original: "\u2618\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 shamrock",
+		original: "\u2618",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 four leaf clover",
+		original: "\U0001F340",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 maple leaf",
+		original: "\U0001F341",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 fallen leaf",
+		original: "\U0001F342",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 leaf fluttering in wind",
+		original: "\U0001F343",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 empty nest",
+		original: "\U0001FAB9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 nest with eggs",
+		original: "\U0001FABA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 mushroom",
+		original: "\U0001F344",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 grapes",
+		original: "\U0001F347",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 melon",
+		original: "\U0001F348",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 watermelon",
+		original: "\U0001F349",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 tangerine",
+		original: "\U0001F34A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 lemon",
+		original: "\U0001F34B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 lime",
+		original: "\U0001F34B\u200D\U0001F7E9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 banana",
+		original: "\U0001F34C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 pineapple",
+		original: "\U0001F34D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 mango",
+		original: "\U0001F96D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 red apple",
+		original: "\U0001F34E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 green apple",
+		original: "\U0001F34F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 pear",
+		original: "\U0001F350",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 peach",
+		original: "\U0001F351",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 cherries",
+		original: "\U0001F352",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 strawberry",
+		original: "\U0001F353",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 blueberries",
+		original: "\U0001FAD0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 kiwi fruit",
+		original: "\U0001F95D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 tomato",
+		original: "\U0001F345",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 olive",
+		original: "\U0001FAD2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 coconut",
+		original: "\U0001F965",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 avocado",
+		original: "\U0001F951",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 eggplant",
+		original: "\U0001F346",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 potato",
+		original: "\U0001F954",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 carrot",
+		original: "\U0001F955",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 ear of corn",
+		original: "\U0001F33D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 hot pepper",
+		original: "\U0001F336\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 hot pepper",
+		original: "\U0001F336",
+		status:   "unqualified",
+	},
+	{
+		name:     "E13.0 bell pepper",
+		original: "\U0001FAD1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 cucumber",
+		original: "\U0001F952",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 leafy green",
+		original: "\U0001F96C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 broccoli",
+		original: "\U0001F966",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 garlic",
+		original: "\U0001F9C4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 onion",
+		original: "\U0001F9C5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 peanuts",
+		original: "\U0001F95C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 beans",
+		original: "\U0001FAD8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 chestnut",
+		original: "\U0001F330",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 ginger root",
+		original: "\U0001FADA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 pea pod",
+		original: "\U0001FADB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 brown mushroom",
+		original: "\U0001F344\u200D\U0001F7EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bread",
+		original: "\U0001F35E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 croissant",
+		original: "\U0001F950",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 baguette bread",
+		original: "\U0001F956",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 flatbread",
+		original: "\U0001FAD3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 pretzel",
+		original: "\U0001F968",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 bagel",
+		original: "\U0001F96F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 pancakes",
+		original: "\U0001F95E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 waffle",
+		original: "\U0001F9C7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 cheese wedge",
+		original: "\U0001F9C0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 meat on bone",
+		original: "\U0001F356",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 poultry leg",
+		original: "\U0001F357",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 cut of meat",
+		original: "\U0001F969",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 bacon",
+		original: "\U0001F953",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 hamburger",
+		original: "\U0001F354",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 french fries",
+		original: "\U0001F35F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 pizza",
+		original: "\U0001F355",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 hot dog",
+		original: "\U0001F32D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 sandwich",
+		original: "\U0001F96A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 taco",
+		original: "\U0001F32E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 burrito",
+		original: "\U0001F32F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 tamale",
+		original: "\U0001FAD4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 stuffed flatbread",
+		original: "\U0001F959",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 falafel",
+		original: "\U0001F9C6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 egg",
+		original: "\U0001F95A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 cooking",
+		original: "\U0001F373",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 shallow pan of food",
+		original: "\U0001F958",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 pot of food",
+		original: "\U0001F372",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 fondue",
+		original: "\U0001FAD5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 bowl with spoon",
+		original: "\U0001F963",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 green salad",
+		original: "\U0001F957",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 popcorn",
+		original: "\U0001F37F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 butter",
+		original: "\U0001F9C8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 salt",
+		original: "\U0001F9C2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 canned food",
+		original: "\U0001F96B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bento box",
+		original: "\U0001F371",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 rice cracker",
+		original: "\U0001F358",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 rice ball",
+		original: "\U0001F359",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 cooked rice",
+		original: "\U0001F35A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 curry rice",
+		original: "\U0001F35B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 steaming bowl",
+		original: "\U0001F35C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 spaghetti",
+		original: "\U0001F35D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 roasted sweet potato",
+		original: "\U0001F360",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 oden",
+		original: "\U0001F362",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 sushi",
+		original: "\U0001F363",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 fried shrimp",
+		original: "\U0001F364",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 fish cake with swirl",
+		original: "\U0001F365",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 moon cake",
+		original: "\U0001F96E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 dango",
+		original: "\U0001F361",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 dumpling",
+		original: "\U0001F95F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 fortune cookie",
+		original: "\U0001F960",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 takeout box",
+		original: "\U0001F961",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 crab",
+		original: "\U0001F980",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 lobster",
+		original: "\U0001F99E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 shrimp",
+		original: "\U0001F990",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 squid",
+		original: "\U0001F991",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 oyster",
+		original: "\U0001F9AA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 soft ice cream",
+		original: "\U0001F366",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 shaved ice",
+		original: "\U0001F367",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 ice cream",
+		original: "\U0001F368",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 doughnut",
+		original: "\U0001F369",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 cookie",
+		original: "\U0001F36A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 birthday cake",
+		original: "\U0001F382",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 shortcake",
+		original: "\U0001F370",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 cupcake",
+		original: "\U0001F9C1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 pie",
+		original: "\U0001F967",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 chocolate bar",
+		original: "\U0001F36B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 candy",
+		original: "\U0001F36C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 lollipop",
+		original: "\U0001F36D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 custard",
+		original: "\U0001F36E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 honey pot",
+		original: "\U0001F36F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 baby bottle",
+		original: "\U0001F37C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 glass of milk",
+		original: "\U0001F95B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 hot beverage",
+		original: "\u2615",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 teapot",
+		original: "\U0001FAD6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 teacup without handle",
+		original: "\U0001F375",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 sake",
+		original: "\U0001F376",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 bottle with popping cork",
+		original: "\U0001F37E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 wine glass",
+		original: "\U0001F377",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 cocktail glass",
+		original: "\U0001F378",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 tropical drink",
+		original: "\U0001F379",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 beer mug",
+		original: "\U0001F37A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 clinking beer mugs",
+		original: "\U0001F37B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 clinking glasses",
+		original: "\U0001F942",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 tumbler glass",
+		original: "\U0001F943",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 pouring liquid",
+		original: "\U0001FAD7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 cup with straw",
+		original: "\U0001F964",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 bubble tea",
+		original: "\U0001F9CB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 beverage box",
+		original: "\U0001F9C3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 mate",
+		original: "\U0001F9C9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 ice",
+		original: "\U0001F9CA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 chopsticks",
+		original: "\U0001F962",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 fork and knife with plate",
+		original: "\U0001F37D\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 fork and knife with plate",
+		original: "\U0001F37D",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 fork and knife",
+		original: "\U0001F374",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 spoon",
+		original: "\U0001F944",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 kitchen knife",
+		original: "\U0001F52A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 jar",
+		original: "\U0001FAD9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 amphora",
+		original: "\U0001F3FA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 globe showing Europe-Africa",
+		original: "\U0001F30D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 globe showing Americas",
+		original: "\U0001F30E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 globe showing Asia-Australia",
+		original: "\U0001F30F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 globe with meridians",
+		original: "\U0001F310",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 world map",
+		original: "\U0001F5FA\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 world map",
+		original: "\U0001F5FA",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 map of Japan",
+		original: "\U0001F5FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 compass",
+		original: "\U0001F9ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 snow-capped mountain",
+		original: "\U0001F3D4\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 snow-capped mountain",
+		original: "\U0001F3D4",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 mountain",
+		original: "\u26F0\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 mountain",
+		original: "\u26F0",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 volcano",
+		original: "\U0001F30B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 mount fuji",
+		original: "\U0001F5FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 camping",
+		original: "\U0001F3D5\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 camping",
+		original: "\U0001F3D5",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 beach with umbrella",
+		original: "\U0001F3D6\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 beach with umbrella",
+		original: "\U0001F3D6",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 desert",
+		original: "\U0001F3DC\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 desert",
+		original: "\U0001F3DC",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 desert island",
+		original: "\U0001F3DD\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 desert island",
+		original: "\U0001F3DD",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 national park",
+		original: "\U0001F3DE\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 national park",
+		original: "\U0001F3DE",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 stadium",
+		original: "\U0001F3DF\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 stadium",
+		original: "\U0001F3DF",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 classical building",
+		original: "\U0001F3DB\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 classical building",
+		original: "\U0001F3DB",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 building construction",
+		original: "\U0001F3D7\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 building construction",
+		original: "\U0001F3D7",
+		status:   "unqualified",
+	},
+	{
+		name:     "E11.0 brick",
+		original: "\U0001F9F1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 rock",
+		original: "\U0001FAA8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 wood",
+		original: "\U0001FAB5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 hut",
+		original: "\U0001F6D6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 houses",
+		original: "\U0001F3D8\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 houses",
+		original: "\U0001F3D8",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 derelict house",
+		original: "\U0001F3DA\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 derelict house",
+		original: "\U0001F3DA",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 house",
+		original: "\U0001F3E0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 house with garden",
+		original: "\U0001F3E1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 office building",
+		original: "\U0001F3E2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese post office",
+		original: "\U0001F3E3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 post office",
+		original: "\U0001F3E4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 hospital",
+		original: "\U0001F3E5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bank",
+		original: "\U0001F3E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 hotel",
+		original: "\U0001F3E8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 love hotel",
+		original: "\U0001F3E9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 convenience store",
+		original: "\U0001F3EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 school",
+		original: "\U0001F3EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 department store",
+		original: "\U0001F3EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 factory",
+		original: "\U0001F3ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese castle",
+		original: "\U0001F3EF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 castle",
+		original: "\U0001F3F0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 wedding",
+		original: "\U0001F492",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Tokyo tower",
+		original: "\U0001F5FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Statue of Liberty",
+		original: "\U0001F5FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 church",
+		original: "\u26EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 mosque",
+		original: "\U0001F54C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 hindu temple",
+		original: "\U0001F6D5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 synagogue",
+		original: "\U0001F54D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 shinto shrine",
+		original: "\u26E9\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 shinto shrine",
+		original: "\u26E9",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 kaaba",
+		original: "\U0001F54B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 fountain",
+		original: "\u26F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 tent",
+		original: "\u26FA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 foggy",
+		original: "\U0001F301",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 night with stars",
+		original: "\U0001F303",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 cityscape",
+		original: "\U0001F3D9\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 cityscape",
+		original: "\U0001F3D9",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 sunrise over mountains",
+		original: "\U0001F304",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 sunrise",
+		original: "\U0001F305",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 cityscape at dusk",
+		original: "\U0001F306",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 sunset",
+		original: "\U0001F307",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bridge at night",
+		original: "\U0001F309",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 hot springs",
+		original: "\u2668\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 hot springs",
+		original: "\u2668",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 carousel horse",
+		original: "\U0001F3A0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 playground slide",
+		original: "\U0001F6DD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 ferris wheel",
+		original: "\U0001F3A1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 roller coaster",
+		original: "\U0001F3A2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 barber pole",
+		original: "\U0001F488",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 circus tent",
+		original: "\U0001F3AA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 locomotive",
+		original: "\U0001F682",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 railway car",
+		original: "\U0001F683",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 high-speed train",
+		original: "\U0001F684",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bullet train",
+		original: "\U0001F685",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 train",
+		original: "\U0001F686",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 metro",
+		original: "\U0001F687",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 light rail",
+		original: "\U0001F688",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 station",
+		original: "\U0001F689",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 tram",
+		original: "\U0001F68A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 monorail",
+		original: "\U0001F69D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 mountain railway",
+		original: "\U0001F69E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 tram car",
+		original: "\U0001F68B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bus",
+		original: "\U0001F68C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 oncoming bus",
+		original: "\U0001F68D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 trolleybus",
+		original: "\U0001F68E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 minibus",
+		original: "\U0001F690",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 ambulance",
+		original: "\U0001F691",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 fire engine",
+		original: "\U0001F692",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 police car",
+		original: "\U0001F693",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 oncoming police car",
+		original: "\U0001F694",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 taxi",
+		original: "\U0001F695",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 oncoming taxi",
+		original: "\U0001F696",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 automobile",
+		original: "\U0001F697",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 oncoming automobile",
+		original: "\U0001F698",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 sport utility vehicle",
+		original: "\U0001F699",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 pickup truck",
+		original: "\U0001F6FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 delivery truck",
+		original: "\U0001F69A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 articulated lorry",
+		original: "\U0001F69B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 tractor",
+		original: "\U0001F69C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 racing car",
+		original: "\U0001F3CE\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 racing car",
+		original: "\U0001F3CE",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 motorcycle",
+		original: "\U0001F3CD\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 motorcycle",
+		original: "\U0001F3CD",
+		status:   "unqualified",
+	},
+	{
+		name:     "E3.0 motor scooter",
+		original: "\U0001F6F5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 manual wheelchair",
+		original: "\U0001F9BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 motorized wheelchair",
+		original: "\U0001F9BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 auto rickshaw",
+		original: "\U0001F6FA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bicycle",
+		original: "\U0001F6B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 kick scooter",
+		original: "\U0001F6F4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 skateboard",
+		original: "\U0001F6F9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 roller skate",
+		original: "\U0001F6FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bus stop",
+		original: "\U0001F68F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 motorway",
+		original: "\U0001F6E3\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 motorway",
+		original: "\U0001F6E3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 railway track",
+		original: "\U0001F6E4\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 railway track",
+		original: "\U0001F6E4",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 oil drum",
+		original: "\U0001F6E2\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 oil drum",
+		original: "\U0001F6E2",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 fuel pump",
+		original: "\u26FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 wheel",
+		original: "\U0001F6DE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 police car light",
+		original: "\U0001F6A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 horizontal traffic light",
+		original: "\U0001F6A5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 vertical traffic light",
+		original: "\U0001F6A6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 stop sign",
+		original: "\U0001F6D1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 construction",
+		original: "\U0001F6A7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 anchor",
+		original: "\u2693",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 ring buoy",
+		original: "\U0001F6DF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 sailboat",
+		original: "\u26F5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 canoe",
+		original: "\U0001F6F6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 speedboat",
+		original: "\U0001F6A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 passenger ship",
+		original: "\U0001F6F3\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 passenger ship",
+		original: "\U0001F6F3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 ferry",
+		original: "\u26F4\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 ferry",
+		original: "\u26F4",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 motor boat",
+		original: "\U0001F6E5\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 motor boat",
+		original: "\U0001F6E5",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 ship",
+		original: "\U0001F6A2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 airplane",
+		original: "\u2708\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 airplane",
+		original: "\u2708",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 small airplane",
+		original: "\U0001F6E9\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 small airplane",
+		original: "\U0001F6E9",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 airplane departure",
+		original: "\U0001F6EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 airplane arrival",
+		original: "\U0001F6EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 parachute",
+		original: "\U0001FA82",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 seat",
+		original: "\U0001F4BA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 helicopter",
+		original: "\U0001F681",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 suspension railway",
+		original: "\U0001F69F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 mountain cableway",
+		original: "\U0001F6A0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 aerial tramway",
+		original: "\U0001F6A1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 satellite",
+		original: "\U0001F6F0\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 satellite",
+		original: "\U0001F6F0",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 rocket",
+		original: "\U0001F680",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 flying saucer",
+		original: "\U0001F6F8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 bellhop bell",
+		original: "\U0001F6CE\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 bellhop bell",
+		original: "\U0001F6CE",
+		status:   "unqualified",
+	},
+	{
+		name:     "E11.0 luggage",
+		original: "\U0001F9F3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 hourglass done",
+		original: "\u231B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 hourglass not done",
+		original: "\u23F3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 watch",
+		original: "\u231A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 alarm clock",
+		original: "\u23F0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 stopwatch",
+		original: "\u23F1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 stopwatch",
+		original: "\u23F1",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 timer clock",
+		original: "\u23F2\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 timer clock",
+		original: "\u23F2",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 mantelpiece clock",
+		original: "\U0001F570\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 mantelpiece clock",
+		original: "\U0001F570",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 twelve o’clock",
+		original: "\U0001F55B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 twelve-thirty",
+		original: "\U0001F567",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 one o’clock",
+		original: "\U0001F550",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 one-thirty",
+		original: "\U0001F55C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 two o’clock",
+		original: "\U0001F551",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 two-thirty",
+		original: "\U0001F55D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 three o’clock",
+		original: "\U0001F552",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 three-thirty",
+		original: "\U0001F55E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 four o’clock",
+		original: "\U0001F553",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 four-thirty",
+		original: "\U0001F55F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 five o’clock",
+		original: "\U0001F554",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 five-thirty",
+		original: "\U0001F560",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 six o’clock",
+		original: "\U0001F555",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 six-thirty",
+		original: "\U0001F561",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 seven o’clock",
+		original: "\U0001F556",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 seven-thirty",
+		original: "\U0001F562",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 eight o’clock",
+		original: "\U0001F557",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 eight-thirty",
+		original: "\U0001F563",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 nine o’clock",
+		original: "\U0001F558",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 nine-thirty",
+		original: "\U0001F564",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 ten o’clock",
+		original: "\U0001F559",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 ten-thirty",
+		original: "\U0001F565",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 eleven o’clock",
+		original: "\U0001F55A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 eleven-thirty",
+		original: "\U0001F566",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 new moon",
+		original: "\U0001F311",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 waxing crescent moon",
+		original: "\U0001F312",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 first quarter moon",
+		original: "\U0001F313",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 waxing gibbous moon",
+		original: "\U0001F314",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 full moon",
+		original: "\U0001F315",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 waning gibbous moon",
+		original: "\U0001F316",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 last quarter moon",
+		original: "\U0001F317",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 waning crescent moon",
+		original: "\U0001F318",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 crescent moon",
+		original: "\U0001F319",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 new moon face",
+		original: "\U0001F31A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 first quarter moon face",
+		original: "\U0001F31B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 last quarter moon face",
+		original: "\U0001F31C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 thermometer",
+		original: "\U0001F321\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 thermometer",
+		original: "\U0001F321",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 sun",
+		original: "\u2600\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 sun",
+		original: "\u2600",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 full moon face",
+		original: "\U0001F31D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 sun with face",
+		original: "\U0001F31E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 ringed planet",
+		original: "\U0001FA90",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 star",
+		original: "\u2B50",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 glowing star",
+		original: "\U0001F31F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 shooting star",
+		original: "\U0001F320",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 milky way",
+		original: "\U0001F30C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 cloud",
+		original: "\u2601\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 cloud",
+		original: "\u2601",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 sun behind cloud",
+		original: "\u26C5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 cloud with lightning and rain",
+		original: "\u26C8\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 cloud with lightning and rain",
+		original: "\u26C8",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 sun behind small cloud",
+		original: "\U0001F324\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 sun behind small cloud",
+		original: "\U0001F324",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 sun behind large cloud",
+		original: "\U0001F325\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 sun behind large cloud",
+		original: "\U0001F325",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 sun behind rain cloud",
+		original: "\U0001F326\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 sun behind rain cloud",
+		original: "\U0001F326",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 cloud with rain",
+		original: "\U0001F327\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 cloud with rain",
+		original: "\U0001F327",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 cloud with snow",
+		original: "\U0001F328\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 cloud with snow",
+		original: "\U0001F328",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 cloud with lightning",
+		original: "\U0001F329\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 cloud with lightning",
+		original: "\U0001F329",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 tornado",
+		original: "\U0001F32A\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 tornado",
+		original: "\U0001F32A",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 fog",
+		original: "\U0001F32B\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 fog",
+		original: "\U0001F32B",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 wind face",
+		original: "\U0001F32C\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 wind face",
+		original: "\U0001F32C",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 cyclone",
+		original: "\U0001F300",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 rainbow",
+		original: "\U0001F308",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 closed umbrella",
+		original: "\U0001F302",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 umbrella",
+		original: "\u2602\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 umbrella",
+		original: "\u2602",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 umbrella with rain drops",
+		original: "\u2614",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 umbrella on ground",
+		original: "\u26F1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 umbrella on ground",
+		original: "\u26F1",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 high voltage",
+		original: "\u26A1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 snowflake",
+		original: "\u2744\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 snowflake",
+		original: "\u2744",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 snowman",
+		original: "\u2603\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 snowman",
+		original: "\u2603",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 snowman without snow",
+		original: "\u26C4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 comet",
+		original: "\u2604\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 comet",
+		original: "\u2604",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 fire",
+		original: "\U0001F525",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 droplet",
+		original: "\U0001F4A7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 water wave",
+		original: "\U0001F30A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 jack-o-lantern",
+		original: "\U0001F383",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Christmas tree",
+		original: "\U0001F384",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 fireworks",
+		original: "\U0001F386",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 sparkler",
+		original: "\U0001F387",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 firecracker",
+		original: "\U0001F9E8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 sparkles",
+		original: "\u2728",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 balloon",
+		original: "\U0001F388",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 party popper",
+		original: "\U0001F389",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 confetti ball",
+		original: "\U0001F38A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 tanabata tree",
+		original: "\U0001F38B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 pine decoration",
+		original: "\U0001F38D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese dolls",
+		original: "\U0001F38E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 carp streamer",
+		original: "\U0001F38F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 wind chime",
+		original: "\U0001F390",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 moon viewing ceremony",
+		original: "\U0001F391",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 red envelope",
+		original: "\U0001F9E7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 ribbon",
+		original: "\U0001F380",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 wrapped gift",
+		original: "\U0001F381",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 reminder ribbon",
+		original: "\U0001F397\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 reminder ribbon",
+		original: "\U0001F397",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 admission tickets",
+		original: "\U0001F39F\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 admission tickets",
+		original: "\U0001F39F",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 ticket",
+		original: "\U0001F3AB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 military medal",
+		original: "\U0001F396\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 military medal",
+		original: "\U0001F396",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 trophy",
+		original: "\U0001F3C6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 sports medal",
+		original: "\U0001F3C5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 1st place medal",
+		original: "\U0001F947",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 2nd place medal",
+		original: "\U0001F948",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 3rd place medal",
+		original: "\U0001F949",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 soccer ball",
+		original: "\u26BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 baseball",
+		original: "\u26BE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 softball",
+		original: "\U0001F94E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 basketball",
+		original: "\U0001F3C0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 volleyball",
+		original: "\U0001F3D0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 american football",
+		original: "\U0001F3C8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 rugby football",
+		original: "\U0001F3C9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 tennis",
+		original: "\U0001F3BE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 flying disc",
+		original: "\U0001F94F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bowling",
+		original: "\U0001F3B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 cricket game",
+		original: "\U0001F3CF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 field hockey",
+		original: "\U0001F3D1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 ice hockey",
+		original: "\U0001F3D2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 lacrosse",
+		original: "\U0001F94D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 ping pong",
+		original: "\U0001F3D3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 badminton",
+		original: "\U0001F3F8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 boxing glove",
+		original: "\U0001F94A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 martial arts uniform",
+		original: "\U0001F94B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 goal net",
+		original: "\U0001F945",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 flag in hole",
+		original: "\u26F3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 ice skate",
+		original: "\u26F8\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 ice skate",
+		original: "\u26F8",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 fishing pole",
+		original: "\U0001F3A3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 diving mask",
+		original: "\U0001F93F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 running shirt",
+		original: "\U0001F3BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 skis",
+		original: "\U0001F3BF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 sled",
+		original: "\U0001F6F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 curling stone",
+		original: "\U0001F94C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bullseye",
+		original: "\U0001F3AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 yo-yo",
+		original: "\U0001FA80",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 kite",
+		original: "\U0001FA81",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 water pistol",
+		original: "\U0001F52B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 pool 8 ball",
+		original: "\U0001F3B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 crystal ball",
+		original: "\U0001F52E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 magic wand",
+		original: "\U0001FA84",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 video game",
+		original: "\U0001F3AE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 joystick",
+		original: "\U0001F579\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 joystick",
+		original: "\U0001F579",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 slot machine",
+		original: "\U0001F3B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 game die",
+		original: "\U0001F3B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 puzzle piece",
+		original: "\U0001F9E9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 teddy bear",
+		original: "\U0001F9F8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 piñata",
+		original: "\U0001FA85",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 mirror ball",
+		original: "\U0001FAA9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 nesting dolls",
+		original: "\U0001FA86",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 spade suit",
+		original: "\u2660\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 spade suit",
+		original: "\u2660",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 heart suit",
+		original: "\u2665\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 heart suit",
+		original: "\u2665",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 diamond suit",
+		original: "\u2666\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 diamond suit",
+		original: "\u2666",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 club suit",
+		original: "\u2663\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 club suit",
+		original: "\u2663",
+		status:   "unqualified",
+	},
+	{
+		name:     "E11.0 chess pawn",
+		original: "\u265F\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 chess pawn",
+		original: "\u265F",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 joker",
+		original: "\U0001F0CF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 mahjong red dragon",
+		original: "\U0001F004",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 flower playing cards",
+		original: "\U0001F3B4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 performing arts",
+		original: "\U0001F3AD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 framed picture",
+		original: "\U0001F5BC\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 framed picture",
+		original: "\U0001F5BC",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 artist palette",
+		original: "\U0001F3A8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 thread",
+		original: "\U0001F9F5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 sewing needle",
+		original: "\U0001FAA1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 yarn",
+		original: "\U0001F9F6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 knot",
+		original: "\U0001FAA2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 glasses",
+		original: "\U0001F453",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 sunglasses",
+		original: "\U0001F576\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 sunglasses",
+		original: "\U0001F576",
+		status:   "unqualified",
+	},
+	{
+		name:     "E11.0 goggles",
+		original: "\U0001F97D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 lab coat",
+		original: "\U0001F97C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 safety vest",
+		original: "\U0001F9BA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 necktie",
+		original: "\U0001F454",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 t-shirt",
+		original: "\U0001F455",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 jeans",
+		original: "\U0001F456",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 scarf",
+		original: "\U0001F9E3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 gloves",
+		original: "\U0001F9E4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 coat",
+		original: "\U0001F9E5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 socks",
+		original: "\U0001F9E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 dress",
+		original: "\U0001F457",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 kimono",
+		original: "\U0001F458",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 sari",
+		original: "\U0001F97B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 one-piece swimsuit",
+		original: "\U0001FA71",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 briefs",
+		original: "\U0001FA72",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 shorts",
+		original: "\U0001FA73",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bikini",
+		original: "\U0001F459",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 woman’s clothes",
+		original: "\U0001F45A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 folding hand fan",
+		original: "\U0001FAAD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 purse",
+		original: "\U0001F45B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 handbag",
+		original: "\U0001F45C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 clutch bag",
+		original: "\U0001F45D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 shopping bags",
+		original: "\U0001F6CD\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 shopping bags",
+		original: "\U0001F6CD",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 backpack",
+		original: "\U0001F392",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 thong sandal",
+		original: "\U0001FA74",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 man’s shoe",
+		original: "\U0001F45E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 running shoe",
+		original: "\U0001F45F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 hiking boot",
+		original: "\U0001F97E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 flat shoe",
+		original: "\U0001F97F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 high-heeled shoe",
+		original: "\U0001F460",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 woman’s sandal",
+		original: "\U0001F461",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 ballet shoes",
+		original: "\U0001FA70",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 woman’s boot",
+		original: "\U0001F462",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 hair pick",
+		original: "\U0001FAAE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 crown",
+		original: "\U0001F451",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 woman’s hat",
+		original: "\U0001F452",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 top hat",
+		original: "\U0001F3A9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 graduation cap",
+		original: "\U0001F393",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 billed cap",
+		original: "\U0001F9E2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 military helmet",
+		original: "\U0001FA96",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 rescue worker’s helmet",
+		original: "\u26D1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 rescue worker’s helmet",
+		original: "\u26D1",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 prayer beads",
+		original: "\U0001F4FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 lipstick",
+		original: "\U0001F484",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 ring",
+		original: "\U0001F48D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 gem stone",
+		original: "\U0001F48E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 muted speaker",
+		original: "\U0001F507",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 speaker low volume",
+		original: "\U0001F508",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 speaker medium volume",
+		original: "\U0001F509",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 speaker high volume",
+		original: "\U0001F50A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 loudspeaker",
+		original: "\U0001F4E2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 megaphone",
+		original: "\U0001F4E3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 postal horn",
+		original: "\U0001F4EF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bell",
+		original: "\U0001F514",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 bell with slash",
+		original: "\U0001F515",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 musical score",
+		original: "\U0001F3BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 musical note",
+		original: "\U0001F3B5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 musical notes",
+		original: "\U0001F3B6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 studio microphone",
+		original: "\U0001F399\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 studio microphone",
+		original: "\U0001F399",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 level slider",
+		original: "\U0001F39A\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 level slider",
+		original: "\U0001F39A",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 control knobs",
+		original: "\U0001F39B\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 control knobs",
+		original: "\U0001F39B",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 microphone",
+		original: "\U0001F3A4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 headphone",
+		original: "\U0001F3A7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 radio",
+		original: "\U0001F4FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 saxophone",
+		original: "\U0001F3B7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 accordion",
+		original: "\U0001FA97",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 guitar",
+		original: "\U0001F3B8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 musical keyboard",
+		original: "\U0001F3B9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 trumpet",
+		original: "\U0001F3BA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 violin",
+		original: "\U0001F3BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 banjo",
+		original: "\U0001FA95",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 drum",
+		original: "\U0001F941",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 long drum",
+		original: "\U0001FA98",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 maracas",
+		original: "\U0001FA87",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 flute",
+		original: "\U0001FA88",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 mobile phone",
+		original: "\U0001F4F1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 mobile phone with arrow",
+		original: "\U0001F4F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 telephone",
+		original: "\u260E\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 telephone",
+		original: "\u260E",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 telephone receiver",
+		original: "\U0001F4DE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 pager",
+		original: "\U0001F4DF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 fax machine",
+		original: "\U0001F4E0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 battery",
+		original: "\U0001F50B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 low battery",
+		original: "\U0001FAAB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 electric plug",
+		original: "\U0001F50C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 laptop",
+		original: "\U0001F4BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 desktop computer",
+		original: "\U0001F5A5\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 desktop computer",
+		original: "\U0001F5A5",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 printer",
+		original: "\U0001F5A8\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 printer",
+		original: "\U0001F5A8",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 keyboard",
+		original: "\u2328\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 keyboard",
+		original: "\u2328",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 computer mouse",
+		original: "\U0001F5B1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 computer mouse",
+		original: "\U0001F5B1",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 trackball",
+		original: "\U0001F5B2\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 trackball",
+		original: "\U0001F5B2",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 computer disk",
+		original: "\U0001F4BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 floppy disk",
+		original: "\U0001F4BE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 optical disk",
+		original: "\U0001F4BF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 dvd",
+		original: "\U0001F4C0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 abacus",
+		original: "\U0001F9EE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 movie camera",
+		original: "\U0001F3A5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 film frames",
+		original: "\U0001F39E\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 film frames",
+		original: "\U0001F39E",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 film projector",
+		original: "\U0001F4FD\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 film projector",
+		original: "\U0001F4FD",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 clapper board",
+		original: "\U0001F3AC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 television",
+		original: "\U0001F4FA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 camera",
+		original: "\U0001F4F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 camera with flash",
+		original: "\U0001F4F8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 video camera",
+		original: "\U0001F4F9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 videocassette",
+		original: "\U0001F4FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 magnifying glass tilted left",
+		original: "\U0001F50D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 magnifying glass tilted right",
+		original: "\U0001F50E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 candle",
+		original: "\U0001F56F\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 candle",
+		original: "\U0001F56F",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 light bulb",
+		original: "\U0001F4A1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 flashlight",
+		original: "\U0001F526",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 red paper lantern",
+		original: "\U0001F3EE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 diya lamp",
+		original: "\U0001FA94",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 notebook with decorative cover",
+		original: "\U0001F4D4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 closed book",
+		original: "\U0001F4D5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 open book",
+		original: "\U0001F4D6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 green book",
+		original: "\U0001F4D7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 blue book",
+		original: "\U0001F4D8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 orange book",
+		original: "\U0001F4D9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 books",
+		original: "\U0001F4DA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 notebook",
+		original: "\U0001F4D3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 ledger",
+		original: "\U0001F4D2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 page with curl",
+		original: "\U0001F4C3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 scroll",
+		original: "\U0001F4DC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 page facing up",
+		original: "\U0001F4C4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 newspaper",
+		original: "\U0001F4F0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 rolled-up newspaper",
+		original: "\U0001F5DE\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 rolled-up newspaper",
+		original: "\U0001F5DE",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 bookmark tabs",
+		original: "\U0001F4D1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bookmark",
+		original: "\U0001F516",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 label",
+		original: "\U0001F3F7\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 label",
+		original: "\U0001F3F7",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 money bag",
+		original: "\U0001F4B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 coin",
+		original: "\U0001FA99",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 yen banknote",
+		original: "\U0001F4B4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 dollar banknote",
+		original: "\U0001F4B5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 euro banknote",
+		original: "\U0001F4B6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 pound banknote",
+		original: "\U0001F4B7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 money with wings",
+		original: "\U0001F4B8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 credit card",
+		original: "\U0001F4B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 receipt",
+		original: "\U0001F9FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 chart increasing with yen",
+		original: "\U0001F4B9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 envelope",
+		original: "\u2709\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 envelope",
+		original: "\u2709",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 e-mail",
+		original: "\U0001F4E7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 incoming envelope",
+		original: "\U0001F4E8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 envelope with arrow",
+		original: "\U0001F4E9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 outbox tray",
+		original: "\U0001F4E4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 inbox tray",
+		original: "\U0001F4E5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 package",
+		original: "\U0001F4E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 closed mailbox with raised flag",
+		original: "\U0001F4EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 closed mailbox with lowered flag",
+		original: "\U0001F4EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 open mailbox with raised flag",
+		original: "\U0001F4EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 open mailbox with lowered flag",
+		original: "\U0001F4ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 postbox",
+		original: "\U0001F4EE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 ballot box with ballot",
+		original: "\U0001F5F3\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 ballot box with ballot",
+		original: "\U0001F5F3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 pencil",
+		original: "\u270F\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 pencil",
+		original: "\u270F",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 black nib",
+		original: "\u2712\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 black nib",
+		original: "\u2712",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 fountain pen",
+		original: "\U0001F58B\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 fountain pen",
+		original: "\U0001F58B",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 pen",
+		original: "\U0001F58A\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 pen",
+		original: "\U0001F58A",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 paintbrush",
+		original: "\U0001F58C\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 paintbrush",
+		original: "\U0001F58C",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 crayon",
+		original: "\U0001F58D\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 crayon",
+		original: "\U0001F58D",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 memo",
+		original: "\U0001F4DD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 briefcase",
+		original: "\U0001F4BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 file folder",
+		original: "\U0001F4C1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 open file folder",
+		original: "\U0001F4C2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 card index dividers",
+		original: "\U0001F5C2\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 card index dividers",
+		original: "\U0001F5C2",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 calendar",
+		original: "\U0001F4C5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 tear-off calendar",
+		original: "\U0001F4C6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 spiral notepad",
+		original: "\U0001F5D2\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 spiral notepad",
+		original: "\U0001F5D2",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 spiral calendar",
+		original: "\U0001F5D3\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 spiral calendar",
+		original: "\U0001F5D3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 card index",
+		original: "\U0001F4C7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 chart increasing",
+		original: "\U0001F4C8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 chart decreasing",
+		original: "\U0001F4C9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 bar chart",
+		original: "\U0001F4CA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 clipboard",
+		original: "\U0001F4CB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 pushpin",
+		original: "\U0001F4CC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 round pushpin",
+		original: "\U0001F4CD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 paperclip",
+		original: "\U0001F4CE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 linked paperclips",
+		original: "\U0001F587\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 linked paperclips",
+		original: "\U0001F587",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 straight ruler",
+		original: "\U0001F4CF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 triangular ruler",
+		original: "\U0001F4D0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 scissors",
+		original: "\u2702\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 scissors",
+		original: "\u2702",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 card file box",
+		original: "\U0001F5C3\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 card file box",
+		original: "\U0001F5C3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 file cabinet",
+		original: "\U0001F5C4\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 file cabinet",
+		original: "\U0001F5C4",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 wastebasket",
+		original: "\U0001F5D1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 wastebasket",
+		original: "\U0001F5D1",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 locked",
+		original: "\U0001F512",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 unlocked",
+		original: "\U0001F513",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 locked with pen",
+		original: "\U0001F50F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 locked with key",
+		original: "\U0001F510",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 key",
+		original: "\U0001F511",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 old key",
+		original: "\U0001F5DD\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 old key",
+		original: "\U0001F5DD",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 hammer",
+		original: "\U0001F528",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 axe",
+		original: "\U0001FA93",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 pick",
+		original: "\u26CF\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 pick",
+		original: "\u26CF",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 hammer and pick",
+		original: "\u2692\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 hammer and pick",
+		original: "\u2692",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 hammer and wrench",
+		original: "\U0001F6E0\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 hammer and wrench",
+		original: "\U0001F6E0",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 dagger",
+		original: "\U0001F5E1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 dagger",
+		original: "\U0001F5E1",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 crossed swords",
+		original: "\u2694\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 crossed swords",
+		original: "\u2694",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 bomb",
+		original: "\U0001F4A3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 boomerang",
+		original: "\U0001FA83",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 bow and arrow",
+		original: "\U0001F3F9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 shield",
+		original: "\U0001F6E1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 shield",
+		original: "\U0001F6E1",
+		status:   "unqualified",
+	},
+	{
+		name:     "E13.0 carpentry saw",
+		original: "\U0001FA9A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 wrench",
+		original: "\U0001F527",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 screwdriver",
+		original: "\U0001FA9B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 nut and bolt",
+		original: "\U0001F529",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 gear",
+		original: "\u2699\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 gear",
+		original: "\u2699",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 clamp",
+		original: "\U0001F5DC\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 clamp",
+		original: "\U0001F5DC",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 balance scale",
+		original: "\u2696\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 balance scale",
+		original: "\u2696",
+		status:   "unqualified",
+	},
+	{
+		name:     "E12.0 white cane",
+		original: "\U0001F9AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 link",
+		original: "\U0001F517",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 broken chain",
+		original: "\u26D3\uFE0F\u200D\U0001F4A5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.1 broken chain",
+		original: "\u26D3\u200D\U0001F4A5",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 chains",
+		original: "\u26D3\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 chains",
+		original: "\u26D3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E13.0 hook",
+		original: "\U0001FA9D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 toolbox",
+		original: "\U0001F9F0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 magnet",
+		original: "\U0001F9F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 ladder",
+		original: "\U0001FA9C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 alembic",
+		original: "\u2697\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 alembic",
+		original: "\u2697",
+		status:   "unqualified",
+	},
+	{
+		name:     "E11.0 test tube",
+		original: "\U0001F9EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 petri dish",
+		original: "\U0001F9EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 dna",
+		original: "\U0001F9EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 microscope",
+		original: "\U0001F52C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 telescope",
+		original: "\U0001F52D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 satellite antenna",
+		original: "\U0001F4E1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 syringe",
+		original: "\U0001F489",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 drop of blood",
+		original: "\U0001FA78",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 pill",
+		original: "\U0001F48A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 adhesive bandage",
+		original: "\U0001FA79",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 crutch",
+		original: "\U0001FA7C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 stethoscope",
+		original: "\U0001FA7A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 x-ray",
+		original: "\U0001FA7B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 door",
+		original: "\U0001F6AA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 elevator",
+		original: "\U0001F6D7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 mirror",
+		original: "\U0001FA9E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 window",
+		original: "\U0001FA9F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 bed",
+		original: "\U0001F6CF\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 bed",
+		original: "\U0001F6CF",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 couch and lamp",
+		original: "\U0001F6CB\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 couch and lamp",
+		original: "\U0001F6CB",
+		status:   "unqualified",
+	},
+	{
+		name:     "E12.0 chair",
+		original: "\U0001FA91",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 toilet",
+		original: "\U0001F6BD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 plunger",
+		original: "\U0001FAA0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 shower",
+		original: "\U0001F6BF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 bathtub",
+		original: "\U0001F6C1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 mouse trap",
+		original: "\U0001FAA4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 razor",
+		original: "\U0001FA92",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 lotion bottle",
+		original: "\U0001F9F4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 safety pin",
+		original: "\U0001F9F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 broom",
+		original: "\U0001F9F9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 basket",
+		original: "\U0001F9FA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 roll of paper",
+		original: "\U0001F9FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 bucket",
+		original: "\U0001FAA3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 soap",
+		original: "\U0001F9FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 bubbles",
+		original: "\U0001FAE7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 toothbrush",
+		original: "\U0001FAA5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 sponge",
+		original: "\U0001F9FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 fire extinguisher",
+		original: "\U0001F9EF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E3.0 shopping cart",
+		original: "\U0001F6D2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 cigarette",
+		original: "\U0001F6AC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 coffin",
+		original: "\u26B0\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 coffin",
+		original: "\u26B0",
+		status:   "unqualified",
+	},
+	{
+		name:     "E13.0 headstone",
+		original: "\U0001FAA6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 funeral urn",
+		original: "\u26B1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 funeral urn",
+		original: "\u26B1",
+		status:   "unqualified",
+	},
+	{
+		name:     "E11.0 nazar amulet",
+		original: "\U0001F9FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 hamsa",
+		original: "\U0001FAAC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 moai",
+		original: "\U0001F5FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 placard",
+		original: "\U0001FAA7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 identification card",
+		original: "\U0001FAAA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 ATM sign",
+		original: "\U0001F3E7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 litter in bin sign",
+		original: "\U0001F6AE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 potable water",
+		original: "\U0001F6B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 wheelchair symbol",
+		original: "\u267F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 men’s room",
+		original: "\U0001F6B9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 women’s room",
+		original: "\U0001F6BA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 restroom",
+		original: "\U0001F6BB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 baby symbol",
+		original: "\U0001F6BC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 water closet",
+		original: "\U0001F6BE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 passport control",
+		original: "\U0001F6C2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 customs",
+		original: "\U0001F6C3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 baggage claim",
+		original: "\U0001F6C4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 left luggage",
+		original: "\U0001F6C5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 warning",
+		original: "\u26A0\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 warning",
+		original: "\u26A0",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 children crossing",
+		original: "\U0001F6B8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 no entry",
+		original: "\u26D4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 prohibited",
+		original: "\U0001F6AB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 no bicycles",
+		original: "\U0001F6B3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 no smoking",
+		original: "\U0001F6AD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 no littering",
+		original: "\U0001F6AF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 non-potable water",
+		original: "\U0001F6B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 no pedestrians",
+		original: "\U0001F6B7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 no mobile phones",
+		original: "\U0001F4F5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 no one under eighteen",
+		original: "\U0001F51E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 radioactive",
+		original: "\u2622\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 radioactive",
+		original: "\u2622",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 biohazard",
+		original: "\u2623\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 biohazard",
+		original: "\u2623",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 up arrow",
+		original: "\u2B06\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 up arrow",
+		original: "\u2B06",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 up-right arrow",
+		original: "\u2197\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 up-right arrow",
+		original: "\u2197",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 right arrow",
+		original: "\u27A1\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 right arrow",
+		original: "\u27A1",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 down-right arrow",
+		original: "\u2198\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 down-right arrow",
+		original: "\u2198",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 down arrow",
+		original: "\u2B07\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 down arrow",
+		original: "\u2B07",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 down-left arrow",
+		original: "\u2199\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 down-left arrow",
+		original: "\u2199",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 left arrow",
+		original: "\u2B05\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 left arrow",
+		original: "\u2B05",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 up-left arrow",
+		original: "\u2196\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 up-left arrow",
+		original: "\u2196",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 up-down arrow",
+		original: "\u2195\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 up-down arrow",
+		original: "\u2195",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 left-right arrow",
+		original: "\u2194\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 left-right arrow",
+		original: "\u2194",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 right arrow curving left",
+		original: "\u21A9\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 right arrow curving left",
+		original: "\u21A9",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 left arrow curving right",
+		original: "\u21AA\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 left arrow curving right",
+		original: "\u21AA",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 right arrow curving up",
+		original: "\u2934\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 right arrow curving up",
+		original: "\u2934",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 right arrow curving down",
+		original: "\u2935\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 right arrow curving down",
+		original: "\u2935",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 clockwise vertical arrows",
+		original: "\U0001F503",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 counterclockwise arrows button",
+		original: "\U0001F504",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 BACK arrow",
+		original: "\U0001F519",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 END arrow",
+		original: "\U0001F51A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 ON! arrow",
+		original: "\U0001F51B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 SOON arrow",
+		original: "\U0001F51C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 TOP arrow",
+		original: "\U0001F51D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 place of worship",
+		original: "\U0001F6D0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 atom symbol",
+		original: "\u269B\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 atom symbol",
+		original: "\u269B",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 om",
+		original: "\U0001F549\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 om",
+		original: "\U0001F549",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 star of David",
+		original: "\u2721\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 star of David",
+		original: "\u2721",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 wheel of dharma",
+		original: "\u2638\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 wheel of dharma",
+		original: "\u2638",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 yin yang",
+		original: "\u262F\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 yin yang",
+		original: "\u262F",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 latin cross",
+		original: "\u271D\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 latin cross",
+		original: "\u271D",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 orthodox cross",
+		original: "\u2626\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 orthodox cross",
+		original: "\u2626",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 star and crescent",
+		original: "\u262A\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 star and crescent",
+		original: "\u262A",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 peace symbol",
+		original: "\u262E\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 peace symbol",
+		original: "\u262E",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 menorah",
+		original: "\U0001F54E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 dotted six-pointed star",
+		original: "\U0001F52F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 khanda",
+		original: "\U0001FAAF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Aries",
+		original: "\u2648",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Taurus",
+		original: "\u2649",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Gemini",
+		original: "\u264A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Cancer",
+		original: "\u264B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Leo",
+		original: "\u264C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Virgo",
+		original: "\u264D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Libra",
+		original: "\u264E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Scorpio",
+		original: "\u264F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Sagittarius",
+		original: "\u2650",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Capricorn",
+		original: "\u2651",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Aquarius",
+		original: "\u2652",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Pisces",
+		original: "\u2653",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Ophiuchus",
+		original: "\u26CE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 shuffle tracks button",
+		original: "\U0001F500",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 repeat button",
+		original: "\U0001F501",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 repeat single button",
+		original: "\U0001F502",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 play button",
+		original: "\u25B6\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 play button",
+		original: "\u25B6",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 fast-forward button",
+		original: "\u23E9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 next track button",
+		original: "\u23ED\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 next track button",
+		original: "\u23ED",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 play or pause button",
+		original: "\u23EF\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 play or pause button",
+		original: "\u23EF",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 reverse button",
+		original: "\u25C0\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 reverse button",
+		original: "\u25C0",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 fast reverse button",
+		original: "\u23EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 last track button",
+		original: "\u23EE\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 last track button",
+		original: "\u23EE",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 upwards button",
+		original: "\U0001F53C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 fast up button",
+		original: "\u23EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 downwards button",
+		original: "\U0001F53D",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 fast down button",
+		original: "\u23EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 pause button",
+		original: "\u23F8\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 pause button",
+		original: "\u23F8",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 stop button",
+		original: "\u23F9\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 stop button",
+		original: "\u23F9",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.7 record button",
+		original: "\u23FA\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 record button",
+		original: "\u23FA",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 eject button",
+		original: "\u23CF\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 eject button",
+		original: "\u23CF",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 cinema",
+		original: "\U0001F3A6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 dim button",
+		original: "\U0001F505",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 bright button",
+		original: "\U0001F506",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 antenna bars",
+		original: "\U0001F4F6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E15.0 wireless",
+		original: "\U0001F6DC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 vibration mode",
+		original: "\U0001F4F3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 mobile phone off",
+		original: "\U0001F4F4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 female sign",
+		original: "\u2640\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 female sign",
+		original: "\u2640",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 male sign",
+		original: "\u2642\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 male sign",
+		original: "\u2642",
+		status:   "unqualified",
+	},
+	{
+		name:     "E13.0 transgender symbol",
+		original: "\u26A7\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 transgender symbol",
+		original: "\u26A7",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 multiply",
+		original: "\u2716\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 multiply",
+		original: "\u2716",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 plus",
+		original: "\u2795",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 minus",
+		original: "\u2796",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 divide",
+		original: "\u2797",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E14.0 heavy equals sign",
+		original: "\U0001F7F0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 infinity",
+		original: "\u267E\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 infinity",
+		original: "\u267E",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 double exclamation mark",
+		original: "\u203C\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 double exclamation mark",
+		original: "\u203C",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 exclamation question mark",
+		original: "\u2049\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 exclamation question mark",
+		original: "\u2049",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 red question mark",
+		original: "\u2753",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 white question mark",
+		original: "\u2754",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 white exclamation mark",
+		original: "\u2755",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 red exclamation mark",
+		original: "\u2757",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 wavy dash",
+		original: "\u3030\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 wavy dash",
+		original: "\u3030",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 currency exchange",
+		original: "\U0001F4B1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 heavy dollar sign",
+		original: "\U0001F4B2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 medical symbol",
+		original: "\u2695\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 medical symbol",
+		original: "\u2695",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 recycling symbol",
+		original: "\u267B\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 recycling symbol",
+		original: "\u267B",
+		status:   "unqualified",
+	},
+	{
+		name:     "E1.0 fleur-de-lis",
+		original: "\u269C\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 fleur-de-lis",
+		original: "\u269C",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 trident emblem",
+		original: "\U0001F531",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 name badge",
+		original: "\U0001F4DB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese symbol for beginner",
+		original: "\U0001F530",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 hollow red circle",
+		original: "\u2B55",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 check mark button",
+		original: "\u2705",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 check box with check",
+		original: "\u2611\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 check box with check",
+		original: "\u2611",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 check mark",
+		original: "\u2714\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 check mark",
+		original: "\u2714",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 cross mark",
+		original: "\u274C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 cross mark button",
+		original: "\u274E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 curly loop",
+		original: "\u27B0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 double curly loop",
+		original: "\u27BF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 part alternation mark",
+		original: "\u303D\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 part alternation mark",
+		original: "\u303D",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 eight-spoked asterisk",
+		original: "\u2733\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 eight-spoked asterisk",
+		original: "\u2733",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 eight-pointed star",
+		original: "\u2734\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 eight-pointed star",
+		original: "\u2734",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 sparkle",
+		original: "\u2747\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 sparkle",
+		original: "\u2747",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 copyright",
+		original: "\u00A9\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 copyright",
+		original: "\u00A9",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 registered",
+		original: "\u00AE\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 registered",
+		original: "\u00AE",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 trade mark",
+		original: "\u2122\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 trade mark",
+		original: "\u2122",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 keycap: #",
+		original: "\u0023\uFE0F\u20E3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 keycap: #",
+		original: "\u0023\u20E3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E2.0 keycap: *",
+		original: "\u002A\uFE0F\u20E3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 keycap: *",
+		original: "\u002A\u20E3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 keycap: 0",
+		original: "\u0030\uFE0F\u20E3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 keycap: 0",
+		original: "\u0030\u20E3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 keycap: 1",
+		original: "\u0031\uFE0F\u20E3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 keycap: 1",
+		original: "\u0031\u20E3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 keycap: 2",
+		original: "\u0032\uFE0F\u20E3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 keycap: 2",
+		original: "\u0032\u20E3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 keycap: 3",
+		original: "\u0033\uFE0F\u20E3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 keycap: 3",
+		original: "\u0033\u20E3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 keycap: 4",
+		original: "\u0034\uFE0F\u20E3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 keycap: 4",
+		original: "\u0034\u20E3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 keycap: 5",
+		original: "\u0035\uFE0F\u20E3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 keycap: 5",
+		original: "\u0035\u20E3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 keycap: 6",
+		original: "\u0036\uFE0F\u20E3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 keycap: 6",
+		original: "\u0036\u20E3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 keycap: 7",
+		original: "\u0037\uFE0F\u20E3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 keycap: 7",
+		original: "\u0037\u20E3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 keycap: 8",
+		original: "\u0038\uFE0F\u20E3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 keycap: 8",
+		original: "\u0038\u20E3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 keycap: 9",
+		original: "\u0039\uFE0F\u20E3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 keycap: 9",
+		original: "\u0039\u20E3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 keycap: 10",
+		original: "\U0001F51F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 input latin uppercase",
+		original: "\U0001F520",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 input latin lowercase",
+		original: "\U0001F521",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 input numbers",
+		original: "\U0001F522",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 input symbols",
+		original: "\U0001F523",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 input latin letters",
+		original: "\U0001F524",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 A button (blood type)",
+		original: "\U0001F170\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 A button (blood type)",
+		original: "\U0001F170",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 AB button (blood type)",
+		original: "\U0001F18E",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 B button (blood type)",
+		original: "\U0001F171\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 B button (blood type)",
+		original: "\U0001F171",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 CL button",
+		original: "\U0001F191",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 COOL button",
+		original: "\U0001F192",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 FREE button",
+		original: "\U0001F193",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 information",
+		original: "\u2139\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 information",
+		original: "\u2139",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 ID button",
+		original: "\U0001F194",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 circled M",
+		original: "\u24C2\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 circled M",
+		original: "\u24C2",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 NEW button",
+		original: "\U0001F195",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 NG button",
+		original: "\U0001F196",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 O button (blood type)",
+		original: "\U0001F17E\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 O button (blood type)",
+		original: "\U0001F17E",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 OK button",
+		original: "\U0001F197",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 P button",
+		original: "\U0001F17F\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 P button",
+		original: "\U0001F17F",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 SOS button",
+		original: "\U0001F198",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 UP! button",
+		original: "\U0001F199",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 VS button",
+		original: "\U0001F19A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese “here” button",
+		original: "\U0001F201",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese “service charge” button",
+		original: "\U0001F202\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese “service charge” button",
+		original: "\U0001F202",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 Japanese “monthly amount” button",
+		original: "\U0001F237\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese “monthly amount” button",
+		original: "\U0001F237",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 Japanese “not free of charge” button",
+		original: "\U0001F236",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese “reserved” button",
+		original: "\U0001F22F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese “bargain” button",
+		original: "\U0001F250",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese “discount” button",
+		original: "\U0001F239",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese “free of charge” button",
+		original: "\U0001F21A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese “prohibited” button",
+		original: "\U0001F232",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese “acceptable” button",
+		original: "\U0001F251",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese “application” button",
+		original: "\U0001F238",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese “passing grade” button",
+		original: "\U0001F234",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese “vacancy” button",
+		original: "\U0001F233",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese “congratulations” button",
+		original: "\u3297\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese “congratulations” button",
+		original: "\u3297",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 Japanese “secret” button",
+		original: "\u3299\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese “secret” button",
+		original: "\u3299",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 Japanese “open for business” button",
+		original: "\U0001F23A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 Japanese “no vacancy” button",
+		original: "\U0001F235",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 red circle",
+		original: "\U0001F534",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 orange circle",
+		original: "\U0001F7E0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 yellow circle",
+		original: "\U0001F7E1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 green circle",
+		original: "\U0001F7E2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 blue circle",
+		original: "\U0001F535",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 purple circle",
+		original: "\U0001F7E3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 brown circle",
+		original: "\U0001F7E4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 black circle",
+		original: "\u26AB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 white circle",
+		original: "\u26AA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 red square",
+		original: "\U0001F7E5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 orange square",
+		original: "\U0001F7E7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 yellow square",
+		original: "\U0001F7E8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 green square",
+		original: "\U0001F7E9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 blue square",
+		original: "\U0001F7E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 purple square",
+		original: "\U0001F7EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E12.0 brown square",
+		original: "\U0001F7EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 black large square",
+		original: "\u2B1B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 white large square",
+		original: "\u2B1C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 black medium square",
+		original: "\u25FC\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 black medium square",
+		original: "\u25FC",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 white medium square",
+		original: "\u25FB\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 white medium square",
+		original: "\u25FB",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 black medium-small square",
+		original: "\u25FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 white medium-small square",
+		original: "\u25FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 black small square",
+		original: "\u25AA\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 black small square",
+		original: "\u25AA",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 white small square",
+		original: "\u25AB\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 white small square",
+		original: "\u25AB",
+		status:   "unqualified",
+	},
+	{
+		name:     "E0.6 large orange diamond",
+		original: "\U0001F536",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 large blue diamond",
+		original: "\U0001F537",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 small orange diamond",
+		original: "\U0001F538",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 small blue diamond",
+		original: "\U0001F539",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 red triangle pointed up",
+		original: "\U0001F53A",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 red triangle pointed down",
+		original: "\U0001F53B",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 diamond with a dot",
+		original: "\U0001F4A0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 radio button",
+		original: "\U0001F518",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 white square button",
+		original: "\U0001F533",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 black square button",
+		original: "\U0001F532",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 chequered flag",
+		original: "\U0001F3C1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 triangular flag",
+		original: "\U0001F6A9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 crossed flags",
+		original: "\U0001F38C",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E1.0 black flag",
+		original: "\U0001F3F4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 white flag",
+		original: "\U0001F3F3\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.7 white flag",
+		original: "\U0001F3F3",
+		status:   "unqualified",
+	},
+	{
+		name:     "E4.0 rainbow flag",
+		original: "\U0001F3F3\uFE0F\u200D\U0001F308",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 rainbow flag",
+		original: "\U0001F3F3\u200D\U0001F308",
+		status:   "unqualified",
+	},
+	{
+		name:     "E13.0 transgender flag",
+		original: "\U0001F3F3\uFE0F\u200D\u26A7\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E13.0 transgender flag",
+		original: "\U0001F3F3\u200D\u26A7\uFE0F",
+		status:   "unqualified",
+	},
+	{
+		name:     "E13.0 transgender flag",
+		original: "\U0001F3F3\uFE0F\u200D\u26A7",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E13.0 transgender flag",
+		original: "\U0001F3F3\u200D\u26A7",
+		status:   "unqualified",
+	},
+	{
+		name:     "E11.0 pirate flag",
+		original: "\U0001F3F4\u200D\u2620\uFE0F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E11.0 pirate flag",
+		original: "\U0001F3F4\u200D\u2620",
+		status:   "minimally-qualified",
+	},
+	{
+		name:     "E2.0 flag: Ascension Island",
+		original: "\U0001F1E6\U0001F1E8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Andorra",
+		original: "\U0001F1E6\U0001F1E9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: United Arab Emirates",
+		original: "\U0001F1E6\U0001F1EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Afghanistan",
+		original: "\U0001F1E6\U0001F1EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Antigua & Barbuda",
+		original: "\U0001F1E6\U0001F1EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Anguilla",
+		original: "\U0001F1E6\U0001F1EE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Albania",
+		original: "\U0001F1E6\U0001F1F1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Armenia",
+		original: "\U0001F1E6\U0001F1F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Angola",
+		original: "\U0001F1E6\U0001F1F4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Antarctica",
+		original: "\U0001F1E6\U0001F1F6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Argentina",
+		original: "\U0001F1E6\U0001F1F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: American Samoa",
+		original: "\U0001F1E6\U0001F1F8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Austria",
+		original: "\U0001F1E6\U0001F1F9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Australia",
+		original: "\U0001F1E6\U0001F1FA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Aruba",
+		original: "\U0001F1E6\U0001F1FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Åland Islands",
+		original: "\U0001F1E6\U0001F1FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Azerbaijan",
+		original: "\U0001F1E6\U0001F1FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Bosnia & Herzegovina",
+		original: "\U0001F1E7\U0001F1E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Barbados",
+		original: "\U0001F1E7\U0001F1E7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Bangladesh",
+		original: "\U0001F1E7\U0001F1E9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Belgium",
+		original: "\U0001F1E7\U0001F1EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Burkina Faso",
+		original: "\U0001F1E7\U0001F1EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Bulgaria",
+		original: "\U0001F1E7\U0001F1EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Bahrain",
+		original: "\U0001F1E7\U0001F1ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Burundi",
+		original: "\U0001F1E7\U0001F1EE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Benin",
+		original: "\U0001F1E7\U0001F1EF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: St. Barthélemy",
+		original: "\U0001F1E7\U0001F1F1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Bermuda",
+		original: "\U0001F1E7\U0001F1F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Brunei",
+		original: "\U0001F1E7\U0001F1F3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Bolivia",
+		original: "\U0001F1E7\U0001F1F4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Caribbean Netherlands",
+		original: "\U0001F1E7\U0001F1F6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Brazil",
+		original: "\U0001F1E7\U0001F1F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Bahamas",
+		original: "\U0001F1E7\U0001F1F8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Bhutan",
+		original: "\U0001F1E7\U0001F1F9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Bouvet Island",
+		original: "\U0001F1E7\U0001F1FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Botswana",
+		original: "\U0001F1E7\U0001F1FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Belarus",
+		original: "\U0001F1E7\U0001F1FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Belize",
+		original: "\U0001F1E7\U0001F1FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Canada",
+		original: "\U0001F1E8\U0001F1E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Cocos (Keeling) Islands",
+		original: "\U0001F1E8\U0001F1E8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Congo - Kinshasa",
+		original: "\U0001F1E8\U0001F1E9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Central African Republic",
+		original: "\U0001F1E8\U0001F1EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Congo - Brazzaville",
+		original: "\U0001F1E8\U0001F1EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Switzerland",
+		original: "\U0001F1E8\U0001F1ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Côte d’Ivoire",
+		original: "\U0001F1E8\U0001F1EE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Cook Islands",
+		original: "\U0001F1E8\U0001F1F0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Chile",
+		original: "\U0001F1E8\U0001F1F1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Cameroon",
+		original: "\U0001F1E8\U0001F1F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 flag: China",
+		original: "\U0001F1E8\U0001F1F3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Colombia",
+		original: "\U0001F1E8\U0001F1F4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Clipperton Island",
+		original: "\U0001F1E8\U0001F1F5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Costa Rica",
+		original: "\U0001F1E8\U0001F1F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Cuba",
+		original: "\U0001F1E8\U0001F1FA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Cape Verde",
+		original: "\U0001F1E8\U0001F1FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Curaçao",
+		original: "\U0001F1E8\U0001F1FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Christmas Island",
+		original: "\U0001F1E8\U0001F1FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Cyprus",
+		original: "\U0001F1E8\U0001F1FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Czechia",
+		original: "\U0001F1E8\U0001F1FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 flag: Germany",
+		original: "\U0001F1E9\U0001F1EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Diego Garcia",
+		original: "\U0001F1E9\U0001F1EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Djibouti",
+		original: "\U0001F1E9\U0001F1EF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Denmark",
+		original: "\U0001F1E9\U0001F1F0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Dominica",
+		original: "\U0001F1E9\U0001F1F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Dominican Republic",
+		original: "\U0001F1E9\U0001F1F4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Algeria",
+		original: "\U0001F1E9\U0001F1FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Ceuta & Melilla",
+		original: "\U0001F1EA\U0001F1E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Ecuador",
+		original: "\U0001F1EA\U0001F1E8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Estonia",
+		original: "\U0001F1EA\U0001F1EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Egypt",
+		original: "\U0001F1EA\U0001F1EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Western Sahara",
+		original: "\U0001F1EA\U0001F1ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Eritrea",
+		original: "\U0001F1EA\U0001F1F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 flag: Spain",
+		original: "\U0001F1EA\U0001F1F8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Ethiopia",
+		original: "\U0001F1EA\U0001F1F9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: European Union",
+		original: "\U0001F1EA\U0001F1FA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Finland",
+		original: "\U0001F1EB\U0001F1EE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Fiji",
+		original: "\U0001F1EB\U0001F1EF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Falkland Islands",
+		original: "\U0001F1EB\U0001F1F0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Micronesia",
+		original: "\U0001F1EB\U0001F1F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Faroe Islands",
+		original: "\U0001F1EB\U0001F1F4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 flag: France",
+		original: "\U0001F1EB\U0001F1F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Gabon",
+		original: "\U0001F1EC\U0001F1E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 flag: United Kingdom",
+		original: "\U0001F1EC\U0001F1E7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Grenada",
+		original: "\U0001F1EC\U0001F1E9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Georgia",
+		original: "\U0001F1EC\U0001F1EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: French Guiana",
+		original: "\U0001F1EC\U0001F1EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Guernsey",
+		original: "\U0001F1EC\U0001F1EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Ghana",
+		original: "\U0001F1EC\U0001F1ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Gibraltar",
+		original: "\U0001F1EC\U0001F1EE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Greenland",
+		original: "\U0001F1EC\U0001F1F1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Gambia",
+		original: "\U0001F1EC\U0001F1F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Guinea",
+		original: "\U0001F1EC\U0001F1F3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Guadeloupe",
+		original: "\U0001F1EC\U0001F1F5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Equatorial Guinea",
+		original: "\U0001F1EC\U0001F1F6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Greece",
+		original: "\U0001F1EC\U0001F1F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: South Georgia & South Sandwich Islands",
+		original: "\U0001F1EC\U0001F1F8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Guatemala",
+		original: "\U0001F1EC\U0001F1F9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Guam",
+		original: "\U0001F1EC\U0001F1FA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Guinea-Bissau",
+		original: "\U0001F1EC\U0001F1FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Guyana",
+		original: "\U0001F1EC\U0001F1FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Hong Kong SAR China",
+		original: "\U0001F1ED\U0001F1F0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Heard & McDonald Islands",
+		original: "\U0001F1ED\U0001F1F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Honduras",
+		original: "\U0001F1ED\U0001F1F3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Croatia",
+		original: "\U0001F1ED\U0001F1F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Haiti",
+		original: "\U0001F1ED\U0001F1F9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Hungary",
+		original: "\U0001F1ED\U0001F1FA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Canary Islands",
+		original: "\U0001F1EE\U0001F1E8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Indonesia",
+		original: "\U0001F1EE\U0001F1E9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Ireland",
+		original: "\U0001F1EE\U0001F1EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Israel",
+		original: "\U0001F1EE\U0001F1F1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Isle of Man",
+		original: "\U0001F1EE\U0001F1F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: India",
+		original: "\U0001F1EE\U0001F1F3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: British Indian Ocean Territory",
+		original: "\U0001F1EE\U0001F1F4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Iraq",
+		original: "\U0001F1EE\U0001F1F6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Iran",
+		original: "\U0001F1EE\U0001F1F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Iceland",
+		original: "\U0001F1EE\U0001F1F8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 flag: Italy",
+		original: "\U0001F1EE\U0001F1F9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Jersey",
+		original: "\U0001F1EF\U0001F1EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Jamaica",
+		original: "\U0001F1EF\U0001F1F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Jordan",
+		original: "\U0001F1EF\U0001F1F4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 flag: Japan",
+		original: "\U0001F1EF\U0001F1F5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Kenya",
+		original: "\U0001F1F0\U0001F1EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Kyrgyzstan",
+		original: "\U0001F1F0\U0001F1EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Cambodia",
+		original: "\U0001F1F0\U0001F1ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Kiribati",
+		original: "\U0001F1F0\U0001F1EE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Comoros",
+		original: "\U0001F1F0\U0001F1F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: St. Kitts & Nevis",
+		original: "\U0001F1F0\U0001F1F3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: North Korea",
+		original: "\U0001F1F0\U0001F1F5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 flag: South Korea",
+		original: "\U0001F1F0\U0001F1F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Kuwait",
+		original: "\U0001F1F0\U0001F1FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Cayman Islands",
+		original: "\U0001F1F0\U0001F1FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Kazakhstan",
+		original: "\U0001F1F0\U0001F1FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Laos",
+		original: "\U0001F1F1\U0001F1E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Lebanon",
+		original: "\U0001F1F1\U0001F1E7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: St. Lucia",
+		original: "\U0001F1F1\U0001F1E8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Liechtenstein",
+		original: "\U0001F1F1\U0001F1EE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Sri Lanka",
+		original: "\U0001F1F1\U0001F1F0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Liberia",
+		original: "\U0001F1F1\U0001F1F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Lesotho",
+		original: "\U0001F1F1\U0001F1F8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Lithuania",
+		original: "\U0001F1F1\U0001F1F9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Luxembourg",
+		original: "\U0001F1F1\U0001F1FA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Latvia",
+		original: "\U0001F1F1\U0001F1FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Libya",
+		original: "\U0001F1F1\U0001F1FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Morocco",
+		original: "\U0001F1F2\U0001F1E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Monaco",
+		original: "\U0001F1F2\U0001F1E8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Moldova",
+		original: "\U0001F1F2\U0001F1E9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Montenegro",
+		original: "\U0001F1F2\U0001F1EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: St. Martin",
+		original: "\U0001F1F2\U0001F1EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Madagascar",
+		original: "\U0001F1F2\U0001F1EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Marshall Islands",
+		original: "\U0001F1F2\U0001F1ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: North Macedonia",
+		original: "\U0001F1F2\U0001F1F0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Mali",
+		original: "\U0001F1F2\U0001F1F1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Myanmar (Burma)",
+		original: "\U0001F1F2\U0001F1F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Mongolia",
+		original: "\U0001F1F2\U0001F1F3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Macao SAR China",
+		original: "\U0001F1F2\U0001F1F4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Northern Mariana Islands",
+		original: "\U0001F1F2\U0001F1F5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Martinique",
+		original: "\U0001F1F2\U0001F1F6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Mauritania",
+		original: "\U0001F1F2\U0001F1F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Montserrat",
+		original: "\U0001F1F2\U0001F1F8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Malta",
+		original: "\U0001F1F2\U0001F1F9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Mauritius",
+		original: "\U0001F1F2\U0001F1FA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Maldives",
+		original: "\U0001F1F2\U0001F1FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Malawi",
+		original: "\U0001F1F2\U0001F1FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Mexico",
+		original: "\U0001F1F2\U0001F1FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Malaysia",
+		original: "\U0001F1F2\U0001F1FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Mozambique",
+		original: "\U0001F1F2\U0001F1FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Namibia",
+		original: "\U0001F1F3\U0001F1E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: New Caledonia",
+		original: "\U0001F1F3\U0001F1E8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Niger",
+		original: "\U0001F1F3\U0001F1EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Norfolk Island",
+		original: "\U0001F1F3\U0001F1EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Nigeria",
+		original: "\U0001F1F3\U0001F1EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Nicaragua",
+		original: "\U0001F1F3\U0001F1EE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Netherlands",
+		original: "\U0001F1F3\U0001F1F1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Norway",
+		original: "\U0001F1F3\U0001F1F4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Nepal",
+		original: "\U0001F1F3\U0001F1F5",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Nauru",
+		original: "\U0001F1F3\U0001F1F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Niue",
+		original: "\U0001F1F3\U0001F1FA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: New Zealand",
+		original: "\U0001F1F3\U0001F1FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Oman",
+		original: "\U0001F1F4\U0001F1F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Panama",
+		original: "\U0001F1F5\U0001F1E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Peru",
+		original: "\U0001F1F5\U0001F1EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: French Polynesia",
+		original: "\U0001F1F5\U0001F1EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Papua New Guinea",
+		original: "\U0001F1F5\U0001F1EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Philippines",
+		original: "\U0001F1F5\U0001F1ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Pakistan",
+		original: "\U0001F1F5\U0001F1F0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Poland",
+		original: "\U0001F1F5\U0001F1F1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: St. Pierre & Miquelon",
+		original: "\U0001F1F5\U0001F1F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Pitcairn Islands",
+		original: "\U0001F1F5\U0001F1F3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Puerto Rico",
+		original: "\U0001F1F5\U0001F1F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Palestinian Territories",
+		original: "\U0001F1F5\U0001F1F8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Portugal",
+		original: "\U0001F1F5\U0001F1F9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Palau",
+		original: "\U0001F1F5\U0001F1FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Paraguay",
+		original: "\U0001F1F5\U0001F1FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Qatar",
+		original: "\U0001F1F6\U0001F1E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Réunion",
+		original: "\U0001F1F7\U0001F1EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Romania",
+		original: "\U0001F1F7\U0001F1F4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Serbia",
+		original: "\U0001F1F7\U0001F1F8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 flag: Russia",
+		original: "\U0001F1F7\U0001F1FA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Rwanda",
+		original: "\U0001F1F7\U0001F1FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Saudi Arabia",
+		original: "\U0001F1F8\U0001F1E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Solomon Islands",
+		original: "\U0001F1F8\U0001F1E7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Seychelles",
+		original: "\U0001F1F8\U0001F1E8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Sudan",
+		original: "\U0001F1F8\U0001F1E9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Sweden",
+		original: "\U0001F1F8\U0001F1EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Singapore",
+		original: "\U0001F1F8\U0001F1EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: St. Helena",
+		original: "\U0001F1F8\U0001F1ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Slovenia",
+		original: "\U0001F1F8\U0001F1EE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Svalbard & Jan Mayen",
+		original: "\U0001F1F8\U0001F1EF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Slovakia",
+		original: "\U0001F1F8\U0001F1F0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Sierra Leone",
+		original: "\U0001F1F8\U0001F1F1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: San Marino",
+		original: "\U0001F1F8\U0001F1F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Senegal",
+		original: "\U0001F1F8\U0001F1F3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Somalia",
+		original: "\U0001F1F8\U0001F1F4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Suriname",
+		original: "\U0001F1F8\U0001F1F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: South Sudan",
+		original: "\U0001F1F8\U0001F1F8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: São Tomé & Príncipe",
+		original: "\U0001F1F8\U0001F1F9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: El Salvador",
+		original: "\U0001F1F8\U0001F1FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Sint Maarten",
+		original: "\U0001F1F8\U0001F1FD",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Syria",
+		original: "\U0001F1F8\U0001F1FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Eswatini",
+		original: "\U0001F1F8\U0001F1FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Tristan da Cunha",
+		original: "\U0001F1F9\U0001F1E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Turks & Caicos Islands",
+		original: "\U0001F1F9\U0001F1E8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Chad",
+		original: "\U0001F1F9\U0001F1E9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: French Southern Territories",
+		original: "\U0001F1F9\U0001F1EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Togo",
+		original: "\U0001F1F9\U0001F1EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Thailand",
+		original: "\U0001F1F9\U0001F1ED",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Tajikistan",
+		original: "\U0001F1F9\U0001F1EF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Tokelau",
+		original: "\U0001F1F9\U0001F1F0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Timor-Leste",
+		original: "\U0001F1F9\U0001F1F1",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Turkmenistan",
+		original: "\U0001F1F9\U0001F1F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Tunisia",
+		original: "\U0001F1F9\U0001F1F3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Tonga",
+		original: "\U0001F1F9\U0001F1F4",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Türkiye",
+		original: "\U0001F1F9\U0001F1F7",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Trinidad & Tobago",
+		original: "\U0001F1F9\U0001F1F9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Tuvalu",
+		original: "\U0001F1F9\U0001F1FB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Taiwan",
+		original: "\U0001F1F9\U0001F1FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Tanzania",
+		original: "\U0001F1F9\U0001F1FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Ukraine",
+		original: "\U0001F1FA\U0001F1E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Uganda",
+		original: "\U0001F1FA\U0001F1EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: U.S. Outlying Islands",
+		original: "\U0001F1FA\U0001F1F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E4.0 flag: United Nations",
+		original: "\U0001F1FA\U0001F1F3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E0.6 flag: United States",
+		original: "\U0001F1FA\U0001F1F8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Uruguay",
+		original: "\U0001F1FA\U0001F1FE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Uzbekistan",
+		original: "\U0001F1FA\U0001F1FF",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Vatican City",
+		original: "\U0001F1FB\U0001F1E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: St. Vincent & Grenadines",
+		original: "\U0001F1FB\U0001F1E8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Venezuela",
+		original: "\U0001F1FB\U0001F1EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: British Virgin Islands",
+		original: "\U0001F1FB\U0001F1EC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: U.S. Virgin Islands",
+		original: "\U0001F1FB\U0001F1EE",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Vietnam",
+		original: "\U0001F1FB\U0001F1F3",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Vanuatu",
+		original: "\U0001F1FB\U0001F1FA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Wallis & Futuna",
+		original: "\U0001F1FC\U0001F1EB",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Samoa",
+		original: "\U0001F1FC\U0001F1F8",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Kosovo",
+		original: "\U0001F1FD\U0001F1F0",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Yemen",
+		original: "\U0001F1FE\U0001F1EA",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Mayotte",
+		original: "\U0001F1FE\U0001F1F9",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: South Africa",
+		original: "\U0001F1FF\U0001F1E6",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Zambia",
+		original: "\U0001F1FF\U0001F1F2",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E2.0 flag: Zimbabwe",
+		original: "\U0001F1FF\U0001F1FC",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 flag: England",
+		original: "\U0001F3F4\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 flag: Scotland",
+		original: "\U0001F3F4\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F",
+		status:   "fully-qualified",
+	},
+	{
+		name:     "E5.0 flag: Wales",
+		original: "\U0001F3F4\U000E0067\U000E0062\U000E0077\U000E006C\U000E0073\U000E007F",
+		status:   "fully-qualified",
+	},
+}
@@ -336,3 +336,218 @@ func ExampleStringWidth() {
 	fmt.Println(uniseg.StringWidth("Hello, 世界"))
 	// Output: 11
 }
+
+func ExampleScriptRuns() {
+	runs := uniseg.NewScriptRuns("Hello, Ελληνικά (abc) мир!")
+	for runs.Next() {
+		fmt.Printf("%s %q\n", runs.Script().Code(), runs.Str())
+	}
+	// Output:
+	// Latn "Hello, "
+	// Grek "Ελληνικά ("
+	// Latn "abc"
+	// Grek ") "
+	// Cyrl "мир!"
+}
+
+func ExampleWordDictionary() {
+	p := &uniseg.Parser{
+		ComplexContext: uniseg.NewWordDictionary([]string{"ภาษา", "ไทย", "ง่าย", "นิด", "เดียว", "นิดเดียว"}),
+	}
+	str := "ภาษาไทยง่ายนิดเดียว!"
+	var (
+		word  string
+		state uniseg.WordBreakState
+	)
+	for len(str) > 0 {
+		word, str, state = p.FirstWordInString(str, state)
+		fmt.Printf("(%s)", word)
+	}
+	fmt.Println()
+	// Output: (ภาษา)(ไทย)(ง่าย)(นิดเดียว)(!)
+}
+
+func ExampleLexicon() {
+	p := &uniseg.Parser{
+		CJK: uniseg.NewLexicon(map[string]int{"研究": 100, "研究生": 20, "生命": 50, "命": 5, "起源": 30}),
+	}
+	g := p.NewGraphemes("研究生命起源")
+	for g.Next() {
+		fmt.Print(g.Str())
+		if g.IsWordBoundary() {
+			fmt.Print("|")
+		}
+	}
+	fmt.Println()
+	// Output: 研究|生命|起源|
+}
+
+func ExampleAbbreviations() {
+	p := &uniseg.Parser{Abbreviations: uniseg.LocaleAbbreviations("en")}
+	str := "Mr. Smith arrived at 5 p.m. yesterday. He left early."
+	var (
+		sentence string
+		state    uniseg.SentenceBreakState
+	)
+	for len(str) > 0 {
+		sentence, str, state = p.FirstSentenceInString(str, state)
+		fmt.Printf("(%s)\n", sentence)
+	}
+	// Output:
+	// (Mr. Smith arrived at 5 p.m. yesterday. )
+	// (He left early.)
+}
+
+func ExampleParser_FirstTokenInString() {
+	p := &uniseg.Parser{Tokens: uniseg.AllTokens}
+	str := "Ask @alice: https://example.com/a?b=c #go-lang"
+	var (
+		word  string
+		kind  uniseg.TokenKind
+		state uniseg.WordBreakState
+	)
+	for len(str) > 0 {
+		word, str, kind, state = p.FirstTokenInString(str, state)
+		if kind != uniseg.TokenNone {
+			fmt.Printf("%s %s\n", kind, word)
+		}
+	}
+	// Output:
+	// mention @alice
+	// url https://example.com/a?b=c
+	// hashtag #go-lang
+}
+
+func ExampleHyphenator() {
+	h, err := uniseg.NewHyphenator([]string{"hy3ph", "he2n", "hena4", "hen5at", "1na", "n2at", "1tio", "2io", "o2n"}, nil)
+	if err != nil {
+		panic(err)
+	}
+	p := &uniseg.Parser{Hyphens: uniseg.HyphensAuto, Hyphenator: h}
+	g := p.NewGraphemes("Hyphenation rules")
+	for g.Next() {
+		fmt.Print(g.Str())
+		switch g.LineBreak() {
+		case uniseg.LineHyphenBreak:
+			fmt.Print("-|")
+		case uniseg.LineCanBreak:
+			fmt.Print("|")
+		}
+	}
+	fmt.Println()
+	// Output:
+	// Hy-|phen-|ation |rules
+}
+
+func ExampleSubstringGraphemes() {
+	str := "Käse 🇩🇪🏳️‍🌈!"
+	fmt.Println(uniseg.SubstringGraphemes(str, 0, 4))
+	fmt.Println(uniseg.SubstringGraphemes(str, -3, -1))
+	fmt.Println(uniseg.GraphemeAt(str, 5))
+	// Output:
+	// Käse
+	// 🇩🇪🏳️‍🌈
+	// 🇩🇪
+}
+
+func ExampleTruncateBytes() {
+	str := "Flags: 🇩🇪🇫🇷"
+	fmt.Printf("%q\n", str[:17])
+	fmt.Printf("%q\n", uniseg.TruncateBytes(str, 17))
+	fmt.Printf("%q\n", uniseg.TruncateBytesAt("Hello, world!", 10, uniseg.TruncateWords))
+	// Output:
+	// "Flags: 🇩🇪\xf0\x9f"
+	// "Flags: 🇩🇪"
+	// "Hello, "
+}
+
+func ExampleChunkText() {
+	str := "The first paragraph. It has two sentences.\n\nThe second paragraph is a bit longer than the first one."
+	for _, chunk := range uniseg.ChunkText(str, uniseg.ChunkOptions{MaxSize: 50}) {
+		fmt.Printf("%q\n", str[chunk.Start:chunk.End])
+	}
+	// Output:
+	// "The first paragraph. It has two sentences.\n\n"
+	// "The second paragraph is a bit longer than the "
+	// "first one."
+}
+
+func ExampleEditor() {
+	e := uniseg.NewEditor("Flags 🇩🇪🇫🇷 are fun.")
+	e.WordRight(false)
+	e.Right(false)
+	e.Right(false)
+	e.Right(false)
+	e.Backspace()
+	fmt.Println(e.Text())
+	e.SelectWord(len(e.Text()) - 2)
+	start, end := e.Selection()
+	fmt.Println(e.Text()[start:end])
+	// Output:
+	// Flags 🇩🇪 are fun.
+	// fun
+}
+
+func ExampleWrapOptimal() {
+	str := "aaa bb cc ddddd"
+	opts := uniseg.WrapOptions{Width: 6}
+	for _, lines := range [][]uniseg.Line{uniseg.WrapGreedy(str, opts), uniseg.WrapOptimal(str, opts)} {
+		var texts []string
+		for _, line := range lines {
+			texts = append(texts, str[line.Start:line.End])
+		}
+		fmt.Printf("%q\n", texts)
+	}
+	// Output:
+	// ["aaa bb " "cc " "ddddd"]
+	// ["aaa " "bb cc " "ddddd"]
+}
+
+func ExampleWrapBalanced() {
+	str := "The quick brown fox jumped"
+	for _, line := range uniseg.WrapBalanced(str, uniseg.WrapOptions{Width: 20}) {
+		fmt.Printf("%q\n", str[line.Start:line.End])
+	}
+	// Output:
+	// "The quick brown "
+	// "fox jumped"
+}
+
+func ExampleOverflow() {
+	str := "Commit 0123456789abcdef0123456789abcdef"
+	for _, overflow := range []uniseg.Overflow{uniseg.OverflowBreakWord, uniseg.OverflowEllipsis} {
+		var texts []string
+		for _, line := range uniseg.WrapGreedy(str, uniseg.WrapOptions{Width: 16, Overflow: overflow}) {
+			text := str[line.Start:line.End]
+			if line.Ellipsis {
+				text += "…"
+			}
+			texts = append(texts, text)
+		}
+		fmt.Printf("%q\n", texts)
+	}
+	// Output:
+	// ["Commit " "0123456789abcdef" "0123456789abcdef"]
+	// ["Commit " "0123456789abcde…"]
+}
+
+func ExampleHardLinesInString() {
+	str := "One\r\nTwo\u2028Three\u2029Four"
+	fmt.Printf("%q\n", uniseg.HardLinesInString(str))
+	fmt.Printf("%q\n", uniseg.NormalizeNewlinesInString(str, "\n"))
+	// Output:
+	// ["One\r\n" "Two\u2028" "Three\u2029" "Four"]
+	// "One\nTwo\nThree\nFour"
+}
+
+func ExampleBidi() {
+	str := "The title is \"שלום עולם\" (Hello World).\nשלום (עולם) 123!"
+	bidi := uniseg.NewBidi(str, uniseg.DirectionAuto)
+	for _, line := range uniseg.WrapGreedy(str, uniseg.WrapOptions{Width: 30}) {
+		fmt.Printf("%d %q\n", bidi.ParagraphLevel(line.Start), bidi.VisualLine(line.Start, line.End))
+	}
+	// Output:
+	// 0 "The title is \"םלוע םולש\" "
+	// 0 "(Hello World).\n"
+	// 1 "!123 (םלוע) םולש"
+}
@@ -39,6 +39,13 @@ type Graphemes struct {
 
 	// The current state of the [Step] parser.
 	state State
+
+	// The hyphenation points of the current word, which don't fit into the
+	// state.
+	hyphens hyphenationPoints
+
+	// The script runs of the original string, created by [Graphemes.Script].
+	scriptRuns *ScriptRuns
 }
 
 // NewGraphemes returns a new grapheme cluster iterator.
@@ -69,7 +76,7 @@ func (g *Graphemes) Next() bool {
 		return false
 	}
 	g.offset += len(g.cluster)
-	g.cluster, g.remaining, g.boundaries, g.state = step(g.parser, g.remaining, g.state, utf8.DecodeRuneInString)
+	g.cluster, g.remaining, g.boundaries, g.state = step(g.parser, g.remaining, g.state, &g.hyphens, utf8.DecodeRuneInString)
 	return true
 }
 
@@ -135,8 +142,9 @@ func (g *Graphemes) IsSentenceBoundary() bool {
 
 // LineBreak returns whether the line can be broken after the current grapheme
 // cluster. A value of [LineDontBreak] means the line may not be broken, a value
-// of [LineMustBreak] means the line must be broken, and a value of
-// [LineCanBreak] means the line may or may not be broken.
+// of [LineMustBreak] means the line must be broken, a value of
+// [LineCanBreak] means the line may or may not be broken, and a value of
+// [LineHyphenBreak] means the line may be broken with a hyphen.
 func (g *Graphemes) LineBreak() LineBreak {
 	if g.state == -1 {
 		return LineDontBreak
@@ -155,6 +163,30 @@ func (g *Graphemes) Width() int {
 	return g.boundaries.Width()
 }
 
+// Script returns the script of the current grapheme cluster. The script is
+// resolved from the context of the cluster in the same way as by [ScriptRuns],
+// i.e. it is the script of the script run containing the cluster. If the
+// iterator is already past the end or [Graphemes.Next] has not yet been called,
+// [ScriptUnknown] is returned.
+func (g *Graphemes) Script() Script {
+	if g.state <= 0 {
+		return ScriptUnknown
+	}
+	if g.scriptRuns == nil {
+		g.scriptRuns = g.parser.NewScriptRuns(g.original)
+		g.scriptRuns.Next()
+	} else if from, _ := g.scriptRuns.Positions(); g.offset < from {
+		g.scriptRuns.Reset()
+		g.scriptRuns.Next()
+	}
+	for {
+		if _, to := g.scriptRuns.Positions(); g.offset < to || !g.scriptRuns.Next() {
+			break
+		}
+	}
+	return g.scriptRuns.Script()
+}
+
 // Reset puts the iterator into its initial state such that the next call to
 // [Graphemes.Next] sets it to the first grapheme cluster again.
 func (g *Graphemes) Reset() {
@@ -314,13 +346,14 @@ func firstGraphemeCluster[T bytes](p *Parser, str T, state GraphemeBreakState, d
 	var myState grState
 	var firstProp property
 	if state <= 0 {
-		myState, firstProp, _ = transitionGraphemeState(myState, r)
+		myState, firstProp, _ = transitionGraphemeState(p, myState, r)
 	} else {
 		myState, firstProp = state.unpack()
 	}
 	width += runeWidth(p, r, firstProp)
 
 	// Transition until we find a boundary.
+	prev := r
 	for {
 		var (
 			prop     property
@@ -328,21 +361,14 @@ func firstGraphemeCluster[T bytes](p *Parser, str T, state GraphemeBreakState, d
 		)
 
 		r, l := decoder(str[length:])
-		myState, prop, boundary = transitionGraphemeState(myState, r)
+		myState, prop, boundary = transitionGraphemeState(p, myState, r)
 
 		if boundary {
 			return str[:length], str[length:], width, newGraphemeBreakState(myState, prop)
 		}
 
-		if firstProp == prExtendedPictographic {
-			if r == vs15 {
-				width = 1
-			} else if r == vs16 {
-				width = 2
-			}
-		} else if firstProp != prRegionalIndicator && firstProp != prL {
-			width += runeWidth(p, r, prop)
-		}
+		width = clusterWidth(p, width, firstProp, prev, r, prop)
+		prev = r
 
 		length += l
 		if len(str) <= length {
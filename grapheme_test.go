@@ -1,6 +1,7 @@
 package uniseg
 
 import (
+	"reflect"
 	"runtime"
 	"testing"
 	"unicode/utf8"
@@ -336,6 +337,83 @@ func TestGraphemesCount(t *testing.T) {
 	}
 }
 
+// legacyTestCases are texts with their expected legacy grapheme clusters.
+var legacyTestCases = []testCase{
+	{name: "basic", original: "mo\u0308p", expected: [][]rune{{0x6d}, {0x6f, 0x308}, {0x70}}},
+	{name: "CRLF", original: "\r\n", expected: [][]rune{{0xd, 0xa}}},
+	{name: "Hangul", original: "\u1105\u116c\u11ab", expected: [][]rune{{0x1105, 0x116c, 0x11ab}}},
+	{name: "emoji", original: "🏳️\u200d🌈🇩🇪", expected: [][]rune{{0x1f3f3, 0xfe0f, 0x200d, 0x1f308}, {0x1f1e9, 0x1f1ea}}},
+	{name: "GB9a", original: "ำำ", expected: [][]rune{{0xe33}, {0xe33}}},
+	{name: "GB9a after base", original: "สระอำ", expected: [][]rune{{0xe2a}, {0xe23}, {0xe30}, {0xe2d}, {0xe33}}},
+	{name: "GB9b", original: "ܐ\u070fܒܓܕ", expected: [][]rune{{0x710}, {0x70f}, {0x712}, {0x713}, {0x715}}},
+	{name: "GB9c", original: "क्षि", expected: [][]rune{{0x915, 0x94d}, {0x937}, {0x93f}}},
+	{name: "GB9c with extend", original: "क\u094d\u200dष", expected: [][]rune{{0x915, 0x94d, 0x200d}, {0x937}}},
+}
+
+// Test legacy grapheme clusters with all functions.
+func TestLegacyGraphemeClusters(t *testing.T) {
+	p := &Parser{LegacyGraphemeClusters: true}
+	for _, tt := range legacyTestCases {
+		// FirstGraphemeClusterInString.
+		var (
+			got   [][]rune
+			c     string
+			state GraphemeBreakState
+		)
+		str := tt.original
+		for len(str) > 0 {
+			c, str, _, state = p.FirstGraphemeClusterInString(str, state)
+			got = append(got, []rune(c))
+		}
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%s: FirstGraphemeClusterInString(%q) = %x, want %x", tt.name, tt.original, got, tt.expected)
+		}
+
+		// FirstGraphemeCluster.
+		got, state = nil, 0
+		b := []byte(tt.original)
+		for len(b) > 0 {
+			var cluster []byte
+			cluster, b, _, state = p.FirstGraphemeCluster(b, state)
+			got = append(got, []rune(string(cluster)))
+		}
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%s: FirstGraphemeCluster(%q) = %x, want %x", tt.name, tt.original, got, tt.expected)
+		}
+
+		// StepString.
+		got = nil
+		var stepState State
+		for str = tt.original; len(str) > 0; {
+			c, str, _, stepState = p.StepString(str, stepState)
+			got = append(got, []rune(c))
+		}
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%s: StepString(%q) = %x, want %x", tt.name, tt.original, got, tt.expected)
+		}
+
+		// Graphemes.
+		got = nil
+		g := p.NewGraphemes(tt.original)
+		for g.Next() {
+			got = append(got, g.Runes())
+		}
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%s: Graphemes(%q) = %x, want %x", tt.name, tt.original, got, tt.expected)
+		}
+
+		// GraphemeClusterCount.
+		if n := p.GraphemeClusterCount(tt.original); n != len(tt.expected) {
+			t.Errorf("%s: GraphemeClusterCount(%q) = %d, want %d", tt.name, tt.original, n, len(tt.expected))
+		}
+	}
+
+	// Extended grapheme clusters are longer.
+	if n := GraphemeClusterCount("क्षि"); n != 1 {
+		t.Errorf("GraphemeClusterCount of an Indic conjunct = %d, want 1", n)
+	}
+}
+
 // Test the ReverseString function.
 func TestReverseString(t *testing.T) {
 	for _, testCase := range testCases {
@@ -120,16 +120,29 @@ var grTransitions = [grMax * prMax]grTransitionResult{
 // transitionGraphemeState determines the new state of the grapheme cluster
 // parser given the current state and the next code point. It also returns the
 // code point's grapheme property (the value mapped by the [graphemeCodePoints]
-// table) and whether a cluster boundary was detected.
-func transitionGraphemeState(state grState, r rune) (newState grState, prop property, boundary bool) {
+// table) and whether a cluster boundary was detected. If the parser's
+// LegacyGraphemeClusters field is set, rules GB9a, GB9b, and GB9c are not
+// applied.
+func transitionGraphemeState(p *Parser, state grState, r rune) (newState grState, prop property, boundary bool) {
 	// Determine the property of the next character.
 	prop = graphemeCodePoints.search(r)
-	incbProp := incb.search(r)
+	legacy := p != nil && p.LegacyGraphemeClusters
+	var incbProp incbProperty
+	if !legacy {
+		incbProp = incb.search(r)
+	}
+
+	// Legacy grapheme clusters treat SpacingMark and Prepend like any other
+	// character.
+	transitionProp := prop
+	if legacy && (prop == prSpacingMark || prop == prPrepend) {
+		transitionProp = prAny
+	}
 
 	// Find the applicable transition.
 	gb9cState := state & grGB9cStateMask
 	state &= grStateMask
-	transition := grTransitions[int(state)*prMax+int(prop)]
+	transition := grTransitions[int(state)*prMax+int(transitionProp)]
 	ruleNumber := 0
 	if transition.ruleNumber > 0 {
 		// We have a specific transition.
@@ -139,7 +152,7 @@ func transitionGraphemeState(state grState, r rune) (newState grState, prop prop
 	} else {
 		// No specific transition found. Try the less specific ones.
 		transAnyProp := grTransitions[int(state)*prMax+int(prAny)]
-		transAnyState := grTransitions[int(grAny)*prMax+int(prop)]
+		transAnyState := grTransitions[int(grAny)*prMax+int(transitionProp)]
 		if transAnyProp.ruleNumber > 0 && transAnyState.ruleNumber > 0 {
 			// Both apply. We'll use a mix (see comments for grTransitions).
 			ruleNumber = transAnyState.ruleNumber
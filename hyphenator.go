@@ -0,0 +1,649 @@
+package uniseg
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Hyphenator finds the points at which words may be hyphenated using Frank
+// Liang's algorithm, as used by TeX, with a set of hyphenation patterns and
+// exceptions. Pattern sets for many languages are available from the
+// hyph-utf8 project and can be loaded with [LoadHyphenator].
+//
+// Typically, a [Hyphenator] is assigned to [Parser.Hyphenator] together with
+// [HyphensAuto]:
+//
+//	p := &uniseg.Parser{Hyphens: uniseg.HyphensAuto, Hyphenator: h}
+type Hyphenator struct {
+	// LeftMin and RightMin are the minimum numbers of characters before and
+	// after a hyphenation point. [NewHyphenator] sets them to 2 and 3, the
+	// values used by TeX for English.
+	LeftMin, RightMin int
+
+	// The inter-letter values of the patterns, keyed by their letters. Word
+	// boundaries are represented by full stops.
+	patterns map[string][]uint8
+
+	// The length of the longest pattern in runes.
+	maxLength int
+
+	// The hyphenation points of the exceptions, in runes, keyed by the
+	// lowercase words.
+	exceptions map[string][]int
+
+	// The length of the longest exception in runes.
+	maxExceptionLength int
+
+	// The lowercase exceptions which are longer than hyphenWindowSize, sorted.
+	longExceptions []string
+}
+
+// NewHyphenator returns a new hyphenator for the given patterns and
+// exceptions. Patterns are written as in TeX: letters with the inter-letter
+// values in between, such as "a1b" or ".ach4", where full stops mark the
+// beginning or the end of a word. Exceptions are words with hyphens at their
+// hyphenation points, such as "ta-ble". An error is returned for patterns
+// without letters or with two adjacent values.
+func NewHyphenator(patterns, exceptions []string) (*Hyphenator, error) {
+	h := &Hyphenator{
+		LeftMin:    2,
+		RightMin:   3,
+		patterns:   make(map[string][]uint8, len(patterns)),
+		exceptions: make(map[string][]int, len(exceptions)),
+	}
+	for _, pattern := range patterns {
+		var (
+			letters strings.Builder
+			values  []uint8
+			digit   bool
+		)
+		for _, r := range pattern {
+			if r >= '0' && r <= '9' {
+				if digit {
+					return nil, fmt.Errorf("uniseg: invalid hyphenation pattern %q", pattern)
+				}
+				values = append(values, uint8(r-'0'))
+				digit = true
+				continue
+			}
+			if !digit {
+				values = append(values, 0)
+			}
+			letters.WriteRune(unicode.ToLower(r))
+			digit = false
+		}
+		if !digit {
+			values = append(values, 0)
+		}
+		if letters.Len() == 0 || !utf8.ValidString(pattern) {
+			return nil, fmt.Errorf("uniseg: invalid hyphenation pattern %q", pattern)
+		}
+		h.patterns[letters.String()] = values
+		h.maxLength = max(h.maxLength, len(values)-1)
+	}
+	for _, exception := range exceptions {
+		var (
+			word   strings.Builder
+			points []int
+			length int
+		)
+		for _, r := range exception {
+			if r == '-' {
+				points = append(points, length)
+				continue
+			}
+			word.WriteRune(unicode.ToLower(r))
+			length++
+		}
+		h.exceptions[word.String()] = points
+		h.maxExceptionLength = max(h.maxExceptionLength, length)
+		if length > hyphenWindowSize {
+			h.longExceptions = append(h.longExceptions, word.String())
+		}
+	}
+	slices.Sort(h.longExceptions)
+	h.longExceptions = slices.Compact(h.longExceptions)
+	return h, nil
+}
+
+// ReadHyphenator reads hyphenation patterns and exceptions in the format of
+// the hyph-*.tex files of the hyph-utf8 project, i.e. the arguments of the
+// TeX commands \patterns{...} and \hyphenation{...}. Comments starting with
+// "%" are ignored. If there is no \patterns command, the whole input is read
+// as a whitespace-separated list of patterns, as found in the hyph-*.pat.txt
+// files.
+func ReadHyphenator(r io.Reader) (*Hyphenator, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove comments.
+	lines := strings.Split(string(data), "\n")
+	for i, line := range lines {
+		if index := strings.IndexByte(line, '%'); index >= 0 {
+			lines[i] = line[:index]
+		}
+	}
+	text := strings.Join(lines, "\n")
+
+	// Find the arguments of the commands.
+	argument := func(command string) ([]string, bool, error) {
+		index := strings.Index(text, command)
+		if index < 0 {
+			return nil, false, nil
+		}
+		rest := strings.TrimLeft(text[index+len(command):], " \t\r\n")
+		if !strings.HasPrefix(rest, "{") {
+			return nil, false, fmt.Errorf("uniseg: missing { after %s", command)
+		}
+		end := strings.IndexByte(rest, '}')
+		if end < 0 {
+			return nil, false, fmt.Errorf("uniseg: missing } after %s", command)
+		}
+		return strings.Fields(rest[1:end]), true, nil
+	}
+	patterns, found, err := argument(`\patterns`)
+	if err != nil {
+		return nil, err
+	}
+	exceptions, _, err := argument(`\hyphenation`)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		patterns = strings.Fields(text)
+	}
+	return NewHyphenator(patterns, exceptions)
+}
+
+// LoadHyphenator reads hyphenation patterns and exceptions from the file with
+// the given name, such as "hyph-en-us.tex". See [ReadHyphenator] for the
+// format.
+func LoadHyphenator(name string) (*Hyphenator, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ReadHyphenator(file)
+}
+
+// Hyphenate returns the byte offsets in the given word at which it may be
+// hyphenated, in ascending order.
+func (h *Hyphenator) Hyphenate(word string) []int {
+	runes := []rune(word)
+	points := h.hyphenate(runes, true, true, nil)
+	if len(points) == 0 {
+		return nil
+	}
+	offsets := make([]int, len(points))
+	var offset, index int
+	for i, r := range runes {
+		for index < len(points) && points[index] == i {
+			offsets[index] = offset
+			index++
+		}
+		offset += utf8.RuneLen(r)
+	}
+	return offsets
+}
+
+// hyphenate appends the hyphenation points of the given word to "points", as
+// the numbers of runes before each point, and returns the result. If "start"
+// is false, the word continues runes which are not known, and the points which
+// depend on them, those closer to the beginning than the longest pattern, are
+// omitted. If "end" is false, the word is continued by unknown runes, and the
+// points close to its end are omitted likewise. Exceptions are only applied to
+// whole words. It does not allocate for words of up to 64 runes if "points"
+// has enough capacity.
+func (h *Hyphenator) hyphenate(word []rune, start, end bool, points []int) []int {
+	first, last := max(h.LeftMin, 1), len(word)-max(h.RightMin, 1)
+	if !start {
+		first = max(h.maxLength, 1)
+	}
+	if !end {
+		last = len(word) - max(h.maxLength, h.RightMin, 1)
+	}
+	if first > last {
+		return points
+	}
+
+	// Build the lowercase word, surrounded by full stops at its beginning and
+	// end, and the byte offsets of its runes.
+	var (
+		textBuffer    [4*64 + 2]byte
+		offsetsBuffer [64 + 3]int
+		valuesBuffer  [64 + 3]uint8
+	)
+	text, offsets, values := textBuffer[:0], offsetsBuffer[:0], valuesBuffer[:]
+	if len(word) > 64 {
+		offsets = make([]int, 0, len(word)+3)
+		values = make([]uint8, len(word)+3)
+	}
+	values = values[:len(word)+3]
+	var shift int // The number of full stops before the word.
+	if start {
+		offsets = append(offsets, 0)
+		text = append(text, '.')
+		shift = 1
+	}
+	for _, r := range word {
+		offsets = append(offsets, len(text))
+		text = utf8.AppendRune(text, unicode.ToLower(r))
+	}
+	if end {
+		offsets = append(offsets, len(text))
+		text = append(text, '.')
+	}
+	offsets = append(offsets, len(text))
+
+	// Exceptions take precedence over patterns.
+	if start && end {
+		if exception, ok := h.exceptions[string(text[1:len(text)-1])]; ok {
+			for _, point := range exception {
+				if point >= first && point <= last {
+					points = append(points, point)
+				}
+			}
+			return points
+		}
+	}
+
+	// Apply all patterns which match the word.
+	for start := 0; start < len(offsets)-1; start++ {
+		for end := start + 1; end < len(offsets) && end-start <= h.maxLength; end++ {
+			pattern, ok := h.patterns[string(text[offsets[start]:offsets[end]])]
+			if !ok {
+				continue
+			}
+			for i, value := range pattern {
+				values[start+i] = max(values[start+i], value)
+			}
+		}
+	}
+
+	// Odd values mark hyphenation points. The value between the runes i-1 and
+	// i of the word is at index i+shift.
+	for i := first; i <= last; i++ {
+		if values[i+shift]%2 == 1 {
+			points = append(points, i)
+		}
+	}
+	return points
+}
+
+// isException returns true if the given word is one of the exceptions.
+func (h *Hyphenator) isException(word []rune) bool {
+	var lower strings.Builder
+	for _, r := range word {
+		lower.WriteRune(unicode.ToLower(r))
+	}
+	_, ok := h.exceptions[lower.String()]
+	return ok
+}
+
+// hyphenationPoints holds all hyphenation points of the current word for the
+// callers of step which keep them outside of its state.
+type hyphenationPoints struct {
+	offsets []int // The hyphenation points, in bytes from the start of the word.
+	next    int   // The index of the next hyphenation point in offsets.
+	offset  int   // The number of bytes of the word parsed so far.
+}
+
+// advance moves past a grapheme cluster with the given length in bytes. It
+// returns true for "point" if the cluster is followed by a hyphenation point
+// and true for "more" if there are more hyphenation points after it.
+func (h *hyphenationPoints) advance(length int) (point, more bool) {
+	h.offset += length
+	for h.next < len(h.offsets) && h.offsets[h.next] <= h.offset {
+		point = h.offsets[h.next] == h.offset
+		h.next++
+	}
+	return point, h.next < len(h.offsets)
+}
+
+// startHyphenation finds the hyphenation points of the word at the start of
+// the given string for step and returns true if there are any. They are stored
+// in "points" if it is not nil, see firstHyphenation. Otherwise, the returned
+// window holds the first of them, see startHyphenWindow.
+func startHyphenation[T bytes](p *Parser, str T, prev rune, decoder runeDecoder[T], points *hyphenationPoints) (window hyphenWindow, found bool) {
+	if points == nil {
+		return startHyphenWindow(p, str, prev, decoder)
+	}
+	points.offsets = firstHyphenation(p, str, prev, decoder, points.offsets[:0])
+	points.next, points.offset = 0, 0
+	return 0, len(points.offsets) > 0
+}
+
+// firstHyphenation appends the hyphenation points of the word at the start of
+// the given string to "offsets", as byte offsets, if the parser hyphenates
+// words automatically, and returns the result. Points within grapheme clusters
+// are omitted. Words are runs of letters and marks which are neither preceded
+// nor followed by digits. They start at the beginning of the text (a negative
+// "prev") or after a character other than a letter, digit, mark, or soft
+// hyphen, and they must not contain soft hyphens. Han, Hiragana, Katakana, and
+// Complex_Context characters are not letters here.
+func firstHyphenation[T bytes](p *Parser, str T, prev rune, decoder runeDecoder[T], offsets []int) []int {
+	if p == nil || p.Hyphens != HyphensAuto || p.Hyphenator == nil || len(str) == 0 || !startsHyphenationWord(p, prev) {
+		return offsets
+	}
+	var (
+		wordBuffer   [64]rune
+		pointsBuffer [32]int
+	)
+	word, length, _ := hyphenationWord(p, str, 0, false, decoder, wordBuffer[:0])
+	points := p.Hyphenator.hyphenate(word, true, true, pointsBuffer[:0])
+	if len(points) == 0 {
+		return offsets
+	}
+
+	// Map the points to grapheme cluster boundaries.
+	var (
+		runes, offset int
+		state         GraphemeBreakState
+	)
+	for offset < length && len(points) > 0 {
+		var c T
+		c, _, _, state = firstGraphemeCluster(p, str[offset:length], state, decoder)
+		offset += len(c)
+		for i := 0; i < len(c); {
+			_, l := decoder(c[i:])
+			i += l
+			runes++
+		}
+		for len(points) > 0 && points[0] < runes {
+			points = points[1:] // Inside a grapheme cluster.
+		}
+		if len(points) > 0 && points[0] == runes {
+			offsets = append(offsets, offset)
+			points = points[1:]
+		}
+	}
+	return offsets
+}
+
+// hyphenWindow holds the hyphenation points of a word after a position inside
+// it for the states of [Parser.Step] and [Parser.FirstLineSegment], which have
+// no room for all of them. It has 20 bits, the size of the two segment
+// counters of the [Step] state. Bit i-1 is set if the word may be hyphenated
+// after the next i characters, up to the number of characters for which the
+// points are known (at most hyphenWindowSize), which is stored above these
+// bits. The points after them are found again from the rest of the word when
+// needed, starting early enough to include all patterns covering them. This
+// requires that no pattern is longer than hyphenWindowSize+1 characters. If
+// hyphenWordEnd is set, the word ends after the known characters.
+//
+// The points of exceptions can't be found from the rest of the word. For
+// exceptions longer than hyphenWindowSize, hyphenException is set, and the
+// lower bits hold the number of characters before the position instead. The
+// exception is then found again by the rest of the word.
+type hyphenWindow int
+
+const (
+	hyphenWindowSize = 14                      // The maximum number of characters whose points are stored.
+	hyphenPointsMask = 1<<hyphenWindowSize - 1 // The bits of the points.
+	hyphenKnownShift = hyphenWindowSize        // The position of the number of known characters.
+	hyphenKnownMask  = 0xf                     // The bit mask of the number of known characters, after shifting.
+	hyphenWordEnd    = 1 << 18                 // The word ends after the known characters.
+	hyphenException  = 1 << 19                 // The word is a long exception.
+	hyphenOffsetMask = hyphenException - 1     // The bit mask of the number of characters before the position in an exception.
+
+	hyphenWordDone = hyphenWindow(hyphenWordEnd) // The window of a word without more hyphenation points.
+)
+
+// startHyphenWindow returns the window of the hyphenation points at the
+// beginning of the word at the start of the given string, see
+// firstHyphenation, and true if the word may be hyphenated.
+func startHyphenWindow[T bytes](p *Parser, str T, prev rune, decoder runeDecoder[T]) (hyphenWindow, bool) {
+	if p == nil || p.Hyphens != HyphensAuto || p.Hyphenator == nil || len(str) == 0 || !startsHyphenationWord(p, prev) {
+		return 0, false
+	}
+	h := p.Hyphenator
+	length, ok := hyphenationWordLength(p, str, decoder)
+	if !ok || length == 0 {
+		return 0, false
+	}
+
+	// Long exceptions are identified by the whole word.
+	if length > hyphenWindowSize && length <= min(h.maxExceptionLength, hyphenOffsetMask) {
+		var (
+			wordBuffer   [64]rune
+			pointsBuffer [32]int
+		)
+		word, _, _ := hyphenationWord(p, str, 0, false, decoder, wordBuffer[:0])
+		if h.isException(word) {
+			return hyphenException, len(h.hyphenate(word, true, true, pointsBuffer[:0])) > 0
+		}
+	}
+
+	window := fillHyphenWindow(p, str, 0, true, decoder)
+	return window, window != hyphenWordDone
+}
+
+// fillHyphenWindow returns the given window of the word continued by the given
+// string with the points after the known ones added, up to hyphenWindowSize
+// characters. If "start" is true, the word starts at the beginning of the
+// string.
+func fillHyphenWindow[T bytes](p *Parser, str T, window hyphenWindow, start bool, decoder runeDecoder[T]) hyphenWindow {
+	h := p.Hyphenator
+	var (
+		wordBuffer   [64]rune
+		pointsBuffer [32]int
+		from         int // The first character of the word whose patterns are applied.
+	)
+	known, points := window.known()
+	margin := max(h.maxLength, h.RightMin, 1)
+	word, _, complete := hyphenationWord(p, str, hyphenWindowSize+margin, !start, decoder, wordBuffer[:0])
+	if !start {
+		from = min(max(known+1-h.maxLength, 0), len(word))
+	}
+	for _, point := range h.hyphenate(word[from:], start, complete, pointsBuffer[:0]) {
+		if point += from; point > known && point <= hyphenWindowSize {
+			points |= 1 << (point - 1)
+		}
+	}
+	if complete && len(word) <= hyphenWindowSize {
+		if points == 0 {
+			return hyphenWordDone
+		}
+		return hyphenWindow(points | len(word)<<hyphenKnownShift | hyphenWordEnd)
+	}
+	return hyphenWindow(points | hyphenWindowSize<<hyphenKnownShift)
+}
+
+// known returns the number of characters for which the window knows the
+// hyphenation points and their bits.
+func (w hyphenWindow) known() (known, points int) {
+	return int(w>>hyphenKnownShift) & hyphenKnownMask, int(w) & hyphenPointsMask
+}
+
+// advanceHyphenWindow moves the given window of the word continued by the given
+// string past its next "n" characters. It returns true for "point" if the word
+// may be hyphenated after them and true for "more" if there are hyphenation
+// points after them.
+func advanceHyphenWindow[T bytes](p *Parser, str T, window hyphenWindow, n int, decoder runeDecoder[T]) (_ hyphenWindow, point, more bool) {
+	if window&hyphenException != 0 {
+		return advanceHyphenException(p, str, window, n, decoder)
+	}
+
+	// The characters which must stay known to find the points after them.
+	keep := max(p.Hyphenator.maxLength-1, 0)
+	for n > 0 {
+		known, points := window.known()
+		end := window&hyphenWordEnd != 0
+		if !end && known-n < keep {
+			window = fillHyphenWindow(p, str, window, false, decoder)
+			if window == hyphenWordDone {
+				return window, false, false
+			}
+			known, points = window.known()
+			end = window&hyphenWordEnd != 0
+		}
+		if end && n > known {
+			return hyphenWordDone, false, false
+		}
+		step := n
+		if !end {
+			step = min(n, max(known-keep, 1))
+		}
+		point = points&(1<<(step-1)) != 0
+		points >>= step
+		window = hyphenWindow(points | (known-step)<<hyphenKnownShift | int(window&hyphenWordEnd))
+		if n -= step; n > 0 {
+			for ; step > 0; step-- {
+				_, l := decoder(str)
+				str = str[l:]
+			}
+		}
+	}
+	if window&hyphenWordEnd != 0 && window&hyphenPointsMask == 0 {
+		return hyphenWordDone, point, false
+	}
+	return window, point, true
+}
+
+// advanceHyphenException is advanceHyphenWindow for a window with
+// hyphenException set. The exception is the first of the long exceptions which
+// ends with the rest of the word and has its length.
+func advanceHyphenException[T bytes](p *Parser, str T, window hyphenWindow, n int, decoder runeDecoder[T]) (_ hyphenWindow, point, more bool) {
+	h := p.Hyphenator
+	before := int(window & hyphenOffsetMask)
+	var (
+		wordBuffer   [64]rune
+		pointsBuffer [32]int
+		suffix       strings.Builder
+	)
+	rest, _, _ := hyphenationWord(p, str, h.maxExceptionLength-before+1, true, decoder, wordBuffer[:0])
+	for _, r := range rest {
+		suffix.WriteRune(unicode.ToLower(r))
+	}
+	for _, exception := range h.longExceptions {
+		if !strings.HasSuffix(exception, suffix.String()) || utf8.RuneCountInString(exception) != before+len(rest) {
+			continue
+		}
+		before += n
+		for _, at := range h.hyphenate([]rune(exception), true, true, pointsBuffer[:0]) {
+			point = point || at == before
+			more = more || at > before
+		}
+		if !more || before > hyphenOffsetMask {
+			return hyphenWordDone, point, false
+		}
+		return hyphenException | hyphenWindow(before), point, true
+	}
+	return hyphenWordDone, false, false
+}
+
+// nextHyphenPoint returns the byte offset of the first hyphenation point at a
+// grapheme cluster boundary in the word continued by the given string, whose
+// points are described by the given window, and the window after it. It
+// returns 0 if there is none.
+func nextHyphenPoint[T bytes](p *Parser, str T, window hyphenWindow, decoder runeDecoder[T]) (int, hyphenWindow) {
+	var (
+		offset int
+		state  GraphemeBreakState
+	)
+	for offset < len(str) {
+		var (
+			c           T
+			point, more bool
+		)
+		c, _, _, state = firstGraphemeCluster(p, str[offset:], state, decoder)
+		window, point, more = advanceHyphenWindow(p, str[offset:], window, runeCount(c, decoder), decoder)
+		offset += len(c)
+		if point {
+			return offset, window
+		}
+		if !more {
+			break
+		}
+	}
+	return 0, hyphenWordDone
+}
+
+// runeCount returns the number of runes in the given string.
+func runeCount[T bytes](str T, decoder runeDecoder[T]) (n int) {
+	for len(str) > 0 {
+		_, l := decoder(str)
+		str = str[l:]
+		n++
+	}
+	return
+}
+
+// hyphenationWordLength returns the number of characters of the word at the
+// start of the given string and true if it may be hyphenated automatically,
+// see hyphenationWord.
+func hyphenationWordLength[T bytes](p *Parser, str T, decoder runeDecoder[T]) (length int, ok bool) {
+	for offset := 0; offset < len(str); length++ {
+		r, l := decoder(str[offset:])
+		if r == softHyphen {
+			return 0, false
+		}
+		if !isHyphenationLetter(p, r) && (length == 0 || !unicode.IsMark(r)) {
+			if unicode.IsDigit(r) {
+				return 0, false
+			}
+			break
+		}
+		offset += l
+	}
+	return length, true
+}
+
+// hyphenationWord appends the characters of the word at the start of the given
+// string to "word" and returns the result and the number of bytes read. If
+// "continued" is true, the string continues a word, and it may start with
+// marks. If "limit" is positive, the word is only read up to "limit"
+// characters, and "complete" is false if it may continue. No characters are
+// returned for words which must not be hyphenated automatically, see
+// firstHyphenation.
+func hyphenationWord[T bytes](p *Parser, str T, limit int, continued bool, decoder runeDecoder[T], word []rune) (_ []rune, length int, complete bool) {
+	for length < len(str) {
+		if limit > 0 && len(word) >= limit {
+			return word, length, false
+		}
+		r, l := decoder(str[length:])
+		if r == softHyphen {
+			return nil, 0, true // The word is hyphenated manually.
+		}
+		if !isHyphenationLetter(p, r) && (len(word) == 0 && !continued || !unicode.IsMark(r)) {
+			if unicode.IsDigit(r) {
+				return nil, 0, true // Part of an alphanumeric string.
+			}
+			break
+		}
+		word = append(word, r)
+		length += l
+	}
+	return word, length, true
+}
+
+// startsHyphenationWord returns true if a word which is hyphenated
+// automatically may start after the given rune, which is negative at the
+// beginning of the text.
+func startsHyphenationWord(p *Parser, prev rune) bool {
+	return prev < 0 || prev != softHyphen && !isHyphenationLetter(p, prev) && !unicode.IsDigit(prev) && !unicode.IsMark(prev)
+}
+
+// softHyphen is U+00AD SOFT HYPHEN.
+const softHyphen = '\u00ad'
+
+// isHyphenationLetter returns true if the given rune is a letter which may be
+// part of a word hyphenated by a [Hyphenator].
+func isHyphenationLetter(p *Parser, r rune) bool {
+	if !unicode.IsLetter(r) {
+		return false
+	}
+	switch scripts.search(r) {
+	case ScriptHan, ScriptHiragana, ScriptKatakana:
+		return false
+	}
+	return p.lineBreakOf(r).lbProperty != lbprSA
+}
@@ -0,0 +1,275 @@
+package uniseg
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// hyphenationTestFile is a small excerpt of English hyphenation patterns in the
+// format of the hyph-*.tex files.
+const hyphenationTestFile = `% A few English patterns.
+\patterns{ % Comments may appear anywhere.
+hy3ph he2n hena4 hen5at 1na n2at 1tio 2io o2n
+.con5 n1c 1ca 4te. 1te 1tr
+}
+\hyphenation{
+ta-ble
+Uni-code
+su-per-cal-i-fra-gil-is-tic-ex-pi-al-i-do-cious
+}
+`
+
+// newTestHyphenator returns a hyphenator for hyphenationTestFile.
+func newTestHyphenator(t *testing.T) *Hyphenator {
+	t.Helper()
+	h, err := ReadHyphenator(strings.NewReader(hyphenationTestFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+// hyphenTestCases are texts with their expected line segments when broken
+// using newTestHyphenator. Hyphenation breaks are marked with a trailing "-|".
+var hyphenTestCases = []struct {
+	name     string
+	hyphens  Hyphens
+	original string
+	expected []string
+}{
+	{"default", HyphensDefault, "hyphenation", []string{"hyphenation"}},
+	{"default soft hyphen", HyphensDefault, "hy\u00adphen", []string{"hy\u00ad", "phen"}},
+	{"manual", HyphensManual, "hyphenation hy\u00adphen", []string{"hyphenation ", "hy\u00ad-|", "phen"}},
+	{"auto", HyphensAuto, "hyphenation", []string{"hy-|", "phen-|", "ation"}},
+	{"auto sentence", HyphensAuto, "(Hyphenation) is concatenated.", []string{"(Hy-|", "phen-|", "ation) ", "is ", "con-|", "ca-|", "te-|", "na-|", "ted."}},
+	{"auto soft hyphen", HyphensAuto, "soft\u00adhyphen hyphenation", []string{"soft\u00ad-|", "hyphen ", "hy-|", "phen-|", "ation"}},
+	{"auto exception", HyphensAuto, "Unicode table", []string{"Uni-|", "code ", "ta-|", "ble"}},
+	{"auto marks", HyphensAuto, "hyphe\u0301nation", []string{"hy-|", "phe\u0301-|", "na-|", "tion"}},
+	{"auto digits", HyphensAuto, "4hyphenation hyphenation4", []string{"4hyphenation ", "hyphenation4"}},
+	{"auto ideographs", HyphensAuto, "日本hyphenation", []string{"日", "本", "hy-|", "phen-|", "ation"}},
+	{"auto hyphen", HyphensAuto, "re-hyphenation", []string{"re-", "hy-|", "phen-|", "ation"}},
+}
+
+func TestHyphenate(t *testing.T) {
+	h := newTestHyphenator(t)
+	for _, tt := range []struct {
+		word     string
+		expected []int
+	}{
+		{"hyphenation", []int{2, 6}},
+		{"Hyphenation", []int{2, 6}},
+		{"concatenation", []int{3, 5, 7, 9}},
+		{"table", []int{2}},
+		{"TABLE", []int{2}},
+		{"hyphénation", []int{2, 6, 8}}, // Byte offsets.
+		{"hy", nil},
+		{"", nil},
+	} {
+		if got := h.Hyphenate(tt.word); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("Hyphenate(%q) = %v, want %v", tt.word, got, tt.expected)
+		}
+	}
+
+	// Minimum lengths.
+	h.LeftMin, h.RightMin = 3, 5
+	if got, expected := h.Hyphenate("hyphenation"), []int{6}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Hyphenate with LeftMin 3 and RightMin 5 = %v, want %v", got, expected)
+	}
+}
+
+func TestReadHyphenator(t *testing.T) {
+	// Plain pattern lists.
+	h, err := ReadHyphenator(strings.NewReader("hy3ph he2n\nhena4 hen5at 1na n2at % comment\n1tio 2io o2n\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := h.Hyphenate("hyphenation"), []int{2, 6}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Hyphenate = %v, want %v", got, expected)
+	}
+
+	// Errors.
+	for _, input := range []string{
+		`\patterns a1b`,
+		`\patterns{a1b`,
+		`\patterns{a1b} \hyphenation ta-ble`,
+		`\patterns{a12b}`,
+		`\patterns{123}`,
+	} {
+		if _, err := ReadHyphenator(strings.NewReader(input)); err == nil {
+			t.Errorf("ReadHyphenator(%q) did not fail", input)
+		}
+	}
+}
+
+func TestLoadHyphenator(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "hyph-en-test.tex")
+	if err := os.WriteFile(name, []byte(hyphenationTestFile), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	h, err := LoadHyphenator(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := h.Hyphenate("table"), []int{2}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Hyphenate = %v, want %v", got, expected)
+	}
+	if _, err := LoadHyphenator(filepath.Join(t.TempDir(), "missing.tex")); err == nil {
+		t.Error("LoadHyphenator did not fail for a missing file")
+	}
+}
+
+func TestHyphenBreaks(t *testing.T) {
+	h := newTestHyphenator(t)
+	for _, tt := range hyphenTestCases {
+		p := &Parser{Hyphens: tt.hyphens, Hyphenator: h}
+
+		// StepString.
+		var (
+			got      []string
+			segment  string
+			c        string
+			boundary Boundaries
+			state    State
+		)
+		str := tt.original
+		for len(str) > 0 {
+			c, str, boundary, state = p.StepString(str, state)
+			segment += c
+			switch boundary.Line() {
+			case LineHyphenBreak:
+				got, segment = append(got, segment+"-|"), ""
+			case LineCanBreak, LineMustBreak:
+				got, segment = append(got, segment), ""
+			}
+		}
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%s: StepString(%q) = %q, want %q", tt.name, tt.original, got, tt.expected)
+		}
+
+		// Graphemes.
+		got, segment = nil, ""
+		g := p.NewGraphemes(tt.original)
+		for g.Next() {
+			segment += g.Str()
+			switch g.LineBreak() {
+			case LineHyphenBreak:
+				got, segment = append(got, segment+"-|"), ""
+			case LineCanBreak, LineMustBreak:
+				got, segment = append(got, segment), ""
+			}
+		}
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%s: Graphemes(%q) = %q, want %q", tt.name, tt.original, got, tt.expected)
+		}
+
+		// FirstLineSegmentInString.
+		got = nil
+		var lineState LineBreakState
+		for str = tt.original; len(str) > 0; {
+			segment, str, _, lineState = p.FirstLineSegmentInString(str, lineState)
+			if lineState.HyphenBreak() {
+				segment += "-|"
+			}
+			got = append(got, segment)
+		}
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%s: FirstLineSegmentInString(%q) = %q, want %q", tt.name, tt.original, got, tt.expected)
+		}
+	}
+}
+
+func TestHyphenBreaksLongWords(t *testing.T) {
+	h := newTestHyphenator(t)
+	p := &Parser{Hyphens: HyphensAuto, Hyphenator: h}
+	for _, word := range []string{
+		strings.Repeat("hyphenation", 30),
+		strings.Repeat("concatenation", 20) + "table",
+		"hyphe\u0301" + strings.Repeat("nationhyphe\u0301", 20) + "nation",
+		"Supercalifragilisticexpialidocious", // An exception.
+	} {
+		text := "(" + word + ") " + word
+		var expected []string
+		for range 2 {
+			var start int
+			for _, offset := range h.Hyphenate(word) {
+				if strings.HasPrefix(word[offset:], "\u0301") {
+					continue // Inside a grapheme cluster.
+				}
+				expected = append(expected, word[start:offset]+"-|")
+				start = offset
+			}
+			expected = append(expected, word[start:])
+		}
+		expected[0] = "(" + expected[0]
+		expected[len(expected)/2-1] += ") "
+
+		// StepString.
+		var (
+			got      []string
+			segment  string
+			c        string
+			boundary Boundaries
+			s        State
+		)
+		for str := text; len(str) > 0; {
+			c, str, boundary, s = p.StepString(str, s)
+			segment += c
+			switch boundary.Line() {
+			case LineHyphenBreak:
+				got, segment = append(got, segment+"-|"), ""
+			case LineCanBreak, LineMustBreak:
+				got, segment = append(got, segment), ""
+			}
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("StepString(%q) = %q, want %q", text, got, expected)
+		}
+
+		// Graphemes.
+		got, segment = nil, ""
+		g := p.NewGraphemes(text)
+		for g.Next() {
+			segment += g.Str()
+			switch g.LineBreak() {
+			case LineHyphenBreak:
+				got, segment = append(got, segment+"-|"), ""
+			case LineCanBreak, LineMustBreak:
+				got, segment = append(got, segment), ""
+			}
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Graphemes(%q) = %q, want %q", text, got, expected)
+		}
+
+		// FirstLineSegmentInString.
+		got = nil
+		var state LineBreakState
+		for str := text; len(str) > 0; {
+			segment, str, _, state = p.FirstLineSegmentInString(str, state)
+			if state.HyphenBreak() {
+				segment += "-|"
+			}
+			got = append(got, segment)
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("FirstLineSegmentInString(%q) = %q, want %q", text, got, expected)
+		}
+	}
+}
+
+func TestHyphenBreaksAllocations(t *testing.T) {
+	p := &Parser{Hyphens: HyphensAuto, Hyphenator: newTestHyphenator(t)}
+	allocs := testing.AllocsPerRun(100, func() {
+		var state State
+		str := "Hyphenation is concatenated."
+		for len(str) > 0 {
+			_, str, _, state = p.StepString(str, state)
+		}
+	})
+	if allocs > 0 {
+		t.Errorf("StepString allocated %.1f times, want 0", allocs)
+	}
+}
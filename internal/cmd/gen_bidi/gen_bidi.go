@@ -0,0 +1,314 @@
+// This program generates the property tables of the Unicode Bidirectional
+// Algorithm (UAX #9) from the Unicode Character Database: the Bidi_Class
+// property from DerivedBidiClass.txt, the Bidi_Paired_Bracket and
+// Bidi_Paired_Bracket_Type properties from BidiBrackets.txt, and the
+// Bidi_Mirroring_Glyph property from BidiMirroring.txt. The command line
+// arguments are as follows:
+//
+//  1. The name of the locally generated Go file.
+//
+// The -ucd flag names a local directory to read the UCD files from instead of
+// downloading them from unicode.org.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// We want to test against a specific version rather than the latest. When the
+// package is upgraded to a new version, change these to generate new tests.
+const (
+	unicodeVersion = "17.0.0"
+	ucdURLFormat   = `https://www.unicode.org/Public/%s/ucd/%s`
+)
+
+// The regular expressions for the data lines of the UCD files. DerivedBidiClass
+// lists the default values of unlisted code points in "@missing" comments.
+var (
+	classPattern   = regexp.MustCompile(`^(?:# @missing: )?([0-9A-F]{4,6})(?:\.\.([0-9A-F]{4,6}))?\s*;\s*([A-Za-z_]+)`)
+	bracketPattern = regexp.MustCompile(`^([0-9A-F]{4,6});\s*([0-9A-F]{4,6});\s*([oc])`)
+	mirrorPattern  = regexp.MustCompile(`^([0-9A-F]{4,6});\s*([0-9A-F]{4,6})`)
+)
+
+// The short names of the Bidi_Class values. The long names are used in the
+// "@missing" lines.
+var classNames = map[string]string{
+	"Left_To_Right":           "L",
+	"Right_To_Left":           "R",
+	"Arabic_Letter":           "AL",
+	"European_Number":         "EN",
+	"European_Separator":      "ES",
+	"European_Terminator":     "ET",
+	"Arabic_Number":           "AN",
+	"Common_Separator":        "CS",
+	"Nonspacing_Mark":         "NSM",
+	"Boundary_Neutral":        "BN",
+	"Paragraph_Separator":     "B",
+	"Segment_Separator":       "S",
+	"White_Space":             "WS",
+	"Other_Neutral":           "ON",
+	"Left_To_Right_Embedding": "LRE",
+	"Left_To_Right_Override":  "LRO",
+	"Right_To_Left_Embedding": "RLE",
+	"Right_To_Left_Override":  "RLO",
+	"Pop_Directional_Format":  "PDF",
+	"Left_To_Right_Isolate":   "LRI",
+	"Right_To_Left_Isolate":   "RLI",
+	"First_Strong_Isolate":    "FSI",
+	"Pop_Directional_Isolate": "PDI",
+}
+
+func main() {
+	var ucdDir string
+	flag.StringVar(&ucdDir, "ucd", "", "local directory containing the UCD files")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: gen_bidi [-ucd <directory>] <output file>")
+		os.Exit(2)
+	}
+
+	log.SetPrefix("gen_bidi: ")
+	log.SetFlags(0)
+
+	src, err := parse(ucdDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Format the Go code.
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		log.Fatal("gofmt:", err)
+	}
+
+	// Save it to the (local) target file.
+	log.Print("Writing to ", flag.Arg(0))
+	if err := os.WriteFile(flag.Arg(0), formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse parses the UCD files and returns the Go source code of the tables.
+func parse(ucdDir string) (string, error) {
+	classURL := fmt.Sprintf(ucdURLFormat, unicodeVersion, "extracted/DerivedBidiClass.txt")
+	classes, err := parseClasses(ucdDir, classURL)
+	if err != nil {
+		return "", err
+	}
+	bracketURL := fmt.Sprintf(ucdURLFormat, unicodeVersion, "BidiBrackets.txt")
+	brackets, err := parseLines(ucdDir, bracketURL, bracketPattern)
+	if err != nil {
+		return "", err
+	}
+	mirrorURL := fmt.Sprintf(ucdURLFormat, unicodeVersion, "BidiMirroring.txt")
+	mirrors, err := parseLines(ucdDir, mirrorURL, mirrorPattern)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`// Code generated by internal/cmd/gen_bidi; DO NOT EDIT.
+
+package uniseg
+
+// bidiClasses are taken from
+// ` + classURL + `
+// Code points with the class L are omitted.
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+var bidiClasses = dictionary[bidiClass]{
+`)
+	for _, c := range eytzinger(classes) {
+		fmt.Fprintf(&buf, "{runeRange{0x%04X, 0x%04X}, bcpr%s},\n", c.from, c.to, c.value)
+	}
+	buf.WriteString(`}
+
+// bidiBrackets are taken from
+// ` + bracketURL + `
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+var bidiBrackets = dictionary[bidiBracket]{
+`)
+	for _, b := range eytzinger(brackets) {
+		fmt.Fprintf(&buf, "{runeRange{0x%s, 0x%s}, bidiBracket{0x%s, %t}},\n", b[1], b[1], b[2], b[3] == "o")
+	}
+	buf.WriteString(`}
+
+// bidiMirrors are taken from
+// ` + mirrorURL + `
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+var bidiMirrors = dictionary[rune]{
+`)
+	for _, m := range eytzinger(mirrors) {
+		fmt.Fprintf(&buf, "{runeRange{0x%s, 0x%s}, 0x%s},\n", m[1], m[1], m[2])
+	}
+	buf.WriteString("}\n")
+
+	return buf.String(), nil
+}
+
+// classRange is a range of code points with the same Bidi_Class.
+type classRange struct {
+	from, to uint64
+	value    string
+}
+
+// parseClasses parses DerivedBidiClass.txt and returns the ranges of code
+// points whose Bidi_Class is not L, in ascending order. The defaults of the
+// "@missing" lines are applied first, broader ranges before the narrower ranges
+// nested in them, wherever they appear in the file. The data lines take
+// precedence over all defaults.
+func parseClasses(ucdDir, url string) ([]classRange, error) {
+	log.Printf("Parsing %s", url)
+	in, err := open(ucdDir, url)
+	if err != nil {
+		return nil, err
+	}
+	defer in.Close()
+
+	var defaults, values []classRange
+	scanner := bufio.NewScanner(in)
+	num := 0
+	for scanner.Scan() {
+		num++
+		line := scanner.Text()
+		missing := strings.HasPrefix(line, "# @missing:")
+		if line == "" || strings.HasPrefix(line, "#") && !missing {
+			continue
+		}
+		fields := classPattern.FindStringSubmatch(line)
+		if fields == nil {
+			return nil, fmt.Errorf("%s line %d: no property found", filepath.Base(url), num)
+		}
+		from, _ := strconv.ParseUint(fields[1], 16, 64)
+		to := from
+		if fields[2] != "" {
+			to, _ = strconv.ParseUint(fields[2], 16, 64)
+		}
+		value := fields[3]
+		if name, ok := classNames[value]; ok {
+			value = name
+		}
+		if to > 0x10ffff || from > to {
+			return nil, fmt.Errorf("%s line %d: invalid code point range", filepath.Base(url), num)
+		}
+		if missing {
+			defaults = append(defaults, classRange{from, to, value})
+		} else {
+			values = append(values, classRange{from, to, value})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(defaults) == 0 {
+		return nil, fmt.Errorf("%s: no @missing lines found", filepath.Base(url))
+	}
+
+	classes := make([]string, 0x110000)
+	sort.SliceStable(defaults, func(i, j int) bool {
+		return defaults[i].to-defaults[i].from > defaults[j].to-defaults[j].from
+	})
+	for _, c := range append(defaults, values...) {
+		for r := c.from; r <= c.to; r++ {
+			classes[r] = c.value
+		}
+	}
+
+	// Merge the code points into ranges.
+	var ranges []classRange
+	for r, value := range classes {
+		switch {
+		case value == "" || value == "L":
+		case len(ranges) > 0 && ranges[len(ranges)-1].to == uint64(r)-1 && ranges[len(ranges)-1].value == value:
+			ranges[len(ranges)-1].to = uint64(r)
+		default:
+			ranges = append(ranges, classRange{uint64(r), uint64(r), value})
+		}
+	}
+	return ranges, nil
+}
+
+// parseLines parses the data lines of the UCD file at the given URL with the
+// given pattern and returns the submatches of each line. The lines must be in
+// ascending order of their first field.
+func parseLines(ucdDir, url string, pattern *regexp.Regexp) ([][]string, error) {
+	log.Printf("Parsing %s", url)
+	in, err := open(ucdDir, url)
+	if err != nil {
+		return nil, err
+	}
+	defer in.Close()
+
+	var lines [][]string
+	scanner := bufio.NewScanner(in)
+	num := 0
+	for scanner.Scan() {
+		num++
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := pattern.FindStringSubmatch(line)
+		if fields == nil {
+			return nil, fmt.Errorf("%s line %d: no property found", filepath.Base(url), num)
+		}
+		lines = append(lines, fields)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// open opens the UCD file at the given URL. If "ucdDir" is not empty, the file
+// is read from that local directory instead, using the path of the URL
+// relative to the "ucd" directory.
+func open(ucdDir, url string) (io.ReadCloser, error) {
+	if ucdDir != "" {
+		name := url[strings.Index(url, "/ucd/")+len("/ucd/"):]
+		return os.Open(filepath.Join(ucdDir, filepath.FromSlash(name)))
+	}
+	res, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("GET %s: %s", url, res.Status)
+	}
+	return res.Body, nil
+}
+
+func eytzinger[S ~[]E, E any](a S) S {
+	b := make(S, len(a))
+	ctx := &eytzingerContext[S, E]{a: a, b: b}
+	ctx.eytzinger(0, 0)
+	return b
+}
+
+type eytzingerContext[S ~[]E, E any] struct {
+	a S // input slice
+	b S // output slice
+}
+
+func (ctx *eytzingerContext[S, E]) eytzinger(i, k int) int {
+	if k < len(ctx.a) {
+		i = ctx.eytzinger(i, 2*k+1)
+		ctx.b[k] = ctx.a[i]
+		i++
+		i = ctx.eytzinger(i, 2*k+2)
+	}
+	return i
+}
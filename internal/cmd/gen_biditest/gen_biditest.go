@@ -0,0 +1,206 @@
+// This program generates a Go file containing the test cases of the Unicode
+// Bidirectional Algorithm (UAX #9), BidiTest.txt or BidiCharacterTest.txt. The
+// command line arguments are as follows:
+//
+//  1. The name of the Unicode data file (just the filename, without extension),
+//     or the path to a local copy of it (ending in ".txt").
+//  2. The name of the locally generated Go file.
+//
+// The test cases are assigned to bidiTestCases or bidiCharacterTestCases, which
+// are declared in bidi_test.go, so the conformance tests are skipped until the
+// files are generated.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// We want to test against a specific version rather than the latest. When the
+// package is upgraded to a new version, change these to generate new tests.
+const (
+	testCaseURL = `https://www.unicode.org/Public/17.0.0/ucd/%s.txt`
+)
+
+// The Go constants for the paragraph directions of BidiCharacterTest.txt.
+var directions = map[string]string{
+	"0": "DirectionLTR",
+	"1": "DirectionRTL",
+	"2": "DirectionAuto",
+}
+
+func main() {
+	if len(os.Args) < 3 {
+		fmt.Println("Not enough arguments, see code for details")
+		os.Exit(1)
+	}
+
+	log.SetPrefix("gen_biditest: ")
+	log.SetFlags(0)
+
+	// Read text of testcases and parse into Go source code.
+	source := os.Args[1]
+	if !strings.HasSuffix(source, ".txt") {
+		source = fmt.Sprintf(testCaseURL, source)
+	}
+	src, err := parse(source)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Format the Go code.
+	formatted, err := format.Source(src)
+	if err != nil {
+		log.Fatalln("gofmt:", err)
+	}
+
+	// Write it out.
+	log.Print("Writing to ", os.Args[2])
+	if err := os.WriteFile(os.Args[2], formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse reads a bidi test file, either from a local file or from a URL. It
+// parses the file data into Go source code representing the test cases.
+func parse(url string) ([]byte, error) {
+	log.Printf("Parsing %s", url)
+	body, err := open(url)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	character := strings.Contains(filepath.Base(url), "Character")
+	if !strings.HasPrefix(url, "https://") {
+		url = filepath.Base(url)
+	}
+
+	variable, typ := "bidiTestCases", "bidiTestCase"
+	if character {
+		variable, typ = "bidiCharacterTestCases", "bidiCharacterTestCase"
+	}
+	buf := new(bytes.Buffer)
+	buf.Grow(8 << 20)
+	buf.WriteString(`// Code generated by ./internal/cmd/gen_biditest/gen_biditest.go; DO NOT EDIT.
+
+package uniseg
+
+// ` + variable + ` are taken from
+// ` + url + `
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+func init() {
+	` + variable + ` = []` + typ + `{
+`)
+
+	sc := bufio.NewScanner(body)
+	num, cases := 0, 0
+	var levels, reorder string
+	for sc.Scan() {
+		num++
+		line := sc.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		var n int
+		if character {
+			n, err = parseCharacterTest(buf, line)
+		} else {
+			n, err = parseTest(buf, line, &levels, &reorder)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v: %q", num, err, line)
+		}
+		cases += n
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if cases == 0 {
+		return nil, errors.New("no test cases found")
+	}
+
+	buf.WriteString("}\n}\n")
+	return buf.Bytes(), nil
+}
+
+// parseTest parses a line of BidiTest.txt and returns the number of test cases
+// written to the buffer. The "@Levels" and "@Reorder" lines set the expected
+// results of the following test cases.
+func parseTest(buf *bytes.Buffer, line string, levels, reorder *string) (int, error) {
+	if value, ok := strings.CutPrefix(line, "@Levels:"); ok {
+		*levels = strings.TrimSpace(value)
+		return 0, nil
+	}
+	if value, ok := strings.CutPrefix(line, "@Reorder:"); ok {
+		*reorder = strings.TrimSpace(value)
+		return 0, nil
+	}
+	classes, bitset, ok := strings.Cut(line, ";")
+	if !ok {
+		return 0, errors.New("missing paragraph levels")
+	}
+	paragraphs, err := strconv.ParseUint(strings.TrimSpace(bitset), 10, 8)
+	if err != nil {
+		return 0, err
+	}
+	fmt.Fprintf(buf, "{%q, %d, %q, %q},\n", strings.TrimSpace(classes), paragraphs, *levels, *reorder)
+	return 1, nil
+}
+
+// parseCharacterTest parses a line of BidiCharacterTest.txt and returns the
+// number of test cases written to the buffer.
+func parseCharacterTest(buf *bytes.Buffer, line string) (int, error) {
+	fields := strings.Split(line, ";")
+	if len(fields) != 5 {
+		return 0, errors.New("expected 5 fields")
+	}
+	var text strings.Builder
+	for _, field := range strings.Fields(fields[0]) {
+		r, err := strconv.ParseUint(field, 16, 32)
+		if err != nil {
+			return 0, err
+		}
+		fmt.Fprintf(&text, "\\U%08X", r)
+	}
+	direction, ok := directions[strings.TrimSpace(fields[1])]
+	if !ok {
+		return 0, errors.New("invalid paragraph direction")
+	}
+	level, err := strconv.ParseUint(strings.TrimSpace(fields[2]), 10, 8)
+	if err != nil {
+		return 0, err
+	}
+	fmt.Fprintf(buf, "{\"%s\", %s, %d, %q, %q},\n", text.String(), direction, level, strings.TrimSpace(fields[3]), strings.TrimSpace(fields[4]))
+	return 1, nil
+}
+
+// open opens a bidi test file. URLs are downloaded, everything else is read
+// from the local file system.
+func open(url string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(url, "https://") {
+		return os.Open(url)
+	}
+	res, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("GET %s: %s", url, res.Status)
+	}
+	return res.Body, nil
+}
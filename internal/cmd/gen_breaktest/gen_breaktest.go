@@ -2,7 +2,8 @@
 // Unicode Character Database auxiliary data files. The command line arguments
 // are as follows:
 //
-//   1. The name of the Unicode data file (just the filename, without extension).
+//   1. The name of the Unicode data file (just the filename, without extension),
+//      or the path to a local copy of it (ending in ".txt").
 //   2. The name of the locally generated Go file.
 //   3. The name of the slice containing the test cases.
 //   4. The name of the generator, for logging purposes.
@@ -16,9 +17,12 @@ import (
 	"errors"
 	"fmt"
 	"go/format"
+	"io"
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strings"
 )
 
 // We want to test against a specific version rather than the latest. When the
@@ -37,7 +41,11 @@ func main() {
 	log.SetFlags(0)
 
 	// Read text of testcases and parse into Go source code.
-	src, err := parse(fmt.Sprintf(testCaseURL, os.Args[1]))
+	source := os.Args[1]
+	if !strings.HasSuffix(source, ".txt") {
+		source = fmt.Sprintf(testCaseURL, source)
+	}
+	src, err := parse(source)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -59,12 +67,14 @@ func main() {
 // parses the file data into Go source code representing the test cases.
 func parse(url string) ([]byte, error) {
 	log.Printf("Parsing %s", url)
-	res, err := http.Get(url)
+	body, err := open(url)
 	if err != nil {
 		return nil, err
 	}
-	body := res.Body
 	defer body.Close()
+	if !strings.HasPrefix(url, "https://") {
+		url = filepath.Base(url)
+	}
 
 	buf := new(bytes.Buffer)
 	buf.Grow(120 << 10)
@@ -117,6 +127,23 @@ var ` + os.Args[3] + ` = []testCase {
 	return buf.Bytes(), nil
 }
 
+// open opens a break test file. URLs are downloaded, everything else is read
+// from the local file system.
+func open(url string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(url, "https://") {
+		return os.Open(url)
+	}
+	res, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("GET %s: %s", url, res.Status)
+	}
+	return res.Body, nil
+}
+
 // Used by parseRuneSequence to match input via bytes.HasPrefix.
 var (
 	prefixBreak     = []byte("÷ ")
@@ -0,0 +1,211 @@
+// This program generates a Go file containing a slice of test cases based on
+// the Unicode emoji test data file (emoji-test.txt). The command line arguments
+// are as follows:
+//
+//  1. The name of the Unicode data file (just the filename, without extension),
+//     or the path to a local copy of it (ending in ".txt").
+//  2. The name of the locally generated Go file.
+//  3. The name of the slice containing the test cases.
+//
+// Fully-qualified, minimally-qualified, unqualified, and component entries are
+// all included.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// We want to test against a specific version rather than the latest. When the
+// package is upgraded to a new version, change these to generate new tests.
+const (
+	testCaseURL = `https://www.unicode.org/Public/emoji/17.0/%s.txt`
+)
+
+// The qualification statuses found in emoji-test.txt.
+var statuses = map[string]bool{
+	"component":           true,
+	"fully-qualified":     true,
+	"minimally-qualified": true,
+	"unqualified":         true,
+}
+
+func main() {
+	if len(os.Args) < 4 {
+		fmt.Println("Not enough arguments, see code for details")
+		os.Exit(1)
+	}
+
+	log.SetPrefix("gen_emojitest: ")
+	log.SetFlags(0)
+
+	// Read text of testcases and parse into Go source code.
+	source := os.Args[1]
+	if !strings.HasSuffix(source, ".txt") {
+		source = fmt.Sprintf(testCaseURL, source)
+	}
+	src, err := parse(source)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Format the Go code.
+	formatted, err := format.Source(src)
+	if err != nil {
+		log.Fatalln("gofmt:", err)
+	}
+
+	// Write it out.
+	log.Print("Writing to ", os.Args[2])
+	if err := os.WriteFile(os.Args[2], formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse reads an emoji test file, either from a local file or from a URL. It
+// parses the file data into Go source code representing the test cases.
+func parse(url string) ([]byte, error) {
+	log.Printf("Parsing %s", url)
+	body, err := open(url)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	if !strings.HasPrefix(url, "https://") {
+		url = filepath.Base(url)
+	}
+
+	buf := new(bytes.Buffer)
+	buf.Grow(500 << 10)
+
+	sc := bufio.NewScanner(body)
+	num := 0
+	var (
+		line    []byte
+		version string
+	)
+	for sc.Scan() {
+		num++
+		line = sc.Bytes()
+		if v, ok := bytes.CutPrefix(line, []byte("# Version:")); ok && version == "" {
+			version = string(bytes.TrimSpace(v))
+		}
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		original, status, name, err := parseLine(line)
+		if err != nil {
+			return nil, fmt.Errorf(`line %d: %v: %q`, num, err, line)
+		}
+		fmt.Fprintf(buf, `{
+	name: %q,
+	original: "%s",
+	status: %q,
+},
+`, name, original, status)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	// Check for final "#EOF", useful check if we're streaming via HTTP
+	if !bytes.Equal(line, []byte("#EOF")) {
+		return nil, fmt.Errorf(`line %d: exected "#EOF" as final line, got %q`, num, line)
+	}
+	if version == "" {
+		return nil, errors.New(`missing "# Version:" line`)
+	}
+	buf.WriteString("}\n")
+
+	// The header records the version found in the file so that a local copy
+	// can be told apart from the release named in testCaseURL.
+	src := new(bytes.Buffer)
+	src.Grow(buf.Len() + 512)
+	src.WriteString(`// Code generated by ./internal/cmd/gen_emojitest/gen_emojitest.go; DO NOT EDIT.
+
+package uniseg
+
+// ` + os.Args[3] + ` are emoji testcases taken from
+// ` + url + ` (Version: ` + version + `)
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+var ` + os.Args[3] + ` = []emojiTestCase {
+`)
+	src.Write(buf.Bytes())
+	return src.Bytes(), nil
+}
+
+// open opens an emoji test file. URLs are downloaded, everything else is read
+// from the local file system.
+func open(url string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(url, "https://") {
+		return os.Open(url)
+	}
+	res, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("GET %s: %s", url, res.Status)
+	}
+	return res.Body, nil
+}
+
+// parseLine parses a line of emoji-test.txt such as
+//
+//	263A FE0F ; fully-qualified # ☺️ E0.6 smiling face
+//
+// and returns the Go code for the code point sequence ("☺️"), the
+// qualification status, and the name of the emoji ("E0.6 smiling face").
+func parseLine(line []byte) (original, status, name string, err error) {
+	fields, comment, ok := bytes.Cut(line, []byte("#"))
+	if !ok {
+		return "", "", "", errors.New("missing comment")
+	}
+	codePoints, qualification, ok := bytes.Cut(fields, []byte(";"))
+	if !ok {
+		return "", "", "", errors.New("missing status")
+	}
+	status = string(bytes.TrimSpace(qualification))
+	if !statuses[status] {
+		return "", "", "", fmt.Errorf("unknown status %q", status)
+	}
+
+	var orig strings.Builder
+	for _, cp := range bytes.Fields(codePoints) {
+		for _, d := range cp {
+			if ('0' <= d && d <= '9') || ('A' <= d && d <= 'F') {
+				continue
+			}
+			return "", "", "", errors.New("bad hex digit")
+		}
+		switch len(cp) {
+		case 4:
+			orig.WriteString("\\u")
+		case 5:
+			orig.WriteString("\\U000")
+		default:
+			return "", "", "", errors.New("unsupport code point hex length")
+		}
+		orig.Write(cp)
+	}
+	if orig.Len() == 0 {
+		return "", "", "", errors.New("no code points")
+	}
+
+	// The comment is the emoji itself followed by its version and name.
+	comment = bytes.TrimSpace(comment)
+	if _, rest, ok := bytes.Cut(comment, []byte(" ")); ok {
+		comment = rest
+	}
+	return orig.String(), status, string(comment), nil
+}
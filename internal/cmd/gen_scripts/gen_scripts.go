@@ -0,0 +1,337 @@
+// This program generates a Go file containing the Script and Script_Extensions
+// property tables and the list of Unicode scripts, based on the Unicode
+// Character Database files Scripts.txt, ScriptExtensions.txt, and
+// PropertyValueAliases.txt. The command line arguments are as follows:
+//
+//  1. (Optional) "-ucd <directory>": read the files from the given local
+//     directory instead of downloading them.
+//  2. The name of the locally generated Go file.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// We want to test against a specific version rather than the latest. When the
+// package is upgraded to a new version, change these to generate new tests.
+const (
+	unicodeVersion = "17.0.0"
+	ucdURLFormat   = `https://www.unicode.org/Public/%s/ucd/%s`
+)
+
+// The regular expression for a line containing a code point range property.
+// The property may consist of several space-separated values.
+var propertyPattern = regexp.MustCompile(`^([0-9A-F]{4,6})(?:\.\.([0-9A-F]{4,6}))?\s*;\s*([A-Za-z0-9_ ]+?)\s*#\s?(.*)$`)
+
+// The regular expression for a script line of PropertyValueAliases.txt.
+var aliasPattern = regexp.MustCompile(`^sc\s*;\s*([A-Za-z]{4})\s*;\s*([A-Za-z0-9_]+)`)
+
+// entry is a code point range with its property values.
+type entry struct {
+	from, to uint64
+	values   []string
+	comment  string
+}
+
+func main() {
+	var ucdDir string
+	flag.StringVar(&ucdDir, "ucd", "", "local directory containing the UCD files")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: gen_scripts [-ucd <directory>] <output file>")
+		os.Exit(2)
+	}
+	outputFilename := flag.Arg(0)
+
+	log.SetPrefix("gen_scripts: ")
+	log.SetFlags(0)
+
+	src, err := generate(ucdDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Format the Go code.
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		log.Fatal("gofmt:", err)
+	}
+
+	// Save it to the (local) target file.
+	log.Print("Writing to ", outputFilename)
+	if err := os.WriteFile(outputFilename, formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate parses the UCD files and returns the Go source code.
+func generate(ucdDir string) (string, error) {
+	// Script names and their ISO 15924 codes.
+	codes := make(map[string]string) // Long name to code.
+	names := make(map[string]string) // Code to long name.
+	aliasesSource, err := parseFile(ucdDir, "PropertyValueAliases.txt", func(line string) error {
+		if fields := aliasPattern.FindStringSubmatch(line); fields != nil {
+			codes[fields[2]] = fields[1]
+			names[fields[1]] = fields[2]
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Scripts.
+	var scripts []entry
+	scriptsSource, err := parseFile(ucdDir, "Scripts.txt", func(line string) error {
+		e, err := parseEntry(line)
+		if err != nil {
+			return err
+		}
+		if _, ok := codes[e.values[0]]; !ok {
+			return fmt.Errorf("unknown script %q", e.values[0])
+		}
+		scripts = append(scripts, e)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Script extensions.
+	var extensions []entry
+	extensionsSource, err := parseFile(ucdDir, "ScriptExtensions.txt", func(line string) error {
+		e, err := parseEntry(line)
+		if err != nil {
+			return err
+		}
+		for i, code := range e.values {
+			name, ok := names[code]
+			if !ok {
+				return fmt.Errorf("unknown script code %q", code)
+			}
+			e.values[i] = name
+		}
+		extensions = append(extensions, e)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Collect the scripts which occur in the tables. The special values come
+	// first, the rest is sorted by name.
+	used := map[string]bool{"Unknown": true, "Common": true, "Inherited": true}
+	for _, e := range append(scripts, extensions...) {
+		for _, v := range e.values {
+			used[v] = true
+		}
+	}
+	list := []string{"Unknown", "Common", "Inherited"}
+	var rest []string
+	for name := range used {
+		if name != "Unknown" && name != "Common" && name != "Inherited" {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(rest)
+	list = append(list, rest...)
+
+	sortEntries(scripts)
+	scripts = eytzinger(scripts)
+	sortEntries(extensions)
+	extensions = eytzinger(extensions)
+
+	// Script constants.
+	var buf bytes.Buffer
+	buf.WriteString(`// Code generated by internal/cmd/gen_scripts; DO NOT EDIT.
+
+package uniseg
+
+// The Unicode scripts as listed in
+// ` + aliasesSource + `
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+const (
+`)
+	for i, name := range list {
+		if i == 0 {
+			fmt.Fprintf(&buf, "%s Script = iota // %s\n", scriptConstant(name), codes[name])
+		} else {
+			fmt.Fprintf(&buf, "%s // %s\n", scriptConstant(name), codes[name])
+		}
+	}
+	buf.WriteString(`)
+
+// scriptNames contains the ISO 15924 codes and the Unicode names of the
+// scripts, indexed by Script.
+var scriptNames = [...]struct{ code, name string }{
+`)
+	for _, name := range list {
+		fmt.Fprintf(&buf, "%s: {%q, %q},\n", scriptConstant(name), codes[name], name)
+	}
+
+	// Script table.
+	buf.WriteString(`}
+
+// scripts are taken from
+// ` + scriptsSource + `
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+var scripts = dictionary[Script]{
+`)
+	for _, e := range scripts {
+		fmt.Fprintf(&buf, "{runeRange{0x%04X,0x%04X}, %s}, // %s\n", e.from, e.to, scriptConstant(e.values[0]), e.comment)
+	}
+
+	// Script extensions table.
+	buf.WriteString(`}
+
+// scriptExtensions are taken from
+// ` + extensionsSource + `
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+var scriptExtensions = dictionary[[]Script]{
+`)
+	for _, e := range extensions {
+		constants := make([]string, len(e.values))
+		for i, v := range e.values {
+			constants[i] = scriptConstant(v)
+		}
+		fmt.Fprintf(&buf, "{runeRange{0x%04X,0x%04X}, []Script{%s}}, // %s\n", e.from, e.to, strings.Join(constants, ", "), e.comment)
+	}
+	buf.WriteString("}\n")
+
+	return buf.String(), nil
+}
+
+// parseFile reads the given UCD file, either from the local directory
+// "ucdDir" or from unicode.org, and calls "handle" for each line which is not
+// empty or a comment. It returns a description of the source of the file for
+// the generated comments: the URL or, for local files, the first line of the
+// file which names the file and its version. Local files which do not name the
+// expected version or lack the Unicode copyright line are rejected.
+func parseFile(ucdDir, name string, handle func(line string) error) (string, error) {
+	url := fmt.Sprintf(ucdURLFormat, unicodeVersion, name)
+	source := url
+	var in io.ReadCloser
+	if ucdDir != "" {
+		f, err := os.Open(filepath.Join(ucdDir, name))
+		if err != nil {
+			return "", err
+		}
+		in = f
+	} else {
+		res, err := http.Get(url)
+		if err != nil {
+			return "", err
+		}
+		if res.StatusCode != http.StatusOK {
+			res.Body.Close()
+			return "", fmt.Errorf("GET %s: %s", url, res.Status)
+		}
+		in = res.Body
+	}
+	defer in.Close()
+
+	// A local file must be a copy of the file named in the URL: its header
+	// names the file and its version, followed by the Unicode copyright.
+	ext := path.Ext(name)
+	versioned := strings.TrimSuffix(name, ext) + "-" + unicodeVersion + ext
+	copyright := ucdDir == ""
+
+	scanner := bufio.NewScanner(in)
+	num := 0
+	for scanner.Scan() {
+		num++
+		line := strings.TrimSpace(scanner.Text())
+		if num == 1 && ucdDir != "" {
+			source = strings.TrimSpace(strings.TrimPrefix(line, "#"))
+			if source != versioned {
+				return "", fmt.Errorf("%s: expected %q as the first line, got %q", name, "# "+versioned, line)
+			}
+		}
+		if strings.HasPrefix(line, "# © ") && strings.Contains(line, "Unicode") {
+			copyright = true
+		}
+
+		// Skip comments and empty lines.
+		if strings.HasPrefix(line, "#") || line == "" {
+			continue
+		}
+		if err := handle(line); err != nil {
+			return "", fmt.Errorf("%s line %d: %v", path.Base(name), num, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	if !copyright {
+		return "", fmt.Errorf("%s: not a copy of %s, no Unicode copyright line found", name, url)
+	}
+	return source, nil
+}
+
+// parseEntry parses a line of Scripts.txt or ScriptExtensions.txt.
+func parseEntry(line string) (entry, error) {
+	fields := propertyPattern.FindStringSubmatch(line)
+	if fields == nil {
+		return entry{}, errors.New("no property found")
+	}
+	from, _ := strconv.ParseUint(fields[1], 16, 64)
+	to := from
+	if fields[2] != "" {
+		to, _ = strconv.ParseUint(fields[2], 16, 64)
+	}
+	return entry{
+		from:    from,
+		to:      to,
+		values:  strings.Fields(fields[3]),
+		comment: fields[4],
+	}, nil
+}
+
+// scriptConstant returns the name of the Go constant for the given script.
+func scriptConstant(name string) string {
+	return "Script" + strings.ReplaceAll(name, "_", "")
+}
+
+// sortEntries sorts the given entries by their first code point.
+func sortEntries(entries []entry) {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].from < entries[j].from
+	})
+}
+
+func eytzinger[S ~[]E, E any](a S) S {
+	b := make(S, len(a))
+	ctx := &eytzingerContext[S, E]{a: a, b: b}
+	ctx.eytzinger(0, 0)
+	return b
+}
+
+type eytzingerContext[S ~[]E, E any] struct {
+	a S // input slice
+	b S // output slice
+}
+
+func (ctx *eytzingerContext[S, E]) eytzinger(i, k int) int {
+	if k < len(ctx.a) {
+		i = ctx.eytzinger(i, 2*k+1)
+		ctx.b[k] = ctx.a[i]
+		i++
+		i = ctx.eytzinger(i, 2*k+2)
+	}
+	return i
+}
@@ -0,0 +1,106 @@
+package uniseg
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Lexicon is a [WordSegmenter] which splits text into words from a word list
+// with word frequencies, typically for Chinese or Japanese text. It uses
+// maximal matching: of all ways to split the text into words from the list, it
+// chooses the one with the fewest unknown grapheme clusters and, among those,
+// the one with the fewest words. Ties are broken in favor of the most frequent
+// words, i.e. the highest product of word frequencies.
+//
+// Typically, a [Lexicon] is assigned to [Parser.CJK]:
+//
+//	p := &uniseg.Parser{CJK: lexicon}
+type Lexicon struct {
+	wordList
+
+	// The logarithms of the word frequencies, indexed like the words.
+	weights []float64
+
+	// The word frequencies, indexed like the words.
+	frequencies []int
+}
+
+// NewLexicon returns a new lexicon containing the given words with their
+// frequencies. Empty strings and invalid UTF-8 are ignored. Frequencies below
+// 1 are treated as 1.
+func NewLexicon(frequencies map[string]int) *Lexicon {
+	l := &Lexicon{}
+	for word := range frequencies {
+		if word != "" && utf8.ValidString(word) {
+			l.words = append(l.words, word)
+		}
+	}
+	l.init()
+	l.weights = make([]float64, len(l.words))
+	l.frequencies = make([]int, len(l.words))
+	for i, word := range l.words {
+		frequency := max(1, frequencies[word])
+		l.frequencies[i] = frequency
+		l.weights[i] = math.Log(float64(frequency))
+	}
+	return l
+}
+
+// ReadLexicon reads a lexicon from a text file. Each line contains a word,
+// optionally followed by whitespace and its frequency. The frequency defaults
+// to 1. Further fields (such as part-of-speech tags), empty lines, and lines
+// starting with "#" are ignored. If a word occurs more than once, its
+// frequencies are added up.
+func ReadLexicon(r io.Reader) (*Lexicon, error) {
+	frequencies := make(map[string]int)
+	scanner := bufio.NewScanner(r)
+	var num int
+	for scanner.Scan() {
+		num++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		frequency := 1
+		if len(fields) > 1 {
+			var err error
+			frequency, err = strconv.Atoi(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("uniseg: lexicon line %d: invalid frequency %q", num, fields[1])
+			}
+		}
+		frequencies[fields[0]] += frequency
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return NewLexicon(frequencies), nil
+}
+
+// Len returns the number of words in the lexicon.
+func (l *Lexicon) Len() int {
+	return len(l.words)
+}
+
+// Frequency returns the frequency of the given word, or 0 if the lexicon does
+// not contain it.
+func (l *Lexicon) Frequency(word string) int {
+	if i := l.index(word); i >= 0 {
+		return l.frequencies[i]
+	}
+	return 0
+}
+
+// FirstWord returns the length in bytes of the first word of the given text.
+// Text which is not found in the lexicon is returned one grapheme cluster at a
+// time. FirstWord is part of the [WordSegmenter] interface.
+func (l *Lexicon) FirstWord(text string) int {
+	return l.firstWord(text, func(word int) float64 {
+		return l.weights[word]
+	})
+}
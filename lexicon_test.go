@@ -0,0 +1,65 @@
+package uniseg
+
+import (
+	"strings"
+	"testing"
+)
+
+// cjkTestLexicon is a small Chinese and Japanese lexicon used for testing.
+const cjkTestLexicon = `# word frequency tag
+研究 100 v
+研究生 20 n
+生命 50 n
+命 5 n
+起源 30 n
+東京 80
+京都 60
+東京都 40
+都 10
+に 500
+住む 30
+コーヒー 20
+カップ 20
+`
+
+func TestLexicon(t *testing.T) {
+	lexicon, err := ReadLexicon(strings.NewReader(cjkTestLexicon + "研究 1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lexicon.Len() != 13 {
+		t.Errorf("Len() = %d, want 13", lexicon.Len())
+	}
+	if f := lexicon.Frequency("研究"); f != 101 {
+		t.Errorf("Frequency(%q) = %d, want 101", "研究", f)
+	}
+	if f := lexicon.Frequency("研"); f != 0 {
+		t.Errorf("Frequency(%q) = %d, want 0", "研", f)
+	}
+	if f := NewLexicon(map[string]int{"研究": -3}).Frequency("研究"); f != 1 {
+		t.Errorf("Frequency(%q) = %d, want 1", "研究", f)
+	}
+	if _, err := ReadLexicon(strings.NewReader("研究 many\n")); err == nil {
+		t.Error("ReadLexicon accepted an invalid frequency")
+	}
+
+	p := &Parser{CJK: lexicon}
+	for _, testCase := range []struct {
+		original string
+		expected []string
+	}{
+		{"研究生命起源", []string{"研究", "生命", "起源"}}, // Not "研究生", "命", "起源".
+		{"東京都に住む", []string{"東京都", "に", "住む"}},
+		{"コーヒーカップ", []string{"コーヒー", "カップ"}},
+		{"カップ麺", []string{"カップ", "麺"}},
+		{"I live in 東京!", []string{"I", " ", "live", " ", "in", " ", "東京", "!"}},
+		{"東京、京都", []string{"東京", "、", "京都"}},
+		{"ﾃﾚﾋﾞ", []string{"ﾃ", "ﾚ", "ﾋﾞ"}}, // Unknown words.
+	} {
+		testSegmentedWords(t, p, testCase.original, testCase.expected)
+	}
+
+	// Without a segmenter.
+	testSegmentedWords(t, &Parser{}, "東京都に住む", []string{"東", "京", "都", "に", "住", "む"})
+	testSegmentedWords(t, &Parser{}, "コーヒーカップ", []string{"コーヒーカップ"})
+}
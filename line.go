@@ -47,7 +47,7 @@ import "unicode/utf8"
 // [Unicode Standard Annex #14]: https://www.unicode.org/reports/tr14/tr14-53.html
 // [UAX #14 LB3]: https://www.unicode.org/reports/tr14/tr14-53.html#Algorithm
 func FirstLineSegment(b []byte, state LineBreakState) (segment, rest []byte, mustBreak bool, newState LineBreakState) {
-	return firstLineSegment(b, state, utf8.DecodeRune)
+	return firstLineSegment(DefaultParser, b, state, utf8.DecodeRune)
 }
 
 // FirstLineSegment returns the prefix of the given byte slice after which a
@@ -92,25 +92,30 @@ func FirstLineSegment(b []byte, state LineBreakState) (segment, rest []byte, mus
 // addressed in Section 8.2 Example 6 of UAX #14. To avoid this, you can use
 // the [Step] function instead.
 //
+// If [Parser.Hyphens] is set, [LineBreakState.HyphenBreak] of the returned
+// state reports whether the segment ends at a hyphenation point, where a
+// hyphen must be displayed if the line is broken there. With [HyphensAuto],
+// segments also end at the hyphenation points found by [Parser.Hyphenator].
+//
 // [Unicode Standard Annex #14]: https://www.unicode.org/reports/tr14/tr14-53.html
 // [UAX #14 LB3]: https://www.unicode.org/reports/tr14/tr14-53.html#Algorithm
-func (*Parser) FirstLineSegment(b []byte, state LineBreakState) (segment, rest []byte, mustBreak bool, newState LineBreakState) {
-	return firstLineSegment(b, state, utf8.DecodeRune)
+func (p *Parser) FirstLineSegment(b []byte, state LineBreakState) (segment, rest []byte, mustBreak bool, newState LineBreakState) {
+	return firstLineSegment(p, b, state, utf8.DecodeRune)
 }
 
 // FirstLineSegmentInString is like [FirstLineSegment] but its input and outputs
 // are strings.
 func FirstLineSegmentInString(str string, state LineBreakState) (segment, rest string, mustBreak bool, newState LineBreakState) {
-	return firstLineSegment(str, state, utf8.DecodeRuneInString)
+	return firstLineSegment(DefaultParser, str, state, utf8.DecodeRuneInString)
 }
 
 // FirstLineSegmentInString is like [Parser.FirstLineSegment] but its input and outputs
 // are strings.
-func (*Parser) FirstLineSegmentInString(str string, state LineBreakState) (segment, rest string, mustBreak bool, newState LineBreakState) {
-	return firstLineSegment(str, state, utf8.DecodeRuneInString)
+func (p *Parser) FirstLineSegmentInString(str string, state LineBreakState) (segment, rest string, mustBreak bool, newState LineBreakState) {
+	return firstLineSegment(p, str, state, utf8.DecodeRuneInString)
 }
 
-func firstLineSegment[T bytes](str T, state LineBreakState, decoder runeDecoder[T]) (segment, rest T, mustBreak bool, newState LineBreakState) {
+func firstLineSegment[T bytes](p *Parser, str T, state LineBreakState, decoder runeDecoder[T]) (segment, rest T, mustBreak bool, newState LineBreakState) {
 	var zero T
 
 	// An empty byte slice returns nothing.
@@ -125,20 +130,96 @@ func firstLineSegment[T bytes](str T, state LineBreakState, decoder runeDecoder[
 	}
 
 	// If we don't know the state, determine it now.
+	var (
+		urlLeft     int
+		hyphenState LineBreakState
+	)
 	if state <= 0 {
-		state, _ = transitionLineBreakState(state, r, str[length:], decoder)
+		state, _ = transitionLineBreakState(p, state, r, str[length:], decoder)
+	} else {
+		urlLeft = int(state>>lbURLShift) & lbURLMask
+		hyphenState = state >> lbHyphenShift
+		state &= maskLineState
+	}
+
+	// The end of the current segment found by the parser's complex context
+	// segmenter or of a URL, if any.
+	segmentEnd := urlLeft
+	if segmentEnd == 0 {
+		segmentEnd = firstURL(p, str, -1, decoder)
+	}
+	url := segmentEnd > 0
+	if !url {
+		segmentEnd = firstSegment(p, str, decoder, lineSegmenter)
+	}
+
+	// The hyphenation points of the current word: its window at the byte
+	// offset hyphenStart, the byte offset of the next point, if any, and the
+	// window after it.
+	var (
+		hyphenStart, hyphenEnd   int
+		hyphenation, hyphenAfter hyphenWindow
+	)
+	if hyphenState&lbHyphenWord != 0 {
+		hyphenation = hyphenWindow(hyphenState >> lbHyphenWindowShift)
+		hyphenEnd, hyphenAfter = nextHyphenPoint(p, str, hyphenation, decoder)
+	} else if !url {
+		if window, ok := startHyphenWindow(p, str, -1, decoder); ok {
+			hyphenation = window
+			hyphenEnd, hyphenAfter = nextHyphenPoint(p, str, window, decoder)
+		}
 	}
 
 	// Transition until we find a boundary.
 	var boundary LineBreak
+	prev := r
 	for {
 		r, l := decoder(str[length:])
-		state, boundary = transitionLineBreakState(state, r, str[length+l:], decoder)
+		state, boundary = transitionLineBreakState(p, state, r, str[length+l:], decoder)
+		if length < segmentEnd {
+			if url {
+				boundary = urlLineBreak(prev, r)
+			} else if boundary == LineCanBreak {
+				boundary = LineDontBreak
+			}
+		} else if length == segmentEnd && !url && boundary == LineDontBreak && startsSegment(p, r, lineSegmenter) {
+			boundary = LineCanBreak // The segmenter continues with another segment.
+		}
+
+		if length == hyphenEnd && boundary == LineDontBreak {
+			boundary = LineHyphenBreak
+		}
 
 		if boundary != LineDontBreak {
+			if url && length < segmentEnd {
+				state |= LineBreakState(segmentEnd-length) << lbURLShift // Continue the URL.
+			}
+			window := hyphenWordDone // The hyphenation points after the segment.
+			if length == hyphenEnd {
+				window = hyphenAfter
+			} else if length < hyphenEnd {
+				window, _, _ = advanceHyphenWindow(p, str[hyphenStart:], hyphenation, runeCount(str[hyphenStart:length], decoder), decoder)
+			}
+			state |= lineHyphenState(p, prev, boundary, window) << lbHyphenShift
 			return str[:length], str[length:], boundary == LineMustBreak, state
 		}
+		if length >= segmentEnd {
+			url = false
+			if n := firstURL(p, str[length:], prev, decoder); n > 0 {
+				segmentEnd, url = length+n, true
+			} else if n := firstSegment(p, str[length:], decoder, lineSegmenter); n > 0 {
+				segmentEnd = length + n
+			}
+		}
+		if length >= segmentEnd && length >= hyphenEnd {
+			if window, ok := startHyphenWindow(p, str[length:], prev, decoder); ok {
+				if n, after := nextHyphenPoint(p, str[length:], window, decoder); n > 0 {
+					hyphenStart, hyphenEnd, hyphenation, hyphenAfter = length, length+n, window, after
+				}
+			}
+		}
 
+		prev = r
 		length += l
 		if len(str) <= length {
 			return str, zero, true, lbAny // LB3.
@@ -146,36 +227,65 @@ func firstLineSegment[T bytes](str T, state LineBreakState, decoder runeDecoder[
 	}
 }
 
+// The hyphenation state stored above the URL in the states returned by
+// firstLineSegment: the following flags and, above them, the hyphenWindow of
+// the word after the end of the segment.
+const (
+	lbHyphenBreak = 1 << iota // The segment ends at a hyphenation point.
+	lbHyphenWord              // The segment ends inside a word.
+
+	lbHyphenShift       = 31 // The position of the hyphenation state in the states.
+	lbHyphenWindowShift = 2  // The position of the hyphenWindow in the hyphenation state.
+)
+
+// lineHyphenState returns the hyphenation state for the end of a line segment
+// after "prev" with the given boundary. "window" holds the hyphenation points
+// of the rest of the word if the segment ends inside a word.
+func lineHyphenState(p *Parser, prev rune, boundary LineBreak, window hyphenWindow) (hyphenState LineBreakState) {
+	if p == nil || p.Hyphens == HyphensDefault {
+		return 0
+	}
+	if boundary == LineHyphenBreak || prev == softHyphen && boundary == LineCanBreak {
+		hyphenState = lbHyphenBreak
+	}
+	if p.Hyphens != HyphensAuto || p.Hyphenator == nil || startsHyphenationWord(p, prev) {
+		return
+	}
+	return hyphenState | lbHyphenWord | LineBreakState(window)<<lbHyphenWindowShift
+}
+
+// HyphenBreak returns true if the line segment returned by
+// [Parser.FirstLineSegment] together with this state ends at a hyphenation
+// point, where a hyphen must be displayed if the line is broken there (see
+// [LineHyphenBreak]). This requires [Parser.Hyphens] to be set.
+func (s LineBreakState) HyphenBreak() bool {
+	return s > 0 && s>>lbHyphenShift&lbHyphenBreak != 0
+}
+
 // HasTrailingLineBreak returns true if the last rune in the given byte slice is
 // one of the hard line break code points defined in LB4 and LB5 of [UAX #14].
 //
 // [UAX #14]: https://www.unicode.org/reports/tr14/tr14-53.html#Algorithm
 func HasTrailingLineBreak(b []byte) bool {
-	r, _ := utf8.DecodeLastRune(b)
-	p := lineBreakCodePoints.search(r).lbProperty
-	return p == lbprBK || p == lbprCR || p == lbprLF || p == lbprNL
+	return DefaultParser.HasTrailingLineBreak(b)
 }
 
 // HasTrailingLineBreak returns true if the last rune in the given byte slice is
 // one of the hard line break code points defined in LB4 and LB5 of [UAX #14].
 //
 // [UAX #14]: https://www.unicode.org/reports/tr14/tr14-53.html#Algorithm
-func (*Parser) HasTrailingLineBreak(b []byte) bool {
+func (p *Parser) HasTrailingLineBreak(b []byte) bool {
 	r, _ := utf8.DecodeLastRune(b)
-	p := lineBreakCodePoints.search(r).lbProperty
-	return p == lbprBK || p == lbprCR || p == lbprLF || p == lbprNL
+	return p.isHardLineBreak(r)
 }
 
 // HasTrailingLineBreakInString is like [HasTrailingLineBreak] but for a string.
 func HasTrailingLineBreakInString(str string) bool {
-	r, _ := utf8.DecodeLastRuneInString(str)
-	p := lineBreakCodePoints.search(r).lbProperty
-	return p == lbprBK || p == lbprCR || p == lbprLF || p == lbprNL
+	return DefaultParser.HasTrailingLineBreakInString(str)
 }
 
 // HasTrailingLineBreakInString is like [HasTrailingLineBreak] but for a string.
-func (*Parser) HasTrailingLineBreakInString(str string) bool {
+func (p *Parser) HasTrailingLineBreakInString(str string) bool {
 	r, _ := utf8.DecodeLastRuneInString(str)
-	p := lineBreakCodePoints.search(r).lbProperty
-	return p == lbprBK || p == lbprCR || p == lbprLF || p == lbprNL
+	return p.isHardLineBreak(r)
 }
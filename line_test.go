@@ -2,9 +2,27 @@ package uniseg
 
 import (
 	"runtime"
+	"strings"
 	"testing"
+	"unicode/utf8"
 )
 
+// lineBreakOffsets returns the byte offsets of the line break opportunities
+// between the given segments. The end of the text is not included.
+func lineBreakOffsets(segments [][]rune) map[int]bool {
+	offsets := make(map[int]bool)
+	var offset int
+	for i, segment := range segments {
+		for _, r := range segment {
+			offset += utf8.RuneLen(r)
+		}
+		if i < len(segments)-1 {
+			offsets[offset] = true
+		}
+	}
+	return offsets
+}
+
 // Test all official Unicode test cases for line breaks using the byte slice
 // function.
 func TestLineCasesBytes(t *testing.T) {
@@ -165,32 +183,53 @@ func TestHasTrailingLineBreakInString(t *testing.T) {
 	}
 }
 
-func collectLineSegmentsInString(input string) []string {
+func collectLineSegmentsInString(p *Parser, input string) []string {
 	var (
 		segments []string
 		state    LineBreakState
 	)
 	for len(input) > 0 {
 		var segment string
-		segment, input, _, state = FirstLineSegmentInString(input, state)
+		segment, input, _, state = p.FirstLineSegmentInString(input, state)
 		segments = append(segments, segment)
 	}
 	return segments
 }
 
-func collectLineSegmentsInBytes(input []byte) []string {
+func collectLineSegmentsInBytes(p *Parser, input []byte) []string {
 	var (
 		segments []string
 		state    LineBreakState
 	)
 	for len(input) > 0 {
 		var segment []byte
-		segment, input, _, state = FirstLineSegment(input, state)
+		segment, input, _, state = p.FirstLineSegment(input, state)
 		segments = append(segments, string(segment))
 	}
 	return segments
 }
 
+func collectLineSegmentsWithStep(p *Parser, input string) []string {
+	var (
+		segments   []string
+		start, end int
+		state      State
+	)
+	for rest := input; len(rest) > 0; {
+		var (
+			cluster    string
+			boundaries Boundaries
+		)
+		cluster, rest, boundaries, state = p.StepString(rest, state)
+		end += len(cluster)
+		if boundaries.Line() != LineDontBreak {
+			segments = append(segments, input[start:end])
+			start = end
+		}
+	}
+	return segments
+}
+
 func TestLB20aWordInitialHyphenContext(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -226,7 +265,7 @@ func TestLB20aWordInitialHyphenContext(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name+"/string", func(t *testing.T) {
-			got := collectLineSegmentsInString(tt.input)
+			got := collectLineSegmentsInString(DefaultParser, tt.input)
 			if len(got) != len(tt.expected) {
 				t.Fatalf("collectLineSegmentsInString(%q) returned %d segments, want %d (%q)", tt.input, len(got), len(tt.expected), got)
 			}
@@ -238,7 +277,7 @@ func TestLB20aWordInitialHyphenContext(t *testing.T) {
 		})
 
 		t.Run(tt.name+"/bytes", func(t *testing.T) {
-			got := collectLineSegmentsInBytes([]byte(tt.input))
+			got := collectLineSegmentsInBytes(DefaultParser, []byte(tt.input))
 			if len(got) != len(tt.expected) {
 				t.Fatalf("collectLineSegmentsInBytes(%q) returned %d segments, want %d (%q)", tt.input, len(got), len(tt.expected), got)
 			}
@@ -248,6 +287,257 @@ func TestLB20aWordInitialHyphenContext(t *testing.T) {
 				}
 			}
 		})
+
+		t.Run(tt.name+"/step", func(t *testing.T) {
+			got := collectLineSegmentsWithStep(DefaultParser, tt.input)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("collectLineSegmentsWithStep(%q) returned %d segments, want %d (%q)", tt.input, len(got), len(tt.expected), got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Fatalf("collectLineSegmentsWithStep(%q)[%d] = %q, want %q", tt.input, i, got[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestComplexContextLineBreaks(t *testing.T) {
+	dictionary := NewWordDictionary(thaiTestWords)
+	tests := []struct {
+		name     string
+		parser   *Parser
+		input    string
+		expected []string
+	}{
+		{
+			name:     "no segmenter",
+			parser:   &Parser{},
+			input:    "ภาษาไทยง่าย",
+			expected: []string{"ภาษาไทยง่าย"},
+		},
+		{
+			name:     "word segmenter",
+			parser:   &Parser{ComplexContext: dictionary},
+			input:    "ภาษาไทยง่าย",
+			expected: []string{"ภาษา", "ไทย", "ง่าย"},
+		},
+		{
+			name:     "line breaker",
+			parser:   &Parser{ComplexContextBreaker: dictionary},
+			input:    "ภาษาไทยง่าย",
+			expected: []string{"ภาษา", "ไทย", "ง่าย"},
+		},
+		{
+			name:     "line breaker takes precedence",
+			parser:   &Parser{ComplexContext: dictionary, ComplexContextBreaker: NewWordDictionary([]string{"ภาษาไทย"})},
+			input:    "ภาษาไทยง่าย",
+			expected: []string{"ภาษาไทย", "ง่", "า", "ย"}, // Unknown text is broken between clusters.
+		},
+		{
+			name:     "mixed with Latin",
+			parser:   &Parser{ComplexContext: dictionary},
+			input:    "Thai ภาษาไทย.",
+			expected: []string{"Thai ", "ภาษา", "ไทย."},
+		},
+		{
+			name:     "brackets",
+			parser:   &Parser{ComplexContext: dictionary},
+			input:    "(ภาษาไทย) ง่าย",
+			expected: []string{"(ภาษา", "ไทย) ", "ง่าย"},
+		},
+		{
+			name:     "mandatory breaks",
+			parser:   &Parser{ComplexContext: dictionary},
+			input:    "ภาษาไทย\nไทย",
+			expected: []string{"ภาษา", "ไทย\n", "ไทย"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, got := range map[string][]string{
+				"FirstLineSegmentInString": collectLineSegmentsInString(tt.parser, tt.input),
+				"FirstLineSegment":         collectLineSegmentsInBytes(tt.parser, []byte(tt.input)),
+				"StepString":               collectLineSegmentsWithStep(tt.parser, tt.input),
+			} {
+				if strings.Join(got, "|") != strings.Join(tt.expected, "|") {
+					t.Errorf("%s(%q) = %q, want %q", name, tt.input, got, tt.expected)
+				}
+			}
+		})
+	}
+
+	// Word boundaries are not affected by the line breaker.
+	testSegmentedWords(t, &Parser{ComplexContextBreaker: dictionary}, "ไทย", []string{"ไ", "ท", "ย"})
+}
+
+// lineBreakStrictnessTestCases are taken from the examples of the CSS Text
+// Module Level 3, section 5.3 "Line Breaking Strictness". Each case lists the
+// expected line segments for strict, normal, and loose line breaking.
+var lineBreakStrictnessTestCases = []struct {
+	name                  string
+	input                 string
+	strict, normal, loose []string
+}{
+	{"small kana", "あぁ", []string{"あぁ"}, []string{"あ", "ぁ"}, []string{"あ", "ぁ"}},
+	{"small katakana", "ァィゥ", []string{"ァィゥ"}, []string{"ァ", "ィ", "ゥ"}, []string{"ァ", "ィ", "ゥ"}},
+	{"halfwidth small katakana", "ｱｧ", []string{"ｱｧ"}, []string{"ｱ", "ｧ"}, []string{"ｱ", "ｧ"}},
+	{"prolonged sound mark", "あーあ", []string{"あー", "あ"}, []string{"あ", "ー", "あ"}, []string{"あ", "ー", "あ"}},
+	{"wave dash", "あ〜あ", []string{"あ〜", "あ"}, []string{"あ", "〜", "あ"}, []string{"あ", "〜", "あ"}},
+	{"double hyphen", "あ゠あ", []string{"あ゠", "あ"}, []string{"あ", "゠", "あ"}, []string{"あ", "゠", "あ"}},
+	{"iteration mark", "あ々", []string{"あ々"}, []string{"あ々"}, []string{"あ", "々"}},
+	{"hiragana iteration mark", "あゝ", []string{"あゝ"}, []string{"あゝ"}, []string{"あ", "ゝ"}},
+	{"inseparable", "あ…", []string{"あ…"}, []string{"あ…"}, []string{"あ", "…"}},
+	{"two dot leader", "あ‥", []string{"あ‥"}, []string{"あ‥"}, []string{"あ", "‥"}},
+	{"katakana middle dot", "あ・あ", []string{"あ・", "あ"}, []string{"あ・", "あ"}, []string{"あ", "・", "あ"}},
+	{"fullwidth colon", "あ：あ", []string{"あ：", "あ"}, []string{"あ：", "あ"}, []string{"あ", "：", "あ"}},
+	{"fullwidth exclamation mark", "あ！あ", []string{"あ！", "あ"}, []string{"あ！", "あ"}, []string{"あ", "！", "あ"}},
+	{"double exclamation mark", "あ‼あ", []string{"あ‼", "あ"}, []string{"あ‼", "あ"}, []string{"あ", "‼", "あ"}},
+	{"fullwidth percent sign", "あ％", []string{"あ％"}, []string{"あ％"}, []string{"あ", "％"}},
+	{"degree sign", "あ°", []string{"あ°"}, []string{"あ°"}, []string{"あ", "°"}},
+	{"per mille sign", "あ‰", []string{"あ‰"}, []string{"あ‰"}, []string{"あ", "‰"}},
+	{"narrow percent sign", "あ%", []string{"あ%"}, []string{"あ%"}, []string{"あ%"}},
+	{"fullwidth yen sign", "￥あ", []string{"￥あ"}, []string{"￥あ"}, []string{"￥", "あ"}},
+	{"hyphen after ideograph", "あ‐あ", []string{"あ‐", "あ"}, []string{"あ‐", "あ"}, []string{"あ", "‐", "あ"}},
+	{"en dash after ideograph", "漢–字", []string{"漢–", "字"}, []string{"漢–", "字"}, []string{"漢", "–", "字"}},
+	{"hyphen after letter", "a‐b", []string{"a‐", "b"}, []string{"a‐", "b"}, []string{"a‐", "b"}},
+	{"wave dash after letter", "a〜b", []string{"a〜", "b"}, []string{"a〜", "b"}, []string{"a〜", "b"}},
+	{"iteration mark after letter", "a々", []string{"a々"}, []string{"a々"}, []string{"a々"}},
+	{"inseparable between letters", "ab…cd", []string{"ab…", "cd"}, []string{"ab…", "cd"}, []string{"ab…", "cd"}},
+	{"fullwidth yen sign after letters", "abc￥100", []string{"abc￥100"}, []string{"abc￥100"}, []string{"abc￥100"}},
+	{"fullwidth yen sign after ideograph", "円￥あ", []string{"円", "￥あ"}, []string{"円", "￥あ"}, []string{"円", "￥", "あ"}},
+	{"fullwidth percent sign after digit", "1％あ", []string{"1％", "あ"}, []string{"1％", "あ"}, []string{"1％", "あ"}},
+}
+
+func TestLineBreakStrictness(t *testing.T) {
+	for _, tt := range lineBreakStrictnessTestCases {
+		for strictness, expected := range [][]string{tt.strict, tt.normal, tt.loose} {
+			p := &Parser{LineBreakStrictness: LineBreakStrictness(strictness)}
+			for name, got := range map[string][]string{
+				"FirstLineSegmentInString": collectLineSegmentsInString(p, tt.input),
+				"FirstLineSegment":         collectLineSegmentsInBytes(p, []byte(tt.input)),
+				"StepString":               collectLineSegmentsWithStep(p, tt.input),
+			} {
+				if strings.Join(got, "|") != strings.Join(expected, "|") {
+					t.Errorf("%s: %s(%q) with strictness %d = %q, want %q", tt.name, name, tt.input, strictness, got, expected)
+				}
+			}
+		}
+	}
+}
+
+func TestWordBreakMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     WordBreakMode
+		input    string
+		expected []string
+	}{
+		{"normal Korean", WordBreakNormal, "한국어 텍스트", []string{"한", "국", "어 ", "텍", "스", "트"}},
+		{"keep-all Korean", WordBreakKeepAll, "한국어 텍스트", []string{"한국어 ", "텍스트"}},
+		{"keep-all Korean jamo", WordBreakKeepAll, "\u1100\u1161\u1102\u1161", []string{"\u1100\u1161\u1102\u1161"}},
+		{"keep-all Japanese", WordBreakKeepAll, "日本語、かな", []string{"日本語、", "かな"}},
+		{"keep-all mixed", WordBreakKeepAll, "Go言語です", []string{"Go言語です"}},
+		{"keep-all digits", WordBreakKeepAll, "第3章", []string{"第3章"}},
+		{"keep-all mandatory break", WordBreakKeepAll, "한국\n어", []string{"한국\n", "어"}},
+		{"keep-all English", WordBreakKeepAll, "hello world", []string{"hello ", "world"}},
+		{"break-all English", WordBreakBreakAll, "hello w", []string{"h", "e", "l", "l", "o ", "w"}},
+		{"break-all digits", WordBreakBreakAll, "a12", []string{"a", "1", "2"}},
+		{"break-all hyphen", WordBreakBreakAll, "ab-cd", []string{"a", "b-", "c", "d"}},
+		{"break-all punctuation", WordBreakBreakAll, "ab.", []string{"a", "b."}},
+		{"break-all combining marks", WordBreakBreakAll, "e\u0301e", []string{"e\u0301", "e"}},
+		{"break-all no-break space", WordBreakBreakAll, "a\u00a0b", []string{"a\u00a0b"}},
+		{"break-all word joiner", WordBreakBreakAll, "a\u2060b", []string{"a\u2060b"}},
+		{"break-all mandatory break", WordBreakBreakAll, "ab\ncd", []string{"a", "b\n", "c", "d"}},
+	}
+
+	for _, tt := range tests {
+		p := &Parser{WordBreak: tt.mode}
+		var fromGraphemes []string
+		g := p.NewGraphemes(tt.input)
+		var segment string
+		for g.Next() {
+			segment += g.Str()
+			if g.LineBreak() != LineDontBreak {
+				fromGraphemes = append(fromGraphemes, segment)
+				segment = ""
+			}
+		}
+		for name, got := range map[string][]string{
+			"FirstLineSegmentInString": collectLineSegmentsInString(p, tt.input),
+			"FirstLineSegment":         collectLineSegmentsInBytes(p, []byte(tt.input)),
+			"StepString":               collectLineSegmentsWithStep(p, tt.input),
+			"Graphemes":                fromGraphemes,
+		} {
+			if strings.Join(got, "|") != strings.Join(tt.expected, "|") {
+				t.Errorf("%s: %s(%q) = %q, want %q", tt.name, name, tt.input, got, tt.expected)
+			}
+		}
+	}
+}
+
+func TestURLLineBreaks(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		input    string
+		expected []string
+	}{
+		{"default query", false, "https://example.com/a?b=c&d=e#f", []string{"https://", "example.com/", "a?", "b=c&d=e#f"}},
+		{"query", true, "https://example.com/a?b=c&d=e#f", []string{"https://", "example.com/", "a", "?b=c", "&d=e", "#f"}},
+		{"default hyphen", false, "https://example.com/foo-bar/baz", []string{"https://", "example.com/", "foo-", "bar/", "baz"}},
+		{"hyphen", true, "https://example.com/foo-bar/baz", []string{"https://", "example.com/", "foo-bar/", "baz"}},
+		{"sentence", true, "See http://x.org/a?q. Ok", []string{"See ", "http://", "x.org/", "a", "?q. ", "Ok"}},
+		{"www", true, "(www.example.org/wiki/Go_(lang)?x) y", []string{"(www.example.org/", "wiki/", "Go_(lang)", "?x) ", "y"}},
+		{"after ideograph", true, "日https://a.b/c?d", []string{"日", "https://", "a.b/", "c", "?d"}},
+		{"scheme only", true, "https:// a?b", []string{"https:// ", "a?", "b"}},
+		{"not a URL", true, "a/b?c", []string{"a/", "b?", "c"}},
+	}
+
+	for _, tt := range tests {
+		p := &Parser{URLLineBreaks: tt.enabled}
+		for name, got := range map[string][]string{
+			"FirstLineSegmentInString": collectLineSegmentsInString(p, tt.input),
+			"FirstLineSegment":         collectLineSegmentsInBytes(p, []byte(tt.input)),
+			"StepString":               collectLineSegmentsWithStep(p, tt.input),
+		} {
+			if strings.Join(got, "|") != strings.Join(tt.expected, "|") {
+				t.Errorf("%s: %s(%q) = %q, want %q", tt.name, name, tt.input, got, tt.expected)
+			}
+		}
+	}
+}
+
+// Test that the functions keep URLs of up to MaxTokenLength bytes together and
+// break longer ones like other text.
+func TestLongURLLineBreaks(t *testing.T) {
+	url := func(n int) string {
+		return "https://example.com/a-" + strings.Repeat("b", n-24) + "?c"
+	}
+	enabled, disabled := &Parser{URLLineBreaks: true}, &Parser{}
+	for _, tt := range []struct {
+		input string
+		url   bool // Whether the input is kept together as a URL.
+	}{
+		{url(900), true},
+		{url(1023), true},
+		{url(1024), false},
+		{url(70000), false},
+	} {
+		expected := []string{"https://", "example.com/", "a-" + strings.Repeat("b", len(tt.input)-24), "?c"}
+		for name, collect := range map[string]func(*Parser, string) []string{
+			"FirstLineSegmentInString": collectLineSegmentsInString,
+			"StepString":               collectLineSegmentsWithStep,
+		} {
+			want := expected
+			if !tt.url {
+				want = collect(disabled, tt.input)
+			}
+			if got := collect(enabled, tt.input); strings.Join(got, "|") != strings.Join(want, "|") {
+				t.Errorf("%d bytes: %s returned %d segments, want %d", len(tt.input), name, len(got), len(want))
+			}
+		}
 	}
 }
 
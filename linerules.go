@@ -5,8 +5,9 @@ import (
 	"unicode/utf8"
 )
 
-// LineBreakState is the type of the line break parser's states.
-type LineBreakState int
+// LineBreakState is the type of the line break parser's states. It has 64 bits
+// on all platforms.
+type LineBreakState int64
 
 // The states of the line break parser.
 const (
@@ -76,6 +77,11 @@ const (
 	lbPrevLB20aBit    LineBreakState = 4096
 	lbHLHyphenBit     LineBreakState = 8192
 	lbExtPicCnBit     LineBreakState = 16384
+
+	// The number of bytes left in a URL found by firstURL is stored above the
+	// flags in the states returned by FirstLineSegment.
+	lbURLShift = 15
+	lbURLMask  = 0x3ff
 )
 
 // LineBreak defines whether a given text may be broken into the next line.
@@ -89,6 +95,87 @@ const (
 	LineDontBreak LineBreak = iota // You may not break the line here.
 	LineCanBreak                   // You may or may not break the line here.
 	LineMustBreak                  // You must break the line here.
+
+	// LineHyphenBreak means that you may or may not break the line here, but
+	// if you do, a hyphen must be displayed at the end of the line. It is
+	// only returned if [Parser.Hyphens] is set. Soft hyphens (U+00AD) have a
+	// width of 0 and are only displayed, as a hyphen with a width of 1, if
+	// the line is broken after them.
+	LineHyphenBreak
+)
+
+// LineBreakStrictness controls how strictly line breaking rules are applied to
+// Chinese and Japanese text. It corresponds to the values of the CSS
+// [line-break] property.
+//
+// [line-break]: https://www.w3.org/TR/css-text-3/#line-break-property
+type LineBreakStrictness int
+
+// The line breaking strictness levels.
+const (
+	// LineBreakStrict follows the rules of UAX #14 as they are. Small kana and
+	// the prolonged sound mark (class CJ) are treated like nonstarters (NS),
+	// so lines are not broken before them.
+	LineBreakStrict LineBreakStrictness = iota
+
+	// LineBreakNormal allows breaks before small kana and the prolonged sound
+	// mark, and breaks before the hyphens U+301C (〜) and U+30A0 (゠) after
+	// ideographs and kana (class ID).
+	LineBreakNormal
+
+	// LineBreakLoose allows the same breaks as LineBreakNormal. In addition,
+	// it allows breaks before iteration marks, inseparable characters (class
+	// IN) such as U+2026 (…), centered punctuation such as U+30FB (・), the
+	// hyphens U+2010 (‐) and U+2013 (–), and suffixes (class PO) which are
+	// wide or ambiguous, all after ideographs and kana (class ID). It also
+	// allows breaks between such prefixes (class PR) and a following
+	// ideograph or kana. Text without ideographs and kana, such as "ab…cd",
+	// is broken like with LineBreakNormal.
+	LineBreakLoose
+)
+
+// WordBreakMode controls whether lines may be broken inside words. It
+// corresponds to the values of the CSS [word-break] property. Note that it
+// does not affect word boundaries, see [FirstWord] for those.
+//
+// [word-break]: https://www.w3.org/TR/css-text-3/#word-break-property
+type WordBreakMode int
+
+// The word breaking modes for line breaking.
+const (
+	// WordBreakNormal breaks lines according to the rules of UAX #14.
+	WordBreakNormal WordBreakMode = iota
+
+	// WordBreakKeepAll suppresses breaks between letters, digits, and
+	// ideographs (including Hangul syllables), so lines are only broken at
+	// spaces and punctuation. This is common for Korean text.
+	WordBreakKeepAll
+
+	// WordBreakBreakAll allows breaks between any two letters or digits by
+	// treating them like ideographs (class ID).
+	WordBreakBreakAll
+)
+
+// Hyphens controls whether words are hyphenated when lines are broken. It
+// corresponds to the values of the CSS [hyphens] property.
+//
+// [hyphens]: https://www.w3.org/TR/css-text-3/#hyphens-property
+type Hyphens int
+
+// The hyphenation modes.
+const (
+	// HyphensDefault follows the rules of UAX #14: a line may be broken after
+	// a soft hyphen (U+00AD), which is reported as [LineCanBreak].
+	HyphensDefault Hyphens = iota
+
+	// HyphensManual reports line breaks after soft hyphens as
+	// [LineHyphenBreak].
+	HyphensManual
+
+	// HyphensAuto also hyphenates words at the points found by
+	// [Parser.Hyphenator], reported as [LineHyphenBreak]. Words which contain
+	// soft hyphens are only hyphenated there.
+	HyphensAuto
 )
 
 type lbTransitionResult struct {
@@ -339,9 +426,9 @@ var lbTransitions = [lbMax * lbprMax]lbTransitionResult{
 // code point is needed to determine the new state, the byte slice or the string
 // starting after rune "r" can be used (whichever is not nil or empty) for
 // further lookups.
-func transitionLineBreakState[T bytes](state LineBreakState, r rune, str T, decoder runeDecoder[T]) (newState LineBreakState, lineBreak LineBreak) {
+func transitionLineBreakState[T bytes](p *Parser, state LineBreakState, r rune, str T, decoder runeDecoder[T]) (newState LineBreakState, lineBreak LineBreak) {
 	// Determine the property of the next character.
-	lbProp := lineBreakCodePoints.search(r)
+	lbProp := p.lineBreakOf(r)
 	nextProperty := lbProp.lbProperty
 	generalCategory := lbProp.generalCategory
 	if nextProperty == lbprXX && generalCategory == gcNone && unicode.Is(unicode.Cn, r) {
@@ -349,7 +436,7 @@ func transitionLineBreakState[T bytes](state LineBreakState, r rune, str T, deco
 	}
 
 	// Prepare.
-	var forceNoBreak, isCPeaFWH, isLB15, isDottedCircle, wasQUPf, isLB20a, isPrevLB20a, isHLHyphen, isExtPicCn bool
+	var forceNoBreak, forceBreak, breakAfterPrefix, isCPeaFWH, isLB15, isDottedCircle, wasQUPf, isLB20a, isPrevLB20a, isHLHyphen, isExtPicCn bool
 	if state > 0 && state&lbCPeaFWHBit != 0 {
 		isCPeaFWH = true // LB30: CP but ea is not F, W, or H.
 		state = state &^ lbCPeaFWHBit
@@ -388,13 +475,17 @@ func transitionLineBreakState[T bytes](state LineBreakState, r rune, str T, deco
 	}
 
 	defer func() {
+		if breakAfterPrefix && newState == lbPR {
+			newState = lbIDEM // Allows a break before the next ideograph.
+		}
+
 		if newState == lbQU && generalCategory == gcPf && (state == lbIDEM || state == lbNS || state == lbCL || state == lbCP || state == lbEX) {
 			newState |= lbQUPfBit
 		}
 
 		// Transition into LB30.
 		if newState == lbCP || newState == lbNUCP {
-			ea := eastAsianWidth.search(r)
+			ea := p.eastAsianWidthOf(r)
 			if ea != eawprF && ea != eawprW && ea != eawprH {
 				newState |= lbCPeaFWHBit
 			}
@@ -427,7 +518,15 @@ func transitionLineBreakState[T bytes](state LineBreakState, r rune, str T, deco
 			newState |= lbPrevLB20aBit
 		}
 
+		// CSS "word-break: keep-all".
+		if p != nil && p.WordBreak == WordBreakKeepAll && lineBreak == LineCanBreak && isKeepAllState(state) && isKeepAllProperty(nextProperty) {
+			lineBreak = LineDontBreak
+		}
+
 		// Override break.
+		if forceBreak && lineBreak == LineDontBreak {
+			lineBreak = LineCanBreak
+		}
 		if forceNoBreak {
 			lineBreak = LineDontBreak
 		}
@@ -443,7 +542,32 @@ func transitionLineBreakState[T bytes](state LineBreakState, r rune, str T, deco
 			nextProperty = lbprAL
 		}
 	} else if nextProperty == lbprCJ {
-		nextProperty = lbprNS
+		if p != nil && p.LineBreakStrictness >= LineBreakNormal {
+			nextProperty = lbprID
+		} else {
+			nextProperty = lbprNS
+		}
+	}
+
+	// Tailorings for CSS "line-break: normal" and "line-break: loose". Like
+	// CSS, they only apply to Chinese and Japanese text, i.e. next to
+	// ideographs and kana (class ID).
+	if p != nil && p.LineBreakStrictness >= LineBreakNormal {
+		if state == lbIDEM && p.strictnessBreaksBefore(r, nextProperty) {
+			forceBreak = true
+		}
+		if len(str) > 0 && p.strictnessBreaksAfter(r, nextProperty) {
+			next, _ := decoder(str)
+			switch p.lineBreakOf(next).lbProperty {
+			case lbprID, lbprEB, lbprEM, lbprCJ:
+				breakAfterPrefix = true
+			}
+		}
+	}
+
+	// CSS "word-break: break-all".
+	if p != nil && p.WordBreak == WordBreakBreakAll && (nextProperty == lbprAL || nextProperty == lbprHL || nextProperty == lbprNU) {
+		nextProperty = lbprID
 	}
 
 	// Combining marks.
@@ -526,7 +650,7 @@ func transitionLineBreakState[T bytes](state LineBreakState, r rune, str T, deco
 	if rule > 130 && state != lbNU && state != lbNUNU {
 		if state == lbSP && nextProperty == lbprIS && (r == '.' || r == ',') {
 			r2, _ := decoder(str)
-			if r2 != utf8.RuneError && lineBreakCodePoints.search(r2).lbProperty == lbprNU {
+			if r2 != utf8.RuneError && p.lineBreakOf(r2).lbProperty == lbprNU {
 				return lbIS, LineCanBreak
 			}
 		}
@@ -556,7 +680,7 @@ func transitionLineBreakState[T bytes](state LineBreakState, r rune, str T, deco
 		}
 		r, _ = decoder(str)
 		if r != utf8.RuneError {
-			pr := lineBreakCodePoints.search(r).lbProperty
+			pr := p.lineBreakOf(r).lbProperty
 			if pr == lbprSP || pr == lbprGL || pr == lbprWJ || pr == lbprCL ||
 				pr == lbprQU || pr == lbprCP || pr == lbprEX || pr == lbprIS ||
 				pr == lbprSY || pr == lbprBK || pr == lbprCR || pr == lbprLF ||
@@ -570,7 +694,7 @@ func transitionLineBreakState[T bytes](state LineBreakState, r rune, str T, deco
 	if rule == 190 && nextProperty == lbprQU && generalCategory == gcPi && (r == '“' || r == '‘') && (state == lbNS || state == lbIDEM) {
 		r2, _ := decoder(str)
 		if r2 != utf8.RuneError {
-			p2 := lineBreakCodePoints.search(r2).lbProperty
+			p2 := p.lineBreakOf(r2).lbProperty
 			if (p2 == lbprID && unicode.Is(unicode.Han, r2)) || p2 == lbprOP {
 				return lbQU, LineCanBreak
 			}
@@ -617,7 +741,7 @@ func transitionLineBreakState[T bytes](state LineBreakState, r rune, str T, deco
 		var r rune
 		r, _ = decoder(str)
 		if r != utf8.RuneError {
-			pr := lineBreakCodePoints.search(r).lbProperty
+			pr := p.lineBreakOf(r).lbProperty
 			if pr == lbprNU {
 				return lbNU, LineDontBreak
 			}
@@ -654,7 +778,7 @@ func transitionLineBreakState[T bytes](state LineBreakState, r rune, str T, deco
 			var r rune
 			r, _ = decoder(str)
 			if r != utf8.RuneError {
-				pr := lineBreakCodePoints.search(r).lbProperty
+				pr := p.lineBreakOf(r).lbProperty
 				if pr == lbprVF {
 					if nextProperty == lbprAK {
 						return lbAK, LineDontBreak
@@ -671,7 +795,7 @@ func transitionLineBreakState[T bytes](state LineBreakState, r rune, str T, deco
 	// LB30 (part one).
 	if rule > 300 {
 		if (state == lbAL || state == lbHL || state == lbNU || state == lbNUNU) && nextProperty == lbprOP {
-			ea := eastAsianWidth.search(r)
+			ea := p.eastAsianWidthOf(r)
 			if ea != eawprF && ea != eawprW && ea != eawprH {
 				return lbOP, LineDontBreak
 			}
@@ -715,3 +839,61 @@ func transitionLineBreakState[T bytes](state LineBreakState, r rune, str T, deco
 
 	return
 }
+
+// strictnessBreaksBefore returns true if the parser's line breaking
+// strictness, which must be LineBreakNormal or LineBreakLoose, allows a break
+// before the given rune with the given line break property when it follows an
+// ideograph or kana.
+func (p *Parser) strictnessBreaksBefore(r rune, property lbProperty) bool {
+	switch r {
+	case '\u301C', '\u30A0': // Hyphens.
+		return true
+	}
+	if p.LineBreakStrictness != LineBreakLoose {
+		return false
+	}
+	switch r {
+	case '\u2010', '\u2013': // Hyphens.
+		return true
+	case '\u3005', '\u303B', '\u309D', '\u309E', '\u30FD', '\u30FE': // Iteration marks.
+		return true
+	case '\u30FB', '\uFF1A', '\uFF1B', '\uFF65', '\u203C', '\u2047', '\u2048', '\u2049', '\uFF01', '\uFF1F': // Centered punctuation.
+		return true
+	}
+	return property == lbprIN || property == lbprPO && p.isWideOrAmbiguous(r)
+}
+
+// strictnessBreaksAfter returns true if the parser's line breaking strictness,
+// which must be LineBreakNormal or LineBreakLoose, allows a break after the
+// given rune with the given line break property when it is followed by an
+// ideograph or kana.
+func (p *Parser) strictnessBreaksAfter(r rune, property lbProperty) bool {
+	return p.LineBreakStrictness == LineBreakLoose && property == lbprPR && p.isWideOrAmbiguous(r)
+}
+
+// isWideOrAmbiguous returns true if the given rune has the East Asian Width
+// property F, W, or A.
+func (p *Parser) isWideOrAmbiguous(r rune) bool {
+	ea := p.eastAsianWidthOf(r)
+	return ea == eawprF || ea == eawprW || ea == eawprA
+}
+
+// isKeepAllState returns true if the given line break state follows a letter,
+// a digit, or an ideograph, for "word-break: keep-all".
+func isKeepAllState(state LineBreakState) bool {
+	switch state {
+	case lbAL, lbHL, lbNU, lbNUNU, lbIDEM, lbJL, lbJV, lbJT, lbH2, lbH3:
+		return true
+	}
+	return false
+}
+
+// isKeepAllProperty returns true if the given line break property denotes a
+// letter, a digit, or an ideograph, for "word-break: keep-all".
+func isKeepAllProperty(property lbProperty) bool {
+	switch property {
+	case lbprAL, lbprHL, lbprNU, lbprID, lbprJL, lbprJV, lbprJT, lbprH2, lbprH3:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package uniseg
+
+import (
+	"slices"
+	"unicode/utf8"
+)
+
+// HardLines splits the given byte slice after each mandatory line break
+// defined in LB4 and LB5 of [UAX #14], that is after the code points with the
+// line break classes BK (VT, FF, U+2028 LINE SEPARATOR, U+2029 PARAGRAPH
+// SEPARATOR), CR, LF, and NL (U+0085 NEXT LINE). A CR followed by an LF is one
+// line break. Each line includes its line break, except for the last line if
+// b doesn't end with one. An empty slice results in no lines.
+//
+// These are the lines of a text before it is wrapped. Use
+// [HasTrailingLineBreak] to check if a line ends with a line break.
+//
+// [UAX #14]: https://www.unicode.org/reports/tr14/tr14-53.html#Algorithm
+func HardLines(b []byte) [][]byte {
+	return DefaultParser.HardLines(b)
+}
+
+// HardLines is like [HardLines] but uses the given parser.
+func (p *Parser) HardLines(b []byte) [][]byte {
+	return clipAll(splitNewlines(b, p.isHardLineBreak, utf8.DecodeRune))
+}
+
+// HardLinesInString is like [HardLines] but for a string.
+func HardLinesInString(str string) []string {
+	return DefaultParser.HardLinesInString(str)
+}
+
+// HardLinesInString is like [HardLines] but for a string.
+func (p *Parser) HardLinesInString(str string) []string {
+	return splitNewlines(str, p.isHardLineBreak, utf8.DecodeRuneInString)
+}
+
+// Paragraphs splits the given byte slice after each paragraph separator,
+// that is after the code points with the sentence break properties Sep, CR, and
+// LF of [Unicode Standard Annex #29]: CR, LF, U+0085 NEXT LINE, U+2028 LINE
+// SEPARATOR, and U+2029 PARAGRAPH SEPARATOR. A CR followed by an LF is one
+// separator. Unlike [HardLines], VT and FF don't end a paragraph. Each
+// paragraph includes its separator, except for the last paragraph if b
+// doesn't end with one. An empty slice results in no paragraphs.
+//
+// Sentences and words never extend across paragraph separators, so each
+// paragraph may be segmented on its own.
+//
+// Other functions use other definitions of a paragraph. [Bidi] follows rule P1
+// of UAX #9, which ends a paragraph after U+001C to U+001E as well but not
+// after U+2028 LINE SEPARATOR. [ChunkText] prefers to end chunks at empty
+// lines and U+2029 PARAGRAPH SEPARATOR, and treats other line breaks like
+// sentence boundaries, because prose paragraphs often span several lines.
+//
+// [Unicode Standard Annex #29]: https://www.unicode.org/reports/tr29/tr29-45.html#Sentence_Boundaries
+func Paragraphs(b []byte) [][]byte {
+	return clipAll(splitNewlines(b, isParagraphSeparator, utf8.DecodeRune))
+}
+
+// ParagraphsInString is like [Paragraphs] but for a string.
+func ParagraphsInString(str string) []string {
+	return splitNewlines(str, isParagraphSeparator, utf8.DecodeRuneInString)
+}
+
+// NormalizeNewlines returns a copy of the given byte slice in which each
+// mandatory line break, as found by [HardLines], is replaced with the given
+// newline sequence, for example "\n" or "\r\n". A CR followed by an LF is
+// replaced as a whole. Line breaks are always grapheme clusters of their own,
+// so grapheme clusters are not affected by the replacement as long as the
+// newline sequence is a line break itself.
+func NormalizeNewlines(b []byte, newline string) []byte {
+	return DefaultParser.NormalizeNewlines(b, newline)
+}
+
+// NormalizeNewlines is like [NormalizeNewlines] but uses the given parser.
+func (p *Parser) NormalizeNewlines(b []byte, newline string) []byte {
+	return normalizeNewlines(p, b, newline, utf8.DecodeRune)
+}
+
+// NormalizeNewlinesInString is like [NormalizeNewlines] but for a string.
+func NormalizeNewlinesInString(str, newline string) string {
+	return DefaultParser.NormalizeNewlinesInString(str, newline)
+}
+
+// NormalizeNewlinesInString is like [NormalizeNewlines] but for a string.
+func (p *Parser) NormalizeNewlinesInString(str, newline string) string {
+	return string(normalizeNewlines(p, str, newline, utf8.DecodeRuneInString))
+}
+
+// splitNewlines splits the given string after each rune for which isNewline
+// returns true, treating CR LF as one newline.
+func splitNewlines[T bytes](str T, isNewline func(r rune) bool, decoder runeDecoder[T]) []T {
+	var (
+		lines []T
+		start int
+	)
+	for pos := 0; pos < len(str); {
+		r, length := decoder(str[pos:])
+		if pos += length; !isNewline(r) {
+			continue
+		}
+		if r == '\r' && pos < len(str) && str[pos] == '\n' {
+			pos++
+		}
+		lines = append(lines, str[start:pos])
+		start = pos
+	}
+	if start < len(str) {
+		lines = append(lines, str[start:])
+	}
+	return lines
+}
+
+// normalizeNewlines replaces the mandatory line breaks in the given string,
+// see [NormalizeNewlines].
+func normalizeNewlines[T bytes](p *Parser, str T, newline string, decoder runeDecoder[T]) []byte {
+	result := make([]byte, 0, len(str))
+	var start int
+	for pos := 0; pos < len(str); {
+		r, length := decoder(str[pos:])
+		if !p.isHardLineBreak(r) {
+			pos += length
+			continue
+		}
+		result = append(result, str[start:pos]...)
+		result = append(result, newline...)
+		if pos += length; r == '\r' && pos < len(str) && str[pos] == '\n' {
+			pos++
+		}
+		start = pos
+	}
+	return append(result, str[start:]...)
+}
+
+// isHardLineBreak returns true if the given rune has one of the line break
+// classes BK, CR, LF, or NL, which cause mandatory line breaks.
+func (p *Parser) isHardLineBreak(r rune) bool {
+	switch p.lineBreakOf(r).lbProperty {
+	case lbprBK, lbprCR, lbprLF, lbprNL:
+		return true
+	}
+	return false
+}
+
+// isParagraphSeparator returns true if the given rune has one of the sentence
+// break properties Sep, CR, or LF.
+func isParagraphSeparator(r rune) bool {
+	switch sentenceBreakCodePoints.search(r) {
+	case sbprSep, sbprCR, sbprLF:
+		return true
+	}
+	return false
+}
+
+// clipAll limits the capacity of the given slices to their lengths, so
+// appending to one of them doesn't overwrite the next one.
+func clipAll(b [][]byte) [][]byte {
+	for i := range b {
+		b[i] = slices.Clip(b[i])
+	}
+	return b
+}
@@ -0,0 +1,183 @@
+package uniseg
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// newlineTestCases are texts with their expected hard lines and paragraphs.
+var newlineTestCases = []struct {
+	name       string
+	original   string
+	lines      []string
+	paragraphs []string
+}{
+	{"empty", "", nil, nil},
+	{"no newline", "Hello", []string{"Hello"}, []string{"Hello"}},
+	{"LF", "a\nb\n", []string{"a\n", "b\n"}, []string{"a\n", "b\n"}},
+	{"CR", "a\rb", []string{"a\r", "b"}, []string{"a\r", "b"}},
+	{"CRLF", "a\r\nb\r\n\r\nc", []string{"a\r\n", "b\r\n", "\r\n", "c"}, []string{"a\r\n", "b\r\n", "\r\n", "c"}},
+	{"LFCR", "a\n\rb", []string{"a\n", "\r", "b"}, []string{"a\n", "\r", "b"}},
+	{"NEL", "a\u0085b", []string{"a\u0085", "b"}, []string{"a\u0085", "b"}},
+	{"LS", "a\u2028b", []string{"a\u2028", "b"}, []string{"a\u2028", "b"}},
+	{"PS", "a\u2029b", []string{"a\u2029", "b"}, []string{"a\u2029", "b"}},
+	{"VT and FF", "a\vb\fc", []string{"a\v", "b\f", "c"}, []string{"a\vb\fc"}},
+	{"combining mark", "a\n\u0301b", []string{"a\n", "\u0301b"}, []string{"a\n", "\u0301b"}},
+	{"not newlines", "a\u001cb\u000ec d", []string{"a\u001cb\u000ec d"}, []string{"a\u001cb\u000ec d"}},
+}
+
+func TestHardLines(t *testing.T) {
+	for _, tt := range newlineTestCases {
+		if got := HardLinesInString(tt.original); !reflect.DeepEqual(got, tt.lines) {
+			t.Errorf("%s: HardLinesInString(%q) = %q, want %q", tt.name, tt.original, got, tt.lines)
+		}
+		var got []string
+		for _, line := range HardLines([]byte(tt.original)) {
+			got = append(got, string(line))
+		}
+		if !reflect.DeepEqual(got, tt.lines) {
+			t.Errorf("%s: HardLines(%q) = %q, want %q", tt.name, tt.original, got, tt.lines)
+		}
+
+		// All lines except the last one end with a line break.
+		for i, line := range tt.lines {
+			if HasTrailingLineBreakInString(line) != (i < len(tt.lines)-1 || HasTrailingLineBreakInString(tt.original)) {
+				t.Errorf("%s: line %q has an unexpected line break", tt.name, line)
+			}
+		}
+	}
+
+	// Appending to a line must not overwrite the next one.
+	b := []byte("a\nb")
+	_ = append(HardLines(b)[0], '!')
+	if string(b) != "a\nb" {
+		t.Errorf("appending to a line modified the input: %q", b)
+	}
+}
+
+func TestParagraphs(t *testing.T) {
+	for _, tt := range newlineTestCases {
+		if got := ParagraphsInString(tt.original); !reflect.DeepEqual(got, tt.paragraphs) {
+			t.Errorf("%s: ParagraphsInString(%q) = %q, want %q", tt.name, tt.original, got, tt.paragraphs)
+		}
+		var got []string
+		for _, paragraph := range Paragraphs([]byte(tt.original)) {
+			got = append(got, string(paragraph))
+		}
+		if !reflect.DeepEqual(got, tt.paragraphs) {
+			t.Errorf("%s: Paragraphs(%q) = %q, want %q", tt.name, tt.original, got, tt.paragraphs)
+		}
+	}
+
+	// Sentences don't extend across paragraphs.
+	for _, tt := range sentenceBreakTestCases {
+		var sentences []string
+		for _, paragraph := range ParagraphsInString(tt.original) {
+			var state SentenceBreakState
+			for len(paragraph) > 0 {
+				var sentence string
+				sentence, paragraph, state = FirstSentenceInString(paragraph, state)
+				sentences = append(sentences, sentence)
+			}
+		}
+		var expected []string
+		for _, segment := range tt.expected {
+			expected = append(expected, string(segment))
+		}
+		if !reflect.DeepEqual(sentences, expected) {
+			t.Errorf("%s: sentences of paragraphs are %q, want %q", tt.name, sentences, expected)
+		}
+	}
+}
+
+// Test the documented differences between the paragraphs of Paragraphs and
+// those of Bidi.
+func TestParagraphDefinitions(t *testing.T) {
+	for _, tt := range []struct {
+		separator  string
+		paragraphs int  // The number of paragraphs of "א" + separator + "b".
+		bidi       bool // Whether Bidi starts a new paragraph.
+	}{
+		{"\n", 2, true},
+		{"\r\n", 2, true},
+		{"\u0085", 2, true},
+		{"\u2029", 2, true},
+		{"\u2028", 2, false},
+		{"\x1c", 1, true},
+		{"\v", 1, false},
+	} {
+		text := "א" + tt.separator + "b"
+		if n := len(ParagraphsInString(text)); n != tt.paragraphs {
+			t.Errorf("%q: ParagraphsInString returned %d paragraphs, want %d", tt.separator, n, tt.paragraphs)
+		}
+		level := 1 // The level of "b" in the paragraph of "א".
+		if tt.bidi {
+			level = 0
+		}
+		if got := NewBidi(text, DirectionAuto).ParagraphLevel(len(text) - 1); got != level {
+			t.Errorf("%q: ParagraphLevel = %d, want %d", tt.separator, got, level)
+		}
+	}
+}
+
+func TestNormalizeNewlines(t *testing.T) {
+	for _, tt := range []struct {
+		original string
+		newline  string
+		expected string
+	}{
+		{"", "\n", ""},
+		{"abc", "\n", "abc"},
+		{"a\r\nb\rc\nd", "\n", "a\nb\nc\nd"},
+		{"a\nb\n", "\r\n", "a\r\nb\r\n"},
+		{"a\r\n\r\nb", "\r\n", "a\r\n\r\nb"},
+		{"a\n\rb", "\r\n", "a\r\n\r\nb"},
+		{"a\u0085b\u2028c\u2029d\ve\ff", "\n", "a\nb\nc\nd\ne\nf"},
+		{"a\r\n\u0301b\r", "\u2028", "a\u2028\u0301b\u2028"},
+	} {
+		if got := NormalizeNewlinesInString(tt.original, tt.newline); got != tt.expected {
+			t.Errorf("NormalizeNewlinesInString(%q, %q) = %q, want %q", tt.original, tt.newline, got, tt.expected)
+		}
+		if got := string(NormalizeNewlines([]byte(tt.original), tt.newline)); got != tt.expected {
+			t.Errorf("NormalizeNewlines(%q, %q) = %q, want %q", tt.original, tt.newline, got, tt.expected)
+		}
+
+		// Grapheme clusters other than line breaks are unchanged.
+		var got, expected []string
+		for _, cluster := range graphemeClusters(tt.original) {
+			if !HasTrailingLineBreakInString(cluster) {
+				expected = append(expected, cluster)
+			}
+		}
+		for _, cluster := range graphemeClusters(tt.expected) {
+			if !HasTrailingLineBreakInString(cluster) {
+				got = append(got, cluster)
+			}
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("NormalizeNewlinesInString(%q, %q) changed grapheme clusters %q to %q", tt.original, tt.newline, expected, got)
+		}
+	}
+
+	// Normalizing twice doesn't change anything.
+	text := strings.Repeat("a\r\nb\rc\u2028", 10)
+	once := NormalizeNewlinesInString(text, "\r\n")
+	if twice := NormalizeNewlinesInString(once, "\r\n"); twice != once {
+		t.Errorf("Normalizing twice results in %q, want %q", twice, once)
+	}
+}
+
+// graphemeClusters returns the grapheme clusters of the given string.
+func graphemeClusters(str string) []string {
+	var (
+		clusters []string
+		cluster  string
+		state    GraphemeBreakState
+	)
+	for len(str) > 0 {
+		cluster, str, _, state = FirstGraphemeClusterInString(str, state)
+		clusters = append(clusters, cluster)
+	}
+	return clusters
+}
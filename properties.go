@@ -163,6 +163,14 @@ const (
 	prEmojiPresentation
 )
 
+// derivedCoreProperty is the type of the derived core properties.
+type derivedCoreProperty int8
+
+const (
+	_ derivedCoreProperty = iota // reserved for the zero value
+	prDefaultIgnorableCodePoint
+)
+
 // generalCategory is the Unicode General Categories.
 type generalCategory int
 
@@ -217,12 +225,56 @@ const (
 	incbExtend
 )
 
+// bidiClass is the Bidi_Class property of the Unicode Bidirectional Algorithm.
+type bidiClass int8
+
+// Bidi_Class values, see https://www.unicode.org/reports/tr9/tr9-50.html#Table_Bidirectional_Character_Types
+const (
+	bcprL   bidiClass = iota // Left-to-Right, bcprL must be 0.
+	bcprR                    // Right-to-Left
+	bcprAL                   // Right-to-Left Arabic
+	bcprEN                   // European Number
+	bcprES                   // European Number Separator
+	bcprET                   // European Number Terminator
+	bcprAN                   // Arabic Number
+	bcprCS                   // Common Number Separator
+	bcprNSM                  // Nonspacing Mark
+	bcprBN                   // Boundary Neutral
+	bcprB                    // Paragraph Separator
+	bcprS                    // Segment Separator
+	bcprWS                   // Whitespace
+	bcprON                   // Other Neutrals
+	bcprLRE                  // Left-to-Right Embedding
+	bcprLRO                  // Left-to-Right Override
+	bcprRLE                  // Right-to-Left Embedding
+	bcprRLO                  // Right-to-Left Override
+	bcprPDF                  // Pop Directional Format
+	bcprLRI                  // Left-to-Right Isolate
+	bcprRLI                  // Right-to-Left Isolate
+	bcprFSI                  // First Strong Isolate
+	bcprPDI                  // Pop Directional Isolate
+)
+
+// bidiBracket is the Bidi_Paired_Bracket and Bidi_Paired_Bracket_Type
+// properties of a bracket.
+type bidiBracket struct {
+	pair rune // The matching bracket.
+	open bool // Whether this is an opening bracket.
+}
+
 // Special code points.
 const (
-	vs15 = 0xfe0e // Variation Selector-15 (text presentation)
-	vs16 = 0xfe0f // Variation Selector-16 (emoji presentation)
+	vs15   = 0xfe0e // Variation Selector-15 (text presentation)
+	vs16   = 0xfe0f // Variation Selector-16 (emoji presentation)
+	keycap = 0x20e3 // Combining Enclosing Keycap
 )
 
+// isEmojiModifier returns true if r is one of the Emoji_Modifier code points
+// (the skin tone modifiers U+1F3FB..U+1F3FF).
+func isEmojiModifier(r rune) bool {
+	return r >= 0x1f3fb && r <= 0x1f3ff
+}
+
 // runeRange represents of a range of Unicode code points.
 // The range runs from Lo to Hi inclusive.
 type runeRange struct {
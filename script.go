@@ -0,0 +1,347 @@
+package uniseg
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// Script is a Unicode script as defined in [Unicode Standard Annex #24], e.g.
+// [ScriptLatin] or [ScriptHan]. Code points used with more than one script
+// have the script [ScriptCommon] (e.g. punctuation) or [ScriptInherited]
+// (e.g. combining marks). Unassigned code points have the script
+// [ScriptUnknown].
+//
+// [Unicode Standard Annex #24]: https://www.unicode.org/reports/tr24/
+type Script int
+
+// String returns the Unicode name of the script, e.g. "Latin".
+func (s Script) String() string {
+	if s < 0 || int(s) >= len(scriptNames) {
+		return "Script(" + strconv.Itoa(int(s)) + ")"
+	}
+	return scriptNames[s].name
+}
+
+// Code returns the four-letter ISO 15924 code of the script, e.g. "Latn".
+func (s Script) Code() string {
+	if s < 0 || int(s) >= len(scriptNames) {
+		return "Zzzz"
+	}
+	return scriptNames[s].code
+}
+
+// LookupScript returns the script with the given Unicode name (e.g. "Latin")
+// or ISO 15924 code (e.g. "Latn"). If there is no such script, it returns
+// [ScriptUnknown] and false.
+func LookupScript(name string) (Script, bool) {
+	for s, n := range scriptNames {
+		if n.name == name || n.code == name {
+			return Script(s), true
+		}
+	}
+	return ScriptUnknown, false
+}
+
+// RuneScript returns the value of the Script property of the given rune.
+func RuneScript(r rune) Script {
+	return scripts.search(r)
+}
+
+// RuneScriptExtensions returns the value of the Script_Extensions property of
+// the given rune, i.e. the set of scripts the rune is commonly used with. For
+// most runes, this is just the value of [RuneScript].
+func RuneScriptExtensions(r rune) []Script {
+	return append([]Script(nil), scriptExtensionsOf(r)...)
+}
+
+// scriptExtensionsOf is like [RuneScriptExtensions] but the returned slice
+// must not be modified.
+func scriptExtensionsOf(r rune) []Script {
+	if extensions := scriptExtensions.search(r); extensions != nil {
+		return extensions
+	}
+	s := scripts.search(r)
+	return scriptSingletons[s : s+1]
+}
+
+// scriptSingletons is used to return single-element script sets without
+// allocations.
+var scriptSingletons = func() []Script {
+	s := make([]Script, len(scriptNames))
+	for i := range s {
+		s[i] = Script(i)
+	}
+	return s
+}()
+
+// maxScriptBrackets is the maximum number of open brackets remembered by the
+// script run iterator.
+const maxScriptBrackets = 64
+
+// scriptBracket is an open bracket remembered by the script run iterator.
+type scriptBracket struct {
+	closing rune   // The matching closing bracket.
+	run     int    // The index of the script run containing the bracket.
+	script  Script // The script of that run, once it is known.
+}
+
+// scriptBrackets maps opening brackets to their closing brackets. Closing
+// brackets are resolved to the script of their opening bracket.
+var scriptBrackets = map[rune]rune{
+	'(':      ')',
+	'[':      ']',
+	'{':      '}',
+	'\u00ab': '\u00bb',
+	'\u2039': '\u203a',
+	'\u2045': '\u2046',
+	'\u2329': '\u232a',
+	'\u27e8': '\u27e9',
+	'\u3008': '\u3009',
+	'\u300a': '\u300b',
+	'\u300c': '\u300d',
+	'\u300e': '\u300f',
+	'\u3010': '\u3011',
+	'\u3014': '\u3015',
+	'\u3016': '\u3017',
+	'\u3018': '\u3019',
+	'\u301a': '\u301b',
+	'\ufe59': '\ufe5a',
+	'\ufe5b': '\ufe5c',
+	'\ufe5d': '\ufe5e',
+	'\uff08': '\uff09',
+	'\uff3b': '\uff3d',
+	'\uff5b': '\uff5d',
+	'\uff5f': '\uff60',
+	'\uff62': '\uff63',
+}
+
+// ScriptRuns implements an iterator over runs of text in a single script, as
+// needed e.g. to select fonts or to choose a segmentation dictionary.
+//
+// Runs are made of whole grapheme clusters, so a run never splits a cluster.
+// The script of a cluster is determined by its first code point. Following
+// the recommendations of [Unicode Standard Annex #24], characters with the
+// script Common or Inherited take the script of the preceding text (or of the
+// following text if there is none), characters with a Script_Extensions
+// property stay in the run if it uses one of their scripts, and closing
+// brackets take the script of their matching opening bracket.
+//
+// After constructing the iterator via [NewScriptRuns] for a given string,
+// [ScriptRuns.Next] is called for every run in a loop until it returns false.
+//
+// [Unicode Standard Annex #24]: https://www.unicode.org/reports/tr24/
+type ScriptRuns struct {
+	parser *Parser
+
+	// The original string.
+	original string
+
+	// The remaining string to be parsed.
+	remaining string
+
+	// The current run.
+	run string
+
+	// The byte offset of the current run relative to the original string.
+	offset int
+
+	// The script of the current run.
+	script Script
+
+	// The number of runs returned so far.
+	runs int
+
+	// The state of the grapheme cluster parser.
+	state GraphemeBreakState
+
+	// The currently open brackets.
+	brackets []scriptBracket
+}
+
+// NewScriptRuns returns a new script run iterator.
+func NewScriptRuns(str string) *ScriptRuns {
+	return DefaultParser.NewScriptRuns(str)
+}
+
+// NewScriptRuns returns a new script run iterator.
+func (p *Parser) NewScriptRuns(str string) *ScriptRuns {
+	return &ScriptRuns{
+		parser:    p,
+		original:  str,
+		remaining: str,
+	}
+}
+
+// Next advances the iterator by one script run and returns false if no runs
+// are left. This function must be called before the first run is accessed.
+func (s *ScriptRuns) Next() bool {
+	s.offset += len(s.run)
+	s.run = ""
+	if len(s.remaining) == 0 {
+		s.script = ScriptUnknown
+		return false
+	}
+	s.runs++
+
+	var (
+		set          []Script // The possible scripts of the run, nil for any.
+		preferred    = ScriptUnknown
+		allInherited = true
+		length       int
+		str          = s.remaining
+	)
+	for len(str) > 0 {
+		cluster, rest, _, state := firstGraphemeCluster(s.parser, str, s.state, utf8.DecodeRuneInString)
+		clusterSet, clusterScript := clusterScripts(cluster)
+		r, _ := utf8.DecodeRuneInString(cluster)
+		if clusterScript != ScriptInherited {
+			allInherited = false
+		}
+
+		// Closing brackets take the script of their opening bracket.
+		closing := -1
+		for i := len(s.brackets) - 1; i >= 0; i-- {
+			if s.brackets[i].closing == r {
+				closing = i
+				break
+			}
+		}
+		if closing >= 0 && s.brackets[closing].run != s.runs && s.brackets[closing].script > ScriptInherited {
+			bracketScript := s.brackets[closing].script
+			clusterSet, clusterScript = scriptSingletons[bracketScript:bracketScript+1], bracketScript
+		}
+
+		// Does the cluster continue the run?
+		if clusterSet != nil {
+			if set == nil {
+				set = clusterSet
+			} else if intersection := intersectScripts(set, clusterSet); intersection != nil {
+				set = intersection
+			} else {
+				break // A new run starts here.
+			}
+			if preferred == ScriptUnknown && clusterScript != ScriptCommon && clusterScript != ScriptInherited {
+				preferred = clusterScript
+			}
+		}
+
+		// Maintain the bracket stack.
+		if closing >= 0 {
+			s.brackets = s.brackets[:closing]
+		} else if c, ok := scriptBrackets[r]; ok {
+			if len(s.brackets) == maxScriptBrackets {
+				s.brackets = append(s.brackets[:0], s.brackets[1:]...)
+			}
+			s.brackets = append(s.brackets, scriptBracket{closing: c, run: s.runs})
+		}
+
+		length += len(cluster)
+		str = rest
+		s.state = state
+	}
+
+	// Resolve the script of the run.
+	switch {
+	case set == nil && allInherited:
+		s.script = ScriptInherited
+	case set == nil:
+		s.script = ScriptCommon
+	case preferred != ScriptUnknown && containsScript(set, preferred):
+		s.script = preferred
+	default:
+		s.script = set[0]
+	}
+	for i := range s.brackets {
+		if s.brackets[i].run == s.runs {
+			s.brackets[i].script = s.script
+		}
+	}
+
+	s.run = s.remaining[:length]
+	s.remaining = s.remaining[length:]
+	return true
+}
+
+// Str returns the current script run. If the iterator is already past the end
+// or [ScriptRuns.Next] has not yet been called, an empty string is returned.
+func (s *ScriptRuns) Str() string {
+	return s.run
+}
+
+// Script returns the script of the current run. If the iterator is already
+// past the end or [ScriptRuns.Next] has not yet been called, [ScriptUnknown] is
+// returned.
+func (s *ScriptRuns) Script() Script {
+	return s.script
+}
+
+// Positions returns the interval of the current script run as byte positions
+// into the original string, i.e. str[from:to] is the current run of the
+// original string "str".
+func (s *ScriptRuns) Positions() (int, int) {
+	return s.offset, s.offset + len(s.run)
+}
+
+// Reset puts the iterator into its initial state such that the next call to
+// [ScriptRuns.Next] sets it to the first run again.
+func (s *ScriptRuns) Reset() {
+	*s = ScriptRuns{
+		parser:    s.parser,
+		original:  s.original,
+		remaining: s.original,
+		brackets:  s.brackets[:0],
+	}
+}
+
+// clusterScripts returns the possible scripts of the given grapheme cluster
+// (nil if it can be used with any script) and the script of its first code
+// point. The scripts are determined by the first code point of the cluster or,
+// if that is Common or Inherited, by the first code point that is not.
+func clusterScripts(cluster string) ([]Script, Script) {
+	var first Script
+	for i, r := range cluster {
+		script := scripts.search(r)
+		if i == 0 {
+			first = script
+		}
+		extensions := scriptExtensions.search(r)
+		if extensions != nil {
+			return extensions, first
+		}
+		if script != ScriptCommon && script != ScriptInherited {
+			return scriptSingletons[script : script+1], first
+		}
+	}
+	return nil, first
+}
+
+// intersectScripts returns the scripts contained in both a and b, or nil if
+// there are none. If the result equals a or b, no new slice is allocated.
+func intersectScripts(a, b []Script) []Script {
+	if len(b) == 1 {
+		if containsScript(a, b[0]) {
+			return b
+		}
+		return nil
+	}
+	var result []Script
+	for _, s := range a {
+		if containsScript(b, s) {
+			result = append(result, s)
+		}
+	}
+	if len(result) == len(a) {
+		return a
+	}
+	return result
+}
+
+// containsScript returns true if the given script set contains the script.
+func containsScript(set []Script, script Script) bool {
+	for _, s := range set {
+		if s == script {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package uniseg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRuneScript(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want Script
+	}{
+		{'a', ScriptLatin},
+		{'α', ScriptGreek},
+		{'я', ScriptCyrillic},
+		{'ก', ScriptThai},
+		{'日', ScriptHan},
+		{'の', ScriptHiragana},
+		{'カ', ScriptKatakana},
+		{'한', ScriptHangul},
+		{' ', ScriptCommon},
+		{'1', ScriptCommon},
+		{'́', ScriptInherited},
+		{'\U0001F600', ScriptCommon},
+		{'\U000E0080', ScriptUnknown},
+	}
+	for _, tt := range tests {
+		if got := RuneScript(tt.r); got != tt.want {
+			t.Errorf("RuneScript(%q) = %v, want %v", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestRuneScriptExtensions(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want []Script
+	}{
+		{'a', []Script{ScriptLatin}},
+		{'.', []Script{ScriptCommon}},
+		{'͂', []Script{ScriptGreek}},                      // COMBINING GREEK PERISPOMENI
+		{'҄', []Script{ScriptCyrillic, ScriptGlagolitic}}, // COMBINING CYRILLIC PALATALIZATION
+		{'ー', []Script{ScriptHiragana, ScriptKatakana}},   // KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	}
+	for _, tt := range tests {
+		got := RuneScriptExtensions(tt.r)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("RuneScriptExtensions(%q) = %v, want %v", tt.r, got, tt.want)
+		}
+	}
+
+	// The result must be a copy.
+	RuneScriptExtensions('a')[0] = ScriptGreek
+	if got := RuneScriptExtensions('a'); got[0] != ScriptLatin {
+		t.Errorf("RuneScriptExtensions('a') was modified: %v", got)
+	}
+}
+
+func TestScriptNames(t *testing.T) {
+	if got := ScriptLatin.String(); got != "Latin" {
+		t.Errorf("String() = %q, want %q", got, "Latin")
+	}
+	if got := ScriptOldItalic.String(); got != "Old_Italic" {
+		t.Errorf("String() = %q, want %q", got, "Old_Italic")
+	}
+	if got := ScriptLatin.Code(); got != "Latn" {
+		t.Errorf("Code() = %q, want %q", got, "Latn")
+	}
+	if got := Script(-1).String(); got != "Script(-1)" {
+		t.Errorf("String() = %q, want %q", got, "Script(-1)")
+	}
+	for _, name := range []string{"Cyrillic", "Cyrl"} {
+		if s, ok := LookupScript(name); !ok || s != ScriptCyrillic {
+			t.Errorf("LookupScript(%q) = %v, %v, want %v, true", name, s, ok, ScriptCyrillic)
+		}
+	}
+	if s, ok := LookupScript("Klingon"); ok || s != ScriptUnknown {
+		t.Errorf("LookupScript(%q) = %v, %v, want %v, false", "Klingon", s, ok, ScriptUnknown)
+	}
+}
+
+var scriptRunTestCases = []struct {
+	name     string
+	original string
+	expected []string
+	scripts  []Script
+}{
+	{"empty", "", nil, nil},
+	{"latin", "Hello, world!", []string{"Hello, world!"}, []Script{ScriptLatin}},
+	{"common only", "123 ...", []string{"123 ..."}, []Script{ScriptCommon}},
+	{"inherited only", "\u0316\u0317", []string{"\u0316\u0317"}, []Script{ScriptInherited}},
+	{"leading common", "1234 ไทย abc", []string{"1234 ไทย ", "abc"}, []Script{ScriptThai, ScriptLatin}},
+	{"trailing common", "abc, αβγ.", []string{"abc, ", "αβγ."}, []Script{ScriptLatin, ScriptGreek}},
+	{"brackets", "α (abc) β", []string{"α (", "abc", ") β"}, []Script{ScriptGreek, ScriptLatin, ScriptGreek}},
+	{"nested brackets", "«(Ελλ)abc»", []string{"«(Ελλ)", "abc", "»"}, []Script{ScriptGreek, ScriptLatin, ScriptGreek}},
+	{"brackets in run", "“Привет” (мир)", []string{"“Привет” (мир)"}, []Script{ScriptCyrillic}},
+	{"japanese", "日本語のテキスト、カタカナ。", []string{"日本語", "の", "テキスト、カタカナ。"}, []Script{ScriptHan, ScriptHiragana, ScriptKatakana}},
+	{"prolonged sound mark", "ラーメン", []string{"ラーメン"}, []Script{ScriptKatakana}},
+	{"script extensions", "क॑ ন", []string{"क॑ ", "ন"}, []Script{ScriptDevanagari, ScriptBengali}},
+	{"inherited mark", "áβ́", []string{"á", "β́"}, []Script{ScriptLatin, ScriptGreek}},
+	{"leading mark", "́abc", []string{"́abc"}, []Script{ScriptLatin}},
+	{"emoji", "abc 😀 αβγ", []string{"abc 😀 ", "αβγ"}, []Script{ScriptLatin, ScriptGreek}},
+	{"grapheme cluster", "aक्ष", []string{"a", "क्ष"}, []Script{ScriptLatin, ScriptDevanagari}},
+}
+
+func TestScriptRuns(t *testing.T) {
+	for _, tt := range scriptRunTestCases {
+		var (
+			runs    []string
+			scripts []Script
+			offset  int
+		)
+		r := NewScriptRuns(tt.original)
+		for r.Next() {
+			from, to := r.Positions()
+			if from != offset || tt.original[from:to] != r.Str() {
+				t.Errorf("%s: Positions() = %d, %d, inconsistent with Str() = %q", tt.name, from, to, r.Str())
+			}
+			offset = to
+			runs = append(runs, r.Str())
+			scripts = append(scripts, r.Script())
+		}
+		if !reflect.DeepEqual(runs, tt.expected) || !reflect.DeepEqual(scripts, tt.scripts) {
+			t.Errorf("%s: %q: got %q %v, want %q %v", tt.name, tt.original, runs, scripts, tt.expected, tt.scripts)
+		}
+		if r.Script() != ScriptUnknown || r.Str() != "" {
+			t.Errorf("%s: iterator past the end returned %q, %v", tt.name, r.Str(), r.Script())
+		}
+
+		// Reset must start over.
+		r.Reset()
+		if len(tt.expected) > 0 && (!r.Next() || r.Str() != tt.expected[0]) {
+			t.Errorf("%s: after Reset, got %q, want %q", tt.name, r.Str(), tt.expected[0])
+		}
+	}
+}
+
+func TestScriptRunsGraphemes(t *testing.T) {
+	for _, tt := range scriptRunTestCases {
+		var expected []Script
+		for i, run := range tt.expected {
+			for range GraphemeClusterCount(run) {
+				expected = append(expected, tt.scripts[i])
+			}
+		}
+		var scripts []Script
+		g := NewGraphemes(tt.original)
+		if s := g.Script(); s != ScriptUnknown {
+			t.Errorf("%s: Script() before Next() = %v, want %v", tt.name, s, ScriptUnknown)
+		}
+		for g.Next() {
+			scripts = append(scripts, g.Script())
+		}
+		if !reflect.DeepEqual(scripts, expected) {
+			t.Errorf("%s: %q: got %v, want %v", tt.name, tt.original, scripts, expected)
+		}
+
+		// Scripts are also available after a reset.
+		g.Reset()
+		scripts = scripts[:0]
+		for g.Next() {
+			scripts = append(scripts, g.Script())
+		}
+		if len(expected) > 0 && !reflect.DeepEqual(scripts, expected) {
+			t.Errorf("%s: after Reset: got %v, want %v", tt.name, scripts, expected)
+		}
+	}
+}
@@ -0,0 +1,4052 @@
+// Code generated by internal/cmd/gen_scripts; DO NOT EDIT.
+
+package uniseg
+
+// The Unicode scripts as listed in
+// PropertyValueAliases-17.0.0.txt
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+const (
+	ScriptUnknown               Script = iota // Zzzz
+	ScriptCommon                              // Zyyy
+	ScriptInherited                           // Zinh
+	ScriptAdlam                               // Adlm
+	ScriptAhom                                // Ahom
+	ScriptAnatolianHieroglyphs                // Hluw
+	ScriptArabic                              // Arab
+	ScriptArmenian                            // Armn
+	ScriptAvestan                             // Avst
+	ScriptBalinese                            // Bali
+	ScriptBamum                               // Bamu
+	ScriptBassaVah                            // Bass
+	ScriptBatak                               // Batk
+	ScriptBengali                             // Beng
+	ScriptBeriaErfe                           // Berf
+	ScriptBhaiksuki                           // Bhks
+	ScriptBopomofo                            // Bopo
+	ScriptBrahmi                              // Brah
+	ScriptBraille                             // Brai
+	ScriptBuginese                            // Bugi
+	ScriptBuhid                               // Buhd
+	ScriptCanadianAboriginal                  // Cans
+	ScriptCarian                              // Cari
+	ScriptCaucasianAlbanian                   // Aghb
+	ScriptChakma                              // Cakm
+	ScriptCham                                // Cham
+	ScriptCherokee                            // Cher
+	ScriptChorasmian                          // Chrs
+	ScriptCoptic                              // Copt
+	ScriptCuneiform                           // Xsux
+	ScriptCypriot                             // Cprt
+	ScriptCyproMinoan                         // Cpmn
+	ScriptCyrillic                            // Cyrl
+	ScriptDeseret                             // Dsrt
+	ScriptDevanagari                          // Deva
+	ScriptDivesAkuru                          // Diak
+	ScriptDogra                               // Dogr
+	ScriptDuployan                            // Dupl
+	ScriptEgyptianHieroglyphs                 // Egyp
+	ScriptElbasan                             // Elba
+	ScriptElymaic                             // Elym
+	ScriptEthiopic                            // Ethi
+	ScriptGaray                               // Gara
+	ScriptGeorgian                            // Geor
+	ScriptGlagolitic                          // Glag
+	ScriptGothic                              // Goth
+	ScriptGrantha                             // Gran
+	ScriptGreek                               // Grek
+	ScriptGujarati                            // Gujr
+	ScriptGunjalaGondi                        // Gong
+	ScriptGurmukhi                            // Guru
+	ScriptGurungKhema                         // Gukh
+	ScriptHan                                 // Hani
+	ScriptHangul                              // Hang
+	ScriptHanifiRohingya                      // Rohg
+	ScriptHanunoo                             // Hano
+	ScriptHatran                              // Hatr
+	ScriptHebrew                              // Hebr
+	ScriptHiragana                            // Hira
+	ScriptImperialAramaic                     // Armi
+	ScriptInscriptionalPahlavi                // Phli
+	ScriptInscriptionalParthian               // Prti
+	ScriptJavanese                            // Java
+	ScriptKaithi                              // Kthi
+	ScriptKannada                             // Knda
+	ScriptKatakana                            // Kana
+	ScriptKawi                                // Kawi
+	ScriptKayahLi                             // Kali
+	ScriptKharoshthi                          // Khar
+	ScriptKhitanSmallScript                   // Kits
+	ScriptKhmer                               // Khmr
+	ScriptKhojki                              // Khoj
+	ScriptKhudawadi                           // Sind
+	ScriptKiratRai                            // Krai
+	ScriptLao                                 // Laoo
+	ScriptLatin                               // Latn
+	ScriptLepcha                              // Lepc
+	ScriptLimbu                               // Limb
+	ScriptLinearA                             // Lina
+	ScriptLinearB                             // Linb
+	ScriptLisu                                // Lisu
+	ScriptLycian                              // Lyci
+	ScriptLydian                              // Lydi
+	ScriptMahajani                            // Mahj
+	ScriptMakasar                             // Maka
+	ScriptMalayalam                           // Mlym
+	ScriptMandaic                             // Mand
+	ScriptManichaean                          // Mani
+	ScriptMarchen                             // Marc
+	ScriptMasaramGondi                        // Gonm
+	ScriptMedefaidrin                         // Medf
+	ScriptMeeteiMayek                         // Mtei
+	ScriptMendeKikakui                        // Mend
+	ScriptMeroiticCursive                     // Merc
+	ScriptMeroiticHieroglyphs                 // Mero
+	ScriptMiao                                // Plrd
+	ScriptModi                                // Modi
+	ScriptMongolian                           // Mong
+	ScriptMro                                 // Mroo
+	ScriptMultani                             // Mult
+	ScriptMyanmar                             // Mymr
+	ScriptNabataean                           // Nbat
+	ScriptNagMundari                          // Nagm
+	ScriptNandinagari                         // Nand
+	ScriptNewTaiLue                           // Talu
+	ScriptNewa                                // Newa
+	ScriptNko                                 // Nkoo
+	ScriptNushu                               // Nshu
+	ScriptNyiakengPuachueHmong                // Hmnp
+	ScriptOgham                               // Ogam
+	ScriptOlChiki                             // Olck
+	ScriptOlOnal                              // Onao
+	ScriptOldHungarian                        // Hung
+	ScriptOldItalic                           // Ital
+	ScriptOldNorthArabian                     // Narb
+	ScriptOldPermic                           // Perm
+	ScriptOldPersian                          // Xpeo
+	ScriptOldSogdian                          // Sogo
+	ScriptOldSouthArabian                     // Sarb
+	ScriptOldTurkic                           // Orkh
+	ScriptOldUyghur                           // Ougr
+	ScriptOriya                               // Orya
+	ScriptOsage                               // Osge
+	ScriptOsmanya                             // Osma
+	ScriptPahawhHmong                         // Hmng
+	ScriptPalmyrene                           // Palm
+	ScriptPauCinHau                           // Pauc
+	ScriptPhagsPa                             // Phag
+	ScriptPhoenician                          // Phnx
+	ScriptPsalterPahlavi                      // Phlp
+	ScriptRejang                              // Rjng
+	ScriptRunic                               // Runr
+	ScriptSamaritan                           // Samr
+	ScriptSaurashtra                          // Saur
+	ScriptSharada                             // Shrd
+	ScriptShavian                             // Shaw
+	ScriptSiddham                             // Sidd
+	ScriptSidetic                             // Sidt
+	ScriptSignWriting                         // Sgnw
+	ScriptSinhala                             // Sinh
+	ScriptSogdian                             // Sogd
+	ScriptSoraSompeng                         // Sora
+	ScriptSoyombo                             // Soyo
+	ScriptSundanese                           // Sund
+	ScriptSunuwar                             // Sunu
+	ScriptSylotiNagri                         // Sylo
+	ScriptSyriac                              // Syrc
+	ScriptTagalog                             // Tglg
+	ScriptTagbanwa                            // Tagb
+	ScriptTaiLe                               // Tale
+	ScriptTaiTham                             // Lana
+	ScriptTaiViet                             // Tavt
+	ScriptTaiYo                               // Tayo
+	ScriptTakri                               // Takr
+	ScriptTamil                               // Taml
+	ScriptTangsa                              // Tnsa
+	ScriptTangut                              // Tang
+	ScriptTelugu                              // Telu
+	ScriptThaana                              // Thaa
+	ScriptThai                                // Thai
+	ScriptTibetan                             // Tibt
+	ScriptTifinagh                            // Tfng
+	ScriptTirhuta                             // Tirh
+	ScriptTodhri                              // Todr
+	ScriptTolongSiki                          // Tols
+	ScriptToto                                // Toto
+	ScriptTuluTigalari                        // Tutg
+	ScriptUgaritic                            // Ugar
+	ScriptVai                                 // Vaii
+	ScriptVithkuqi                            // Vith
+	ScriptWancho                              // Wcho
+	ScriptWarangCiti                          // Wara
+	ScriptYezidi                              // Yezi
+	ScriptYi                                  // Yiii
+	ScriptZanabazarSquare                     // Zanb
+)
+
+// scriptNames contains the ISO 15924 codes and the Unicode names of the
+// scripts, indexed by Script.
+var scriptNames = [...]struct{ code, name string }{
+	ScriptUnknown:               {"Zzzz", "Unknown"},
+	ScriptCommon:                {"Zyyy", "Common"},
+	ScriptInherited:             {"Zinh", "Inherited"},
+	ScriptAdlam:                 {"Adlm", "Adlam"},
+	ScriptAhom:                  {"Ahom", "Ahom"},
+	ScriptAnatolianHieroglyphs:  {"Hluw", "Anatolian_Hieroglyphs"},
+	ScriptArabic:                {"Arab", "Arabic"},
+	ScriptArmenian:              {"Armn", "Armenian"},
+	ScriptAvestan:               {"Avst", "Avestan"},
+	ScriptBalinese:              {"Bali", "Balinese"},
+	ScriptBamum:                 {"Bamu", "Bamum"},
+	ScriptBassaVah:              {"Bass", "Bassa_Vah"},
+	ScriptBatak:                 {"Batk", "Batak"},
+	ScriptBengali:               {"Beng", "Bengali"},
+	ScriptBeriaErfe:             {"Berf", "Beria_Erfe"},
+	ScriptBhaiksuki:             {"Bhks", "Bhaiksuki"},
+	ScriptBopomofo:              {"Bopo", "Bopomofo"},
+	ScriptBrahmi:                {"Brah", "Brahmi"},
+	ScriptBraille:               {"Brai", "Braille"},
+	ScriptBuginese:              {"Bugi", "Buginese"},
+	ScriptBuhid:                 {"Buhd", "Buhid"},
+	ScriptCanadianAboriginal:    {"Cans", "Canadian_Aboriginal"},
+	ScriptCarian:                {"Cari", "Carian"},
+	ScriptCaucasianAlbanian:     {"Aghb", "Caucasian_Albanian"},
+	ScriptChakma:                {"Cakm", "Chakma"},
+	ScriptCham:                  {"Cham", "Cham"},
+	ScriptCherokee:              {"Cher", "Cherokee"},
+	ScriptChorasmian:            {"Chrs", "Chorasmian"},
+	ScriptCoptic:                {"Copt", "Coptic"},
+	ScriptCuneiform:             {"Xsux", "Cuneiform"},
+	ScriptCypriot:               {"Cprt", "Cypriot"},
+	ScriptCyproMinoan:           {"Cpmn", "Cypro_Minoan"},
+	ScriptCyrillic:              {"Cyrl", "Cyrillic"},
+	ScriptDeseret:               {"Dsrt", "Deseret"},
+	ScriptDevanagari:            {"Deva", "Devanagari"},
+	ScriptDivesAkuru:            {"Diak", "Dives_Akuru"},
+	ScriptDogra:                 {"Dogr", "Dogra"},
+	ScriptDuployan:              {"Dupl", "Duployan"},
+	ScriptEgyptianHieroglyphs:   {"Egyp", "Egyptian_Hieroglyphs"},
+	ScriptElbasan:               {"Elba", "Elbasan"},
+	ScriptElymaic:               {"Elym", "Elymaic"},
+	ScriptEthiopic:              {"Ethi", "Ethiopic"},
+	ScriptGaray:                 {"Gara", "Garay"},
+	ScriptGeorgian:              {"Geor", "Georgian"},
+	ScriptGlagolitic:            {"Glag", "Glagolitic"},
+	ScriptGothic:                {"Goth", "Gothic"},
+	ScriptGrantha:               {"Gran", "Grantha"},
+	ScriptGreek:                 {"Grek", "Greek"},
+	ScriptGujarati:              {"Gujr", "Gujarati"},
+	ScriptGunjalaGondi:          {"Gong", "Gunjala_Gondi"},
+	ScriptGurmukhi:              {"Guru", "Gurmukhi"},
+	ScriptGurungKhema:           {"Gukh", "Gurung_Khema"},
+	ScriptHan:                   {"Hani", "Han"},
+	ScriptHangul:                {"Hang", "Hangul"},
+	ScriptHanifiRohingya:        {"Rohg", "Hanifi_Rohingya"},
+	ScriptHanunoo:               {"Hano", "Hanunoo"},
+	ScriptHatran:                {"Hatr", "Hatran"},
+	ScriptHebrew:                {"Hebr", "Hebrew"},
+	ScriptHiragana:              {"Hira", "Hiragana"},
+	ScriptImperialAramaic:       {"Armi", "Imperial_Aramaic"},
+	ScriptInscriptionalPahlavi:  {"Phli", "Inscriptional_Pahlavi"},
+	ScriptInscriptionalParthian: {"Prti", "Inscriptional_Parthian"},
+	ScriptJavanese:              {"Java", "Javanese"},
+	ScriptKaithi:                {"Kthi", "Kaithi"},
+	ScriptKannada:               {"Knda", "Kannada"},
+	ScriptKatakana:              {"Kana", "Katakana"},
+	ScriptKawi:                  {"Kawi", "Kawi"},
+	ScriptKayahLi:               {"Kali", "Kayah_Li"},
+	ScriptKharoshthi:            {"Khar", "Kharoshthi"},
+	ScriptKhitanSmallScript:     {"Kits", "Khitan_Small_Script"},
+	ScriptKhmer:                 {"Khmr", "Khmer"},
+	ScriptKhojki:                {"Khoj", "Khojki"},
+	ScriptKhudawadi:             {"Sind", "Khudawadi"},
+	ScriptKiratRai:              {"Krai", "Kirat_Rai"},
+	ScriptLao:                   {"Laoo", "Lao"},
+	ScriptLatin:                 {"Latn", "Latin"},
+	ScriptLepcha:                {"Lepc", "Lepcha"},
+	ScriptLimbu:                 {"Limb", "Limbu"},
+	ScriptLinearA:               {"Lina", "Linear_A"},
+	ScriptLinearB:               {"Linb", "Linear_B"},
+	ScriptLisu:                  {"Lisu", "Lisu"},
+	ScriptLycian:                {"Lyci", "Lycian"},
+	ScriptLydian:                {"Lydi", "Lydian"},
+	ScriptMahajani:              {"Mahj", "Mahajani"},
+	ScriptMakasar:               {"Maka", "Makasar"},
+	ScriptMalayalam:             {"Mlym", "Malayalam"},
+	ScriptMandaic:               {"Mand", "Mandaic"},
+	ScriptManichaean:            {"Mani", "Manichaean"},
+	ScriptMarchen:               {"Marc", "Marchen"},
+	ScriptMasaramGondi:          {"Gonm", "Masaram_Gondi"},
+	ScriptMedefaidrin:           {"Medf", "Medefaidrin"},
+	ScriptMeeteiMayek:           {"Mtei", "Meetei_Mayek"},
+	ScriptMendeKikakui:          {"Mend", "Mende_Kikakui"},
+	ScriptMeroiticCursive:       {"Merc", "Meroitic_Cursive"},
+	ScriptMeroiticHieroglyphs:   {"Mero", "Meroitic_Hieroglyphs"},
+	ScriptMiao:                  {"Plrd", "Miao"},
+	ScriptModi:                  {"Modi", "Modi"},
+	ScriptMongolian:             {"Mong", "Mongolian"},
+	ScriptMro:                   {"Mroo", "Mro"},
+	ScriptMultani:               {"Mult", "Multani"},
+	ScriptMyanmar:               {"Mymr", "Myanmar"},
+	ScriptNabataean:             {"Nbat", "Nabataean"},
+	ScriptNagMundari:            {"Nagm", "Nag_Mundari"},
+	ScriptNandinagari:           {"Nand", "Nandinagari"},
+	ScriptNewTaiLue:             {"Talu", "New_Tai_Lue"},
+	ScriptNewa:                  {"Newa", "Newa"},
+	ScriptNko:                   {"Nkoo", "Nko"},
+	ScriptNushu:                 {"Nshu", "Nushu"},
+	ScriptNyiakengPuachueHmong:  {"Hmnp", "Nyiakeng_Puachue_Hmong"},
+	ScriptOgham:                 {"Ogam", "Ogham"},
+	ScriptOlChiki:               {"Olck", "Ol_Chiki"},
+	ScriptOlOnal:                {"Onao", "Ol_Onal"},
+	ScriptOldHungarian:          {"Hung", "Old_Hungarian"},
+	ScriptOldItalic:             {"Ital", "Old_Italic"},
+	ScriptOldNorthArabian:       {"Narb", "Old_North_Arabian"},
+	ScriptOldPermic:             {"Perm", "Old_Permic"},
+	ScriptOldPersian:            {"Xpeo", "Old_Persian"},
+	ScriptOldSogdian:            {"Sogo", "Old_Sogdian"},
+	ScriptOldSouthArabian:       {"Sarb", "Old_South_Arabian"},
+	ScriptOldTurkic:             {"Orkh", "Old_Turkic"},
+	ScriptOldUyghur:             {"Ougr", "Old_Uyghur"},
+	ScriptOriya:                 {"Orya", "Oriya"},
+	ScriptOsage:                 {"Osge", "Osage"},
+	ScriptOsmanya:               {"Osma", "Osmanya"},
+	ScriptPahawhHmong:           {"Hmng", "Pahawh_Hmong"},
+	ScriptPalmyrene:             {"Palm", "Palmyrene"},
+	ScriptPauCinHau:             {"Pauc", "Pau_Cin_Hau"},
+	ScriptPhagsPa:               {"Phag", "Phags_Pa"},
+	ScriptPhoenician:            {"Phnx", "Phoenician"},
+	ScriptPsalterPahlavi:        {"Phlp", "Psalter_Pahlavi"},
+	ScriptRejang:                {"Rjng", "Rejang"},
+	ScriptRunic:                 {"Runr", "Runic"},
+	ScriptSamaritan:             {"Samr", "Samaritan"},
+	ScriptSaurashtra:            {"Saur", "Saurashtra"},
+	ScriptSharada:               {"Shrd", "Sharada"},
+	ScriptShavian:               {"Shaw", "Shavian"},
+	ScriptSiddham:               {"Sidd", "Siddham"},
+	ScriptSidetic:               {"Sidt", "Sidetic"},
+	ScriptSignWriting:           {"Sgnw", "SignWriting"},
+	ScriptSinhala:               {"Sinh", "Sinhala"},
+	ScriptSogdian:               {"Sogd", "Sogdian"},
+	ScriptSoraSompeng:           {"Sora", "Sora_Sompeng"},
+	ScriptSoyombo:               {"Soyo", "Soyombo"},
+	ScriptSundanese:             {"Sund", "Sundanese"},
+	ScriptSunuwar:               {"Sunu", "Sunuwar"},
+	ScriptSylotiNagri:           {"Sylo", "Syloti_Nagri"},
+	ScriptSyriac:                {"Syrc", "Syriac"},
+	ScriptTagalog:               {"Tglg", "Tagalog"},
+	ScriptTagbanwa:              {"Tagb", "Tagbanwa"},
+	ScriptTaiLe:                 {"Tale", "Tai_Le"},
+	ScriptTaiTham:               {"Lana", "Tai_Tham"},
+	ScriptTaiViet:               {"Tavt", "Tai_Viet"},
+	ScriptTaiYo:                 {"Tayo", "Tai_Yo"},
+	ScriptTakri:                 {"Takr", "Takri"},
+	ScriptTamil:                 {"Taml", "Tamil"},
+	ScriptTangsa:                {"Tnsa", "Tangsa"},
+	ScriptTangut:                {"Tang", "Tangut"},
+	ScriptTelugu:                {"Telu", "Telugu"},
+	ScriptThaana:                {"Thaa", "Thaana"},
+	ScriptThai:                  {"Thai", "Thai"},
+	ScriptTibetan:               {"Tibt", "Tibetan"},
+	ScriptTifinagh:              {"Tfng", "Tifinagh"},
+	ScriptTirhuta:               {"Tirh", "Tirhuta"},
+	ScriptTodhri:                {"Todr", "Todhri"},
+	ScriptTolongSiki:            {"Tols", "Tolong_Siki"},
+	ScriptToto:                  {"Toto", "Toto"},
+	ScriptTuluTigalari:          {"Tutg", "Tulu_Tigalari"},
+	ScriptUgaritic:              {"Ugar", "Ugaritic"},
+	ScriptVai:                   {"Vaii", "Vai"},
+	ScriptVithkuqi:              {"Vith", "Vithkuqi"},
+	ScriptWancho:                {"Wcho", "Wancho"},
+	ScriptWarangCiti:            {"Wara", "Warang_Citi"},
+	ScriptYezidi:                {"Yezi", "Yezidi"},
+	ScriptYi:                    {"Yiii", "Yi"},
+	ScriptZanabazarSquare:       {"Zanb", "Zanabazar_Square"},
+}
+
+// scripts are taken from
+// Scripts-17.0.0.txt
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+var scripts = dictionary[Script]{
+	{runeRange{0x309D, 0x309E}, ScriptHiragana},                // Lm   [2] HIRAGANA ITERATION MARK..HIRAGANA VOICED ITERATION MARK
+	{runeRange{0x0FD0, 0x0FD4}, ScriptTibetan},                 // Po   [5] TIBETAN MARK BSKA- SHOG GI MGO RGYAN..TIBETAN MARK CLOSING BRDA RNYING YIG MGO SGAB MA
+	{runeRange{0x116B6, 0x116B6}, ScriptTakri},                 // Mc       TAKRI SIGN VIRAMA
+	{runeRange{0x04B9, 0x04B9}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER CHE WITH VERTICAL STROKE
+	{runeRange{0x1F10, 0x1F15}, ScriptGreek},                   // Ll   [6] GREEK SMALL LETTER EPSILON WITH PSILI..GREEK SMALL LETTER EPSILON WITH DASIA AND OXIA
+	{runeRange{0xFE68, 0xFE68}, ScriptCommon},                  // Po       SMALL REVERSE SOLIDUS
+	{runeRange{0x1D000, 0x1D0F5}, ScriptCommon},                // So [246] BYZANTINE MUSICAL SYMBOL PSILI..BYZANTINE MUSICAL SYMBOL GORGON NEO KATO
+	{runeRange{0x01E4, 0x01E4}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER G WITH STROKE
+	{runeRange{0x09CE, 0x09CE}, ScriptBengali},                 // Lo       BENGALI LETTER KHANDA TA
+	{runeRange{0x1D6B, 0x1D77}, ScriptLatin},                   // Ll  [13] LATIN SMALL LETTER UE..LATIN SMALL LETTER TURNED G
+	{runeRange{0x29DA, 0x29DA}, ScriptCommon},                  // Ps       LEFT DOUBLE WIGGLY FENCE
+	{runeRange{0xA7A4, 0xA7A4}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER N WITH OBLIQUE STROKE
+	{runeRange{0x11145, 0x11146}, ScriptChakma},                // Mc   [2] CHAKMA VOWEL SIGN AA..CHAKMA VOWEL SIGN EI
+	{runeRange{0x11D97, 0x11D97}, ScriptGunjalaGondi},          // Mn       GUNJALA GONDI VIRAMA
+	{runeRange{0x1E4EC, 0x1E4EF}, ScriptNagMundari},            // Mn   [4] NAG MUNDARI SIGN MUHOR..NAG MUNDARI SIGN SUTUH
+	{runeRange{0x0144, 0x0144}, ScriptLatin},                   // Ll       LATIN SMALL LETTER N WITH ACUTE
+	{runeRange{0x0391, 0x03A1}, ScriptGreek},                   // Lu  [17] GREEK CAPITAL LETTER ALPHA..GREEK CAPITAL LETTER RHO
+	{runeRange{0x05C0, 0x05C0}, ScriptHebrew},                  // Po       HEBREW PUNCTUATION PASEQ
+	{runeRange{0x0C66, 0x0C6F}, ScriptTelugu},                  // Nd  [10] TELUGU DIGIT ZERO..TELUGU DIGIT NINE
+	{runeRange{0x18AA, 0x18AA}, ScriptMongolian},               // Lo       MONGOLIAN LETTER MANCHU ALI GALI LHA
+	{runeRange{0x1E7A, 0x1E7A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH MACRON AND DIAERESIS
+	{runeRange{0x2134, 0x2134}, ScriptCommon},                  // Ll       SCRIPT SMALL O
+	{runeRange{0x2CE0, 0x2CE0}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER OLD NUBIAN NYI
+	{runeRange{0xA69A, 0xA69A}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER CROSSED O
+	{runeRange{0xAA77, 0xAA79}, ScriptMyanmar},                 // So   [3] MYANMAR SYMBOL AITON EXCLAMATION..MYANMAR SYMBOL AITON TWO
+	{runeRange{0x10858, 0x1085F}, ScriptImperialAramaic},       // No   [8] IMPERIAL ARAMAIC NUMBER ONE..IMPERIAL ARAMAIC NUMBER TEN THOUSAND
+	{runeRange{0x113CC, 0x113CD}, ScriptTuluTigalari},          // Mc   [2] TULU-TIGALARI SIGN ANUSVARA..TULU-TIGALARI SIGN VISARGA
+	{runeRange{0x11A3B, 0x11A3E}, ScriptZanabazarSquare},       // Mn   [4] ZANABAZAR SQUARE CLUSTER-FINAL LETTER YA..ZANABAZAR SQUARE CLUSTER-FINAL LETTER VA
+	{runeRange{0x16B45, 0x16B45}, ScriptPahawhHmong},           // So       PAHAWH HMONG SIGN CIM TSOV ROG
+	{runeRange{0x1D6C1, 0x1D6C1}, ScriptCommon},                // Sm       MATHEMATICAL BOLD NABLA
+	{runeRange{0x1EE74, 0x1EE77}, ScriptArabic},                // Lo   [4] ARABIC MATHEMATICAL STRETCHED SHEEN..ARABIC MATHEMATICAL STRETCHED KHAH
+	{runeRange{0x0103, 0x0103}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH BREVE
+	{runeRange{0x018C, 0x018D}, ScriptLatin},                   // Ll   [2] LATIN SMALL LETTER D WITH TOPBAR..LATIN SMALL LETTER TURNED DELTA
+	{runeRange{0x0227, 0x0227}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH DOT ABOVE
+	{runeRange{0x0476, 0x0476}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER IZHITSA WITH DOUBLE GRAVE ACCENT
+	{runeRange{0x04FB, 0x04FB}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER GHE WITH STROKE AND HOOK
+	{runeRange{0x07FA, 0x07FA}, ScriptNko},                     // Lm       NKO LAJANYALAN
+	{runeRange{0x0B13, 0x0B28}, ScriptOriya},                   // Lo  [22] ORIYA LETTER O..ORIYA LETTER NA
+	{runeRange{0x0DE6, 0x0DEF}, ScriptSinhala},                 // Nd  [10] SINHALA LITH DIGIT ZERO..SINHALA LITH DIGIT NINE
+	{runeRange{0x1369, 0x137C}, ScriptEthiopic},                // No  [20] ETHIOPIC DIGIT ONE..ETHIOPIC NUMBER TEN THOUSAND
+	{runeRange{0x1B61, 0x1B6A}, ScriptBalinese},                // So  [10] BALINESE MUSICAL SYMBOL DONG..BALINESE MUSICAL SYMBOL DANG GEDE
+	{runeRange{0x1E3A, 0x1E3A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER L WITH LINE BELOW
+	{runeRange{0x1EC2, 0x1EC2}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND HOOK ABOVE
+	{runeRange{0x2030, 0x2038}, ScriptCommon},                  // Po   [9] PER MILLE SIGN..CARET
+	{runeRange{0x25C1, 0x25C1}, ScriptCommon},                  // Sm       WHITE LEFT-POINTING TRIANGLE
+	{runeRange{0x2CA0, 0x2CA0}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER PI
+	{runeRange{0x2E3A, 0x2E3B}, ScriptCommon},                  // Pd   [2] TWO-EM DASH..THREE-EM DASH
+	{runeRange{0xA64F, 0xA64F}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER NEUTRAL YER
+	{runeRange{0xA759, 0xA759}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Q WITH DIAGONAL STROKE
+	{runeRange{0xA830, 0xA835}, ScriptCommon},                  // No   [6] NORTH INDIC FRACTION ONE QUARTER..NORTH INDIC FRACTION THREE SIXTEENTHS
+	{runeRange{0xFB2A, 0xFB36}, ScriptHebrew},                  // Lo  [13] HEBREW LETTER SHIN WITH SHIN DOT..HEBREW LETTER ZAYIN WITH DAGESH
+	{runeRange{0x10100, 0x10102}, ScriptCommon},                // Po   [3] AEGEAN WORD SEPARATOR LINE..AEGEAN CHECK MARK
+	{runeRange{0x10D69, 0x10D6D}, ScriptGaray},                 // Mn   [5] GARAY VOWEL SIGN E..GARAY CONSONANT NASALIZATION MARK
+	{runeRange{0x11300, 0x11301}, ScriptGrantha},               // Mn   [2] GRANTHA SIGN COMBINING ANUSVARA ABOVE..GRANTHA SIGN CANDRABINDU
+	{runeRange{0x114C2, 0x114C3}, ScriptTirhuta},               // Mn   [2] TIRHUTA SIGN VIRAMA..TIRHUTA SIGN NUKTA
+	{runeRange{0x1190C, 0x11913}, ScriptDivesAkuru},            // Lo   [8] DIVES AKURU LETTER KA..DIVES AKURU LETTER JA
+	{runeRange{0x11C3F, 0x11C3F}, ScriptBhaiksuki},             // Mn       BHAIKSUKI SIGN VIRAMA
+	{runeRange{0x12400, 0x1246E}, ScriptCuneiform},             // Nl [111] CUNEIFORM NUMERIC SIGN TWO ASH..CUNEIFORM NUMERIC SIGN NINE U VARIANT FORM
+	{runeRange{0x18CFF, 0x18CFF}, ScriptKhitanSmallScript},     // Lo       KHITAN SMALL SCRIPT CHARACTER-18CFF
+	{runeRange{0x1D4A9, 0x1D4AC}, ScriptCommon},                // Lu   [4] MATHEMATICAL SCRIPT CAPITAL N..MATHEMATICAL SCRIPT CAPITAL Q
+	{runeRange{0x1DA75, 0x1DA75}, ScriptSignWriting},           // Mn       SIGNWRITING UPPER BODY TILTING FROM HIP JOINTS
+	{runeRange{0x1ECAC, 0x1ECAC}, ScriptCommon},                // So       INDIC SIYAQ PLACEHOLDER
+	{runeRange{0x1F800, 0x1F80B}, ScriptCommon},                // So  [12] LEFTWARDS ARROW WITH SMALL TRIANGLE ARROWHEAD..DOWNWARDS ARROW WITH LARGE TRIANGLE ARROWHEAD
+	{runeRange{0x00A2, 0x00A5}, ScriptCommon},                  // Sc   [4] CENT SIGN..YEN SIGN
+	{runeRange{0x0123, 0x0123}, ScriptLatin},                   // Ll       LATIN SMALL LETTER G WITH CEDILLA
+	{runeRange{0x0165, 0x0165}, ScriptLatin},                   // Ll       LATIN SMALL LETTER T WITH CARON
+	{runeRange{0x01C0, 0x01C3}, ScriptLatin},                   // Lo   [4] LATIN LETTER DENTAL CLICK..LATIN LETTER RETROFLEX CLICK
+	{runeRange{0x0207, 0x0207}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH INVERTED BREVE
+	{runeRange{0x02B9, 0x02C1}, ScriptCommon},                  // Lm   [9] MODIFIER LETTER PRIME..MODIFIER LETTER REVERSED GLOTTAL STOP
+	{runeRange{0x03F4, 0x03F4}, ScriptGreek},                   // Lu       GREEK CAPITAL THETA SYMBOL
+	{runeRange{0x0499, 0x0499}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER ZE WITH DESCENDER
+	{runeRange{0x04DB, 0x04DB}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER SCHWA WITH DIAERESIS
+	{runeRange{0x051B, 0x051B}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER QA
+	{runeRange{0x06D4, 0x06D4}, ScriptArabic},                  // Po       ARABIC FULL STOP
+	{runeRange{0x093D, 0x093D}, ScriptDevanagari},              // Lo       DEVANAGARI SIGN AVAGRAHA
+	{runeRange{0x0A70, 0x0A71}, ScriptGurmukhi},                // Mn   [2] GURMUKHI TIPPI..GURMUKHI ADDAK
+	{runeRange{0x0BAE, 0x0BB9}, ScriptTamil},                   // Lo  [12] TAMIL LETTER MA..TAMIL LETTER HA
+	{runeRange{0x0D0E, 0x0D10}, ScriptMalayalam},               // Lo   [3] MALAYALAM LETTER E..MALAYALAM LETTER AI
+	{runeRange{0x0F13, 0x0F13}, ScriptTibetan},                 // So       TIBETAN MARK CARET -DZUD RTAGS ME LONG CAN
+	{runeRange{0x108E, 0x108E}, ScriptMyanmar},                 // Lo       MYANMAR LETTER RUMAI PALAUNG FA
+	{runeRange{0x17B4, 0x17B5}, ScriptKhmer},                   // Mn   [2] KHMER VOWEL INHERENT AQ..KHMER VOWEL INHERENT AA
+	{runeRange{0x1A60, 0x1A60}, ScriptTaiTham},                 // Mn       TAI THAM SIGN SAKOT
+	{runeRange{0x1C40, 0x1C49}, ScriptLepcha},                  // Nd  [10] LEPCHA DIGIT ZERO..LEPCHA DIGIT NINE
+	{runeRange{0x1E1A, 0x1E1A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH TILDE BELOW
+	{runeRange{0x1E5A, 0x1E5A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER R WITH DOT BELOW
+	{runeRange{0x1EA2, 0x1EA2}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH HOOK ABOVE
+	{runeRange{0x1EE2, 0x1EE2}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH HORN AND DOT BELOW
+	{runeRange{0x1FCC, 0x1FCC}, ScriptGreek},                   // Lt       GREEK CAPITAL LETTER ETA WITH PROSGEGRAMMENI
+	{runeRange{0x20E1, 0x20E1}, ScriptInherited},               // Mn       COMBINING LEFT RIGHT ARROW ABOVE
+	{runeRange{0x21AF, 0x21CD}, ScriptCommon},                  // So  [31] DOWNWARDS ZIGZAG ARROW..LEFTWARDS DOUBLE ARROW WITH STROKE
+	{runeRange{0x27EC, 0x27EC}, ScriptCommon},                  // Ps       MATHEMATICAL LEFT WHITE TORTOISE SHELL BRACKET
+	{runeRange{0x2C80, 0x2C80}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER ALFA
+	{runeRange{0x2CC0, 0x2CC0}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER SAMPI
+	{runeRange{0x2E00, 0x2E01}, ScriptCommon},                  // Po   [2] RIGHT ANGLE SUBSTITUTION MARKER..RIGHT ANGLE DOTTED SUBSTITUTION MARKER
+	{runeRange{0x300D, 0x300D}, ScriptCommon},                  // Pe       RIGHT CORNER BRACKET
+	{runeRange{0x3358, 0x33FF}, ScriptCommon},                  // So [168] IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR ZERO..SQUARE GAL
+	{runeRange{0xA66F, 0xA66F}, ScriptCyrillic},                // Mn       COMBINING CYRILLIC VZMET
+	{runeRange{0xA739, 0xA739}, ScriptLatin},                   // Ll       LATIN SMALL LETTER AV
+	{runeRange{0xA781, 0xA781}, ScriptLatin},                   // Ll       LATIN SMALL LETTER TURNED L
+	{runeRange{0xA7D0, 0xA7D0}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER CLOSED INSULAR G
+	{runeRange{0xA9B3, 0xA9B3}, ScriptJavanese},                // Mn       JAVANESE SIGN CECAK TELU
+	{runeRange{0xAB20, 0xAB26}, ScriptEthiopic},                // Lo   [7] ETHIOPIC SYLLABLE CCHHA..ETHIOPIC SYLLABLE CCHHO
+	{runeRange{0xFE37, 0xFE37}, ScriptCommon},                  // Ps       PRESENTATION FORM FOR VERTICAL LEFT CURLY BRACKET
+	{runeRange{0xFF5F, 0xFF5F}, ScriptCommon},                  // Ps       FULLWIDTH LEFT WHITE PARENTHESIS
+	{runeRange{0x10428, 0x1044F}, ScriptDeseret},               // Ll  [40] DESERET SMALL LETTER LONG I..DESERET SMALL LETTER EW
+	{runeRange{0x10A60, 0x10A7C}, ScriptOldSouthArabian},       // Lo  [29] OLD SOUTH ARABIAN LETTER HE..OLD SOUTH ARABIAN LETTER THETH
+	{runeRange{0x11003, 0x11037}, ScriptBrahmi},                // Lo  [53] BRAHMI SIGN JIHVAMULIYA..BRAHMI LETTER OLD TAMIL NNNA
+	{runeRange{0x11236, 0x11237}, ScriptKhojki},                // Mn   [2] KHOJKI SIGN NUKTA..KHOJKI SIGN SHADDA
+	{runeRange{0x11350, 0x11350}, ScriptGrantha},               // Lo       GRANTHA OM
+	{runeRange{0x11446, 0x11446}, ScriptNewa},                  // Mn       NEWA SIGN NUKTA
+	{runeRange{0x11630, 0x11632}, ScriptModi},                  // Mc   [3] MODI VOWEL SIGN AA..MODI VOWEL SIGN II
+	{runeRange{0x1173C, 0x1173E}, ScriptAhom},                  // Po   [3] AHOM SIGN SMALL SECTION..AHOM SIGN RULAI
+	{runeRange{0x119AA, 0x119D0}, ScriptNandinagari},           // Lo  [39] NANDINAGARI LETTER E..NANDINAGARI LETTER RRA
+	{runeRange{0x11B00, 0x11B09}, ScriptDevanagari},            // Po  [10] DEVANAGARI HEAD MARK..DEVANAGARI SIGN MINDU
+	{runeRange{0x11D0B, 0x11D30}, ScriptMasaramGondi},          // Lo  [38] MASARAM GONDI LETTER AU..MASARAM GONDI LETTER TRA
+	{runeRange{0x11F34, 0x11F35}, ScriptKawi},                  // Mc   [2] KAWI VOWEL SIGN AA..KAWI VOWEL SIGN ALTERNATE AA
+	{runeRange{0x16130, 0x16139}, ScriptGurungKhema},           // Nd  [10] GURUNG KHEMA DIGIT ZERO..GURUNG KHEMA DIGIT NINE
+	{runeRange{0x16F00, 0x16F4A}, ScriptMiao},                  // Lo  [75] MIAO LETTER PA..MIAO LETTER RTE
+	{runeRange{0x1BC80, 0x1BC88}, ScriptDuployan},              // Lo   [9] DUPLOYAN AFFIX HIGH ACUTE..DUPLOYAN AFFIX HIGH VERTICAL
+	{runeRange{0x1D245, 0x1D245}, ScriptGreek},                 // So       GREEK MUSICAL LEIMMA
+	{runeRange{0x1D546, 0x1D546}, ScriptCommon},                // Lu       MATHEMATICAL DOUBLE-STRUCK CAPITAL O
+	{runeRange{0x1D770, 0x1D788}, ScriptCommon},                // Ll  [25] MATHEMATICAL SANS-SERIF BOLD SMALL ALPHA..MATHEMATICAL SANS-SERIF BOLD SMALL OMEGA
+	{runeRange{0x1E030, 0x1E06D}, ScriptCyrillic},              // Lm  [62] MODIFIER LETTER CYRILLIC SMALL A..MODIFIER LETTER CYRILLIC SMALL STRAIGHT U WITH STROKE
+	{runeRange{0x1E6FE, 0x1E6FE}, ScriptTaiYo},                 // Lo       TAI YO SYMBOL MUEANG
+	{runeRange{0x1EE42, 0x1EE42}, ScriptArabic},                // Lo       ARABIC MATHEMATICAL TAILED JEEM
+	{runeRange{0x1F10D, 0x1F1AD}, ScriptCommon},                // So [161] CIRCLED ZERO WITH SLASH..MASK WORK SYMBOL
+	{runeRange{0x1FAEF, 0x1FAF8}, ScriptCommon},                // So  [10] FIGHT CLOUD..RIGHTWARDS PUSHING HAND
+	{runeRange{0x003F, 0x0040}, ScriptCommon},                  // Po   [2] QUESTION MARK..COMMERCIAL AT
+	{runeRange{0x00B6, 0x00B7}, ScriptCommon},                  // Po   [2] PILCROW SIGN..MIDDLE DOT
+	{runeRange{0x0113, 0x0113}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH MACRON
+	{runeRange{0x0133, 0x0133}, ScriptLatin},                   // Ll       LATIN SMALL LIGATURE IJ
+	{runeRange{0x0155, 0x0155}, ScriptLatin},                   // Ll       LATIN SMALL LETTER R WITH ACUTE
+	{runeRange{0x0175, 0x0175}, ScriptLatin},                   // Ll       LATIN SMALL LETTER W WITH CIRCUMFLEX
+	{runeRange{0x01A8, 0x01A8}, ScriptLatin},                   // Ll       LATIN SMALL LETTER TONE TWO
+	{runeRange{0x01D3, 0x01D3}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH CARON
+	{runeRange{0x01F5, 0x01F5}, ScriptLatin},                   // Ll       LATIN SMALL LETTER G WITH ACUTE
+	{runeRange{0x0217, 0x0217}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH INVERTED BREVE
+	{runeRange{0x023F, 0x0240}, ScriptLatin},                   // Ll   [2] LATIN SMALL LETTER S WITH SWASH TAIL..LATIN SMALL LETTER Z WITH SWASH TAIL
+	{runeRange{0x0374, 0x0374}, ScriptCommon},                  // Lm       GREEK NUMERAL SIGN
+	{runeRange{0x03E1, 0x03E1}, ScriptGreek},                   // Ll       GREEK SMALL LETTER SAMPI
+	{runeRange{0x0466, 0x0466}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER LITTLE YUS
+	{runeRange{0x0488, 0x0489}, ScriptCyrillic},                // Me   [2] COMBINING CYRILLIC HUNDRED THOUSANDS SIGN..COMBINING CYRILLIC MILLIONS SIGN
+	{runeRange{0x04A9, 0x04A9}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER ABKHASIAN HA
+	{runeRange{0x04CA, 0x04CA}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER EN WITH TAIL
+	{runeRange{0x04EB, 0x04EB}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER BARRED O WITH DIAERESIS
+	{runeRange{0x050B, 0x050B}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER KOMI NJE
+	{runeRange{0x052B, 0x052B}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER DZZHE
+	{runeRange{0x060E, 0x060F}, ScriptArabic},                  // So   [2] ARABIC POETIC VERSE SIGN..ARABIC SIGN MISRA
+	{runeRange{0x070F, 0x070F}, ScriptSyriac},                  // Cf       SYRIAC ABBREVIATION MARK
+	{runeRange{0x0870, 0x0887}, ScriptArabic},                  // Lo  [24] ARABIC LETTER ALEF WITH ATTACHED FATHA..ARABIC BASELINE ROUND DOT
+	{runeRange{0x0980, 0x0980}, ScriptBengali},                 // Lo       BENGALI ANJI
+	{runeRange{0x0A05, 0x0A0A}, ScriptGurmukhi},                // Lo   [6] GURMUKHI LETTER A..GURMUKHI LETTER UU
+	{runeRange{0x0AC7, 0x0AC8}, ScriptGujarati},                // Mn   [2] GUJARATI VOWEL SIGN E..GUJARATI VOWEL SIGN AI
+	{runeRange{0x0B5F, 0x0B61}, ScriptOriya},                   // Lo   [3] ORIYA LETTER YYA..ORIYA LETTER VOCALIC LL
+	{runeRange{0x0C04, 0x0C04}, ScriptTelugu},                  // Mn       TELUGU SIGN COMBINING ANUSVARA ABOVE
+	{runeRange{0x0CBF, 0x0CBF}, ScriptKannada},                 // Mn       KANNADA VOWEL SIGN I
+	{runeRange{0x0D66, 0x0D6F}, ScriptMalayalam},               // Nd  [10] MALAYALAM DIGIT ZERO..MALAYALAM DIGIT NINE
+	{runeRange{0x0E86, 0x0E8A}, ScriptLao},                     // Lo   [5] LAO LETTER PALI GHA..LAO LETTER SO TAM
+	{runeRange{0x0F3D, 0x0F3D}, ScriptTibetan},                 // Pe       TIBETAN MARK ANG KHANG GYAS
+	{runeRange{0x1056, 0x1057}, ScriptMyanmar},                 // Mc   [2] MYANMAR VOWEL SIGN VOCALIC R..MYANMAR VOWEL SIGN VOCALIC RR
+	{runeRange{0x1250, 0x1256}, ScriptEthiopic},                // Lo   [7] ETHIOPIC SYLLABLE QHA..ETHIOPIC SYLLABLE QHO
+	{runeRange{0x16EE, 0x16F0}, ScriptRunic},                   // Nl   [3] RUNIC ARLAUG SYMBOL..RUNIC BELGTHOR SYMBOL
+	{runeRange{0x1802, 0x1803}, ScriptCommon},                  // Po   [2] MONGOLIAN COMMA..MONGOLIAN FULL STOP
+	{runeRange{0x1980, 0x19AB}, ScriptNewTaiLue},               // Lo  [44] NEW TAI LUE LETTER HIGH QA..NEW TAI LUE LETTER LOW SUA
+	{runeRange{0x1AE0, 0x1AEB}, ScriptInherited},               // Mn  [12] COMBINING LEFT TACK ABOVE..COMBINING DOUBLE RIGHTWARDS ARROW ABOVE
+	{runeRange{0x1BC0, 0x1BE5}, ScriptBatak},                   // Lo  [38] BATAK LETTER A..BATAK LETTER U
+	{runeRange{0x1CE2, 0x1CE8}, ScriptInherited},               // Mn   [7] VEDIC SIGN VISARGA SVARITA..VEDIC SIGN VISARGA ANUDATTA WITH TAIL
+	{runeRange{0x1E0A, 0x1E0A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER D WITH DOT ABOVE
+	{runeRange{0x1E2A, 0x1E2A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER H WITH BREVE BELOW
+	{runeRange{0x1E4A, 0x1E4A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER N WITH CIRCUMFLEX BELOW
+	{runeRange{0x1E6A, 0x1E6A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER T WITH DOT ABOVE
+	{runeRange{0x1E8A, 0x1E8A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER X WITH DOT ABOVE
+	{runeRange{0x1EB2, 0x1EB2}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH BREVE AND HOOK ABOVE
+	{runeRange{0x1ED2, 0x1ED2}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND GRAVE
+	{runeRange{0x1EF2, 0x1EF2}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Y WITH GRAVE
+	{runeRange{0x1F80, 0x1F87}, ScriptGreek},                   // Ll   [8] GREEK SMALL LETTER ALPHA WITH PSILI AND YPOGEGRAMMENI..GREEK SMALL LETTER ALPHA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	{runeRange{0x200C, 0x200D}, ScriptInherited},               // Cf   [2] ZERO WIDTH NON-JOINER..ZERO WIDTH JOINER
+	{runeRange{0x2066, 0x206F}, ScriptCommon},                  // Cf  [10] LEFT-TO-RIGHT ISOLATE..NOMINAL DIGIT SHAPES
+	{runeRange{0x2118, 0x2118}, ScriptCommon},                  // Sm       SCRIPT CAPITAL P
+	{runeRange{0x2183, 0x2183}, ScriptLatin},                   // Lu       ROMAN NUMERAL REVERSED ONE HUNDRED
+	{runeRange{0x2329, 0x2329}, ScriptCommon},                  // Ps       LEFT-POINTING ANGLE BRACKET
+	{runeRange{0x2772, 0x2772}, ScriptCommon},                  // Ps       LIGHT LEFT TORTOISE SHELL BRACKET ORNAMENT
+	{runeRange{0x298C, 0x298C}, ScriptCommon},                  // Pe       RIGHT SQUARE BRACKET WITH UNDERBAR
+	{runeRange{0x2C62, 0x2C64}, ScriptLatin},                   // Lu   [3] LATIN CAPITAL LETTER L WITH MIDDLE TILDE..LATIN CAPITAL LETTER R WITH TAIL
+	{runeRange{0x2C90, 0x2C90}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER THETHE
+	{runeRange{0x2CB0, 0x2CB0}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER OOU
+	{runeRange{0x2CD0, 0x2CD0}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER L-SHAPED HA
+	{runeRange{0x2D27, 0x2D27}, ScriptGeorgian},                // Ll       GEORGIAN SMALL LETTER YN
+	{runeRange{0x2E1C, 0x2E1C}, ScriptCommon},                  // Pi       LEFT LOW PARAPHRASE BRACKET
+	{runeRange{0x2E5D, 0x2E5D}, ScriptCommon},                  // Pd       OBLIQUE HYPHEN
+	{runeRange{0x301E, 0x301F}, ScriptCommon},                  // Pe   [2] DOUBLE PRIME QUOTATION MARK..LOW DOUBLE PRIME QUOTATION MARK
+	{runeRange{0x31F0, 0x31FF}, ScriptKatakana},                // Lo  [16] KATAKANA LETTER SMALL KU..KATAKANA LETTER SMALL RO
+	{runeRange{0xA62A, 0xA62B}, ScriptVai},                     // Lo   [2] VAI SYLLABLE NDOLE MA..VAI SYLLABLE NDOLE DO
+	{runeRange{0xA65F, 0xA65F}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER YN
+	{runeRange{0xA68A, 0xA68A}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER TE WITH MIDDLE HOOK
+	{runeRange{0xA727, 0xA727}, ScriptLatin},                   // Ll       LATIN SMALL LETTER HENG
+	{runeRange{0xA749, 0xA749}, ScriptLatin},                   // Ll       LATIN SMALL LETTER L WITH HIGH STROKE
+	{runeRange{0xA769, 0xA769}, ScriptLatin},                   // Ll       LATIN SMALL LETTER VEND
+	{runeRange{0xA792, 0xA792}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER C WITH BAR
+	{runeRange{0xA7BC, 0xA7BC}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER GLOTTAL I
+	{runeRange{0xA7F7, 0xA7F7}, ScriptLatin},                   // Lo       LATIN EPIGRAPHIC LETTER SIDEWAYS I
+	{runeRange{0xA8FC, 0xA8FC}, ScriptDevanagari},              // Po       DEVANAGARI SIGN SIDDHAM
+	{runeRange{0xAA00, 0xAA28}, ScriptCham},                    // Lo  [41] CHAM LETTER A..CHAM LETTER HA
+	{runeRange{0xAAC2, 0xAAC2}, ScriptTaiViet},                 // Lo       TAI VIET TONE MAI SONG
+	{runeRange{0xABE9, 0xABEA}, ScriptMeeteiMayek},             // Mc   [2] MEETEI MAYEK VOWEL SIGN CHEINAP..MEETEI MAYEK VOWEL SIGN NUNG
+	{runeRange{0xFDC8, 0xFDCF}, ScriptArabic},                  // So   [8] ARABIC LIGATURE RAHIMAHU ALLAAH TAAALAA..ARABIC LIGATURE SALAAMUHU ALAYNAA
+	{runeRange{0xFE48, 0xFE48}, ScriptCommon},                  // Pe       PRESENTATION FORM FOR VERTICAL RIGHT SQUARE BRACKET
+	{runeRange{0xFF10, 0xFF19}, ScriptCommon},                  // Nd  [10] FULLWIDTH DIGIT ZERO..FULLWIDTH DIGIT NINE
+	{runeRange{0xFFE2, 0xFFE2}, ScriptCommon},                  // Sm       FULLWIDTH NOT SIGN
+	{runeRange{0x10300, 0x1031F}, ScriptOldItalic},             // Lo  [32] OLD ITALIC LETTER A..OLD ITALIC LETTER ESS
+	{runeRange{0x105BB, 0x105BC}, ScriptVithkuqi},              // Ll   [2] VITHKUQI SMALL LETTER Y..VITHKUQI SMALL LETTER ZE
+	{runeRange{0x109A0, 0x109B7}, ScriptMeroiticCursive},       // Lo  [24] MEROITIC CURSIVE LETTER A..MEROITIC CURSIVE LETTER DA
+	{runeRange{0x10B78, 0x10B7F}, ScriptInscriptionalPahlavi},  // No   [8] INSCRIPTIONAL PAHLAVI NUMBER ONE..INSCRIPTIONAL PAHLAVI NUMBER ONE THOUSAND
+	{runeRange{0x10F00, 0x10F1C}, ScriptOldSogdian},            // Lo  [29] OLD SOGDIAN LETTER ALEPH..OLD SOGDIAN LETTER FINAL TAW WITH VERTICAL TAIL
+	{runeRange{0x110B9, 0x110BA}, ScriptKaithi},                // Mn   [2] KAITHI SIGN VIRAMA..KAITHI SIGN NUKTA
+	{runeRange{0x111CE, 0x111CE}, ScriptSharada},               // Mc       SHARADA VOWEL SIGN PRISHTHAMATRA E
+	{runeRange{0x1128F, 0x1129D}, ScriptMultani},               // Lo  [15] MULTANI LETTER NYA..MULTANI LETTER BA
+	{runeRange{0x1133B, 0x1133B}, ScriptInherited},             // Mn       COMBINING BINDU BELOW
+	{runeRange{0x1138E, 0x1138E}, ScriptTuluTigalari},          // Lo       TULU-TIGALARI LETTER AI
+	{runeRange{0x113D7, 0x113D8}, ScriptTuluTigalari},          // Po   [2] TULU-TIGALARI SIGN OM PUSHPIKA..TULU-TIGALARI SIGN SHRII PUSHPIKA
+	{runeRange{0x11480, 0x114AF}, ScriptTirhuta},               // Lo  [48] TIRHUTA ANJI..TIRHUTA LETTER HA
+	{runeRange{0x115B8, 0x115BB}, ScriptSiddham},               // Mc   [4] SIDDHAM VOWEL SIGN E..SIDDHAM VOWEL SIGN AU
+	{runeRange{0x11650, 0x11659}, ScriptModi},                  // Nd  [10] MODI DIGIT ZERO..MODI DIGIT NINE
+	{runeRange{0x1171E, 0x1171E}, ScriptAhom},                  // Mc       AHOM CONSONANT SIGN MEDIAL RA
+	{runeRange{0x1183B, 0x1183B}, ScriptDogra},                 // Po       DOGRA ABBREVIATION SIGN
+	{runeRange{0x1193F, 0x1193F}, ScriptDivesAkuru},            // Lo       DIVES AKURU PREFIXED NASAL SIGN
+	{runeRange{0x119E3, 0x119E3}, ScriptNandinagari},           // Lo       NANDINAGARI HEADSTROKE
+	{runeRange{0x11A8A, 0x11A96}, ScriptSoyombo},               // Mn  [13] SOYOMBO FINAL CONSONANT SIGN G..SOYOMBO SIGN ANUSVARA
+	{runeRange{0x11BE1, 0x11BE1}, ScriptSunuwar},               // Po       SUNUWAR SIGN PVO
+	{runeRange{0x11CA9, 0x11CA9}, ScriptMarchen},               // Mc       MARCHEN SUBJOINED LETTER YA
+	{runeRange{0x11D60, 0x11D65}, ScriptGunjalaGondi},          // Lo   [6] GUNJALA GONDI LETTER A..GUNJALA GONDI LETTER UU
+	{runeRange{0x11EF3, 0x11EF4}, ScriptMakasar},               // Mn   [2] MAKASAR VOWEL SIGN I..MAKASAR VOWEL SIGN U
+	{runeRange{0x11F5A, 0x11F5A}, ScriptKawi},                  // Mn       KAWI SIGN NUKTA
+	{runeRange{0x13441, 0x13446}, ScriptEgyptianHieroglyphs},   // Lo   [6] EGYPTIAN HIEROGLYPH FULL BLANK..EGYPTIAN HIEROGLYPH WIDE LOST SIGN
+	{runeRange{0x16AF0, 0x16AF4}, ScriptBassaVah},              // Mn   [5] BASSA VAH COMBINING HIGH TONE..BASSA VAH COMBINING HIGH-LOW TONE
+	{runeRange{0x16D6D, 0x16D6F}, ScriptKiratRai},              // Po   [3] KIRAT RAI SIGN YUPI..KIRAT RAI DOUBLE DANDA
+	{runeRange{0x16FE2, 0x16FE2}, ScriptHan},                   // Po       OLD CHINESE HOOK MARK
+	{runeRange{0x1B120, 0x1B122}, ScriptKatakana},              // Lo   [3] KATAKANA LETTER ARCHAIC YI..KATAKANA LETTER ARCHAIC WU
+	{runeRange{0x1CCFA, 0x1CCFC}, ScriptCommon},                // So   [3] SNAKE SYMBOL..NOSE SYMBOL
+	{runeRange{0x1D17B, 0x1D182}, ScriptInherited},             // Mn   [8] MUSICAL SYMBOL COMBINING ACCENT..MUSICAL SYMBOL COMBINING LOURE
+	{runeRange{0x1D44E, 0x1D454}, ScriptCommon},                // Ll   [7] MATHEMATICAL ITALIC SMALL A..MATHEMATICAL ITALIC SMALL G
+	{runeRange{0x1D504, 0x1D505}, ScriptCommon},                // Lu   [2] MATHEMATICAL FRAKTUR CAPITAL A..MATHEMATICAL FRAKTUR CAPITAL B
+	{runeRange{0x1D5EE, 0x1D607}, ScriptCommon},                // Ll  [26] MATHEMATICAL SANS-SERIF BOLD SMALL A..MATHEMATICAL SANS-SERIF BOLD SMALL Z
+	{runeRange{0x1D716, 0x1D71B}, ScriptCommon},                // Ll   [6] MATHEMATICAL ITALIC EPSILON SYMBOL..MATHEMATICAL ITALIC PI SYMBOL
+	{runeRange{0x1D7CA, 0x1D7CA}, ScriptCommon},                // Lu       MATHEMATICAL BOLD CAPITAL DIGAMMA
+	{runeRange{0x1DF0A, 0x1DF0A}, ScriptLatin},                 // Lo       LATIN LETTER RETROFLEX CLICK WITH RETROFLEX HOOK
+	{runeRange{0x1E290, 0x1E2AD}, ScriptToto},                  // Lo  [30] TOTO LETTER PA..TOTO LETTER A
+	{runeRange{0x1E6E0, 0x1E6E2}, ScriptTaiYo},                 // Lo   [3] TAI YO LETTER AA..TAI YO LETTER UE
+	{runeRange{0x1E8D0, 0x1E8D6}, ScriptMendeKikakui},          // Mn   [7] MENDE KIKAKUI COMBINING NUMBER TEENS..MENDE KIKAKUI COMBINING NUMBER MILLIONS
+	{runeRange{0x1EE05, 0x1EE1F}, ScriptArabic},                // Lo  [27] ARABIC MATHEMATICAL WAW..ARABIC MATHEMATICAL DOTLESS QAF
+	{runeRange{0x1EE59, 0x1EE59}, ScriptArabic},                // Lo       ARABIC MATHEMATICAL TAILED DAD
+	{runeRange{0x1EEF0, 0x1EEF1}, ScriptArabic},                // Sm   [2] ARABIC MATHEMATICAL OPERATOR MEEM WITH HAH WITH TATWEEL..ARABIC MATHEMATICAL OPERATOR HAH WITH DAL
+	{runeRange{0x1F300, 0x1F3FA}, ScriptCommon},                // So [251] CYCLONE..AMPHORA
+	{runeRange{0x1F900, 0x1FA57}, ScriptCommon},                // So [344] CIRCLED CROSS FORMEE WITH FOUR DOTS..BLACK CHESS ALFIL
+	{runeRange{0x2CEB0, 0x2EBE0}, ScriptHan},                   // Lo [7473] CJK UNIFIED IDEOGRAPH-2CEB0..CJK UNIFIED IDEOGRAPH-2EBE0
+	{runeRange{0x002A, 0x002A}, ScriptCommon},                  // Po       ASTERISK
+	{runeRange{0x0061, 0x007A}, ScriptLatin},                   // Ll  [26] LATIN SMALL LETTER A..LATIN SMALL LETTER Z
+	{runeRange{0x00AD, 0x00AD}, ScriptCommon},                  // Cf       SOFT HYPHEN
+	{runeRange{0x00D7, 0x00D7}, ScriptCommon},                  // Sm       MULTIPLICATION SIGN
+	{runeRange{0x010B, 0x010B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER C WITH DOT ABOVE
+	{runeRange{0x011B, 0x011B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH CARON
+	{runeRange{0x012B, 0x012B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER I WITH MACRON
+	{runeRange{0x013C, 0x013C}, ScriptLatin},                   // Ll       LATIN SMALL LETTER L WITH CEDILLA
+	{runeRange{0x014D, 0x014D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH MACRON
+	{runeRange{0x015D, 0x015D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER S WITH CIRCUMFLEX
+	{runeRange{0x016D, 0x016D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH BREVE
+	{runeRange{0x017E, 0x0180}, ScriptLatin},                   // Ll   [3] LATIN SMALL LETTER Z WITH CARON..LATIN SMALL LETTER B WITH STROKE
+	{runeRange{0x019E, 0x019E}, ScriptLatin},                   // Ll       LATIN SMALL LETTER N WITH LONG RIGHT LEG
+	{runeRange{0x01B4, 0x01B4}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Y WITH HOOK
+	{runeRange{0x01CB, 0x01CB}, ScriptLatin},                   // Lt       LATIN CAPITAL LETTER N WITH SMALL LETTER J
+	{runeRange{0x01DB, 0x01DB}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH DIAERESIS AND GRAVE
+	{runeRange{0x01EC, 0x01EC}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH OGONEK AND MACRON
+	{runeRange{0x01FF, 0x01FF}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH STROKE AND ACUTE
+	{runeRange{0x020F, 0x020F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH INVERTED BREVE
+	{runeRange{0x021F, 0x021F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER H WITH CARON
+	{runeRange{0x022F, 0x022F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH DOT ABOVE
+	{runeRange{0x024B, 0x024B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Q WITH HOOK TAIL
+	{runeRange{0x02ED, 0x02ED}, ScriptCommon},                  // Sk       MODIFIER LETTER UNASPIRATED
+	{runeRange{0x0384, 0x0384}, ScriptGreek},                   // Sk       GREEK TONOS
+	{runeRange{0x03D9, 0x03D9}, ScriptGreek},                   // Ll       GREEK SMALL LETTER ARCHAIC KOPPA
+	{runeRange{0x03E9, 0x03E9}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER HORI
+	{runeRange{0x0400, 0x042F}, ScriptCyrillic},                // Lu  [48] CYRILLIC CAPITAL LETTER IE WITH GRAVE..CYRILLIC CAPITAL LETTER YA
+	{runeRange{0x046E, 0x046E}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER KSI
+	{runeRange{0x047E, 0x047E}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER OT
+	{runeRange{0x0491, 0x0491}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER GHE WITH UPTURN
+	{runeRange{0x04A1, 0x04A1}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER BASHKIR KA
+	{runeRange{0x04B1, 0x04B1}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER STRAIGHT U WITH STROKE
+	{runeRange{0x04C2, 0x04C2}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER ZHE WITH BREVE
+	{runeRange{0x04D3, 0x04D3}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER A WITH DIAERESIS
+	{runeRange{0x04E3, 0x04E3}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER I WITH MACRON
+	{runeRange{0x04F3, 0x04F3}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER U WITH DOUBLE ACUTE
+	{runeRange{0x0503, 0x0503}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER KOMI DJE
+	{runeRange{0x0513, 0x0513}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER EL WITH HOOK
+	{runeRange{0x0523, 0x0523}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER EN WITH MIDDLE HOOK
+	{runeRange{0x0560, 0x0588}, ScriptArmenian},                // Ll  [41] ARMENIAN SMALL LETTER TURNED AYB..ARMENIAN SMALL LETTER YI WITH STROKE
+	{runeRange{0x05F3, 0x05F4}, ScriptHebrew},                  // Po   [2] HEBREW PUNCTUATION GERESH..HEBREW PUNCTUATION GERSHAYIM
+	{runeRange{0x0641, 0x064A}, ScriptArabic},                  // Lo  [10] ARABIC LETTER FEH..ARABIC LETTER YEH
+	{runeRange{0x06E9, 0x06E9}, ScriptArabic},                  // So       ARABIC PLACE OF SAJDAH
+	{runeRange{0x07A6, 0x07B0}, ScriptThaana},                  // Mn  [11] THAANA ABAFILI..THAANA SUKUN
+	{runeRange{0x0825, 0x0827}, ScriptSamaritan},               // Mn   [3] SAMARITAN VOWEL SIGN SHORT A..SAMARITAN VOWEL SIGN U
+	{runeRange{0x08E2, 0x08E2}, ScriptCommon},                  // Cf       ARABIC DISPUTED END OF AYAH
+	{runeRange{0x0955, 0x0957}, ScriptDevanagari},              // Mn   [3] DEVANAGARI VOWEL SIGN CANDRA LONG E..DEVANAGARI VOWEL SIGN UUE
+	{runeRange{0x09B6, 0x09B9}, ScriptBengali},                 // Lo   [4] BENGALI LETTER SHA..BENGALI LETTER HA
+	{runeRange{0x09F4, 0x09F9}, ScriptBengali},                 // No   [6] BENGALI CURRENCY NUMERATOR ONE..BENGALI CURRENCY DENOMINATOR SIXTEEN
+	{runeRange{0x0A3E, 0x0A40}, ScriptGurmukhi},                // Mc   [3] GURMUKHI VOWEL SIGN AA..GURMUKHI VOWEL SIGN II
+	{runeRange{0x0A93, 0x0AA8}, ScriptGujarati},                // Lo  [22] GUJARATI LETTER O..GUJARATI LETTER NA
+	{runeRange{0x0AF0, 0x0AF0}, ScriptGujarati},                // Po       GUJARATI ABBREVIATION SIGN
+	{runeRange{0x0B40, 0x0B40}, ScriptOriya},                   // Mc       ORIYA VOWEL SIGN II
+	{runeRange{0x0B85, 0x0B8A}, ScriptTamil},                   // Lo   [6] TAMIL LETTER A..TAMIL LETTER UU
+	{runeRange{0x0BD7, 0x0BD7}, ScriptTamil},                   // Mc       TAMIL AU LENGTH MARK
+	{runeRange{0x0C41, 0x0C44}, ScriptTelugu},                  // Mc   [4] TELUGU VOWEL SIGN U..TELUGU VOWEL SIGN VOCALIC RR
+	{runeRange{0x0C85, 0x0C8C}, ScriptKannada},                 // Lo   [8] KANNADA LETTER A..KANNADA LETTER VOCALIC L
+	{runeRange{0x0CE0, 0x0CE1}, ScriptKannada},                 // Lo   [2] KANNADA LETTER VOCALIC RR..KANNADA LETTER VOCALIC LL
+	{runeRange{0x0D4D, 0x0D4D}, ScriptMalayalam},               // Mn       MALAYALAM SIGN VIRAMA
+	{runeRange{0x0DB3, 0x0DBB}, ScriptSinhala},                 // Lo   [9] SINHALA LETTER SANYAKA DAYANNA..SINHALA LETTER RAYANNA
+	{runeRange{0x0E40, 0x0E45}, ScriptThai},                    // Lo   [6] THAI CHARACTER SARA E..THAI CHARACTER LAKKHANGYAO
+	{runeRange{0x0EC0, 0x0EC4}, ScriptLao},                     // Lo   [5] LAO VOWEL SIGN E..LAO VOWEL SIGN AI
+	{runeRange{0x0F35, 0x0F35}, ScriptTibetan},                 // Mn       TIBETAN MARK NGAS BZUNG NYI ZLA
+	{runeRange{0x0F86, 0x0F87}, ScriptTibetan},                 // Mn   [2] TIBETAN SIGN LCI RTAGS..TIBETAN SIGN YANG RTAGS
+	{runeRange{0x1038, 0x1038}, ScriptMyanmar},                 // Mc       MYANMAR SIGN VISARGA
+	{runeRange{0x106E, 0x1070}, ScriptMyanmar},                 // Lo   [3] MYANMAR LETTER EASTERN PWO KAREN NNA..MYANMAR LETTER EASTERN PWO KAREN GHWA
+	{runeRange{0x10CD, 0x10CD}, ScriptGeorgian},                // Lu       GEORGIAN CAPITAL LETTER AEN
+	{runeRange{0x12C0, 0x12C0}, ScriptEthiopic},                // Lo       ETHIOPIC SYLLABLE KXWA
+	{runeRange{0x166E, 0x166E}, ScriptCanadianAboriginal},      // Po       CANADIAN SYLLABICS FULL STOP
+	{runeRange{0x1734, 0x1734}, ScriptHanunoo},                 // Mc       HANUNOO SIGN PAMUDPOD
+	{runeRange{0x17D7, 0x17D7}, ScriptKhmer},                   // Lm       KHMER SIGN LEK TOO
+	{runeRange{0x1810, 0x1819}, ScriptMongolian},               // Nd  [10] MONGOLIAN DIGIT ZERO..MONGOLIAN DIGIT NINE
+	{runeRange{0x1932, 0x1932}, ScriptLimbu},                   // Mn       LIMBU SMALL LETTER ANUSVARA
+	{runeRange{0x1A19, 0x1A1A}, ScriptBuginese},                // Mc   [2] BUGINESE VOWEL SIGN E..BUGINESE VOWEL SIGN O
+	{runeRange{0x1A80, 0x1A89}, ScriptTaiTham},                 // Nd  [10] TAI THAM HORA DIGIT ZERO..TAI THAM HORA DIGIT NINE
+	{runeRange{0x1B3C, 0x1B3C}, ScriptBalinese},                // Mn       BALINESE VOWEL SIGN LA LENGA
+	{runeRange{0x1BA2, 0x1BA5}, ScriptSundanese},               // Mn   [4] SUNDANESE CONSONANT SIGN PANYAKRA..SUNDANESE VOWEL SIGN PANYUKU
+	{runeRange{0x1BF2, 0x1BF3}, ScriptBatak},                   // Mc   [2] BATAK PANGOLAT..BATAK PANONGONAN
+	{runeRange{0x1C8A, 0x1C8A}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER TJE
+	{runeRange{0x1CFA, 0x1CFA}, ScriptCommon},                  // Lo       VEDIC SIGN DOUBLE ANUSVARA ANTARGOMUKHA
+	{runeRange{0x1E02, 0x1E02}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER B WITH DOT ABOVE
+	{runeRange{0x1E12, 0x1E12}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER D WITH CIRCUMFLEX BELOW
+	{runeRange{0x1E22, 0x1E22}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER H WITH DOT ABOVE
+	{runeRange{0x1E32, 0x1E32}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER K WITH DOT BELOW
+	{runeRange{0x1E42, 0x1E42}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER M WITH DOT BELOW
+	{runeRange{0x1E52, 0x1E52}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH MACRON AND ACUTE
+	{runeRange{0x1E62, 0x1E62}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER S WITH DOT BELOW
+	{runeRange{0x1E72, 0x1E72}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH DIAERESIS BELOW
+	{runeRange{0x1E82, 0x1E82}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER W WITH ACUTE
+	{runeRange{0x1E92, 0x1E92}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Z WITH DOT BELOW
+	{runeRange{0x1EAA, 0x1EAA}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND TILDE
+	{runeRange{0x1EBA, 0x1EBA}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH HOOK ABOVE
+	{runeRange{0x1ECA, 0x1ECA}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER I WITH DOT BELOW
+	{runeRange{0x1EDA, 0x1EDA}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH HORN AND ACUTE
+	{runeRange{0x1EEA, 0x1EEA}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH HORN AND GRAVE
+	{runeRange{0x1EFA, 0x1EFA}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER MIDDLE-WELSH LL
+	{runeRange{0x1F50, 0x1F57}, ScriptGreek},                   // Ll   [8] GREEK SMALL LETTER UPSILON WITH PSILI..GREEK SMALL LETTER UPSILON WITH DASIA AND PERISPOMENI
+	{runeRange{0x1FB8, 0x1FBB}, ScriptGreek},                   // Lu   [4] GREEK CAPITAL LETTER ALPHA WITH VRACHY..GREEK CAPITAL LETTER ALPHA WITH OXIA
+	{runeRange{0x1FED, 0x1FEF}, ScriptGreek},                   // Sk   [3] GREEK DIALYTIKA AND VARIA..GREEK VARIA
+	{runeRange{0x201D, 0x201D}, ScriptCommon},                  // Pf       RIGHT DOUBLE QUOTATION MARK
+	{runeRange{0x2046, 0x2046}, ScriptCommon},                  // Pe       RIGHT SQUARE BRACKET WITH QUILL
+	{runeRange{0x2080, 0x2089}, ScriptCommon},                  // No  [10] SUBSCRIPT ZERO..SUBSCRIPT NINE
+	{runeRange{0x210A, 0x210A}, ScriptCommon},                  // Ll       SCRIPT SMALL G
+	{runeRange{0x2129, 0x2129}, ScriptCommon},                  // So       TURNED GREEK SMALL LETTER IOTA
+	{runeRange{0x2146, 0x2149}, ScriptCommon},                  // Ll   [4] DOUBLE-STRUCK ITALIC SMALL D..DOUBLE-STRUCK ITALIC SMALL J
+	{runeRange{0x219C, 0x219F}, ScriptCommon},                  // So   [4] LEFTWARDS WAVE ARROW..UPWARDS TWO HEADED ARROW
+	{runeRange{0x2300, 0x2307}, ScriptCommon},                  // So   [8] DIAMETER SIGN..WAVY LINE
+	{runeRange{0x23E2, 0x2429}, ScriptCommon},                  // So  [72] WHITE TRAPEZIUM..SYMBOL FOR DELETE MEDIUM SHADE FORM
+	{runeRange{0x276A, 0x276A}, ScriptCommon},                  // Ps       MEDIUM FLATTENED LEFT PARENTHESIS ORNAMENT
+	{runeRange{0x27C6, 0x27C6}, ScriptCommon},                  // Pe       RIGHT S-SHAPED BAG DELIMITER
+	{runeRange{0x2984, 0x2984}, ScriptCommon},                  // Pe       RIGHT WHITE CURLY BRACKET
+	{runeRange{0x2994, 0x2994}, ScriptCommon},                  // Pe       RIGHT ARC GREATER-THAN BRACKET
+	{runeRange{0x2B45, 0x2B46}, ScriptCommon},                  // So   [2] LEFTWARDS QUADRUPLE ARROW..RIGHTWARDS QUADRUPLE ARROW
+	{runeRange{0x2C6D, 0x2C70}, ScriptLatin},                   // Lu   [4] LATIN CAPITAL LETTER ALPHA..LATIN CAPITAL LETTER TURNED ALPHA
+	{runeRange{0x2C88, 0x2C88}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER EIE
+	{runeRange{0x2C98, 0x2C98}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER MI
+	{runeRange{0x2CA8, 0x2CA8}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER UA
+	{runeRange{0x2CB8, 0x2CB8}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER DIALECT-P KAPA
+	{runeRange{0x2CC8, 0x2CC8}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER AKHMIMIC KHEI
+	{runeRange{0x2CD8, 0x2CD8}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER OLD COPTIC DJA
+	{runeRange{0x2CEE, 0x2CEE}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER CRYPTOGRAMMIC GANGIA
+	{runeRange{0x2DA8, 0x2DAE}, ScriptEthiopic},                // Lo   [7] ETHIOPIC SYLLABLE CCA..ETHIOPIC SYLLABLE CCO
+	{runeRange{0x2E0B, 0x2E0B}, ScriptCommon},                  // Po       RAISED SQUARE
+	{runeRange{0x2E25, 0x2E25}, ScriptCommon},                  // Pe       BOTTOM RIGHT HALF BRACKET
+	{runeRange{0x2E55, 0x2E55}, ScriptCommon},                  // Ps       LEFT SQUARE BRACKET WITH STROKE
+	{runeRange{0x3005, 0x3005}, ScriptHan},                     // Lm       IDEOGRAPHIC ITERATION MARK
+	{runeRange{0x3016, 0x3016}, ScriptCommon},                  // Ps       LEFT WHITE LENTICULAR BRACKET
+	{runeRange{0x3038, 0x303A}, ScriptHan},                     // Nl   [3] HANGZHOU NUMERAL TEN..HANGZHOU NUMERAL THIRTY
+	{runeRange{0x3105, 0x312F}, ScriptBopomofo},                // Lo  [43] BOPOMOFO LETTER B..BOPOMOFO LETTER NN
+	{runeRange{0x327F, 0x327F}, ScriptCommon},                  // So       KOREAN STANDARD SYMBOL
+	{runeRange{0xA4D0, 0xA4F7}, ScriptLisu},                    // Lo  [40] LISU LETTER BA..LISU LETTER OE
+	{runeRange{0xA647, 0xA647}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER IOTA
+	{runeRange{0xA657, 0xA657}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER IOTIFIED A
+	{runeRange{0xA667, 0xA667}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER SOFT EM
+	{runeRange{0xA682, 0xA682}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER DZWE
+	{runeRange{0xA692, 0xA692}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER TCHE
+	{runeRange{0xA700, 0xA716}, ScriptCommon},                  // Sk  [23] MODIFIER LETTER CHINESE TONE YIN PING..MODIFIER LETTER EXTRA-LOW LEFT-STEM TONE BAR
+	{runeRange{0xA72F, 0xA731}, ScriptLatin},                   // Ll   [3] LATIN SMALL LETTER CUATRILLO WITH COMMA..LATIN LETTER SMALL CAPITAL S
+	{runeRange{0xA741, 0xA741}, ScriptLatin},                   // Ll       LATIN SMALL LETTER K WITH STROKE
+	{runeRange{0xA751, 0xA751}, ScriptLatin},                   // Ll       LATIN SMALL LETTER P WITH STROKE THROUGH DESCENDER
+	{runeRange{0xA761, 0xA761}, ScriptLatin},                   // Ll       LATIN SMALL LETTER VY
+	{runeRange{0xA771, 0xA778}, ScriptLatin},                   // Ll   [8] LATIN SMALL LETTER DUM..LATIN SMALL LETTER UM
+	{runeRange{0xA789, 0xA78A}, ScriptCommon},                  // Sk   [2] MODIFIER LETTER COLON..MODIFIER LETTER SHORT EQUALS SIGN
+	{runeRange{0xA79C, 0xA79C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER VOLAPUK OE
+	{runeRange{0xA7B0, 0xA7B4}, ScriptLatin},                   // Lu   [5] LATIN CAPITAL LETTER TURNED K..LATIN CAPITAL LETTER BETA
+	{runeRange{0xA7C4, 0xA7C7}, ScriptLatin},                   // Lu   [4] LATIN CAPITAL LETTER C WITH PALATAL HOOK..LATIN CAPITAL LETTER D WITH SHORT STROKE OVERLAY
+	{runeRange{0xA7D8, 0xA7D8}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER SIGMOID S
+	{runeRange{0xA807, 0xA80A}, ScriptSylotiNagri},             // Lo   [4] SYLOTI NAGRI LETTER KO..SYLOTI NAGRI LETTER GHO
+	{runeRange{0xA8B4, 0xA8C3}, ScriptSaurashtra},              // Mc  [16] SAURASHTRA CONSONANT SIGN HAARU..SAURASHTRA VOWEL SIGN AU
+	{runeRange{0xA930, 0xA946}, ScriptRejang},                  // Lo  [23] REJANG LETTER KA..REJANG LETTER A
+	{runeRange{0xA9D0, 0xA9D9}, ScriptJavanese},                // Nd  [10] JAVANESE DIGIT ZERO..JAVANESE DIGIT NINE
+	{runeRange{0xAA44, 0xAA4B}, ScriptCham},                    // Lo   [8] CHAM LETTER FINAL CH..CHAM LETTER FINAL SS
+	{runeRange{0xAAB1, 0xAAB1}, ScriptTaiViet},                 // Lo       TAI VIET VOWEL AA
+	{runeRange{0xAAF0, 0xAAF1}, ScriptMeeteiMayek},             // Po   [2] MEETEI MAYEK CHEIKHAN..MEETEI MAYEK AHANG KHUDAM
+	{runeRange{0xAB69, 0xAB69}, ScriptLatin},                   // Lm       MODIFIER LETTER SMALL TURNED W
+	{runeRange{0xF900, 0xFA6D}, ScriptHan},                     // Lo [366] CJK COMPATIBILITY IDEOGRAPH-F900..CJK COMPATIBILITY IDEOGRAPH-FA6D
+	{runeRange{0xFBC3, 0xFBD2}, ScriptArabic},                  // So  [16] ARABIC LIGATURE JALLA WA-ALAA..ARABIC LIGATURE ALAYHI AR-RAHMAH
+	{runeRange{0xFE19, 0xFE19}, ScriptCommon},                  // Po       PRESENTATION FORM FOR VERTICAL HORIZONTAL ELLIPSIS
+	{runeRange{0xFE3F, 0xFE3F}, ScriptCommon},                  // Ps       PRESENTATION FORM FOR VERTICAL LEFT ANGLE BRACKET
+	{runeRange{0xFE5B, 0xFE5B}, ScriptCommon},                  // Ps       SMALL LEFT CURLY BRACKET
+	{runeRange{0xFF05, 0xFF07}, ScriptCommon},                  // Po   [3] FULLWIDTH PERCENT SIGN..FULLWIDTH APOSTROPHE
+	{runeRange{0xFF3E, 0xFF3E}, ScriptCommon},                  // Sk       FULLWIDTH CIRCUMFLEX ACCENT
+	{runeRange{0xFF71, 0xFF9D}, ScriptKatakana},                // Lo  [45] HALFWIDTH KATAKANA LETTER A..HALFWIDTH KATAKANA LETTER N
+	{runeRange{0xFFFC, 0xFFFD}, ScriptCommon},                  // So   [2] OBJECT REPLACEMENT CHARACTER..REPLACEMENT CHARACTER
+	{runeRange{0x10190, 0x1019C}, ScriptCommon},                // So  [13] ROMAN SEXTANS SIGN..ASCIA SYMBOL
+	{runeRange{0x10376, 0x1037A}, ScriptOldPermic},             // Mn   [5] COMBINING OLD PERMIC LETTER AN..COMBINING OLD PERMIC LETTER SII
+	{runeRange{0x1056F, 0x1056F}, ScriptCaucasianAlbanian},     // Po       CAUCASIAN ALBANIAN CITATION MARK
+	{runeRange{0x10800, 0x10805}, ScriptCypriot},               // Lo   [6] CYPRIOT SYLLABLE A..CYPRIOT SYLLABLE JA
+	{runeRange{0x108FB, 0x108FF}, ScriptHatran},                // No   [5] HATRAN NUMBER ONE..HATRAN NUMBER ONE HUNDRED
+	{runeRange{0x10A0C, 0x10A0F}, ScriptKharoshthi},            // Mn   [4] KHAROSHTHI VOWEL LENGTH MARK..KHAROSHTHI SIGN VISARGA
+	{runeRange{0x10AE5, 0x10AE6}, ScriptManichaean},            // Mn   [2] MANICHAEAN ABBREVIATION MARK ABOVE..MANICHAEAN ABBREVIATION MARK BELOW
+	{runeRange{0x10D00, 0x10D23}, ScriptHanifiRohingya},        // Lo  [36] HANIFI ROHINGYA LETTER A..HANIFI ROHINGYA MARK NA KHONNA
+	{runeRange{0x10EAD, 0x10EAD}, ScriptYezidi},                // Pd       YEZIDI HYPHENATION MARK
+	{runeRange{0x10F82, 0x10F85}, ScriptOldUyghur},             // Mn   [4] OLD UYGHUR COMBINING DOT ABOVE..OLD UYGHUR COMBINING TWO DOTS BELOW
+	{runeRange{0x11075, 0x11075}, ScriptBrahmi},                // Lo       BRAHMI LETTER OLD TAMIL LLA
+	{runeRange{0x11100, 0x11102}, ScriptChakma},                // Mn   [3] CHAKMA SIGN CANDRABINDU..CHAKMA SIGN VISARGA
+	{runeRange{0x11183, 0x111B2}, ScriptSharada},               // Lo  [48] SHARADA LETTER A..SHARADA LETTER HA
+	{runeRange{0x11200, 0x11211}, ScriptKhojki},                // Lo  [18] KHOJKI LETTER A..KHOJKI LETTER JJA
+	{runeRange{0x11241, 0x11241}, ScriptKhojki},                // Mn       KHOJKI VOWEL SIGN VOCALIC R
+	{runeRange{0x112DF, 0x112DF}, ScriptKhudawadi},             // Mn       KHUDAWADI SIGN ANUSVARA
+	{runeRange{0x11313, 0x11328}, ScriptGrantha},               // Lo  [22] GRANTHA LETTER OO..GRANTHA LETTER NA
+	{runeRange{0x11340, 0x11340}, ScriptGrantha},               // Mn       GRANTHA VOWEL SIGN II
+	{runeRange{0x11366, 0x1136C}, ScriptGrantha},               // Mn   [7] COMBINING GRANTHA DIGIT ZERO..COMBINING GRANTHA DIGIT SIX
+	{runeRange{0x113BB, 0x113C0}, ScriptTuluTigalari},          // Mn   [6] TULU-TIGALARI VOWEL SIGN U..TULU-TIGALARI VOWEL SIGN VOCALIC LL
+	{runeRange{0x113D1, 0x113D1}, ScriptTuluTigalari},          // Lo       TULU-TIGALARI REPHA
+	{runeRange{0x11438, 0x1143F}, ScriptNewa},                  // Mn   [8] NEWA VOWEL SIGN U..NEWA VOWEL SIGN AI
+	{runeRange{0x1145A, 0x1145B}, ScriptNewa},                  // Po   [2] NEWA DOUBLE COMMA..NEWA PLACEHOLDER MARK
+	{runeRange{0x114BA, 0x114BA}, ScriptTirhuta},               // Mn       TIRHUTA VOWEL SIGN SHORT E
+	{runeRange{0x114D0, 0x114D9}, ScriptTirhuta},               // Nd  [10] TIRHUTA DIGIT ZERO..TIRHUTA DIGIT NINE
+	{runeRange{0x115C1, 0x115D7}, ScriptSiddham},               // Po  [23] SIDDHAM SIGN SIDDHAM..SIDDHAM SECTION MARK WITH CIRCLES AND FOUR ENCLOSURES
+	{runeRange{0x1163E, 0x1163E}, ScriptModi},                  // Mc       MODI SIGN VISARGA
+	{runeRange{0x116AC, 0x116AC}, ScriptTakri},                 // Mc       TAKRI SIGN VISARGA
+	{runeRange{0x116C0, 0x116C9}, ScriptTakri},                 // Nd  [10] TAKRI DIGIT ZERO..TAKRI DIGIT NINE
+	{runeRange{0x11726, 0x11726}, ScriptAhom},                  // Mc       AHOM VOWEL SIGN E
+	{runeRange{0x1182C, 0x1182E}, ScriptDogra},                 // Mc   [3] DOGRA VOWEL SIGN AA..DOGRA VOWEL SIGN II
+	{runeRange{0x118EA, 0x118F2}, ScriptWarangCiti},            // No   [9] WARANG CITI NUMBER TEN..WARANG CITI NUMBER NINETY
+	{runeRange{0x11937, 0x11938}, ScriptDivesAkuru},            // Mc   [2] DIVES AKURU VOWEL SIGN AI..DIVES AKURU VOWEL SIGN O
+	{runeRange{0x11943, 0x11943}, ScriptDivesAkuru},            // Mn       DIVES AKURU SIGN NUKTA
+	{runeRange{0x119DC, 0x119DF}, ScriptNandinagari},           // Mc   [4] NANDINAGARI VOWEL SIGN O..NANDINAGARI SIGN VISARGA
+	{runeRange{0x11A0B, 0x11A32}, ScriptZanabazarSquare},       // Lo  [40] ZANABAZAR SQUARE LETTER KA..ZANABAZAR SQUARE LETTER KSSA
+	{runeRange{0x11A51, 0x11A56}, ScriptSoyombo},               // Mn   [6] SOYOMBO VOWEL SIGN I..SOYOMBO VOWEL SIGN OE
+	{runeRange{0x11A9D, 0x11A9D}, ScriptSoyombo},               // Lo       SOYOMBO MARK PLUTA
+	{runeRange{0x11B65, 0x11B65}, ScriptSharada},               // Mc       SHARADA VOWEL SIGN SHORT O
+	{runeRange{0x11C2F, 0x11C2F}, ScriptBhaiksuki},             // Mc       BHAIKSUKI VOWEL SIGN AA
+	{runeRange{0x11C5A, 0x11C6C}, ScriptBhaiksuki},             // No  [19] BHAIKSUKI NUMBER ONE..BHAIKSUKI HUNDREDS UNIT MARK
+	{runeRange{0x11CB4, 0x11CB4}, ScriptMarchen},               // Mc       MARCHEN VOWEL SIGN O
+	{runeRange{0x11D3F, 0x11D45}, ScriptMasaramGondi},          // Mn   [7] MASARAM GONDI VOWEL SIGN AU..MASARAM GONDI VIRAMA
+	{runeRange{0x11D90, 0x11D91}, ScriptGunjalaGondi},          // Mn   [2] GUNJALA GONDI VOWEL SIGN EE..GUNJALA GONDI VOWEL SIGN AI
+	{runeRange{0x11DD9, 0x11DD9}, ScriptTolongSiki},            // Lm       TOLONG SIKI SIGN SELA
+	{runeRange{0x11F02, 0x11F02}, ScriptKawi},                  // Lo       KAWI SIGN REPHA
+	{runeRange{0x11F41, 0x11F41}, ScriptKawi},                  // Mc       KAWI SIGN KILLER
+	{runeRange{0x11FDD, 0x11FE0}, ScriptTamil},                 // Sc   [4] TAMIL SIGN KAACU..TAMIL SIGN VARAAKAN
+	{runeRange{0x12FF1, 0x12FF2}, ScriptCyproMinoan},           // Po   [2] CYPRO-MINOAN SIGN CM301..CYPRO-MINOAN SIGN CM302
+	{runeRange{0x16100, 0x1611D}, ScriptGurungKhema},           // Lo  [30] GURUNG KHEMA LETTER A..GURUNG KHEMA LETTER SA
+	{runeRange{0x16A6E, 0x16A6F}, ScriptMro},                   // Po   [2] MRO DANDA..MRO DOUBLE DANDA
+	{runeRange{0x16B37, 0x16B3B}, ScriptPahawhHmong},           // Po   [5] PAHAWH HMONG SIGN VOS THOM..PAHAWH HMONG SIGN VOS FEEM
+	{runeRange{0x16B7D, 0x16B8F}, ScriptPahawhHmong},           // Lo  [19] PAHAWH HMONG CLAN SIGN TSHEEJ..PAHAWH HMONG CLAN SIGN VWJ
+	{runeRange{0x16E80, 0x16E96}, ScriptMedefaidrin},           // No  [23] MEDEFAIDRIN DIGIT ZERO..MEDEFAIDRIN DIGIT THREE ALTERNATE FORM
+	{runeRange{0x16F8F, 0x16F92}, ScriptMiao},                  // Mn   [4] MIAO TONE RIGHT..MIAO TONE BELOW
+	{runeRange{0x16FF2, 0x16FF3}, ScriptHan},                   // Lm   [2] CHINESE SMALL SIMPLIFIED ER..CHINESE SMALL TRADITIONAL ER
+	{runeRange{0x1AFF5, 0x1AFFB}, ScriptKatakana},              // Lm   [7] KATAKANA LETTER MINNAN TONE-7..KATAKANA LETTER MINNAN NASALIZED TONE-5
+	{runeRange{0x1B164, 0x1B167}, ScriptKatakana},              // Lo   [4] KATAKANA LETTER SMALL WI..KATAKANA LETTER SMALL N
+	{runeRange{0x1BC9F, 0x1BC9F}, ScriptDuployan},              // Po       DUPLOYAN PUNCTUATION CHINOOK FULL STOP
+	{runeRange{0x1CEF0, 0x1CEF0}, ScriptCommon},                // Sm       MEDIUM SMALL WHITE CIRCLE WITH HORIZONTAL BAR
+	{runeRange{0x1D167, 0x1D169}, ScriptInherited},             // Mn   [3] MUSICAL SYMBOL COMBINING TREMOLO-1..MUSICAL SYMBOL COMBINING TREMOLO-3
+	{runeRange{0x1D1AA, 0x1D1AD}, ScriptInherited},             // Mn   [4] MUSICAL SYMBOL COMBINING DOWN BOW..MUSICAL SYMBOL COMBINING SNAP PIZZICATO
+	{runeRange{0x1D360, 0x1D378}, ScriptCommon},                // No  [25] COUNTING ROD UNIT DIGIT ONE..TALLY MARK FIVE
+	{runeRange{0x1D49C, 0x1D49C}, ScriptCommon},                // Lu       MATHEMATICAL SCRIPT CAPITAL A
+	{runeRange{0x1D4BD, 0x1D4C3}, ScriptCommon},                // Ll   [7] MATHEMATICAL SCRIPT SMALL H..MATHEMATICAL SCRIPT SMALL N
+	{runeRange{0x1D51E, 0x1D537}, ScriptCommon},                // Ll  [26] MATHEMATICAL FRAKTUR SMALL A..MATHEMATICAL FRAKTUR SMALL Z
+	{runeRange{0x1D586, 0x1D59F}, ScriptCommon},                // Ll  [26] MATHEMATICAL BOLD FRAKTUR SMALL A..MATHEMATICAL BOLD FRAKTUR SMALL Z
+	{runeRange{0x1D656, 0x1D66F}, ScriptCommon},                // Ll  [26] MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL A..MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL Z
+	{runeRange{0x1D6E2, 0x1D6FA}, ScriptCommon},                // Lu  [25] MATHEMATICAL ITALIC CAPITAL ALPHA..MATHEMATICAL ITALIC CAPITAL OMEGA
+	{runeRange{0x1D74F, 0x1D74F}, ScriptCommon},                // Sm       MATHEMATICAL BOLD ITALIC PARTIAL DIFFERENTIAL
+	{runeRange{0x1D7A9, 0x1D7A9}, ScriptCommon},                // Sm       MATHEMATICAL SANS-SERIF BOLD ITALIC NABLA
+	{runeRange{0x1DA00, 0x1DA36}, ScriptSignWriting},           // Mn  [55] SIGNWRITING HEAD RIM..SIGNWRITING AIR SUCKING IN
+	{runeRange{0x1DA87, 0x1DA8B}, ScriptSignWriting},           // Po   [5] SIGNWRITING COMMA..SIGNWRITING PARENTHESIS
+	{runeRange{0x1E008, 0x1E018}, ScriptGlagolitic},            // Mn  [17] COMBINING GLAGOLITIC LETTER ZEMLJA..COMBINING GLAGOLITIC LETTER HERU
+	{runeRange{0x1E137, 0x1E13D}, ScriptNyiakengPuachueHmong},  // Lm   [7] NYIAKENG PUACHUE HMONG SIGN FOR PERSON..NYIAKENG PUACHUE HMONG SYLLABLE LENGTHENER
+	{runeRange{0x1E2F0, 0x1E2F9}, ScriptWancho},                // Nd  [10] WANCHO DIGIT ZERO..WANCHO DIGIT NINE
+	{runeRange{0x1E5F0, 0x1E5F0}, ScriptOlOnal},                // Lo       OL ONAL SIGN HODDOND
+	{runeRange{0x1E6E7, 0x1E6ED}, ScriptTaiYo},                 // Lo   [7] TAI YO LETTER O..TAI YO LETTER AUE
+	{runeRange{0x1E7ED, 0x1E7EE}, ScriptEthiopic},              // Lo   [2] ETHIOPIC SYLLABLE GURAGE MWI..ETHIOPIC SYLLABLE GURAGE MWEE
+	{runeRange{0x1E94B, 0x1E94B}, ScriptAdlam},                 // Lm       ADLAM NASALIZATION MARK
+	{runeRange{0x1ED01, 0x1ED2D}, ScriptCommon},                // No  [45] OTTOMAN SIYAQ NUMBER ONE..OTTOMAN SIYAQ NUMBER NINETY THOUSAND
+	{runeRange{0x1EE29, 0x1EE32}, ScriptArabic},                // Lo  [10] ARABIC MATHEMATICAL INITIAL YEH..ARABIC MATHEMATICAL INITIAL QAF
+	{runeRange{0x1EE4D, 0x1EE4F}, ScriptArabic},                // Lo   [3] ARABIC MATHEMATICAL TAILED NOON..ARABIC MATHEMATICAL TAILED AIN
+	{runeRange{0x1EE61, 0x1EE62}, ScriptArabic},                // Lo   [2] ARABIC MATHEMATICAL STRETCHED BEH..ARABIC MATHEMATICAL STRETCHED JEEM
+	{runeRange{0x1EE8B, 0x1EE9B}, ScriptArabic},                // Lo  [17] ARABIC MATHEMATICAL LOOPED LAM..ARABIC MATHEMATICAL LOOPED GHAIN
+	{runeRange{0x1F0B1, 0x1F0BF}, ScriptCommon},                // So  [15] PLAYING CARD ACE OF HEARTS..PLAYING CARD RED JOKER
+	{runeRange{0x1F210, 0x1F23B}, ScriptCommon},                // So  [44] SQUARED CJK UNIFIED IDEOGRAPH-624B..SQUARED CJK UNIFIED IDEOGRAPH-914D
+	{runeRange{0x1F6F0, 0x1F6FC}, ScriptCommon},                // So  [13] SATELLITE..ROLLER SKATE
+	{runeRange{0x1F890, 0x1F8AD}, ScriptCommon},                // So  [30] LEFTWARDS TRIANGLE ARROWHEAD..WHITE ARROW SHAFT WIDTH TWO THIRDS
+	{runeRange{0x1FA8E, 0x1FAC6}, ScriptCommon},                // So  [57] TREASURE CHEST..FINGERPRINT
+	{runeRange{0x1FBFA, 0x1FBFA}, ScriptCommon},                // So       ALARM BELL SYMBOL
+	{runeRange{0x31350, 0x33479}, ScriptHan},                   // Lo [8490] CJK UNIFIED IDEOGRAPH-31350..CJK UNIFIED IDEOGRAPH-33479
+	{runeRange{0x0024, 0x0024}, ScriptCommon},                  // Sc       DOLLAR SIGN
+	{runeRange{0x002E, 0x002F}, ScriptCommon},                  // Po   [2] FULL STOP..SOLIDUS
+	{runeRange{0x005D, 0x005D}, ScriptCommon},                  // Pe       RIGHT SQUARE BRACKET
+	{runeRange{0x007E, 0x007E}, ScriptCommon},                  // Sm       TILDE
+	{runeRange{0x00A9, 0x00A9}, ScriptCommon},                  // So       COPYRIGHT SIGN
+	{runeRange{0x00B1, 0x00B1}, ScriptCommon},                  // Sm       PLUS-MINUS SIGN
+	{runeRange{0x00BB, 0x00BB}, ScriptCommon},                  // Pf       RIGHT-POINTING DOUBLE ANGLE QUOTATION MARK
+	{runeRange{0x00F8, 0x00FF}, ScriptLatin},                   // Ll   [8] LATIN SMALL LETTER O WITH STROKE..LATIN SMALL LETTER Y WITH DIAERESIS
+	{runeRange{0x0107, 0x0107}, ScriptLatin},                   // Ll       LATIN SMALL LETTER C WITH ACUTE
+	{runeRange{0x010F, 0x010F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER D WITH CARON
+	{runeRange{0x0117, 0x0117}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH DOT ABOVE
+	{runeRange{0x011F, 0x011F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER G WITH BREVE
+	{runeRange{0x0127, 0x0127}, ScriptLatin},                   // Ll       LATIN SMALL LETTER H WITH STROKE
+	{runeRange{0x012F, 0x012F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER I WITH OGONEK
+	{runeRange{0x0137, 0x0138}, ScriptLatin},                   // Ll   [2] LATIN SMALL LETTER K WITH CEDILLA..LATIN SMALL LETTER KRA
+	{runeRange{0x0140, 0x0140}, ScriptLatin},                   // Ll       LATIN SMALL LETTER L WITH MIDDLE DOT
+	{runeRange{0x0148, 0x0149}, ScriptLatin},                   // Ll   [2] LATIN SMALL LETTER N WITH CARON..LATIN SMALL LETTER N PRECEDED BY APOSTROPHE
+	{runeRange{0x0151, 0x0151}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH DOUBLE ACUTE
+	{runeRange{0x0159, 0x0159}, ScriptLatin},                   // Ll       LATIN SMALL LETTER R WITH CARON
+	{runeRange{0x0161, 0x0161}, ScriptLatin},                   // Ll       LATIN SMALL LETTER S WITH CARON
+	{runeRange{0x0169, 0x0169}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH TILDE
+	{runeRange{0x0171, 0x0171}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH DOUBLE ACUTE
+	{runeRange{0x017A, 0x017A}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Z WITH ACUTE
+	{runeRange{0x0185, 0x0185}, ScriptLatin},                   // Ll       LATIN SMALL LETTER TONE SIX
+	{runeRange{0x0195, 0x0195}, ScriptLatin},                   // Ll       LATIN SMALL LETTER HV
+	{runeRange{0x01A3, 0x01A3}, ScriptLatin},                   // Ll       LATIN SMALL LETTER OI
+	{runeRange{0x01AD, 0x01AD}, ScriptLatin},                   // Ll       LATIN SMALL LETTER T WITH HOOK
+	{runeRange{0x01B9, 0x01BA}, ScriptLatin},                   // Ll   [2] LATIN SMALL LETTER EZH REVERSED..LATIN SMALL LETTER EZH WITH TAIL
+	{runeRange{0x01C7, 0x01C7}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER LJ
+	{runeRange{0x01CF, 0x01CF}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER I WITH CARON
+	{runeRange{0x01D7, 0x01D7}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH DIAERESIS AND ACUTE
+	{runeRange{0x01E0, 0x01E0}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH DOT ABOVE AND MACRON
+	{runeRange{0x01E8, 0x01E8}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER K WITH CARON
+	{runeRange{0x01F1, 0x01F1}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER DZ
+	{runeRange{0x01FB, 0x01FB}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH RING ABOVE AND ACUTE
+	{runeRange{0x0203, 0x0203}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH INVERTED BREVE
+	{runeRange{0x020B, 0x020B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER I WITH INVERTED BREVE
+	{runeRange{0x0213, 0x0213}, ScriptLatin},                   // Ll       LATIN SMALL LETTER R WITH INVERTED BREVE
+	{runeRange{0x021B, 0x021B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER T WITH COMMA BELOW
+	{runeRange{0x0223, 0x0223}, ScriptLatin},                   // Ll       LATIN SMALL LETTER OU
+	{runeRange{0x022B, 0x022B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH DIAERESIS AND MACRON
+	{runeRange{0x0233, 0x0239}, ScriptLatin},                   // Ll   [7] LATIN SMALL LETTER Y WITH MACRON..LATIN SMALL LETTER QP DIGRAPH
+	{runeRange{0x0247, 0x0247}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH STROKE
+	{runeRange{0x024F, 0x0293}, ScriptLatin},                   // Ll  [69] LATIN SMALL LETTER Y WITH STROKE..LATIN SMALL LETTER EZH WITH CURL
+	{runeRange{0x02E0, 0x02E4}, ScriptLatin},                   // Lm   [5] MODIFIER LETTER SMALL GAMMA..MODIFIER LETTER SMALL REVERSED GLOTTAL STOP
+	{runeRange{0x0370, 0x0370}, ScriptGreek},                   // Lu       GREEK CAPITAL LETTER HETA
+	{runeRange{0x037A, 0x037A}, ScriptGreek},                   // Lm       GREEK YPOGEGRAMMENI
+	{runeRange{0x0388, 0x038A}, ScriptGreek},                   // Lu   [3] GREEK CAPITAL LETTER EPSILON WITH TONOS..GREEK CAPITAL LETTER IOTA WITH TONOS
+	{runeRange{0x03D0, 0x03D1}, ScriptGreek},                   // Ll   [2] GREEK BETA SYMBOL..GREEK THETA SYMBOL
+	{runeRange{0x03DD, 0x03DD}, ScriptGreek},                   // Ll       GREEK SMALL LETTER DIGAMMA
+	{runeRange{0x03E5, 0x03E5}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER FEI
+	{runeRange{0x03ED, 0x03ED}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER SHIMA
+	{runeRange{0x03F8, 0x03F8}, ScriptGreek},                   // Ll       GREEK SMALL LETTER SHO
+	{runeRange{0x0462, 0x0462}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER YAT
+	{runeRange{0x046A, 0x046A}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER BIG YUS
+	{runeRange{0x0472, 0x0472}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER FITA
+	{runeRange{0x047A, 0x047A}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER ROUND OMEGA
+	{runeRange{0x0482, 0x0482}, ScriptCyrillic},                // So       CYRILLIC THOUSANDS SIGN
+	{runeRange{0x048D, 0x048D}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER SEMISOFT SIGN
+	{runeRange{0x0495, 0x0495}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER GHE WITH MIDDLE HOOK
+	{runeRange{0x049D, 0x049D}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER KA WITH VERTICAL STROKE
+	{runeRange{0x04A5, 0x04A5}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LIGATURE EN GHE
+	{runeRange{0x04AD, 0x04AD}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER TE WITH DESCENDER
+	{runeRange{0x04B5, 0x04B5}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LIGATURE TE TSE
+	{runeRange{0x04BD, 0x04BD}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER ABKHASIAN CHE
+	{runeRange{0x04C6, 0x04C6}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER EL WITH TAIL
+	{runeRange{0x04CE, 0x04CF}, ScriptCyrillic},                // Ll   [2] CYRILLIC SMALL LETTER EM WITH TAIL..CYRILLIC SMALL LETTER PALOCHKA
+	{runeRange{0x04D7, 0x04D7}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER IE WITH BREVE
+	{runeRange{0x04DF, 0x04DF}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER ZE WITH DIAERESIS
+	{runeRange{0x04E7, 0x04E7}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER O WITH DIAERESIS
+	{runeRange{0x04EF, 0x04EF}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER U WITH MACRON
+	{runeRange{0x04F7, 0x04F7}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER GHE WITH DESCENDER
+	{runeRange{0x04FF, 0x04FF}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER HA WITH STROKE
+	{runeRange{0x0507, 0x0507}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER KOMI DZJE
+	{runeRange{0x050F, 0x050F}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER KOMI TJE
+	{runeRange{0x0517, 0x0517}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER RHA
+	{runeRange{0x051F, 0x051F}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER ALEUT KA
+	{runeRange{0x0527, 0x0527}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER SHHA WITH DESCENDER
+	{runeRange{0x052F, 0x052F}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER EL WITH DESCENDER
+	{runeRange{0x058F, 0x058F}, ScriptArmenian},                // Sc       ARMENIAN DRAM SIGN
+	{runeRange{0x05C6, 0x05C6}, ScriptHebrew},                  // Po       HEBREW PUNCTUATION NUN HAFUKHA
+	{runeRange{0x0609, 0x060A}, ScriptArabic},                  // Po   [2] ARABIC-INDIC PER MILLE SIGN..ARABIC-INDIC PER TEN THOUSAND SIGN
+	{runeRange{0x061D, 0x061E}, ScriptArabic},                  // Po   [2] ARABIC END OF TEXT MARK..ARABIC TRIPLE DOT PUNCTUATION MARK
+	{runeRange{0x066A, 0x066D}, ScriptArabic},                  // Po   [4] ARABIC PERCENT SIGN..ARABIC FIVE POINTED STAR
+	{runeRange{0x06DE, 0x06DE}, ScriptArabic},                  // So       ARABIC START OF RUB EL HIZB
+	{runeRange{0x06FA, 0x06FC}, ScriptArabic},                  // Lo   [3] ARABIC LETTER SHEEN WITH DOT BELOW..ARABIC LETTER GHAIN WITH DOT BELOW
+	{runeRange{0x0730, 0x074A}, ScriptSyriac},                  // Mn  [27] SYRIAC PTHAHA ABOVE..SYRIAC BARREKH
+	{runeRange{0x07EB, 0x07F3}, ScriptNko},                     // Mn   [9] NKO COMBINING SHORT HIGH TONE..NKO COMBINING DOUBLE DOT ABOVE
+	{runeRange{0x0816, 0x0819}, ScriptSamaritan},               // Mn   [4] SAMARITAN MARK IN..SAMARITAN MARK DAGESH
+	{runeRange{0x0840, 0x0858}, ScriptMandaic},                 // Lo  [25] MANDAIC LETTER HALQA..MANDAIC LETTER AIN
+	{runeRange{0x0897, 0x089F}, ScriptArabic},                  // Mn   [9] ARABIC PEPET..ARABIC HALF MADDA OVER MADDA
+	{runeRange{0x0904, 0x0939}, ScriptDevanagari},              // Lo  [54] DEVANAGARI LETTER SHORT A..DEVANAGARI LETTER HA
+	{runeRange{0x094D, 0x094D}, ScriptDevanagari},              // Mn       DEVANAGARI SIGN VIRAMA
+	{runeRange{0x0966, 0x096F}, ScriptDevanagari},              // Nd  [10] DEVANAGARI DIGIT ZERO..DEVANAGARI DIGIT NINE
+	{runeRange{0x098F, 0x0990}, ScriptBengali},                 // Lo   [2] BENGALI LETTER E..BENGALI LETTER AI
+	{runeRange{0x09C1, 0x09C4}, ScriptBengali},                 // Mn   [4] BENGALI VOWEL SIGN U..BENGALI VOWEL SIGN VOCALIC RR
+	{runeRange{0x09E2, 0x09E3}, ScriptBengali},                 // Mn   [2] BENGALI VOWEL SIGN VOCALIC L..BENGALI VOWEL SIGN VOCALIC LL
+	{runeRange{0x09FD, 0x09FD}, ScriptBengali},                 // Po       BENGALI ABBREVIATION SIGN
+	{runeRange{0x0A32, 0x0A33}, ScriptGurmukhi},                // Lo   [2] GURMUKHI LETTER LA..GURMUKHI LETTER LLA
+	{runeRange{0x0A51, 0x0A51}, ScriptGurmukhi},                // Mn       GURMUKHI SIGN UDAAT
+	{runeRange{0x0A81, 0x0A82}, ScriptGujarati},                // Mn   [2] GUJARATI SIGN CANDRABINDU..GUJARATI SIGN ANUSVARA
+	{runeRange{0x0ABC, 0x0ABC}, ScriptGujarati},                // Mn       GUJARATI SIGN NUKTA
+	{runeRange{0x0AD0, 0x0AD0}, ScriptGujarati},                // Lo       GUJARATI OM
+	{runeRange{0x0B01, 0x0B01}, ScriptOriya},                   // Mn       ORIYA SIGN CANDRABINDU
+	{runeRange{0x0B3C, 0x0B3C}, ScriptOriya},                   // Mn       ORIYA SIGN NUKTA
+	{runeRange{0x0B4D, 0x0B4D}, ScriptOriya},                   // Mn       ORIYA SIGN VIRAMA
+	{runeRange{0x0B71, 0x0B71}, ScriptOriya},                   // Lo       ORIYA LETTER WA
+	{runeRange{0x0B9C, 0x0B9C}, ScriptTamil},                   // Lo       TAMIL LETTER JA
+	{runeRange{0x0BC6, 0x0BC8}, ScriptTamil},                   // Mc   [3] TAMIL VOWEL SIGN E..TAMIL VOWEL SIGN AI
+	{runeRange{0x0BF9, 0x0BF9}, ScriptTamil},                   // Sc       TAMIL RUPEE SIGN
+	{runeRange{0x0C2A, 0x0C39}, ScriptTelugu},                  // Lo  [16] TELUGU LETTER PA..TELUGU LETTER HA
+	{runeRange{0x0C58, 0x0C5A}, ScriptTelugu},                  // Lo   [3] TELUGU LETTER TSA..TELUGU LETTER RRRA
+	{runeRange{0x0C80, 0x0C80}, ScriptKannada},                 // Lo       KANNADA SIGN SPACING CANDRABINDU
+	{runeRange{0x0CB5, 0x0CB9}, ScriptKannada},                 // Lo   [5] KANNADA LETTER VA..KANNADA LETTER HA
+	{runeRange{0x0CCA, 0x0CCB}, ScriptKannada},                 // Mc   [2] KANNADA VOWEL SIGN O..KANNADA VOWEL SIGN OO
+	{runeRange{0x0CF3, 0x0CF3}, ScriptKannada},                 // Mc       KANNADA SIGN COMBINING ANUSVARA ABOVE RIGHT
+	{runeRange{0x0D3E, 0x0D40}, ScriptMalayalam},               // Mc   [3] MALAYALAM VOWEL SIGN AA..MALAYALAM VOWEL SIGN II
+	{runeRange{0x0D57, 0x0D57}, ScriptMalayalam},               // Mc       MALAYALAM AU LENGTH MARK
+	{runeRange{0x0D81, 0x0D81}, ScriptSinhala},                 // Mn       SINHALA SIGN CANDRABINDU
+	{runeRange{0x0DCF, 0x0DD1}, ScriptSinhala},                 // Mc   [3] SINHALA VOWEL SIGN AELA-PILLA..SINHALA VOWEL SIGN DIGA AEDA-PILLA
+	{runeRange{0x0E31, 0x0E31}, ScriptThai},                    // Mn       THAI CHARACTER MAI HAN-AKAT
+	{runeRange{0x0E50, 0x0E59}, ScriptThai},                    // Nd  [10] THAI DIGIT ZERO..THAI DIGIT NINE
+	{runeRange{0x0EB1, 0x0EB1}, ScriptLao},                     // Mn       LAO VOWEL SIGN MAI KAN
+	{runeRange{0x0EDC, 0x0EDF}, ScriptLao},                     // Lo   [4] LAO HO NO..LAO LETTER KHMU NYO
+	{runeRange{0x0F1A, 0x0F1F}, ScriptTibetan},                 // So   [6] TIBETAN SIGN RDEL DKAR GCIG..TIBETAN SIGN RDEL DKAR RDEL NAG
+	{runeRange{0x0F39, 0x0F39}, ScriptTibetan},                 // Mn       TIBETAN MARK TSA -PHRU
+	{runeRange{0x0F71, 0x0F7E}, ScriptTibetan},                 // Mn  [14] TIBETAN VOWEL SIGN AA..TIBETAN SIGN RJES SU NGA RO
+	{runeRange{0x0FBE, 0x0FC5}, ScriptTibetan},                 // So   [8] TIBETAN KU RU KHA..TIBETAN SYMBOL RDO RJE
+	{runeRange{0x102B, 0x102C}, ScriptMyanmar},                 // Mc   [2] MYANMAR VOWEL SIGN TALL AA..MYANMAR VOWEL SIGN AA
+	{runeRange{0x103F, 0x103F}, ScriptMyanmar},                 // Lo       MYANMAR LETTER GREAT SA
+	{runeRange{0x1061, 0x1061}, ScriptMyanmar},                 // Lo       MYANMAR LETTER SGAW KAREN SHA
+	{runeRange{0x1083, 0x1084}, ScriptMyanmar},                 // Mc   [2] MYANMAR VOWEL SIGN SHAN AA..MYANMAR VOWEL SIGN SHAN E
+	{runeRange{0x109D, 0x109D}, ScriptMyanmar},                 // Mn       MYANMAR VOWEL SIGN AITON AI
+	{runeRange{0x10FD, 0x10FF}, ScriptGeorgian},                // Ll   [3] GEORGIAN LETTER AEN..GEORGIAN LETTER LABIAL SIGN
+	{runeRange{0x128A, 0x128D}, ScriptEthiopic},                // Lo   [4] ETHIOPIC SYLLABLE XWI..ETHIOPIC SYLLABLE XWE
+	{runeRange{0x1312, 0x1315}, ScriptEthiopic},                // Lo   [4] ETHIOPIC SYLLABLE GWI..ETHIOPIC SYLLABLE GWE
+	{runeRange{0x13F8, 0x13FD}, ScriptCherokee},                // Ll   [6] CHEROKEE SMALL LETTER YE..CHEROKEE SMALL LETTER MV
+	{runeRange{0x169B, 0x169B}, ScriptOgham},                   // Ps       OGHAM FEATHER MARK
+	{runeRange{0x1715, 0x1715}, ScriptTagalog},                 // Mc       TAGALOG SIGN PAMUDPOD
+	{runeRange{0x1760, 0x176C}, ScriptTagbanwa},                // Lo  [13] TAGBANWA LETTER A..TAGBANWA LETTER YA
+	{runeRange{0x17C6, 0x17C6}, ScriptKhmer},                   // Mn       KHMER SIGN NIKAHIT
+	{runeRange{0x17DD, 0x17DD}, ScriptKhmer},                   // Mn       KHMER SIGN ATTHACAN
+	{runeRange{0x1807, 0x180A}, ScriptMongolian},               // Po   [4] MONGOLIAN SIBE SYLLABLE BOUNDARY MARKER..MONGOLIAN NIRUGU
+	{runeRange{0x1880, 0x1884}, ScriptMongolian},               // Lo   [5] MONGOLIAN LETTER ALI GALI ANUSVARA ONE..MONGOLIAN LETTER ALI GALI INVERTED UBADAMA
+	{runeRange{0x1923, 0x1926}, ScriptLimbu},                   // Mc   [4] LIMBU VOWEL SIGN EE..LIMBU VOWEL SIGN AU
+	{runeRange{0x1944, 0x1945}, ScriptLimbu},                   // Po   [2] LIMBU EXCLAMATION MARK..LIMBU QUESTION MARK
+	{runeRange{0x19DE, 0x19DF}, ScriptNewTaiLue},               // So   [2] NEW TAI LUE SIGN LAE..NEW TAI LUE SIGN LAEV
+	{runeRange{0x1A55, 0x1A55}, ScriptTaiTham},                 // Mc       TAI THAM CONSONANT SIGN MEDIAL RA
+	{runeRange{0x1A65, 0x1A6C}, ScriptTaiTham},                 // Mn   [8] TAI THAM VOWEL SIGN I..TAI THAM VOWEL SIGN OA BELOW
+	{runeRange{0x1AA8, 0x1AAD}, ScriptTaiTham},                 // Po   [6] TAI THAM SIGN KAAN..TAI THAM SIGN CAANG
+	{runeRange{0x1B34, 0x1B34}, ScriptBalinese},                // Mn       BALINESE SIGN REREKAN
+	{runeRange{0x1B45, 0x1B4C}, ScriptBalinese},                // Lo   [8] BALINESE LETTER KAF SASAK..BALINESE LETTER ARCHAIC JNYA
+	{runeRange{0x1B80, 0x1B81}, ScriptSundanese},               // Mn   [2] SUNDANESE SIGN PANYECEK..SUNDANESE SIGN PANGLAYAR
+	{runeRange{0x1BAB, 0x1BAD}, ScriptSundanese},               // Mn   [3] SUNDANESE SIGN VIRAMA..SUNDANESE CONSONANT SIGN PASANGAN WA
+	{runeRange{0x1BEA, 0x1BEC}, ScriptBatak},                   // Mc   [3] BATAK VOWEL SIGN I..BATAK VOWEL SIGN O
+	{runeRange{0x1C2C, 0x1C33}, ScriptLepcha},                  // Mn   [8] LEPCHA VOWEL SIGN E..LEPCHA CONSONANT SIGN T
+	{runeRange{0x1C78, 0x1C7D}, ScriptOlChiki},                 // Lm   [6] OL CHIKI MU TTUDDAG..OL CHIKI AHAD
+	{runeRange{0x1CD0, 0x1CD2}, ScriptInherited},               // Mn   [3] VEDIC TONE KARSHANA..VEDIC TONE PRENKHA
+	{runeRange{0x1CF4, 0x1CF4}, ScriptInherited},               // Mn       VEDIC TONE CANDRA ABOVE
+	{runeRange{0x1D2C, 0x1D5C}, ScriptLatin},                   // Lm  [49] MODIFIER LETTER CAPITAL A..MODIFIER LETTER SMALL AIN
+	{runeRange{0x1DBF, 0x1DBF}, ScriptGreek},                   // Lm       MODIFIER LETTER SMALL THETA
+	{runeRange{0x1E06, 0x1E06}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER B WITH LINE BELOW
+	{runeRange{0x1E0E, 0x1E0E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER D WITH LINE BELOW
+	{runeRange{0x1E16, 0x1E16}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH MACRON AND ACUTE
+	{runeRange{0x1E1E, 0x1E1E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER F WITH DOT ABOVE
+	{runeRange{0x1E26, 0x1E26}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER H WITH DIAERESIS
+	{runeRange{0x1E2E, 0x1E2E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER I WITH DIAERESIS AND ACUTE
+	{runeRange{0x1E36, 0x1E36}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER L WITH DOT BELOW
+	{runeRange{0x1E3E, 0x1E3E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER M WITH ACUTE
+	{runeRange{0x1E46, 0x1E46}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER N WITH DOT BELOW
+	{runeRange{0x1E4E, 0x1E4E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH TILDE AND DIAERESIS
+	{runeRange{0x1E56, 0x1E56}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER P WITH DOT ABOVE
+	{runeRange{0x1E5E, 0x1E5E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER R WITH LINE BELOW
+	{runeRange{0x1E66, 0x1E66}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER S WITH CARON AND DOT ABOVE
+	{runeRange{0x1E6E, 0x1E6E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER T WITH LINE BELOW
+	{runeRange{0x1E76, 0x1E76}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH CIRCUMFLEX BELOW
+	{runeRange{0x1E7E, 0x1E7E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER V WITH DOT BELOW
+	{runeRange{0x1E86, 0x1E86}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER W WITH DOT ABOVE
+	{runeRange{0x1E8E, 0x1E8E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Y WITH DOT ABOVE
+	{runeRange{0x1E9E, 0x1E9E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER SHARP S
+	{runeRange{0x1EA6, 0x1EA6}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND GRAVE
+	{runeRange{0x1EAE, 0x1EAE}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH BREVE AND ACUTE
+	{runeRange{0x1EB6, 0x1EB6}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH BREVE AND DOT BELOW
+	{runeRange{0x1EBE, 0x1EBE}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND ACUTE
+	{runeRange{0x1EC6, 0x1EC6}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND DOT BELOW
+	{runeRange{0x1ECE, 0x1ECE}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH HOOK ABOVE
+	{runeRange{0x1ED6, 0x1ED6}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND TILDE
+	{runeRange{0x1EDE, 0x1EDE}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH HORN AND HOOK ABOVE
+	{runeRange{0x1EE6, 0x1EE6}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH HOOK ABOVE
+	{runeRange{0x1EEE, 0x1EEE}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH HORN AND TILDE
+	{runeRange{0x1EF6, 0x1EF6}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Y WITH HOOK ABOVE
+	{runeRange{0x1EFE, 0x1EFE}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Y WITH LOOP
+	{runeRange{0x1F30, 0x1F37}, ScriptGreek},                   // Ll   [8] GREEK SMALL LETTER IOTA WITH PSILI..GREEK SMALL LETTER IOTA WITH DASIA AND PERISPOMENI
+	{runeRange{0x1F5F, 0x1F5F}, ScriptGreek},                   // Lu       GREEK CAPITAL LETTER UPSILON WITH DASIA AND PERISPOMENI
+	{runeRange{0x1FA0, 0x1FA7}, ScriptGreek},                   // Ll   [8] GREEK SMALL LETTER OMEGA WITH PSILI AND YPOGEGRAMMENI..GREEK SMALL LETTER OMEGA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	{runeRange{0x1FBF, 0x1FC1}, ScriptGreek},                   // Sk   [3] GREEK PSILI..GREEK DIALYTIKA AND PERISPOMENI
+	{runeRange{0x1FD8, 0x1FDB}, ScriptGreek},                   // Lu   [4] GREEK CAPITAL LETTER IOTA WITH VRACHY..GREEK CAPITAL LETTER IOTA WITH OXIA
+	{runeRange{0x1FFC, 0x1FFC}, ScriptGreek},                   // Lt       GREEK CAPITAL LETTER OMEGA WITH PROSGEGRAMMENI
+	{runeRange{0x2018, 0x2018}, ScriptCommon},                  // Pi       LEFT SINGLE QUOTATION MARK
+	{runeRange{0x2028, 0x2028}, ScriptCommon},                  // Zl       LINE SEPARATOR
+	{runeRange{0x203F, 0x2040}, ScriptCommon},                  // Pc   [2] UNDERTIE..CHARACTER TIE
+	{runeRange{0x2054, 0x2054}, ScriptCommon},                  // Pc       INVERTED UNDERTIE
+	{runeRange{0x207A, 0x207C}, ScriptCommon},                  // Sm   [3] SUPERSCRIPT PLUS SIGN..SUPERSCRIPT EQUALS SIGN
+	{runeRange{0x2090, 0x209C}, ScriptLatin},                   // Lm  [13] LATIN SUBSCRIPT SMALL LETTER A..LATIN SUBSCRIPT SMALL LETTER T
+	{runeRange{0x2102, 0x2102}, ScriptCommon},                  // Lu       DOUBLE-STRUCK CAPITAL C
+	{runeRange{0x2113, 0x2113}, ScriptCommon},                  // Ll       SCRIPT SMALL L
+	{runeRange{0x2125, 0x2125}, ScriptCommon},                  // So       OUNCE SIGN
+	{runeRange{0x212F, 0x212F}, ScriptCommon},                  // Ll       SCRIPT SMALL E
+	{runeRange{0x213C, 0x213D}, ScriptCommon},                  // Ll   [2] DOUBLE-STRUCK SMALL PI..DOUBLE-STRUCK SMALL GAMMA
+	{runeRange{0x214E, 0x214E}, ScriptLatin},                   // Ll       TURNED SMALL F
+	{runeRange{0x218A, 0x218B}, ScriptCommon},                  // So   [2] TURNED DIGIT TWO..TURNED DIGIT THREE
+	{runeRange{0x21A4, 0x21A5}, ScriptCommon},                  // So   [2] LEFTWARDS ARROW FROM BAR..UPWARDS ARROW FROM BAR
+	{runeRange{0x21D3, 0x21D3}, ScriptCommon},                  // So       DOWNWARDS DOUBLE ARROW
+	{runeRange{0x230B, 0x230B}, ScriptCommon},                  // Pe       RIGHT FLOOR
+	{runeRange{0x237D, 0x239A}, ScriptCommon},                  // So  [30] SHOULDERED OPEN BOX..CLEAR SCREEN SYMBOL
+	{runeRange{0x24EA, 0x24FF}, ScriptCommon},                  // No  [22] CIRCLED DIGIT ZERO..NEGATIVE CIRCLED DIGIT ZERO
+	{runeRange{0x266F, 0x266F}, ScriptCommon},                  // Sm       MUSIC SHARP SIGN
+	{runeRange{0x276E, 0x276E}, ScriptCommon},                  // Ps       HEAVY LEFT-POINTING ANGLE QUOTATION MARK ORNAMENT
+	{runeRange{0x2776, 0x2793}, ScriptCommon},                  // No  [30] DINGBAT NEGATIVE CIRCLED DIGIT ONE..DINGBAT NEGATIVE CIRCLED SANS-SERIF NUMBER TEN
+	{runeRange{0x27E8, 0x27E8}, ScriptCommon},                  // Ps       MATHEMATICAL LEFT ANGLE BRACKET
+	{runeRange{0x27F0, 0x27FF}, ScriptCommon},                  // Sm  [16] UPWARDS QUADRUPLE ARROW..LONG RIGHTWARDS SQUIGGLE ARROW
+	{runeRange{0x2988, 0x2988}, ScriptCommon},                  // Pe       Z NOTATION RIGHT IMAGE BRACKET
+	{runeRange{0x2990, 0x2990}, ScriptCommon},                  // Pe       RIGHT SQUARE BRACKET WITH TICK IN TOP CORNER
+	{runeRange{0x2998, 0x2998}, ScriptCommon},                  // Pe       RIGHT BLACK TORTOISE SHELL BRACKET
+	{runeRange{0x29FD, 0x29FD}, ScriptCommon},                  // Pe       RIGHT-POINTING CURVED ANGLE BRACKET
+	{runeRange{0x2C00, 0x2C2F}, ScriptGlagolitic},              // Lu  [48] GLAGOLITIC CAPITAL LETTER AZU..GLAGOLITIC CAPITAL LETTER CAUDATE CHRIVI
+	{runeRange{0x2C69, 0x2C69}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER K WITH DESCENDER
+	{runeRange{0x2C75, 0x2C75}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER HALF H
+	{runeRange{0x2C84, 0x2C84}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER GAMMA
+	{runeRange{0x2C8C, 0x2C8C}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER ZATA
+	{runeRange{0x2C94, 0x2C94}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER KAPA
+	{runeRange{0x2C9C, 0x2C9C}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER KSI
+	{runeRange{0x2CA4, 0x2CA4}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER SIMA
+	{runeRange{0x2CAC, 0x2CAC}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER KHI
+	{runeRange{0x2CB4, 0x2CB4}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER OLD COPTIC AIN
+	{runeRange{0x2CBC, 0x2CBC}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER CRYPTOGRAMMIC NI
+	{runeRange{0x2CC4, 0x2CC4}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER OLD COPTIC SHEI
+	{runeRange{0x2CCC, 0x2CCC}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER OLD COPTIC HORI
+	{runeRange{0x2CD4, 0x2CD4}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER OLD COPTIC HAT
+	{runeRange{0x2CDC, 0x2CDC}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER OLD NUBIAN SHIMA
+	{runeRange{0x2CE5, 0x2CEA}, ScriptCoptic},                  // So   [6] COPTIC SYMBOL MI RO..COPTIC SYMBOL SHIMA SIMA
+	{runeRange{0x2CF9, 0x2CFC}, ScriptCoptic},                  // Po   [4] COPTIC OLD NUBIAN FULL STOP..COPTIC OLD NUBIAN VERSE DIVIDER
+	{runeRange{0x2D70, 0x2D70}, ScriptTifinagh},                // Po       TIFINAGH SEPARATOR MARK
+	{runeRange{0x2DC8, 0x2DCE}, ScriptEthiopic},                // Lo   [7] ETHIOPIC SYLLABLE KYA..ETHIOPIC SYLLABLE KYO
+	{runeRange{0x2E05, 0x2E05}, ScriptCommon},                  // Pf       RIGHT DOTTED SUBSTITUTION BRACKET
+	{runeRange{0x2E17, 0x2E17}, ScriptCommon},                  // Pd       DOUBLE OBLIQUE HYPHEN
+	{runeRange{0x2E21, 0x2E21}, ScriptCommon},                  // Pf       RIGHT VERTICAL BAR WITH QUILL
+	{runeRange{0x2E29, 0x2E29}, ScriptCommon},                  // Pe       RIGHT DOUBLE PARENTHESIS
+	{runeRange{0x2E42, 0x2E42}, ScriptCommon},                  // Ps       DOUBLE LOW-REVERSED-9 QUOTATION MARK
+	{runeRange{0x2E59, 0x2E59}, ScriptCommon},                  // Ps       TOP HALF LEFT PARENTHESIS
+	{runeRange{0x2FF0, 0x2FFF}, ScriptCommon},                  // So  [16] IDEOGRAPHIC DESCRIPTION CHARACTER LEFT TO RIGHT..IDEOGRAPHIC DESCRIPTION CHARACTER ROTATION
+	{runeRange{0x3009, 0x3009}, ScriptCommon},                  // Pe       RIGHT ANGLE BRACKET
+	{runeRange{0x3011, 0x3011}, ScriptCommon},                  // Pe       RIGHT BLACK LENTICULAR BRACKET
+	{runeRange{0x301A, 0x301A}, ScriptCommon},                  // Ps       LEFT WHITE SQUARE BRACKET
+	{runeRange{0x302E, 0x302F}, ScriptHangul},                  // Mc   [2] HANGUL SINGLE DOT TONE MARK..HANGUL DOUBLE DOT TONE MARK
+	{runeRange{0x303E, 0x303F}, ScriptCommon},                  // So   [2] IDEOGRAPHIC VARIATION INDICATOR..IDEOGRAPHIC HALF FILL SPACE
+	{runeRange{0x30FB, 0x30FB}, ScriptCommon},                  // Po       KATAKANA MIDDLE DOT
+	{runeRange{0x3196, 0x319F}, ScriptCommon},                  // So  [10] IDEOGRAPHIC ANNOTATION TOP MARK..IDEOGRAPHIC ANNOTATION MAN MARK
+	{runeRange{0x3248, 0x324F}, ScriptCommon},                  // No   [8] CIRCLED NUMBER TEN ON BLACK SQUARE..CIRCLED NUMBER EIGHTY ON BLACK SQUARE
+	{runeRange{0x32C0, 0x32CF}, ScriptCommon},                  // So  [16] IDEOGRAPHIC TELEGRAPH SYMBOL FOR JANUARY..LIMITED LIABILITY SIGN
+	{runeRange{0xA000, 0xA014}, ScriptYi},                      // Lo  [21] YI SYLLABLE IT..YI SYLLABLE E
+	{runeRange{0xA60C, 0xA60C}, ScriptVai},                     // Lm       VAI SYLLABLE LENGTHENER
+	{runeRange{0xA643, 0xA643}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER DZELO
+	{runeRange{0xA64B, 0xA64B}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER MONOGRAPH UK
+	{runeRange{0xA653, 0xA653}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER IOTIFIED YAT
+	{runeRange{0xA65B, 0xA65B}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER BLENDED YUS
+	{runeRange{0xA663, 0xA663}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER SOFT DE
+	{runeRange{0xA66B, 0xA66B}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER BINOCULAR O
+	{runeRange{0xA67E, 0xA67E}, ScriptCyrillic},                // Po       CYRILLIC KAVYKA
+	{runeRange{0xA686, 0xA686}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER CCHE
+	{runeRange{0xA68E, 0xA68E}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER TSWE
+	{runeRange{0xA696, 0xA696}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER SHWE
+	{runeRange{0xA6A0, 0xA6E5}, ScriptBamum},                   // Lo  [70] BAMUM LETTER A..BAMUM LETTER KI
+	{runeRange{0xA723, 0xA723}, ScriptLatin},                   // Ll       LATIN SMALL LETTER EGYPTOLOGICAL ALEF
+	{runeRange{0xA72B, 0xA72B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER TRESILLO
+	{runeRange{0xA735, 0xA735}, ScriptLatin},                   // Ll       LATIN SMALL LETTER AO
+	{runeRange{0xA73D, 0xA73D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER AY
+	{runeRange{0xA745, 0xA745}, ScriptLatin},                   // Ll       LATIN SMALL LETTER K WITH STROKE AND DIAGONAL STROKE
+	{runeRange{0xA74D, 0xA74D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH LOOP
+	{runeRange{0xA755, 0xA755}, ScriptLatin},                   // Ll       LATIN SMALL LETTER P WITH SQUIRREL TAIL
+	{runeRange{0xA75D, 0xA75D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER RUM ROTUNDA
+	{runeRange{0xA765, 0xA765}, ScriptLatin},                   // Ll       LATIN SMALL LETTER THORN WITH STROKE
+	{runeRange{0xA76D, 0xA76D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER IS
+	{runeRange{0xA77C, 0xA77C}, ScriptLatin},                   // Ll       LATIN SMALL LETTER INSULAR F
+	{runeRange{0xA785, 0xA785}, ScriptLatin},                   // Ll       LATIN SMALL LETTER INSULAR S
+	{runeRange{0xA78E, 0xA78E}, ScriptLatin},                   // Ll       LATIN SMALL LETTER L WITH RETROFLEX HOOK AND BELT
+	{runeRange{0xA798, 0xA798}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER F WITH STROKE
+	{runeRange{0xA7A0, 0xA7A0}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER G WITH OBLIQUE STROKE
+	{runeRange{0xA7A8, 0xA7A8}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER S WITH OBLIQUE STROKE
+	{runeRange{0xA7B8, 0xA7B8}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH STROKE
+	{runeRange{0xA7C0, 0xA7C0}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER OLD POLISH O
+	{runeRange{0xA7CB, 0xA7CC}, ScriptLatin},                   // Lu   [2] LATIN CAPITAL LETTER RAMS HORN..LATIN CAPITAL LETTER S WITH DIAGONAL STROKE
+	{runeRange{0xA7D4, 0xA7D4}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER DOUBLE WYNN
+	{runeRange{0xA7DC, 0xA7DC}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER LAMBDA WITH STROKE
+	{runeRange{0xA800, 0xA801}, ScriptSylotiNagri},             // Lo   [2] SYLOTI NAGRI LETTER A..SYLOTI NAGRI LETTER I
+	{runeRange{0xA825, 0xA826}, ScriptSylotiNagri},             // Mn   [2] SYLOTI NAGRI VOWEL SIGN U..SYLOTI NAGRI VOWEL SIGN E
+	{runeRange{0xA840, 0xA873}, ScriptPhagsPa},                 // Lo  [52] PHAGS-PA LETTER KA..PHAGS-PA LETTER CANDRABINDU
+	{runeRange{0xA8E0, 0xA8F1}, ScriptDevanagari},              // Mn  [18] COMBINING DEVANAGARI DIGIT ZERO..COMBINING DEVANAGARI SIGN AVAGRAHA
+	{runeRange{0xA90A, 0xA925}, ScriptKayahLi},                 // Lo  [28] KAYAH LI LETTER KA..KAYAH LI LETTER OO
+	{runeRange{0xA960, 0xA97C}, ScriptHangul},                  // Lo  [29] HANGUL CHOSEONG TIKEUT-MIEUM..HANGUL CHOSEONG SSANGYEORINHIEUH
+	{runeRange{0xA9BC, 0xA9BD}, ScriptJavanese},                // Mn   [2] JAVANESE VOWEL SIGN PEPET..JAVANESE CONSONANT SIGN KERET
+	{runeRange{0xA9E6, 0xA9E6}, ScriptMyanmar},                 // Lm       MYANMAR MODIFIER LETTER SHAN REDUPLICATION
+	{runeRange{0xAA33, 0xAA34}, ScriptCham},                    // Mc   [2] CHAM CONSONANT SIGN YA..CHAM CONSONANT SIGN RA
+	{runeRange{0xAA5C, 0xAA5F}, ScriptCham},                    // Po   [4] CHAM PUNCTUATION SPIRAL..CHAM PUNCTUATION TRIPLE DANDA
+	{runeRange{0xAA7D, 0xAA7D}, ScriptMyanmar},                 // Mc       MYANMAR SIGN TAI LAING TONE-5
+	{runeRange{0xAAB9, 0xAABD}, ScriptTaiViet},                 // Lo   [5] TAI VIET VOWEL UEA..TAI VIET VOWEL AN
+	{runeRange{0xAAE0, 0xAAEA}, ScriptMeeteiMayek},             // Lo  [11] MEETEI MAYEK LETTER E..MEETEI MAYEK LETTER SSA
+	{runeRange{0xAAF6, 0xAAF6}, ScriptMeeteiMayek},             // Mn       MEETEI MAYEK VIRAMA
+	{runeRange{0xAB5C, 0xAB5F}, ScriptLatin},                   // Lm   [4] MODIFIER LETTER SMALL HENG..MODIFIER LETTER SMALL U WITH LEFT HOOK
+	{runeRange{0xABE3, 0xABE4}, ScriptMeeteiMayek},             // Mc   [2] MEETEI MAYEK VOWEL SIGN ONAP..MEETEI MAYEK VOWEL SIGN INAP
+	{runeRange{0xABF0, 0xABF9}, ScriptMeeteiMayek},             // Nd  [10] MEETEI MAYEK DIGIT ZERO..MEETEI MAYEK DIGIT NINE
+	{runeRange{0xFB1D, 0xFB1D}, ScriptHebrew},                  // Lo       HEBREW LETTER YOD WITH HIRIQ
+	{runeRange{0xFB43, 0xFB44}, ScriptHebrew},                  // Lo   [2] HEBREW LETTER FINAL PE WITH DAGESH..HEBREW LETTER PE WITH DAGESH
+	{runeRange{0xFD40, 0xFD4F}, ScriptArabic},                  // So  [16] ARABIC LIGATURE RAHIMAHU ALLAAH..ARABIC LIGATURE RAHIMAHUM ALLAAH
+	{runeRange{0xFE00, 0xFE0F}, ScriptInherited},               // Mn  [16] VARIATION SELECTOR-1..VARIATION SELECTOR-16
+	{runeRange{0xFE31, 0xFE32}, ScriptCommon},                  // Pd   [2] PRESENTATION FORM FOR VERTICAL EM DASH..PRESENTATION FORM FOR VERTICAL EN DASH
+	{runeRange{0xFE3B, 0xFE3B}, ScriptCommon},                  // Ps       PRESENTATION FORM FOR VERTICAL LEFT BLACK LENTICULAR BRACKET
+	{runeRange{0xFE43, 0xFE43}, ScriptCommon},                  // Ps       PRESENTATION FORM FOR VERTICAL LEFT WHITE CORNER BRACKET
+	{runeRange{0xFE54, 0xFE57}, ScriptCommon},                  // Po   [4] SMALL SEMICOLON..SMALL EXCLAMATION MARK
+	{runeRange{0xFE5F, 0xFE61}, ScriptCommon},                  // Po   [3] SMALL NUMBER SIGN..SMALL ASTERISK
+	{runeRange{0xFE76, 0xFEFC}, ScriptArabic},                  // Lo [135] ARABIC FATHA ISOLATED FORM..ARABIC LIGATURE LAM WITH ALEF FINAL FORM
+	{runeRange{0xFF0B, 0xFF0B}, ScriptCommon},                  // Sm       FULLWIDTH PLUS SIGN
+	{runeRange{0xFF21, 0xFF3A}, ScriptLatin},                   // Lu  [26] FULLWIDTH LATIN CAPITAL LETTER A..FULLWIDTH LATIN CAPITAL LETTER Z
+	{runeRange{0xFF5B, 0xFF5B}, ScriptCommon},                  // Ps       FULLWIDTH LEFT CURLY BRACKET
+	{runeRange{0xFF63, 0xFF63}, ScriptCommon},                  // Pe       HALFWIDTH RIGHT CORNER BRACKET
+	{runeRange{0xFFCA, 0xFFCF}, ScriptHangul},                  // Lo   [6] HALFWIDTH HANGUL LETTER YEO..HALFWIDTH HANGUL LETTER OE
+	{runeRange{0xFFE8, 0xFFE8}, ScriptCommon},                  // So       HALFWIDTH FORMS LIGHT VERTICAL
+	{runeRange{0x1003C, 0x1003D}, ScriptLinearB},               // Lo   [2] LINEAR B SYLLABLE B017 ZA..LINEAR B SYLLABLE B074 ZE
+	{runeRange{0x10175, 0x10178}, ScriptGreek},                 // No   [4] GREEK ONE HALF SIGN..GREEK THREE QUARTERS SIGN
+	{runeRange{0x10280, 0x1029C}, ScriptLycian},                // Lo  [29] LYCIAN LETTER A..LYCIAN LETTER X
+	{runeRange{0x10341, 0x10341}, ScriptGothic},                // Nl       GOTHIC LETTER NINETY
+	{runeRange{0x103C8, 0x103CF}, ScriptOldPersian},            // Lo   [8] OLD PERSIAN SIGN AURAMAZDAA..OLD PERSIAN SIGN BUUMISH
+	{runeRange{0x104B0, 0x104D3}, ScriptOsage},                 // Lu  [36] OSAGE CAPITAL LETTER A..OSAGE CAPITAL LETTER ZHA
+	{runeRange{0x10594, 0x10595}, ScriptVithkuqi},              // Lu   [2] VITHKUQI CAPITAL LETTER Y..VITHKUQI CAPITAL LETTER ZE
+	{runeRange{0x10760, 0x10767}, ScriptLinearA},               // Lo   [8] LINEAR A SIGN A800..LINEAR A SIGN A807
+	{runeRange{0x1083C, 0x1083C}, ScriptCypriot},               // Lo       CYPRIOT SYLLABLE ZA
+	{runeRange{0x10880, 0x1089E}, ScriptNabataean},             // Lo  [31] NABATAEAN LETTER FINAL ALEPH..NABATAEAN LETTER TAW
+	{runeRange{0x10920, 0x10939}, ScriptLydian},                // Lo  [26] LYDIAN LETTER A..LYDIAN LETTER C
+	{runeRange{0x109D2, 0x109FF}, ScriptMeroiticCursive},       // No  [46] MEROITIC CURSIVE NUMBER ONE HUNDRED..MEROITIC CURSIVE FRACTION TEN TWELFTHS
+	{runeRange{0x10A38, 0x10A3A}, ScriptKharoshthi},            // Mn   [3] KHAROSHTHI SIGN BAR ABOVE..KHAROSHTHI SIGN DOT BELOW
+	{runeRange{0x10A9D, 0x10A9F}, ScriptOldNorthArabian},       // No   [3] OLD NORTH ARABIAN NUMBER ONE..OLD NORTH ARABIAN NUMBER TWENTY
+	{runeRange{0x10B39, 0x10B3F}, ScriptAvestan},               // Po   [7] AVESTAN ABBREVIATION MARK..LARGE ONE RING OVER TWO RINGS PUNCTUATION
+	{runeRange{0x10C00, 0x10C48}, ScriptOldTurkic},             // Lo  [73] OLD TURKIC LETTER ORKHON A..OLD TURKIC LETTER ORKHON BASH
+	{runeRange{0x10D4A, 0x10D4D}, ScriptGaray},                 // Lo   [4] GARAY VOWEL SIGN A..GARAY VOWEL SIGN EE
+	{runeRange{0x10D8E, 0x10D8F}, ScriptGaray},                 // Sm   [2] GARAY PLUS SIGN..GARAY MINUS SIGN
+	{runeRange{0x10EC6, 0x10EC7}, ScriptArabic},                // Lo   [2] ARABIC LETTER THIN NOON..ARABIC LETTER YEH WITH FOUR DOTS BELOW
+	{runeRange{0x10F46, 0x10F50}, ScriptSogdian},               // Mn  [11] SOGDIAN COMBINING DOT BELOW..SOGDIAN COMBINING STROKE BELOW
+	{runeRange{0x10FE0, 0x10FF6}, ScriptElymaic},               // Lo  [23] ELYMAIC LETTER ALEPH..ELYMAIC LIGATURE ZAYIN-YODH
+	{runeRange{0x11066, 0x1106F}, ScriptBrahmi},                // Nd  [10] BRAHMI DIGIT ZERO..BRAHMI DIGIT NINE
+	{runeRange{0x11083, 0x110AF}, ScriptKaithi},                // Lo  [45] KAITHI LETTER A..KAITHI LETTER HA
+	{runeRange{0x110C2, 0x110C2}, ScriptKaithi},                // Mn       KAITHI VOWEL SIGN VOCALIC R
+	{runeRange{0x1112D, 0x11134}, ScriptChakma},                // Mn   [8] CHAKMA VOWEL SIGN AI..CHAKMA MAAYYAA
+	{runeRange{0x11174, 0x11175}, ScriptMahajani},              // Po   [2] MAHAJANI ABBREVIATION SIGN..MAHAJANI SECTION MARK
+	{runeRange{0x111C1, 0x111C4}, ScriptSharada},               // Lo   [4] SHARADA SIGN AVAGRAHA..SHARADA OM
+	{runeRange{0x111DB, 0x111DB}, ScriptSharada},               // Po       SHARADA SIGN SIDDHAM
+	{runeRange{0x11232, 0x11233}, ScriptKhojki},                // Mc   [2] KHOJKI VOWEL SIGN O..KHOJKI VOWEL SIGN AU
+	{runeRange{0x1123E, 0x1123E}, ScriptKhojki},                // Mn       KHOJKI SIGN SUKUN
+	{runeRange{0x11288, 0x11288}, ScriptMultani},               // Lo       MULTANI LETTER GHA
+	{runeRange{0x112A9, 0x112A9}, ScriptMultani},               // Po       MULTANI SECTION MARK
+	{runeRange{0x112E3, 0x112EA}, ScriptKhudawadi},             // Mn   [8] KHUDAWADI VOWEL SIGN U..KHUDAWADI SIGN VIRAMA
+	{runeRange{0x11305, 0x1130C}, ScriptGrantha},               // Lo   [8] GRANTHA LETTER A..GRANTHA LETTER VOCALIC L
+	{runeRange{0x11332, 0x11333}, ScriptGrantha},               // Lo   [2] GRANTHA LETTER LA..GRANTHA LETTER LLA
+	{runeRange{0x1133D, 0x1133D}, ScriptGrantha},               // Lo       GRANTHA SIGN AVAGRAHA
+	{runeRange{0x11347, 0x11348}, ScriptGrantha},               // Mc   [2] GRANTHA VOWEL SIGN EE..GRANTHA VOWEL SIGN AI
+	{runeRange{0x1135D, 0x11361}, ScriptGrantha},               // Lo   [5] GRANTHA SIGN PLUTA..GRANTHA LETTER VOCALIC LL
+	{runeRange{0x11380, 0x11389}, ScriptTuluTigalari},          // Lo  [10] TULU-TIGALARI LETTER A..TULU-TIGALARI LETTER VOCALIC LL
+	{runeRange{0x113B7, 0x113B7}, ScriptTuluTigalari},          // Lo       TULU-TIGALARI SIGN AVAGRAHA
+	{runeRange{0x113C5, 0x113C5}, ScriptTuluTigalari},          // Mc       TULU-TIGALARI VOWEL SIGN AI
+	{runeRange{0x113CF, 0x113CF}, ScriptTuluTigalari},          // Mc       TULU-TIGALARI SIGN LOOPED VIRAMA
+	{runeRange{0x113D3, 0x113D3}, ScriptTuluTigalari},          // Lo       TULU-TIGALARI SIGN PLUTA
+	{runeRange{0x11400, 0x11434}, ScriptNewa},                  // Lo  [53] NEWA LETTER A..NEWA LETTER HA
+	{runeRange{0x11442, 0x11444}, ScriptNewa},                  // Mn   [3] NEWA SIGN VIRAMA..NEWA SIGN ANUSVARA
+	{runeRange{0x1144B, 0x1144F}, ScriptNewa},                  // Po   [5] NEWA DANDA..NEWA ABBREVIATION SIGN
+	{runeRange{0x1145E, 0x1145E}, ScriptNewa},                  // Mn       NEWA SANDHI MARK
+	{runeRange{0x114B3, 0x114B8}, ScriptTirhuta},               // Mn   [6] TIRHUTA VOWEL SIGN U..TIRHUTA VOWEL SIGN VOCALIC LL
+	{runeRange{0x114BF, 0x114C0}, ScriptTirhuta},               // Mn   [2] TIRHUTA SIGN CANDRABINDU..TIRHUTA SIGN ANUSVARA
+	{runeRange{0x114C6, 0x114C6}, ScriptTirhuta},               // Po       TIRHUTA ABBREVIATION SIGN
+	{runeRange{0x115AF, 0x115B1}, ScriptSiddham},               // Mc   [3] SIDDHAM VOWEL SIGN AA..SIDDHAM VOWEL SIGN II
+	{runeRange{0x115BE, 0x115BE}, ScriptSiddham},               // Mc       SIDDHAM SIGN VISARGA
+	{runeRange{0x115DC, 0x115DD}, ScriptSiddham},               // Mn   [2] SIDDHAM VOWEL SIGN ALTERNATE U..SIDDHAM VOWEL SIGN ALTERNATE UU
+	{runeRange{0x1163B, 0x1163C}, ScriptModi},                  // Mc   [2] MODI VOWEL SIGN O..MODI VOWEL SIGN AU
+	{runeRange{0x11641, 0x11643}, ScriptModi},                  // Po   [3] MODI DANDA..MODI ABBREVIATION SIGN
+	{runeRange{0x11680, 0x116AA}, ScriptTakri},                 // Lo  [43] TAKRI LETTER A..TAKRI LETTER RRA
+	{runeRange{0x116AE, 0x116AF}, ScriptTakri},                 // Mc   [2] TAKRI VOWEL SIGN I..TAKRI VOWEL SIGN II
+	{runeRange{0x116B8, 0x116B8}, ScriptTakri},                 // Lo       TAKRI LETTER ARCHAIC KHA
+	{runeRange{0x11700, 0x1171A}, ScriptAhom},                  // Lo  [27] AHOM LETTER KA..AHOM LETTER ALTERNATE BA
+	{runeRange{0x11720, 0x11721}, ScriptAhom},                  // Mc   [2] AHOM VOWEL SIGN A..AHOM VOWEL SIGN AA
+	{runeRange{0x11730, 0x11739}, ScriptAhom},                  // Nd  [10] AHOM DIGIT ZERO..AHOM DIGIT NINE
+	{runeRange{0x11740, 0x11746}, ScriptAhom},                  // Lo   [7] AHOM LETTER CA..AHOM LETTER LLA
+	{runeRange{0x11838, 0x11838}, ScriptDogra},                 // Mc       DOGRA SIGN VISARGA
+	{runeRange{0x118C0, 0x118DF}, ScriptWarangCiti},            // Ll  [32] WARANG CITI SMALL LETTER NGAA..WARANG CITI SMALL LETTER VIYO
+	{runeRange{0x11900, 0x11906}, ScriptDivesAkuru},            // Lo   [7] DIVES AKURU LETTER A..DIVES AKURU LETTER E
+	{runeRange{0x11918, 0x1192F}, ScriptDivesAkuru},            // Lo  [24] DIVES AKURU LETTER DDA..DIVES AKURU LETTER ZA
+	{runeRange{0x1193D, 0x1193D}, ScriptDivesAkuru},            // Mc       DIVES AKURU SIGN HALANTA
+	{runeRange{0x11941, 0x11941}, ScriptDivesAkuru},            // Lo       DIVES AKURU INITIAL RA
+	{runeRange{0x11950, 0x11959}, ScriptDivesAkuru},            // Nd  [10] DIVES AKURU DIGIT ZERO..DIVES AKURU DIGIT NINE
+	{runeRange{0x119D4, 0x119D7}, ScriptNandinagari},           // Mn   [4] NANDINAGARI VOWEL SIGN U..NANDINAGARI VOWEL SIGN VOCALIC RR
+	{runeRange{0x119E1, 0x119E1}, ScriptNandinagari},           // Lo       NANDINAGARI SIGN AVAGRAHA
+	{runeRange{0x11A00, 0x11A00}, ScriptZanabazarSquare},       // Lo       ZANABAZAR SQUARE LETTER A
+	{runeRange{0x11A39, 0x11A39}, ScriptZanabazarSquare},       // Mc       ZANABAZAR SQUARE SIGN VISARGA
+	{runeRange{0x11A47, 0x11A47}, ScriptZanabazarSquare},       // Mn       ZANABAZAR SQUARE SUBJOINER
+	{runeRange{0x11A59, 0x11A5B}, ScriptSoyombo},               // Mn   [3] SOYOMBO VOWEL SIGN VOCALIC R..SOYOMBO VOWEL LENGTH MARK
+	{runeRange{0x11A98, 0x11A99}, ScriptSoyombo},               // Mn   [2] SOYOMBO GEMINATION MARK..SOYOMBO SUBJOINER
+	{runeRange{0x11AB0, 0x11ABF}, ScriptCanadianAboriginal},    // Lo  [16] CANADIAN SYLLABICS NATTILIK HI..CANADIAN SYLLABICS SPA
+	{runeRange{0x11B61, 0x11B61}, ScriptSharada},               // Mc       SHARADA VOWEL SIGN OOE
+	{runeRange{0x11B67, 0x11B67}, ScriptSharada},               // Mc       SHARADA VOWEL SIGN CANDRA O
+	{runeRange{0x11C00, 0x11C08}, ScriptBhaiksuki},             // Lo   [9] BHAIKSUKI LETTER A..BHAIKSUKI LETTER VOCALIC L
+	{runeRange{0x11C38, 0x11C3D}, ScriptBhaiksuki},             // Mn   [6] BHAIKSUKI VOWEL SIGN E..BHAIKSUKI SIGN ANUSVARA
+	{runeRange{0x11C41, 0x11C45}, ScriptBhaiksuki},             // Po   [5] BHAIKSUKI DANDA..BHAIKSUKI GAP FILLER-2
+	{runeRange{0x11C72, 0x11C8F}, ScriptMarchen},               // Lo  [30] MARCHEN LETTER KA..MARCHEN LETTER A
+	{runeRange{0x11CB1, 0x11CB1}, ScriptMarchen},               // Mc       MARCHEN VOWEL SIGN I
+	{runeRange{0x11D00, 0x11D06}, ScriptMasaramGondi},          // Lo   [7] MASARAM GONDI LETTER A..MASARAM GONDI LETTER E
+	{runeRange{0x11D3A, 0x11D3A}, ScriptMasaramGondi},          // Mn       MASARAM GONDI VOWEL SIGN E
+	{runeRange{0x11D47, 0x11D47}, ScriptMasaramGondi},          // Mn       MASARAM GONDI RA-KARA
+	{runeRange{0x11D6A, 0x11D89}, ScriptGunjalaGondi},          // Lo  [32] GUNJALA GONDI LETTER OO..GUNJALA GONDI LETTER SA
+	{runeRange{0x11D95, 0x11D95}, ScriptGunjalaGondi},          // Mn       GUNJALA GONDI SIGN ANUSVARA
+	{runeRange{0x11DA0, 0x11DA9}, ScriptGunjalaGondi},          // Nd  [10] GUNJALA GONDI DIGIT ZERO..GUNJALA GONDI DIGIT NINE
+	{runeRange{0x11DE0, 0x11DE9}, ScriptTolongSiki},            // Nd  [10] TOLONG SIKI DIGIT ZERO..TOLONG SIKI DIGIT NINE
+	{runeRange{0x11EF7, 0x11EF8}, ScriptMakasar},               // Po   [2] MAKASAR PASSIMBANG..MAKASAR END OF SECTION
+	{runeRange{0x11F04, 0x11F10}, ScriptKawi},                  // Lo  [13] KAWI LETTER A..KAWI LETTER O
+	{runeRange{0x11F3E, 0x11F3F}, ScriptKawi},                  // Mc   [2] KAWI VOWEL SIGN E..KAWI VOWEL SIGN AI
+	{runeRange{0x11F43, 0x11F4F}, ScriptKawi},                  // Po  [13] KAWI DANDA..KAWI PUNCTUATION CLOSING SPIRAL
+	{runeRange{0x11FC0, 0x11FD4}, ScriptTamil},                 // No  [21] TAMIL FRACTION ONE THREE-HUNDRED-AND-TWENTIETH..TAMIL FRACTION DOWNSCALING FACTOR KIIZH
+	{runeRange{0x11FFF, 0x11FFF}, ScriptTamil},                 // Po       TAMIL PUNCTUATION END OF TEXT
+	{runeRange{0x12480, 0x12543}, ScriptCuneiform},             // Lo [196] CUNEIFORM SIGN AB TIMES NUN TENU..CUNEIFORM SIGN ZU5 TIMES THREE DISH TENU
+	{runeRange{0x13430, 0x1343F}, ScriptEgyptianHieroglyphs},   // Cf  [16] EGYPTIAN HIEROGLYPH VERTICAL JOINER..EGYPTIAN HIEROGLYPH END WALLED ENCLOSURE
+	{runeRange{0x13460, 0x143FA}, ScriptEgyptianHieroglyphs},   // Lo [3995] EGYPTIAN HIEROGLYPH-13460..EGYPTIAN HIEROGLYPH-143FA
+	{runeRange{0x1612A, 0x1612C}, ScriptGurungKhema},           // Mc   [3] GURUNG KHEMA CONSONANT SIGN MEDIAL YA..GURUNG KHEMA CONSONANT SIGN MEDIAL HA
+	{runeRange{0x16A40, 0x16A5E}, ScriptMro},                   // Lo  [31] MRO LETTER TA..MRO LETTER TEK
+	{runeRange{0x16AC0, 0x16AC9}, ScriptTangsa},                // Nd  [10] TANGSA DIGIT ZERO..TANGSA DIGIT NINE
+	{runeRange{0x16B00, 0x16B2F}, ScriptPahawhHmong},           // Lo  [48] PAHAWH HMONG VOWEL KEEB..PAHAWH HMONG CONSONANT CAU
+	{runeRange{0x16B40, 0x16B43}, ScriptPahawhHmong},           // Lm   [4] PAHAWH HMONG SIGN VOS SEEV..PAHAWH HMONG SIGN IB YAM
+	{runeRange{0x16B5B, 0x16B61}, ScriptPahawhHmong},           // No   [7] PAHAWH HMONG NUMBER TENS..PAHAWH HMONG NUMBER TRILLIONS
+	{runeRange{0x16D43, 0x16D6A}, ScriptKiratRai},              // Lo  [40] KIRAT RAI LETTER A..KIRAT RAI VOWEL SIGN AU
+	{runeRange{0x16E40, 0x16E5F}, ScriptMedefaidrin},           // Lu  [32] MEDEFAIDRIN CAPITAL LETTER M..MEDEFAIDRIN CAPITAL LETTER Y
+	{runeRange{0x16EA0, 0x16EB8}, ScriptBeriaErfe},             // Lu  [25] BERIA ERFE CAPITAL LETTER ARKAB..BERIA ERFE CAPITAL LETTER AY
+	{runeRange{0x16F50, 0x16F50}, ScriptMiao},                  // Lo       MIAO LETTER NASALIZATION
+	{runeRange{0x16FE0, 0x16FE0}, ScriptTangut},                // Lm       TANGUT ITERATION MARK
+	{runeRange{0x16FE4, 0x16FE4}, ScriptKhitanSmallScript},     // Mn       KHITAN SMALL SCRIPT FILLER
+	{runeRange{0x17000, 0x18AFF}, ScriptTangut},                // Lo [6912] TANGUT IDEOGRAPH-17000..TANGUT COMPONENT-768
+	{runeRange{0x18D80, 0x18DF2}, ScriptTangut},                // Lo [115] TANGUT COMPONENT-769..TANGUT COMPONENT-883
+	{runeRange{0x1B000, 0x1B000}, ScriptKatakana},              // Lo       KATAKANA LETTER ARCHAIC E
+	{runeRange{0x1B150, 0x1B152}, ScriptHiragana},              // Lo   [3] HIRAGANA LETTER SMALL WI..HIRAGANA LETTER SMALL WO
+	{runeRange{0x1BC00, 0x1BC6A}, ScriptDuployan},              // Lo [107] DUPLOYAN LETTER H..DUPLOYAN LETTER VOCALIC M
+	{runeRange{0x1BC9C, 0x1BC9C}, ScriptDuployan},              // So       DUPLOYAN SIGN O WITH CROSS
+	{runeRange{0x1CC00, 0x1CCEF}, ScriptCommon},                // So [240] UP-POINTING GO-KART..OUTLINED LATIN CAPITAL LETTER Z
+	{runeRange{0x1CEBA, 0x1CED0}, ScriptCommon},                // So  [23] FRAGILE SYMBOL..LEUKOTHEA
+	{runeRange{0x1CF30, 0x1CF46}, ScriptInherited},             // Mn  [23] ZNAMENNY COMBINING TONAL RANGE MARK MRACHNO..ZNAMENNY PRIZNAK MODIFIER ROG
+	{runeRange{0x1D129, 0x1D164}, ScriptCommon},                // So  [60] MUSICAL SYMBOL MULTIPLE MEASURE REST..MUSICAL SYMBOL ONE HUNDRED TWENTY-EIGHTH NOTE
+	{runeRange{0x1D16D, 0x1D172}, ScriptCommon},                // Mc   [6] MUSICAL SYMBOL COMBINING AUGMENTATION DOT..MUSICAL SYMBOL COMBINING FLAG-5
+	{runeRange{0x1D185, 0x1D18B}, ScriptInherited},             // Mn   [7] MUSICAL SYMBOL COMBINING DOIT..MUSICAL SYMBOL COMBINING TRIPLE TONGUE
+	{runeRange{0x1D200, 0x1D241}, ScriptGreek},                 // So  [66] GREEK VOCAL NOTATION SYMBOL-1..GREEK INSTRUMENTAL NOTATION SYMBOL-54
+	{runeRange{0x1D2E0, 0x1D2F3}, ScriptCommon},                // No  [20] MAYAN NUMERAL ZERO..MAYAN NUMERAL NINETEEN
+	{runeRange{0x1D41A, 0x1D433}, ScriptCommon},                // Ll  [26] MATHEMATICAL BOLD SMALL A..MATHEMATICAL BOLD SMALL Z
+	{runeRange{0x1D468, 0x1D481}, ScriptCommon},                // Lu  [26] MATHEMATICAL BOLD ITALIC CAPITAL A..MATHEMATICAL BOLD ITALIC CAPITAL Z
+	{runeRange{0x1D4A2, 0x1D4A2}, ScriptCommon},                // Lu       MATHEMATICAL SCRIPT CAPITAL G
+	{runeRange{0x1D4B6, 0x1D4B9}, ScriptCommon},                // Ll   [4] MATHEMATICAL SCRIPT SMALL A..MATHEMATICAL SCRIPT SMALL D
+	{runeRange{0x1D4D0, 0x1D4E9}, ScriptCommon},                // Lu  [26] MATHEMATICAL BOLD SCRIPT CAPITAL A..MATHEMATICAL BOLD SCRIPT CAPITAL Z
+	{runeRange{0x1D50D, 0x1D514}, ScriptCommon},                // Lu   [8] MATHEMATICAL FRAKTUR CAPITAL J..MATHEMATICAL FRAKTUR CAPITAL Q
+	{runeRange{0x1D53B, 0x1D53E}, ScriptCommon},                // Lu   [4] MATHEMATICAL DOUBLE-STRUCK CAPITAL D..MATHEMATICAL DOUBLE-STRUCK CAPITAL G
+	{runeRange{0x1D552, 0x1D56B}, ScriptCommon},                // Ll  [26] MATHEMATICAL DOUBLE-STRUCK SMALL A..MATHEMATICAL DOUBLE-STRUCK SMALL Z
+	{runeRange{0x1D5BA, 0x1D5D3}, ScriptCommon},                // Ll  [26] MATHEMATICAL SANS-SERIF SMALL A..MATHEMATICAL SANS-SERIF SMALL Z
+	{runeRange{0x1D622, 0x1D63B}, ScriptCommon},                // Ll  [26] MATHEMATICAL SANS-SERIF ITALIC SMALL A..MATHEMATICAL SANS-SERIF ITALIC SMALL Z
+	{runeRange{0x1D68A, 0x1D6A5}, ScriptCommon},                // Ll  [28] MATHEMATICAL MONOSPACE SMALL A..MATHEMATICAL ITALIC SMALL DOTLESS J
+	{runeRange{0x1D6DB, 0x1D6DB}, ScriptCommon},                // Sm       MATHEMATICAL BOLD PARTIAL DIFFERENTIAL
+	{runeRange{0x1D6FC, 0x1D714}, ScriptCommon},                // Ll  [25] MATHEMATICAL ITALIC SMALL ALPHA..MATHEMATICAL ITALIC SMALL OMEGA
+	{runeRange{0x1D735, 0x1D735}, ScriptCommon},                // Sm       MATHEMATICAL BOLD ITALIC NABLA
+	{runeRange{0x1D756, 0x1D76E}, ScriptCommon},                // Lu  [25] MATHEMATICAL SANS-SERIF BOLD CAPITAL ALPHA..MATHEMATICAL SANS-SERIF BOLD CAPITAL OMEGA
+	{runeRange{0x1D78A, 0x1D78F}, ScriptCommon},                // Ll   [6] MATHEMATICAL SANS-SERIF BOLD EPSILON SYMBOL..MATHEMATICAL SANS-SERIF BOLD PI SYMBOL
+	{runeRange{0x1D7C3, 0x1D7C3}, ScriptCommon},                // Sm       MATHEMATICAL SANS-SERIF BOLD ITALIC PARTIAL DIFFERENTIAL
+	{runeRange{0x1D7CE, 0x1D7FF}, ScriptCommon},                // Nd  [50] MATHEMATICAL BOLD DIGIT ZERO..MATHEMATICAL MONOSPACE DIGIT NINE
+	{runeRange{0x1DA3B, 0x1DA6C}, ScriptSignWriting},           // Mn  [50] SIGNWRITING MOUTH CLOSED NEUTRAL..SIGNWRITING EXCITEMENT
+	{runeRange{0x1DA84, 0x1DA84}, ScriptSignWriting},           // Mn       SIGNWRITING LOCATION HEAD NECK
+	{runeRange{0x1DAA1, 0x1DAAF}, ScriptSignWriting},           // Mn  [15] SIGNWRITING ROTATION MODIFIER-2..SIGNWRITING ROTATION MODIFIER-16
+	{runeRange{0x1DF25, 0x1DF2A}, ScriptLatin},                 // Ll   [6] LATIN SMALL LETTER D WITH MID-HEIGHT LEFT HOOK..LATIN SMALL LETTER T WITH MID-HEIGHT LEFT HOOK
+	{runeRange{0x1E023, 0x1E024}, ScriptGlagolitic},            // Mn   [2] COMBINING GLAGOLITIC LETTER YU..COMBINING GLAGOLITIC LETTER SMALL YUS
+	{runeRange{0x1E100, 0x1E12C}, ScriptNyiakengPuachueHmong},  // Lo  [45] NYIAKENG PUACHUE HMONG LETTER MA..NYIAKENG PUACHUE HMONG LETTER W
+	{runeRange{0x1E14E, 0x1E14E}, ScriptNyiakengPuachueHmong},  // Lo       NYIAKENG PUACHUE HMONG LOGOGRAM NYAJ
+	{runeRange{0x1E2C0, 0x1E2EB}, ScriptWancho},                // Lo  [44] WANCHO LETTER AA..WANCHO LETTER YIH
+	{runeRange{0x1E4D0, 0x1E4EA}, ScriptNagMundari},            // Lo  [27] NAG MUNDARI LETTER O..NAG MUNDARI LETTER ELL
+	{runeRange{0x1E5D0, 0x1E5ED}, ScriptOlOnal},                // Lo  [30] OL ONAL LETTER O..OL ONAL LETTER EG
+	{runeRange{0x1E5FF, 0x1E5FF}, ScriptOlOnal},                // Po       OL ONAL ABBREVIATION SIGN
+	{runeRange{0x1E6E4, 0x1E6E5}, ScriptTaiYo},                 // Lo   [2] TAI YO LETTER U..TAI YO LETTER AE
+	{runeRange{0x1E6F0, 0x1E6F4}, ScriptTaiYo},                 // Lo   [5] TAI YO LETTER AN..TAI YO LETTER AP
+	{runeRange{0x1E7E0, 0x1E7E6}, ScriptEthiopic},              // Lo   [7] ETHIOPIC SYLLABLE HHYA..ETHIOPIC SYLLABLE HHYO
+	{runeRange{0x1E800, 0x1E8C4}, ScriptMendeKikakui},          // Lo [197] MENDE KIKAKUI SYLLABLE M001 KI..MENDE KIKAKUI SYLLABLE M060 NYON
+	{runeRange{0x1E922, 0x1E943}, ScriptAdlam},                 // Ll  [34] ADLAM SMALL LETTER ALIF..ADLAM SMALL LETTER SHA
+	{runeRange{0x1E95E, 0x1E95F}, ScriptAdlam},                 // Po   [2] ADLAM INITIAL EXCLAMATION MARK..ADLAM INITIAL QUESTION MARK
+	{runeRange{0x1ECB0, 0x1ECB0}, ScriptCommon},                // Sc       INDIC SIYAQ RUPEE MARK
+	{runeRange{0x1ED2F, 0x1ED3D}, ScriptCommon},                // No  [15] OTTOMAN SIYAQ ALTERNATE NUMBER TWO..OTTOMAN SIYAQ FRACTION ONE SIXTH
+	{runeRange{0x1EE24, 0x1EE24}, ScriptArabic},                // Lo       ARABIC MATHEMATICAL INITIAL HEH
+	{runeRange{0x1EE39, 0x1EE39}, ScriptArabic},                // Lo       ARABIC MATHEMATICAL INITIAL DAD
+	{runeRange{0x1EE49, 0x1EE49}, ScriptArabic},                // Lo       ARABIC MATHEMATICAL TAILED YEH
+	{runeRange{0x1EE54, 0x1EE54}, ScriptArabic},                // Lo       ARABIC MATHEMATICAL TAILED SHEEN
+	{runeRange{0x1EE5D, 0x1EE5D}, ScriptArabic},                // Lo       ARABIC MATHEMATICAL TAILED DOTLESS NOON
+	{runeRange{0x1EE67, 0x1EE6A}, ScriptArabic},                // Lo   [4] ARABIC MATHEMATICAL STRETCHED HAH..ARABIC MATHEMATICAL STRETCHED KAF
+	{runeRange{0x1EE7E, 0x1EE7E}, ScriptArabic},                // Lo       ARABIC MATHEMATICAL STRETCHED DOTLESS FEH
+	{runeRange{0x1EEA5, 0x1EEA9}, ScriptArabic},                // Lo   [5] ARABIC MATHEMATICAL DOUBLE-STRUCK WAW..ARABIC MATHEMATICAL DOUBLE-STRUCK YEH
+	{runeRange{0x1F030, 0x1F093}, ScriptCommon},                // So [100] DOMINO TILE HORIZONTAL BACK..DOMINO TILE VERTICAL-06-06
+	{runeRange{0x1F0D1, 0x1F0F5}, ScriptCommon},                // So  [37] PLAYING CARD ACE OF CLUBS..PLAYING CARD TRUMP-21
+	{runeRange{0x1F200, 0x1F200}, ScriptHiragana},              // So       SQUARE HIRAGANA HOKA
+	{runeRange{0x1F250, 0x1F251}, ScriptCommon},                // So   [2] CIRCLED IDEOGRAPH ADVANTAGE..CIRCLED IDEOGRAPH ACCEPT
+	{runeRange{0x1F400, 0x1F6D8}, ScriptCommon},                // So [729] RAT..LANDSLIDE
+	{runeRange{0x1F7E0, 0x1F7EB}, ScriptCommon},                // So  [12] LARGE ORANGE CIRCLE..LARGE BROWN SQUARE
+	{runeRange{0x1F850, 0x1F859}, ScriptCommon},                // So  [10] LEFTWARDS SANS-SERIF ARROW..UP DOWN SANS-SERIF ARROW
+	{runeRange{0x1F8C0, 0x1F8C1}, ScriptCommon},                // So   [2] LEFTWARDS ARROW FROM DOWNWARDS ARROW..RIGHTWARDS ARROW FROM DOWNWARDS ARROW
+	{runeRange{0x1FA70, 0x1FA7C}, ScriptCommon},                // So  [13] BALLET SHOES..CRUTCH
+	{runeRange{0x1FACD, 0x1FADC}, ScriptCommon},                // So  [16] ORCA..ROOT VEGETABLE
+	{runeRange{0x1FB94, 0x1FBEF}, ScriptCommon},                // So  [92] LEFT HALF INVERSE MEDIUM SHADE AND RIGHT HALF BLOCK..TOP LEFT JUSTIFIED LOWER RIGHT QUARTER BLACK CIRCLE
+	{runeRange{0x2A700, 0x2B81D}, ScriptHan},                   // Lo [4382] CJK UNIFIED IDEOGRAPH-2A700..CJK UNIFIED IDEOGRAPH-2B81D
+	{runeRange{0x2F800, 0x2FA1D}, ScriptHan},                   // Lo [542] CJK COMPATIBILITY IDEOGRAPH-2F800..CJK COMPATIBILITY IDEOGRAPH-2FA1D
+	{runeRange{0xE0020, 0xE007F}, ScriptCommon},                // Cf  [96] TAG SPACE..CANCEL TAG
+	{runeRange{0x0020, 0x0020}, ScriptCommon},                  // Zs       SPACE
+	{runeRange{0x0028, 0x0028}, ScriptCommon},                  // Ps       LEFT PARENTHESIS
+	{runeRange{0x002C, 0x002C}, ScriptCommon},                  // Po       COMMA
+	{runeRange{0x003A, 0x003B}, ScriptCommon},                  // Po   [2] COLON..SEMICOLON
+	{runeRange{0x005B, 0x005B}, ScriptCommon},                  // Ps       LEFT SQUARE BRACKET
+	{runeRange{0x005F, 0x005F}, ScriptCommon},                  // Pc       LOW LINE
+	{runeRange{0x007C, 0x007C}, ScriptCommon},                  // Sm       VERTICAL LINE
+	{runeRange{0x00A0, 0x00A0}, ScriptCommon},                  // Zs       NO-BREAK SPACE
+	{runeRange{0x00A7, 0x00A7}, ScriptCommon},                  // Po       SECTION SIGN
+	{runeRange{0x00AB, 0x00AB}, ScriptCommon},                  // Pi       LEFT-POINTING DOUBLE ANGLE QUOTATION MARK
+	{runeRange{0x00AF, 0x00AF}, ScriptCommon},                  // Sk       MACRON
+	{runeRange{0x00B4, 0x00B4}, ScriptCommon},                  // Sk       ACUTE ACCENT
+	{runeRange{0x00B9, 0x00B9}, ScriptCommon},                  // No       SUPERSCRIPT ONE
+	{runeRange{0x00BF, 0x00BF}, ScriptCommon},                  // Po       INVERTED QUESTION MARK
+	{runeRange{0x00DF, 0x00F6}, ScriptLatin},                   // Ll  [24] LATIN SMALL LETTER SHARP S..LATIN SMALL LETTER O WITH DIAERESIS
+	{runeRange{0x0101, 0x0101}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH MACRON
+	{runeRange{0x0105, 0x0105}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH OGONEK
+	{runeRange{0x0109, 0x0109}, ScriptLatin},                   // Ll       LATIN SMALL LETTER C WITH CIRCUMFLEX
+	{runeRange{0x010D, 0x010D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER C WITH CARON
+	{runeRange{0x0111, 0x0111}, ScriptLatin},                   // Ll       LATIN SMALL LETTER D WITH STROKE
+	{runeRange{0x0115, 0x0115}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH BREVE
+	{runeRange{0x0119, 0x0119}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH OGONEK
+	{runeRange{0x011D, 0x011D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER G WITH CIRCUMFLEX
+	{runeRange{0x0121, 0x0121}, ScriptLatin},                   // Ll       LATIN SMALL LETTER G WITH DOT ABOVE
+	{runeRange{0x0125, 0x0125}, ScriptLatin},                   // Ll       LATIN SMALL LETTER H WITH CIRCUMFLEX
+	{runeRange{0x0129, 0x0129}, ScriptLatin},                   // Ll       LATIN SMALL LETTER I WITH TILDE
+	{runeRange{0x012D, 0x012D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER I WITH BREVE
+	{runeRange{0x0131, 0x0131}, ScriptLatin},                   // Ll       LATIN SMALL LETTER DOTLESS I
+	{runeRange{0x0135, 0x0135}, ScriptLatin},                   // Ll       LATIN SMALL LETTER J WITH CIRCUMFLEX
+	{runeRange{0x013A, 0x013A}, ScriptLatin},                   // Ll       LATIN SMALL LETTER L WITH ACUTE
+	{runeRange{0x013E, 0x013E}, ScriptLatin},                   // Ll       LATIN SMALL LETTER L WITH CARON
+	{runeRange{0x0142, 0x0142}, ScriptLatin},                   // Ll       LATIN SMALL LETTER L WITH STROKE
+	{runeRange{0x0146, 0x0146}, ScriptLatin},                   // Ll       LATIN SMALL LETTER N WITH CEDILLA
+	{runeRange{0x014B, 0x014B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER ENG
+	{runeRange{0x014F, 0x014F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH BREVE
+	{runeRange{0x0153, 0x0153}, ScriptLatin},                   // Ll       LATIN SMALL LIGATURE OE
+	{runeRange{0x0157, 0x0157}, ScriptLatin},                   // Ll       LATIN SMALL LETTER R WITH CEDILLA
+	{runeRange{0x015B, 0x015B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER S WITH ACUTE
+	{runeRange{0x015F, 0x015F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER S WITH CEDILLA
+	{runeRange{0x0163, 0x0163}, ScriptLatin},                   // Ll       LATIN SMALL LETTER T WITH CEDILLA
+	{runeRange{0x0167, 0x0167}, ScriptLatin},                   // Ll       LATIN SMALL LETTER T WITH STROKE
+	{runeRange{0x016B, 0x016B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH MACRON
+	{runeRange{0x016F, 0x016F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH RING ABOVE
+	{runeRange{0x0173, 0x0173}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH OGONEK
+	{runeRange{0x0177, 0x0177}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Y WITH CIRCUMFLEX
+	{runeRange{0x017C, 0x017C}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Z WITH DOT ABOVE
+	{runeRange{0x0183, 0x0183}, ScriptLatin},                   // Ll       LATIN SMALL LETTER B WITH TOPBAR
+	{runeRange{0x0188, 0x0188}, ScriptLatin},                   // Ll       LATIN SMALL LETTER C WITH HOOK
+	{runeRange{0x0192, 0x0192}, ScriptLatin},                   // Ll       LATIN SMALL LETTER F WITH HOOK
+	{runeRange{0x0199, 0x019B}, ScriptLatin},                   // Ll   [3] LATIN SMALL LETTER K WITH HOOK..LATIN SMALL LETTER LAMBDA WITH STROKE
+	{runeRange{0x01A1, 0x01A1}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH HORN
+	{runeRange{0x01A5, 0x01A5}, ScriptLatin},                   // Ll       LATIN SMALL LETTER P WITH HOOK
+	{runeRange{0x01AA, 0x01AB}, ScriptLatin},                   // Ll   [2] LATIN LETTER REVERSED ESH LOOP..LATIN SMALL LETTER T WITH PALATAL HOOK
+	{runeRange{0x01B0, 0x01B0}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH HORN
+	{runeRange{0x01B6, 0x01B6}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Z WITH STROKE
+	{runeRange{0x01BC, 0x01BC}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER TONE FIVE
+	{runeRange{0x01C5, 0x01C5}, ScriptLatin},                   // Lt       LATIN CAPITAL LETTER D WITH SMALL LETTER Z WITH CARON
+	{runeRange{0x01C9, 0x01C9}, ScriptLatin},                   // Ll       LATIN SMALL LETTER LJ
+	{runeRange{0x01CD, 0x01CD}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH CARON
+	{runeRange{0x01D1, 0x01D1}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH CARON
+	{runeRange{0x01D5, 0x01D5}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH DIAERESIS AND MACRON
+	{runeRange{0x01D9, 0x01D9}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH DIAERESIS AND CARON
+	{runeRange{0x01DE, 0x01DE}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH DIAERESIS AND MACRON
+	{runeRange{0x01E2, 0x01E2}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER AE WITH MACRON
+	{runeRange{0x01E6, 0x01E6}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER G WITH CARON
+	{runeRange{0x01EA, 0x01EA}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH OGONEK
+	{runeRange{0x01EE, 0x01EE}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER EZH WITH CARON
+	{runeRange{0x01F3, 0x01F3}, ScriptLatin},                   // Ll       LATIN SMALL LETTER DZ
+	{runeRange{0x01F9, 0x01F9}, ScriptLatin},                   // Ll       LATIN SMALL LETTER N WITH GRAVE
+	{runeRange{0x01FD, 0x01FD}, ScriptLatin},                   // Ll       LATIN SMALL LETTER AE WITH ACUTE
+	{runeRange{0x0201, 0x0201}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH DOUBLE GRAVE
+	{runeRange{0x0205, 0x0205}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH DOUBLE GRAVE
+	{runeRange{0x0209, 0x0209}, ScriptLatin},                   // Ll       LATIN SMALL LETTER I WITH DOUBLE GRAVE
+	{runeRange{0x020D, 0x020D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH DOUBLE GRAVE
+	{runeRange{0x0211, 0x0211}, ScriptLatin},                   // Ll       LATIN SMALL LETTER R WITH DOUBLE GRAVE
+	{runeRange{0x0215, 0x0215}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH DOUBLE GRAVE
+	{runeRange{0x0219, 0x0219}, ScriptLatin},                   // Ll       LATIN SMALL LETTER S WITH COMMA BELOW
+	{runeRange{0x021D, 0x021D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER YOGH
+	{runeRange{0x0221, 0x0221}, ScriptLatin},                   // Ll       LATIN SMALL LETTER D WITH CURL
+	{runeRange{0x0225, 0x0225}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Z WITH HOOK
+	{runeRange{0x0229, 0x0229}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH CEDILLA
+	{runeRange{0x022D, 0x022D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH TILDE AND MACRON
+	{runeRange{0x0231, 0x0231}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH DOT ABOVE AND MACRON
+	{runeRange{0x023C, 0x023C}, ScriptLatin},                   // Ll       LATIN SMALL LETTER C WITH STROKE
+	{runeRange{0x0242, 0x0242}, ScriptLatin},                   // Ll       LATIN SMALL LETTER GLOTTAL STOP
+	{runeRange{0x0249, 0x0249}, ScriptLatin},                   // Ll       LATIN SMALL LETTER J WITH STROKE
+	{runeRange{0x024D, 0x024D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER R WITH STROKE
+	{runeRange{0x0296, 0x02AF}, ScriptLatin},                   // Ll  [26] LATIN LETTER INVERTED GLOTTAL STOP..LATIN SMALL LETTER TURNED H WITH FISHHOOK AND TAIL
+	{runeRange{0x02C6, 0x02D1}, ScriptCommon},                  // Lm  [12] MODIFIER LETTER CIRCUMFLEX ACCENT..MODIFIER LETTER HALF TRIANGULAR COLON
+	{runeRange{0x02EA, 0x02EB}, ScriptBopomofo},                // Sk   [2] MODIFIER LETTER YIN DEPARTING TONE MARK..MODIFIER LETTER YANG DEPARTING TONE MARK
+	{runeRange{0x02EF, 0x02FF}, ScriptCommon},                  // Sk  [17] MODIFIER LETTER LOW DOWN ARROWHEAD..MODIFIER LETTER LOW LEFT ARROW
+	{runeRange{0x0372, 0x0372}, ScriptGreek},                   // Lu       GREEK CAPITAL LETTER ARCHAIC SAMPI
+	{runeRange{0x0376, 0x0376}, ScriptGreek},                   // Lu       GREEK CAPITAL LETTER PAMPHYLIAN DIGAMMA
+	{runeRange{0x037E, 0x037E}, ScriptCommon},                  // Po       GREEK QUESTION MARK
+	{runeRange{0x0386, 0x0386}, ScriptGreek},                   // Lu       GREEK CAPITAL LETTER ALPHA WITH TONOS
+	{runeRange{0x038E, 0x038F}, ScriptGreek},                   // Lu   [2] GREEK CAPITAL LETTER UPSILON WITH TONOS..GREEK CAPITAL LETTER OMEGA WITH TONOS
+	{runeRange{0x03AC, 0x03CE}, ScriptGreek},                   // Ll  [35] GREEK SMALL LETTER ALPHA WITH TONOS..GREEK SMALL LETTER OMEGA WITH TONOS
+	{runeRange{0x03D5, 0x03D7}, ScriptGreek},                   // Ll   [3] GREEK PHI SYMBOL..GREEK KAI SYMBOL
+	{runeRange{0x03DB, 0x03DB}, ScriptGreek},                   // Ll       GREEK SMALL LETTER STIGMA
+	{runeRange{0x03DF, 0x03DF}, ScriptGreek},                   // Ll       GREEK SMALL LETTER KOPPA
+	{runeRange{0x03E3, 0x03E3}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER SHEI
+	{runeRange{0x03E7, 0x03E7}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER KHEI
+	{runeRange{0x03EB, 0x03EB}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER GANGIA
+	{runeRange{0x03EF, 0x03EF}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER DEI
+	{runeRange{0x03F6, 0x03F6}, ScriptGreek},                   // Sm       GREEK REVERSED LUNATE EPSILON SYMBOL
+	{runeRange{0x03FB, 0x03FC}, ScriptGreek},                   // Ll   [2] GREEK SMALL LETTER SAN..GREEK RHO WITH STROKE SYMBOL
+	{runeRange{0x0460, 0x0460}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER OMEGA
+	{runeRange{0x0464, 0x0464}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER IOTIFIED E
+	{runeRange{0x0468, 0x0468}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER IOTIFIED LITTLE YUS
+	{runeRange{0x046C, 0x046C}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER IOTIFIED BIG YUS
+	{runeRange{0x0470, 0x0470}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER PSI
+	{runeRange{0x0474, 0x0474}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER IZHITSA
+	{runeRange{0x0478, 0x0478}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER UK
+	{runeRange{0x047C, 0x047C}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER OMEGA WITH TITLO
+	{runeRange{0x0480, 0x0480}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER KOPPA
+	{runeRange{0x0485, 0x0486}, ScriptInherited},               // Mn   [2] COMBINING CYRILLIC DASIA PNEUMATA..COMBINING CYRILLIC PSILI PNEUMATA
+	{runeRange{0x048B, 0x048B}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER SHORT I WITH TAIL
+	{runeRange{0x048F, 0x048F}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER ER WITH TICK
+	{runeRange{0x0493, 0x0493}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER GHE WITH STROKE
+	{runeRange{0x0497, 0x0497}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER ZHE WITH DESCENDER
+	{runeRange{0x049B, 0x049B}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER KA WITH DESCENDER
+	{runeRange{0x049F, 0x049F}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER KA WITH STROKE
+	{runeRange{0x04A3, 0x04A3}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER EN WITH DESCENDER
+	{runeRange{0x04A7, 0x04A7}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER PE WITH MIDDLE HOOK
+	{runeRange{0x04AB, 0x04AB}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER ES WITH DESCENDER
+	{runeRange{0x04AF, 0x04AF}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER STRAIGHT U
+	{runeRange{0x04B3, 0x04B3}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER HA WITH DESCENDER
+	{runeRange{0x04B7, 0x04B7}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER CHE WITH DESCENDER
+	{runeRange{0x04BB, 0x04BB}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER SHHA
+	{runeRange{0x04BF, 0x04BF}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER ABKHASIAN CHE WITH DESCENDER
+	{runeRange{0x04C4, 0x04C4}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER KA WITH HOOK
+	{runeRange{0x04C8, 0x04C8}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER EN WITH HOOK
+	{runeRange{0x04CC, 0x04CC}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER KHAKASSIAN CHE
+	{runeRange{0x04D1, 0x04D1}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER A WITH BREVE
+	{runeRange{0x04D5, 0x04D5}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LIGATURE A IE
+	{runeRange{0x04D9, 0x04D9}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER SCHWA
+	{runeRange{0x04DD, 0x04DD}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER ZHE WITH DIAERESIS
+	{runeRange{0x04E1, 0x04E1}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER ABKHASIAN DZE
+	{runeRange{0x04E5, 0x04E5}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER I WITH DIAERESIS
+	{runeRange{0x04E9, 0x04E9}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER BARRED O
+	{runeRange{0x04ED, 0x04ED}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER E WITH DIAERESIS
+	{runeRange{0x04F1, 0x04F1}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER U WITH DIAERESIS
+	{runeRange{0x04F5, 0x04F5}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER CHE WITH DIAERESIS
+	{runeRange{0x04F9, 0x04F9}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER YERU WITH DIAERESIS
+	{runeRange{0x04FD, 0x04FD}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER HA WITH HOOK
+	{runeRange{0x0501, 0x0501}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER KOMI DE
+	{runeRange{0x0505, 0x0505}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER KOMI ZJE
+	{runeRange{0x0509, 0x0509}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER KOMI LJE
+	{runeRange{0x050D, 0x050D}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER KOMI SJE
+	{runeRange{0x0511, 0x0511}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER REVERSED ZE
+	{runeRange{0x0515, 0x0515}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER LHA
+	{runeRange{0x0519, 0x0519}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER YAE
+	{runeRange{0x051D, 0x051D}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER WE
+	{runeRange{0x0521, 0x0521}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER EL WITH MIDDLE HOOK
+	{runeRange{0x0525, 0x0525}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER PE WITH DESCENDER
+	{runeRange{0x0529, 0x0529}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER EN WITH LEFT HOOK
+	{runeRange{0x052D, 0x052D}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER DCHE
+	{runeRange{0x0559, 0x0559}, ScriptArmenian},                // Lm       ARMENIAN MODIFIER LETTER LEFT HALF RING
+	{runeRange{0x058A, 0x058A}, ScriptArmenian},                // Pd       ARMENIAN HYPHEN
+	{runeRange{0x05BE, 0x05BE}, ScriptHebrew},                  // Pd       HEBREW PUNCTUATION MAQAF
+	{runeRange{0x05C3, 0x05C3}, ScriptHebrew},                  // Po       HEBREW PUNCTUATION SOF PASUQ
+	{runeRange{0x05D0, 0x05EA}, ScriptHebrew},                  // Lo  [27] HEBREW LETTER ALEF..HEBREW LETTER TAV
+	{runeRange{0x0605, 0x0605}, ScriptCommon},                  // Cf       ARABIC NUMBER MARK ABOVE
+	{runeRange{0x060C, 0x060C}, ScriptCommon},                  // Po       ARABIC COMMA
+	{runeRange{0x061B, 0x061B}, ScriptCommon},                  // Po       ARABIC SEMICOLON
+	{runeRange{0x0620, 0x063F}, ScriptArabic},                  // Lo  [32] ARABIC LETTER KASHMIRI YEH..ARABIC LETTER FARSI YEH WITH THREE DOTS ABOVE
+	{runeRange{0x0656, 0x065F}, ScriptArabic},                  // Mn  [10] ARABIC SUBSCRIPT ALEF..ARABIC WAVY HAMZA BELOW
+	{runeRange{0x0670, 0x0670}, ScriptInherited},               // Mn       ARABIC LETTER SUPERSCRIPT ALEF
+	{runeRange{0x06D6, 0x06DC}, ScriptArabic},                  // Mn   [7] ARABIC SMALL HIGH LIGATURE SAD WITH LAM WITH ALEF MAKSURA..ARABIC SMALL HIGH SEEN
+	{runeRange{0x06E5, 0x06E6}, ScriptArabic},                  // Lm   [2] ARABIC SMALL WAW..ARABIC SMALL YEH
+	{runeRange{0x06EE, 0x06EF}, ScriptArabic},                  // Lo   [2] ARABIC LETTER DAL WITH INVERTED V..ARABIC LETTER REH WITH INVERTED V
+	{runeRange{0x06FF, 0x06FF}, ScriptArabic},                  // Lo       ARABIC LETTER HEH WITH INVERTED V
+	{runeRange{0x0711, 0x0711}, ScriptSyriac},                  // Mn       SYRIAC LETTER SUPERSCRIPT ALAPH
+	{runeRange{0x0750, 0x077F}, ScriptArabic},                  // Lo  [48] ARABIC LETTER BEH WITH THREE DOTS HORIZONTALLY BELOW..ARABIC LETTER KAF WITH TWO DOTS ABOVE
+	{runeRange{0x07C0, 0x07C9}, ScriptNko},                     // Nd  [10] NKO DIGIT ZERO..NKO DIGIT NINE
+	{runeRange{0x07F6, 0x07F6}, ScriptNko},                     // So       NKO SYMBOL OO DENNEN
+	{runeRange{0x07FE, 0x07FF}, ScriptNko},                     // Sc   [2] NKO DOROME SIGN..NKO TAMAN SIGN
+	{runeRange{0x081B, 0x0823}, ScriptSamaritan},               // Mn   [9] SAMARITAN MARK EPENTHETIC YUT..SAMARITAN VOWEL SIGN A
+	{runeRange{0x0829, 0x082D}, ScriptSamaritan},               // Mn   [5] SAMARITAN VOWEL SIGN LONG I..SAMARITAN MARK NEQUDAA
+	{runeRange{0x085E, 0x085E}, ScriptMandaic},                 // Po       MANDAIC PUNCTUATION
+	{runeRange{0x0889, 0x088F}, ScriptArabic},                  // Lo   [7] ARABIC LETTER NOON WITH INVERTED SMALL V..ARABIC LETTER NOON WITH RING ABOVE
+	{runeRange{0x08C9, 0x08C9}, ScriptArabic},                  // Lm       ARABIC SMALL FARSI YEH
+	{runeRange{0x0900, 0x0902}, ScriptDevanagari},              // Mn   [3] DEVANAGARI SIGN INVERTED CANDRABINDU..DEVANAGARI SIGN ANUSVARA
+	{runeRange{0x093B, 0x093B}, ScriptDevanagari},              // Mc       DEVANAGARI VOWEL SIGN OOE
+	{runeRange{0x0941, 0x0948}, ScriptDevanagari},              // Mn   [8] DEVANAGARI VOWEL SIGN U..DEVANAGARI VOWEL SIGN AI
+	{runeRange{0x0950, 0x0950}, ScriptDevanagari},              // Lo       DEVANAGARI OM
+	{runeRange{0x0962, 0x0963}, ScriptDevanagari},              // Mn   [2] DEVANAGARI VOWEL SIGN VOCALIC L..DEVANAGARI VOWEL SIGN VOCALIC LL
+	{runeRange{0x0971, 0x0971}, ScriptDevanagari},              // Lm       DEVANAGARI SIGN HIGH SPACING DOT
+	{runeRange{0x0982, 0x0983}, ScriptBengali},                 // Mc   [2] BENGALI SIGN ANUSVARA..BENGALI SIGN VISARGA
+	{runeRange{0x09AA, 0x09B0}, ScriptBengali},                 // Lo   [7] BENGALI LETTER PA..BENGALI LETTER RA
+	{runeRange{0x09BD, 0x09BD}, ScriptBengali},                 // Lo       BENGALI SIGN AVAGRAHA
+	{runeRange{0x09CB, 0x09CC}, ScriptBengali},                 // Mc   [2] BENGALI VOWEL SIGN O..BENGALI VOWEL SIGN AU
+	{runeRange{0x09DC, 0x09DD}, ScriptBengali},                 // Lo   [2] BENGALI LETTER RRA..BENGALI LETTER RHA
+	{runeRange{0x09F0, 0x09F1}, ScriptBengali},                 // Lo   [2] BENGALI LETTER RA WITH MIDDLE DIAGONAL..BENGALI LETTER RA WITH LOWER DIAGONAL
+	{runeRange{0x09FB, 0x09FB}, ScriptBengali},                 // Sc       BENGALI GANDA MARK
+	{runeRange{0x0A01, 0x0A02}, ScriptGurmukhi},                // Mn   [2] GURMUKHI SIGN ADAK BINDI..GURMUKHI SIGN BINDI
+	{runeRange{0x0A13, 0x0A28}, ScriptGurmukhi},                // Lo  [22] GURMUKHI LETTER OO..GURMUKHI LETTER NA
+	{runeRange{0x0A38, 0x0A39}, ScriptGurmukhi},                // Lo   [2] GURMUKHI LETTER SA..GURMUKHI LETTER HA
+	{runeRange{0x0A47, 0x0A48}, ScriptGurmukhi},                // Mn   [2] GURMUKHI VOWEL SIGN EE..GURMUKHI VOWEL SIGN AI
+	{runeRange{0x0A5E, 0x0A5E}, ScriptGurmukhi},                // Lo       GURMUKHI LETTER FA
+	{runeRange{0x0A75, 0x0A75}, ScriptGurmukhi},                // Mn       GURMUKHI SIGN YAKASH
+	{runeRange{0x0A85, 0x0A8D}, ScriptGujarati},                // Lo   [9] GUJARATI LETTER A..GUJARATI VOWEL CANDRA E
+	{runeRange{0x0AB2, 0x0AB3}, ScriptGujarati},                // Lo   [2] GUJARATI LETTER LA..GUJARATI LETTER LLA
+	{runeRange{0x0ABE, 0x0AC0}, ScriptGujarati},                // Mc   [3] GUJARATI VOWEL SIGN AA..GUJARATI VOWEL SIGN II
+	{runeRange{0x0ACB, 0x0ACC}, ScriptGujarati},                // Mc   [2] GUJARATI VOWEL SIGN O..GUJARATI VOWEL SIGN AU
+	{runeRange{0x0AE2, 0x0AE3}, ScriptGujarati},                // Mn   [2] GUJARATI VOWEL SIGN VOCALIC L..GUJARATI VOWEL SIGN VOCALIC LL
+	{runeRange{0x0AF9, 0x0AF9}, ScriptGujarati},                // Lo       GUJARATI LETTER ZHA
+	{runeRange{0x0B05, 0x0B0C}, ScriptOriya},                   // Lo   [8] ORIYA LETTER A..ORIYA LETTER VOCALIC L
+	{runeRange{0x0B32, 0x0B33}, ScriptOriya},                   // Lo   [2] ORIYA LETTER LA..ORIYA LETTER LLA
+	{runeRange{0x0B3E, 0x0B3E}, ScriptOriya},                   // Mc       ORIYA VOWEL SIGN AA
+	{runeRange{0x0B47, 0x0B48}, ScriptOriya},                   // Mc   [2] ORIYA VOWEL SIGN E..ORIYA VOWEL SIGN AI
+	{runeRange{0x0B57, 0x0B57}, ScriptOriya},                   // Mc       ORIYA AU LENGTH MARK
+	{runeRange{0x0B66, 0x0B6F}, ScriptOriya},                   // Nd  [10] ORIYA DIGIT ZERO..ORIYA DIGIT NINE
+	{runeRange{0x0B82, 0x0B82}, ScriptTamil},                   // Mn       TAMIL SIGN ANUSVARA
+	{runeRange{0x0B92, 0x0B95}, ScriptTamil},                   // Lo   [4] TAMIL LETTER O..TAMIL LETTER KA
+	{runeRange{0x0BA3, 0x0BA4}, ScriptTamil},                   // Lo   [2] TAMIL LETTER NNA..TAMIL LETTER TA
+	{runeRange{0x0BC0, 0x0BC0}, ScriptTamil},                   // Mn       TAMIL VOWEL SIGN II
+	{runeRange{0x0BCD, 0x0BCD}, ScriptTamil},                   // Mn       TAMIL SIGN VIRAMA
+	{runeRange{0x0BF0, 0x0BF2}, ScriptTamil},                   // No   [3] TAMIL NUMBER TEN..TAMIL NUMBER ONE THOUSAND
+	{runeRange{0x0C00, 0x0C00}, ScriptTelugu},                  // Mn       TELUGU SIGN COMBINING CANDRABINDU ABOVE
+	{runeRange{0x0C0E, 0x0C10}, ScriptTelugu},                  // Lo   [3] TELUGU LETTER E..TELUGU LETTER AI
+	{runeRange{0x0C3D, 0x0C3D}, ScriptTelugu},                  // Lo       TELUGU SIGN AVAGRAHA
+	{runeRange{0x0C4A, 0x0C4D}, ScriptTelugu},                  // Mn   [4] TELUGU VOWEL SIGN O..TELUGU SIGN VIRAMA
+	{runeRange{0x0C60, 0x0C61}, ScriptTelugu},                  // Lo   [2] TELUGU LETTER VOCALIC RR..TELUGU LETTER VOCALIC LL
+	{runeRange{0x0C78, 0x0C7E}, ScriptTelugu},                  // No   [7] TELUGU FRACTION DIGIT ZERO FOR ODD POWERS OF FOUR..TELUGU FRACTION DIGIT THREE FOR EVEN POWERS OF FOUR
+	{runeRange{0x0C82, 0x0C83}, ScriptKannada},                 // Mc   [2] KANNADA SIGN ANUSVARA..KANNADA SIGN VISARGA
+	{runeRange{0x0C92, 0x0CA8}, ScriptKannada},                 // Lo  [23] KANNADA LETTER O..KANNADA LETTER NA
+	{runeRange{0x0CBD, 0x0CBD}, ScriptKannada},                 // Lo       KANNADA SIGN AVAGRAHA
+	{runeRange{0x0CC6, 0x0CC6}, ScriptKannada},                 // Mn       KANNADA VOWEL SIGN E
+	{runeRange{0x0CD5, 0x0CD6}, ScriptKannada},                 // Mc   [2] KANNADA LENGTH MARK..KANNADA AI LENGTH MARK
+	{runeRange{0x0CE6, 0x0CEF}, ScriptKannada},                 // Nd  [10] KANNADA DIGIT ZERO..KANNADA DIGIT NINE
+	{runeRange{0x0D02, 0x0D03}, ScriptMalayalam},               // Mc   [2] MALAYALAM SIGN ANUSVARA..MALAYALAM SIGN VISARGA
+	{runeRange{0x0D3B, 0x0D3C}, ScriptMalayalam},               // Mn   [2] MALAYALAM SIGN VERTICAL BAR VIRAMA..MALAYALAM SIGN CIRCULAR VIRAMA
+	{runeRange{0x0D46, 0x0D48}, ScriptMalayalam},               // Mc   [3] MALAYALAM VOWEL SIGN E..MALAYALAM VOWEL SIGN AI
+	{runeRange{0x0D4F, 0x0D4F}, ScriptMalayalam},               // So       MALAYALAM SIGN PARA
+	{runeRange{0x0D5F, 0x0D61}, ScriptMalayalam},               // Lo   [3] MALAYALAM LETTER ARCHAIC II..MALAYALAM LETTER VOCALIC LL
+	{runeRange{0x0D79, 0x0D79}, ScriptMalayalam},               // So       MALAYALAM DATE MARK
+	{runeRange{0x0D85, 0x0D96}, ScriptSinhala},                 // Lo  [18] SINHALA LETTER AYANNA..SINHALA LETTER AUYANNA
+	{runeRange{0x0DC0, 0x0DC6}, ScriptSinhala},                 // Lo   [7] SINHALA LETTER VAYANNA..SINHALA LETTER FAYANNA
+	{runeRange{0x0DD6, 0x0DD6}, ScriptSinhala},                 // Mn       SINHALA VOWEL SIGN DIGA PAA-PILLA
+	{runeRange{0x0DF4, 0x0DF4}, ScriptSinhala},                 // Po       SINHALA PUNCTUATION KUNDDALIYA
+	{runeRange{0x0E34, 0x0E3A}, ScriptThai},                    // Mn   [7] THAI CHARACTER SARA I..THAI CHARACTER PHINTHU
+	{runeRange{0x0E47, 0x0E4E}, ScriptThai},                    // Mn   [8] THAI CHARACTER MAITAIKHU..THAI CHARACTER YAMAKKAN
+	{runeRange{0x0E81, 0x0E82}, ScriptLao},                     // Lo   [2] LAO LETTER KO..LAO LETTER KHO SUNG
+	{runeRange{0x0EA5, 0x0EA5}, ScriptLao},                     // Lo       LAO LETTER LO LOOT
+	{runeRange{0x0EB4, 0x0EBC}, ScriptLao},                     // Mn   [9] LAO VOWEL SIGN I..LAO SEMIVOWEL SIGN LO
+	{runeRange{0x0EC8, 0x0ECE}, ScriptLao},                     // Mn   [7] LAO TONE MAI EK..LAO YAMAKKAN
+	{runeRange{0x0F01, 0x0F03}, ScriptTibetan},                 // So   [3] TIBETAN MARK GTER YIG MGO TRUNCATED A..TIBETAN MARK GTER YIG MGO -UM GTER TSHEG MA
+	{runeRange{0x0F15, 0x0F17}, ScriptTibetan},                 // So   [3] TIBETAN LOGOTYPE SIGN CHAD RTAGS..TIBETAN ASTROLOGICAL SIGN SGRA GCAN -CHAR RTAGS
+	{runeRange{0x0F2A, 0x0F33}, ScriptTibetan},                 // No  [10] TIBETAN DIGIT HALF ONE..TIBETAN DIGIT HALF ZERO
+	{runeRange{0x0F37, 0x0F37}, ScriptTibetan},                 // Mn       TIBETAN MARK NGAS BZUNG SGOR RTAGS
+	{runeRange{0x0F3B, 0x0F3B}, ScriptTibetan},                 // Pe       TIBETAN MARK GUG RTAGS GYAS
+	{runeRange{0x0F40, 0x0F47}, ScriptTibetan},                 // Lo   [8] TIBETAN LETTER KA..TIBETAN LETTER JA
+	{runeRange{0x0F80, 0x0F84}, ScriptTibetan},                 // Mn   [5] TIBETAN VOWEL SIGN REVERSED I..TIBETAN MARK HALANTA
+	{runeRange{0x0F8D, 0x0F97}, ScriptTibetan},                 // Mn  [11] TIBETAN SUBJOINED SIGN LCE TSA CAN..TIBETAN SUBJOINED LETTER JA
+	{runeRange{0x0FC7, 0x0FCC}, ScriptTibetan},                 // So   [6] TIBETAN SYMBOL RDO RJE RGYA GRAM..TIBETAN SYMBOL NOR BU BZHI -KHYIL
+	{runeRange{0x0FD9, 0x0FDA}, ScriptTibetan},                 // Po   [2] TIBETAN MARK LEADING MCHAN RTAGS..TIBETAN MARK TRAILING MCHAN RTAGS
+	{runeRange{0x1031, 0x1031}, ScriptMyanmar},                 // Mc       MYANMAR VOWEL SIGN E
+	{runeRange{0x103B, 0x103C}, ScriptMyanmar},                 // Mc   [2] MYANMAR CONSONANT SIGN MEDIAL YA..MYANMAR CONSONANT SIGN MEDIAL RA
+	{runeRange{0x104A, 0x104F}, ScriptMyanmar},                 // Po   [6] MYANMAR SIGN LITTLE SECTION..MYANMAR SYMBOL GENITIVE
+	{runeRange{0x105A, 0x105D}, ScriptMyanmar},                 // Lo   [4] MYANMAR LETTER MON NGA..MYANMAR LETTER MON BBE
+	{runeRange{0x1065, 0x1066}, ScriptMyanmar},                 // Lo   [2] MYANMAR LETTER WESTERN PWO KAREN THA..MYANMAR LETTER WESTERN PWO KAREN PWA
+	{runeRange{0x1075, 0x1081}, ScriptMyanmar},                 // Lo  [13] MYANMAR LETTER SHAN KA..MYANMAR LETTER SHAN HA
+	{runeRange{0x1087, 0x108C}, ScriptMyanmar},                 // Mc   [6] MYANMAR SIGN SHAN TONE-2..MYANMAR SIGN SHAN COUNCIL TONE-3
+	{runeRange{0x1090, 0x1099}, ScriptMyanmar},                 // Nd  [10] MYANMAR SHAN DIGIT ZERO..MYANMAR SHAN DIGIT NINE
+	{runeRange{0x10A0, 0x10C5}, ScriptGeorgian},                // Lu  [38] GEORGIAN CAPITAL LETTER AN..GEORGIAN CAPITAL LETTER HOE
+	{runeRange{0x10FB, 0x10FB}, ScriptCommon},                  // Po       GEORGIAN PARAGRAPH SEPARATOR
+	{runeRange{0x1200, 0x1248}, ScriptEthiopic},                // Lo  [73] ETHIOPIC SYLLABLE HA..ETHIOPIC SYLLABLE QWA
+	{runeRange{0x125A, 0x125D}, ScriptEthiopic},                // Lo   [4] ETHIOPIC SYLLABLE QHWI..ETHIOPIC SYLLABLE QHWE
+	{runeRange{0x12B2, 0x12B5}, ScriptEthiopic},                // Lo   [4] ETHIOPIC SYLLABLE KWI..ETHIOPIC SYLLABLE KWE
+	{runeRange{0x12C8, 0x12D6}, ScriptEthiopic},                // Lo  [15] ETHIOPIC SYLLABLE WA..ETHIOPIC SYLLABLE PHARYNGEAL O
+	{runeRange{0x135D, 0x135F}, ScriptEthiopic},                // Mn   [3] ETHIOPIC COMBINING GEMINATION AND VOWEL LENGTH MARK..ETHIOPIC COMBINING GEMINATION MARK
+	{runeRange{0x1390, 0x1399}, ScriptEthiopic},                // So  [10] ETHIOPIC TONAL MARK YIZET..ETHIOPIC TONAL MARK KURT
+	{runeRange{0x1401, 0x166C}, ScriptCanadianAboriginal},      // Lo [620] CANADIAN SYLLABICS E..CANADIAN SYLLABICS CARRIER TTSA
+	{runeRange{0x1680, 0x1680}, ScriptOgham},                   // Zs       OGHAM SPACE MARK
+	{runeRange{0x16A0, 0x16EA}, ScriptRunic},                   // Lo  [75] RUNIC LETTER FEHU FEOH FE F..RUNIC LETTER X
+	{runeRange{0x1700, 0x1711}, ScriptTagalog},                 // Lo  [18] TAGALOG LETTER A..TAGALOG LETTER HA
+	{runeRange{0x1720, 0x1731}, ScriptHanunoo},                 // Lo  [18] HANUNOO LETTER A..HANUNOO LETTER HA
+	{runeRange{0x1740, 0x1751}, ScriptBuhid},                   // Lo  [18] BUHID LETTER A..BUHID LETTER HA
+	{runeRange{0x1772, 0x1773}, ScriptTagbanwa},                // Mn   [2] TAGBANWA VOWEL SIGN I..TAGBANWA VOWEL SIGN U
+	{runeRange{0x17B7, 0x17BD}, ScriptKhmer},                   // Mn   [7] KHMER VOWEL SIGN I..KHMER VOWEL SIGN UA
+	{runeRange{0x17C9, 0x17D3}, ScriptKhmer},                   // Mn  [11] KHMER SIGN MUUSIKATOAN..KHMER SIGN BATHAMASAT
+	{runeRange{0x17DB, 0x17DB}, ScriptKhmer},                   // Sc       KHMER CURRENCY SYMBOL RIEL
+	{runeRange{0x17F0, 0x17F9}, ScriptKhmer},                   // No  [10] KHMER SYMBOL LEK ATTAK SON..KHMER SYMBOL LEK ATTAK PRAM-BUON
+	{runeRange{0x1805, 0x1805}, ScriptCommon},                  // Po       MONGOLIAN FOUR DOTS
+	{runeRange{0x180E, 0x180E}, ScriptMongolian},               // Cf       MONGOLIAN VOWEL SEPARATOR
+	{runeRange{0x1843, 0x1843}, ScriptMongolian},               // Lm       MONGOLIAN LETTER TODO LONG VOWEL SIGN
+	{runeRange{0x1887, 0x18A8}, ScriptMongolian},               // Lo  [34] MONGOLIAN LETTER ALI GALI A..MONGOLIAN LETTER MANCHU ALI GALI BHA
+	{runeRange{0x1900, 0x191E}, ScriptLimbu},                   // Lo  [31] LIMBU VOWEL-CARRIER LETTER..LIMBU LETTER TRA
+	{runeRange{0x1929, 0x192B}, ScriptLimbu},                   // Mc   [3] LIMBU SUBJOINED LETTER YA..LIMBU SUBJOINED LETTER WA
+	{runeRange{0x1939, 0x193B}, ScriptLimbu},                   // Mn   [3] LIMBU SIGN MUKPHRENG..LIMBU SIGN SA-I
+	{runeRange{0x1950, 0x196D}, ScriptTaiLe},                   // Lo  [30] TAI LE LETTER KA..TAI LE LETTER AI
+	{runeRange{0x19D0, 0x19D9}, ScriptNewTaiLue},               // Nd  [10] NEW TAI LUE DIGIT ZERO..NEW TAI LUE DIGIT NINE
+	{runeRange{0x1A00, 0x1A16}, ScriptBuginese},                // Lo  [23] BUGINESE LETTER KA..BUGINESE LETTER HA
+	{runeRange{0x1A1E, 0x1A1F}, ScriptBuginese},                // Po   [2] BUGINESE PALLAWA..BUGINESE END OF SECTION
+	{runeRange{0x1A57, 0x1A57}, ScriptTaiTham},                 // Mc       TAI THAM CONSONANT SIGN LA TANG LAI
+	{runeRange{0x1A62, 0x1A62}, ScriptTaiTham},                 // Mn       TAI THAM VOWEL SIGN MAI SAT
+	{runeRange{0x1A73, 0x1A7C}, ScriptTaiTham},                 // Mn  [10] TAI THAM VOWEL SIGN OA ABOVE..TAI THAM SIGN KHUEN-LUE KARAN
+	{runeRange{0x1AA0, 0x1AA6}, ScriptTaiTham},                 // Po   [7] TAI THAM SIGN WIANG..TAI THAM SIGN REVERSED ROTATED RANA
+	{runeRange{0x1ABE, 0x1ABE}, ScriptInherited},               // Me       COMBINING PARENTHESES OVERLAY
+	{runeRange{0x1B04, 0x1B04}, ScriptBalinese},                // Mc       BALINESE SIGN BISAH
+	{runeRange{0x1B36, 0x1B3A}, ScriptBalinese},                // Mn   [5] BALINESE VOWEL SIGN ULU..BALINESE VOWEL SIGN RA REPA
+	{runeRange{0x1B42, 0x1B42}, ScriptBalinese},                // Mn       BALINESE VOWEL SIGN PEPET
+	{runeRange{0x1B50, 0x1B59}, ScriptBalinese},                // Nd  [10] BALINESE DIGIT ZERO..BALINESE DIGIT NINE
+	{runeRange{0x1B74, 0x1B7C}, ScriptBalinese},                // So   [9] BALINESE MUSICAL SYMBOL RIGHT-HAND OPEN DUG..BALINESE MUSICAL SYMBOL LEFT-HAND OPEN PING
+	{runeRange{0x1B83, 0x1BA0}, ScriptSundanese},               // Lo  [30] SUNDANESE LETTER A..SUNDANESE LETTER HA
+	{runeRange{0x1BA8, 0x1BA9}, ScriptSundanese},               // Mn   [2] SUNDANESE VOWEL SIGN PAMEPET..SUNDANESE VOWEL SIGN PANEULEUNG
+	{runeRange{0x1BB0, 0x1BB9}, ScriptSundanese},               // Nd  [10] SUNDANESE DIGIT ZERO..SUNDANESE DIGIT NINE
+	{runeRange{0x1BE7, 0x1BE7}, ScriptBatak},                   // Mc       BATAK VOWEL SIGN E
+	{runeRange{0x1BEE, 0x1BEE}, ScriptBatak},                   // Mc       BATAK VOWEL SIGN U
+	{runeRange{0x1C00, 0x1C23}, ScriptLepcha},                  // Lo  [36] LEPCHA LETTER KA..LEPCHA LETTER A
+	{runeRange{0x1C36, 0x1C37}, ScriptLepcha},                  // Mn   [2] LEPCHA SIGN RAN..LEPCHA SIGN NUKTA
+	{runeRange{0x1C50, 0x1C59}, ScriptOlChiki},                 // Nd  [10] OL CHIKI DIGIT ZERO..OL CHIKI DIGIT NINE
+	{runeRange{0x1C80, 0x1C88}, ScriptCyrillic},                // Ll   [9] CYRILLIC SMALL LETTER ROUNDED VE..CYRILLIC SMALL LETTER UNBLENDED UK
+	{runeRange{0x1CBD, 0x1CBF}, ScriptGeorgian},                // Lu   [3] GEORGIAN MTAVRULI CAPITAL LETTER AEN..GEORGIAN MTAVRULI CAPITAL LETTER LABIAL SIGN
+	{runeRange{0x1CD4, 0x1CE0}, ScriptInherited},               // Mn  [13] VEDIC SIGN YAJURVEDIC MIDLINE SVARITA..VEDIC TONE RIGVEDIC KASHMIRI INDEPENDENT SVARITA
+	{runeRange{0x1CED, 0x1CED}, ScriptInherited},               // Mn       VEDIC SIGN TIRYAK
+	{runeRange{0x1CF7, 0x1CF7}, ScriptCommon},                  // Mc       VEDIC SIGN ATIKRAMA
+	{runeRange{0x1D26, 0x1D2A}, ScriptGreek},                   // Ll   [5] GREEK LETTER SMALL CAPITAL GAMMA..GREEK LETTER SMALL CAPITAL PSI
+	{runeRange{0x1D62, 0x1D65}, ScriptLatin},                   // Lm   [4] LATIN SUBSCRIPT SMALL LETTER I..LATIN SUBSCRIPT SMALL LETTER V
+	{runeRange{0x1D79, 0x1D9A}, ScriptLatin},                   // Ll  [34] LATIN SMALL LETTER INSULAR G..LATIN SMALL LETTER EZH WITH RETROFLEX HOOK
+	{runeRange{0x1E00, 0x1E00}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH RING BELOW
+	{runeRange{0x1E04, 0x1E04}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER B WITH DOT BELOW
+	{runeRange{0x1E08, 0x1E08}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER C WITH CEDILLA AND ACUTE
+	{runeRange{0x1E0C, 0x1E0C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER D WITH DOT BELOW
+	{runeRange{0x1E10, 0x1E10}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER D WITH CEDILLA
+	{runeRange{0x1E14, 0x1E14}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH MACRON AND GRAVE
+	{runeRange{0x1E18, 0x1E18}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH CIRCUMFLEX BELOW
+	{runeRange{0x1E1C, 0x1E1C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH CEDILLA AND BREVE
+	{runeRange{0x1E20, 0x1E20}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER G WITH MACRON
+	{runeRange{0x1E24, 0x1E24}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER H WITH DOT BELOW
+	{runeRange{0x1E28, 0x1E28}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER H WITH CEDILLA
+	{runeRange{0x1E2C, 0x1E2C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER I WITH TILDE BELOW
+	{runeRange{0x1E30, 0x1E30}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER K WITH ACUTE
+	{runeRange{0x1E34, 0x1E34}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER K WITH LINE BELOW
+	{runeRange{0x1E38, 0x1E38}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER L WITH DOT BELOW AND MACRON
+	{runeRange{0x1E3C, 0x1E3C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER L WITH CIRCUMFLEX BELOW
+	{runeRange{0x1E40, 0x1E40}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER M WITH DOT ABOVE
+	{runeRange{0x1E44, 0x1E44}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER N WITH DOT ABOVE
+	{runeRange{0x1E48, 0x1E48}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER N WITH LINE BELOW
+	{runeRange{0x1E4C, 0x1E4C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH TILDE AND ACUTE
+	{runeRange{0x1E50, 0x1E50}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH MACRON AND GRAVE
+	{runeRange{0x1E54, 0x1E54}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER P WITH ACUTE
+	{runeRange{0x1E58, 0x1E58}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER R WITH DOT ABOVE
+	{runeRange{0x1E5C, 0x1E5C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER R WITH DOT BELOW AND MACRON
+	{runeRange{0x1E60, 0x1E60}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER S WITH DOT ABOVE
+	{runeRange{0x1E64, 0x1E64}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER S WITH ACUTE AND DOT ABOVE
+	{runeRange{0x1E68, 0x1E68}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER S WITH DOT BELOW AND DOT ABOVE
+	{runeRange{0x1E6C, 0x1E6C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER T WITH DOT BELOW
+	{runeRange{0x1E70, 0x1E70}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER T WITH CIRCUMFLEX BELOW
+	{runeRange{0x1E74, 0x1E74}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH TILDE BELOW
+	{runeRange{0x1E78, 0x1E78}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH TILDE AND ACUTE
+	{runeRange{0x1E7C, 0x1E7C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER V WITH TILDE
+	{runeRange{0x1E80, 0x1E80}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER W WITH GRAVE
+	{runeRange{0x1E84, 0x1E84}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER W WITH DIAERESIS
+	{runeRange{0x1E88, 0x1E88}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER W WITH DOT BELOW
+	{runeRange{0x1E8C, 0x1E8C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER X WITH DIAERESIS
+	{runeRange{0x1E90, 0x1E90}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Z WITH CIRCUMFLEX
+	{runeRange{0x1E94, 0x1E94}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Z WITH LINE BELOW
+	{runeRange{0x1EA0, 0x1EA0}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH DOT BELOW
+	{runeRange{0x1EA4, 0x1EA4}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND ACUTE
+	{runeRange{0x1EA8, 0x1EA8}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND HOOK ABOVE
+	{runeRange{0x1EAC, 0x1EAC}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND DOT BELOW
+	{runeRange{0x1EB0, 0x1EB0}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH BREVE AND GRAVE
+	{runeRange{0x1EB4, 0x1EB4}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH BREVE AND TILDE
+	{runeRange{0x1EB8, 0x1EB8}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH DOT BELOW
+	{runeRange{0x1EBC, 0x1EBC}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH TILDE
+	{runeRange{0x1EC0, 0x1EC0}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND GRAVE
+	{runeRange{0x1EC4, 0x1EC4}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND TILDE
+	{runeRange{0x1EC8, 0x1EC8}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER I WITH HOOK ABOVE
+	{runeRange{0x1ECC, 0x1ECC}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH DOT BELOW
+	{runeRange{0x1ED0, 0x1ED0}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND ACUTE
+	{runeRange{0x1ED4, 0x1ED4}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND HOOK ABOVE
+	{runeRange{0x1ED8, 0x1ED8}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND DOT BELOW
+	{runeRange{0x1EDC, 0x1EDC}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH HORN AND GRAVE
+	{runeRange{0x1EE0, 0x1EE0}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH HORN AND TILDE
+	{runeRange{0x1EE4, 0x1EE4}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH DOT BELOW
+	{runeRange{0x1EE8, 0x1EE8}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH HORN AND ACUTE
+	{runeRange{0x1EEC, 0x1EEC}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH HORN AND HOOK ABOVE
+	{runeRange{0x1EF0, 0x1EF0}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH HORN AND DOT BELOW
+	{runeRange{0x1EF4, 0x1EF4}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Y WITH DOT BELOW
+	{runeRange{0x1EF8, 0x1EF8}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Y WITH TILDE
+	{runeRange{0x1EFC, 0x1EFC}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER MIDDLE-WELSH V
+	{runeRange{0x1F00, 0x1F07}, ScriptGreek},                   // Ll   [8] GREEK SMALL LETTER ALPHA WITH PSILI..GREEK SMALL LETTER ALPHA WITH DASIA AND PERISPOMENI
+	{runeRange{0x1F20, 0x1F27}, ScriptGreek},                   // Ll   [8] GREEK SMALL LETTER ETA WITH PSILI..GREEK SMALL LETTER ETA WITH DASIA AND PERISPOMENI
+	{runeRange{0x1F40, 0x1F45}, ScriptGreek},                   // Ll   [6] GREEK SMALL LETTER OMICRON WITH PSILI..GREEK SMALL LETTER OMICRON WITH DASIA AND OXIA
+	{runeRange{0x1F5B, 0x1F5B}, ScriptGreek},                   // Lu       GREEK CAPITAL LETTER UPSILON WITH DASIA AND VARIA
+	{runeRange{0x1F68, 0x1F6F}, ScriptGreek},                   // Lu   [8] GREEK CAPITAL LETTER OMEGA WITH PSILI..GREEK CAPITAL LETTER OMEGA WITH DASIA AND PERISPOMENI
+	{runeRange{0x1F90, 0x1F97}, ScriptGreek},                   // Ll   [8] GREEK SMALL LETTER ETA WITH PSILI AND YPOGEGRAMMENI..GREEK SMALL LETTER ETA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	{runeRange{0x1FB0, 0x1FB4}, ScriptGreek},                   // Ll   [5] GREEK SMALL LETTER ALPHA WITH VRACHY..GREEK SMALL LETTER ALPHA WITH OXIA AND YPOGEGRAMMENI
+	{runeRange{0x1FBD, 0x1FBD}, ScriptGreek},                   // Sk       GREEK KORONIS
+	{runeRange{0x1FC6, 0x1FC7}, ScriptGreek},                   // Ll   [2] GREEK SMALL LETTER ETA WITH PERISPOMENI..GREEK SMALL LETTER ETA WITH PERISPOMENI AND YPOGEGRAMMENI
+	{runeRange{0x1FD0, 0x1FD3}, ScriptGreek},                   // Ll   [4] GREEK SMALL LETTER IOTA WITH VRACHY..GREEK SMALL LETTER IOTA WITH DIALYTIKA AND OXIA
+	{runeRange{0x1FE0, 0x1FE7}, ScriptGreek},                   // Ll   [8] GREEK SMALL LETTER UPSILON WITH VRACHY..GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND PERISPOMENI
+	{runeRange{0x1FF6, 0x1FF7}, ScriptGreek},                   // Ll   [2] GREEK SMALL LETTER OMEGA WITH PERISPOMENI..GREEK SMALL LETTER OMEGA WITH PERISPOMENI AND YPOGEGRAMMENI
+	{runeRange{0x2000, 0x200A}, ScriptCommon},                  // Zs  [11] EN QUAD..HAIR SPACE
+	{runeRange{0x2010, 0x2015}, ScriptCommon},                  // Pd   [6] HYPHEN..HORIZONTAL BAR
+	{runeRange{0x201A, 0x201A}, ScriptCommon},                  // Ps       SINGLE LOW-9 QUOTATION MARK
+	{runeRange{0x201F, 0x201F}, ScriptCommon},                  // Pi       DOUBLE HIGH-REVERSED-9 QUOTATION MARK
+	{runeRange{0x202A, 0x202E}, ScriptCommon},                  // Cf   [5] LEFT-TO-RIGHT EMBEDDING..RIGHT-TO-LEFT OVERRIDE
+	{runeRange{0x203A, 0x203A}, ScriptCommon},                  // Pf       SINGLE RIGHT-POINTING ANGLE QUOTATION MARK
+	{runeRange{0x2044, 0x2044}, ScriptCommon},                  // Sm       FRACTION SLASH
+	{runeRange{0x2052, 0x2052}, ScriptCommon},                  // Sm       COMMERCIAL MINUS SIGN
+	{runeRange{0x205F, 0x205F}, ScriptCommon},                  // Zs       MEDIUM MATHEMATICAL SPACE
+	{runeRange{0x2071, 0x2071}, ScriptLatin},                   // Lm       SUPERSCRIPT LATIN SMALL LETTER I
+	{runeRange{0x207E, 0x207E}, ScriptCommon},                  // Pe       SUPERSCRIPT RIGHT PARENTHESIS
+	{runeRange{0x208D, 0x208D}, ScriptCommon},                  // Ps       SUBSCRIPT LEFT PARENTHESIS
+	{runeRange{0x20D0, 0x20DC}, ScriptInherited},               // Mn  [13] COMBINING LEFT HARPOON ABOVE..COMBINING FOUR DOTS ABOVE
+	{runeRange{0x20E5, 0x20F0}, ScriptInherited},               // Mn  [12] COMBINING REVERSE SOLIDUS OVERLAY..COMBINING ASTERISK ABOVE
+	{runeRange{0x2107, 0x2107}, ScriptCommon},                  // Lu       EULER CONSTANT
+	{runeRange{0x210E, 0x210F}, ScriptCommon},                  // Ll   [2] PLANCK CONSTANT..PLANCK CONSTANT OVER TWO PI
+	{runeRange{0x2115, 0x2115}, ScriptCommon},                  // Lu       DOUBLE-STRUCK CAPITAL N
+	{runeRange{0x211E, 0x2123}, ScriptCommon},                  // So   [6] PRESCRIPTION TAKE..VERSICLE
+	{runeRange{0x2127, 0x2127}, ScriptCommon},                  // So       INVERTED OHM SIGN
+	{runeRange{0x212C, 0x212D}, ScriptCommon},                  // Lu   [2] SCRIPT CAPITAL B..BLACK-LETTER CAPITAL C
+	{runeRange{0x2132, 0x2132}, ScriptLatin},                   // Lu       TURNED CAPITAL F
+	{runeRange{0x2139, 0x2139}, ScriptCommon},                  // Ll       INFORMATION SOURCE
+	{runeRange{0x2140, 0x2144}, ScriptCommon},                  // Sm   [5] DOUBLE-STRUCK N-ARY SUMMATION..TURNED SANS-SERIF CAPITAL Y
+	{runeRange{0x214B, 0x214B}, ScriptCommon},                  // Sm       TURNED AMPERSAND
+	{runeRange{0x2150, 0x215F}, ScriptCommon},                  // No  [16] VULGAR FRACTION ONE SEVENTH..FRACTION NUMERATOR ONE
+	{runeRange{0x2185, 0x2188}, ScriptLatin},                   // Nl   [4] ROMAN NUMERAL SIX LATE FORM..ROMAN NUMERAL ONE HUNDRED THOUSAND
+	{runeRange{0x2195, 0x2199}, ScriptCommon},                  // So   [5] UP DOWN ARROW..SOUTH WEST ARROW
+	{runeRange{0x21A1, 0x21A2}, ScriptCommon},                  // So   [2] DOWNWARDS TWO HEADED ARROW..LEFTWARDS ARROW WITH TAIL
+	{runeRange{0x21A7, 0x21AD}, ScriptCommon},                  // So   [7] DOWNWARDS ARROW FROM BAR..LEFT RIGHT WAVE ARROW
+	{runeRange{0x21D0, 0x21D1}, ScriptCommon},                  // So   [2] LEFTWARDS DOUBLE ARROW..UPWARDS DOUBLE ARROW
+	{runeRange{0x21D5, 0x21F3}, ScriptCommon},                  // So  [31] UP DOWN DOUBLE ARROW..UP DOWN WHITE ARROW
+	{runeRange{0x2309, 0x2309}, ScriptCommon},                  // Pe       RIGHT CEILING
+	{runeRange{0x2320, 0x2321}, ScriptCommon},                  // Sm   [2] TOP HALF INTEGRAL..BOTTOM HALF INTEGRAL
+	{runeRange{0x232B, 0x237B}, ScriptCommon},                  // So  [81] ERASE TO THE LEFT..NOT CHECK MARK
+	{runeRange{0x23B4, 0x23DB}, ScriptCommon},                  // So  [40] TOP SQUARE BRACKET..FUSE
+	{runeRange{0x2460, 0x249B}, ScriptCommon},                  // No  [60] CIRCLED DIGIT ONE..NUMBER TWENTY FULL STOP
+	{runeRange{0x25B7, 0x25B7}, ScriptCommon},                  // Sm       WHITE RIGHT-POINTING TRIANGLE
+	{runeRange{0x25F8, 0x25FF}, ScriptCommon},                  // Sm   [8] UPPER LEFT TRIANGLE..LOWER RIGHT TRIANGLE
+	{runeRange{0x2768, 0x2768}, ScriptCommon},                  // Ps       MEDIUM LEFT PARENTHESIS ORNAMENT
+	{runeRange{0x276C, 0x276C}, ScriptCommon},                  // Ps       MEDIUM LEFT-POINTING ANGLE BRACKET ORNAMENT
+	{runeRange{0x2770, 0x2770}, ScriptCommon},                  // Ps       HEAVY LEFT-POINTING ANGLE BRACKET ORNAMENT
+	{runeRange{0x2774, 0x2774}, ScriptCommon},                  // Ps       MEDIUM LEFT CURLY BRACKET ORNAMENT
+	{runeRange{0x27C0, 0x27C4}, ScriptCommon},                  // Sm   [5] THREE DIMENSIONAL ANGLE..OPEN SUPERSET
+	{runeRange{0x27E6, 0x27E6}, ScriptCommon},                  // Ps       MATHEMATICAL LEFT WHITE SQUARE BRACKET
+	{runeRange{0x27EA, 0x27EA}, ScriptCommon},                  // Ps       MATHEMATICAL LEFT DOUBLE ANGLE BRACKET
+	{runeRange{0x27EE, 0x27EE}, ScriptCommon},                  // Ps       MATHEMATICAL LEFT FLATTENED PARENTHESIS
+	{runeRange{0x2900, 0x2982}, ScriptCommon},                  // Sm [131] RIGHTWARDS TWO-HEADED ARROW WITH VERTICAL STROKE..Z NOTATION TYPE COLON
+	{runeRange{0x2986, 0x2986}, ScriptCommon},                  // Pe       RIGHT WHITE PARENTHESIS
+	{runeRange{0x298A, 0x298A}, ScriptCommon},                  // Pe       Z NOTATION RIGHT BINDING BRACKET
+	{runeRange{0x298E, 0x298E}, ScriptCommon},                  // Pe       RIGHT SQUARE BRACKET WITH TICK IN BOTTOM CORNER
+	{runeRange{0x2992, 0x2992}, ScriptCommon},                  // Pe       RIGHT ANGLE BRACKET WITH DOT
+	{runeRange{0x2996, 0x2996}, ScriptCommon},                  // Pe       DOUBLE RIGHT ARC LESS-THAN BRACKET
+	{runeRange{0x29D8, 0x29D8}, ScriptCommon},                  // Ps       LEFT WIGGLY FENCE
+	{runeRange{0x29DC, 0x29FB}, ScriptCommon},                  // Sm  [32] INCOMPLETE INFINITY..TRIPLE PLUS
+	{runeRange{0x2B00, 0x2B2F}, ScriptCommon},                  // So  [48] NORTH EAST WHITE ARROW..WHITE VERTICAL ELLIPSE
+	{runeRange{0x2B4D, 0x2B73}, ScriptCommon},                  // So  [39] DOWNWARDS TRIANGLE-HEADED ZIGZAG ARROW..DOWNWARDS TRIANGLE-HEADED ARROW TO BAR
+	{runeRange{0x2C60, 0x2C60}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER L WITH DOUBLE BAR
+	{runeRange{0x2C67, 0x2C67}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER H WITH DESCENDER
+	{runeRange{0x2C6B, 0x2C6B}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Z WITH DESCENDER
+	{runeRange{0x2C72, 0x2C72}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER W WITH HOOK
+	{runeRange{0x2C7C, 0x2C7D}, ScriptLatin},                   // Lm   [2] LATIN SUBSCRIPT SMALL LETTER J..MODIFIER LETTER CAPITAL V
+	{runeRange{0x2C82, 0x2C82}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER VIDA
+	{runeRange{0x2C86, 0x2C86}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER DALDA
+	{runeRange{0x2C8A, 0x2C8A}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER SOU
+	{runeRange{0x2C8E, 0x2C8E}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER HATE
+	{runeRange{0x2C92, 0x2C92}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER IAUDA
+	{runeRange{0x2C96, 0x2C96}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER LAULA
+	{runeRange{0x2C9A, 0x2C9A}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER NI
+	{runeRange{0x2C9E, 0x2C9E}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER O
+	{runeRange{0x2CA2, 0x2CA2}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER RO
+	{runeRange{0x2CA6, 0x2CA6}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER TAU
+	{runeRange{0x2CAA, 0x2CAA}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER FI
+	{runeRange{0x2CAE, 0x2CAE}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER PSI
+	{runeRange{0x2CB2, 0x2CB2}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER DIALECT-P ALEF
+	{runeRange{0x2CB6, 0x2CB6}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER CRYPTOGRAMMIC EIE
+	{runeRange{0x2CBA, 0x2CBA}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER DIALECT-P NI
+	{runeRange{0x2CBE, 0x2CBE}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER OLD COPTIC OOU
+	{runeRange{0x2CC2, 0x2CC2}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER CROSSED SHEI
+	{runeRange{0x2CC6, 0x2CC6}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER OLD COPTIC ESH
+	{runeRange{0x2CCA, 0x2CCA}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER DIALECT-P HORI
+	{runeRange{0x2CCE, 0x2CCE}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER OLD COPTIC HA
+	{runeRange{0x2CD2, 0x2CD2}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER OLD COPTIC HEI
+	{runeRange{0x2CD6, 0x2CD6}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER OLD COPTIC GANGIA
+	{runeRange{0x2CDA, 0x2CDA}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER OLD COPTIC SHIMA
+	{runeRange{0x2CDE, 0x2CDE}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER OLD NUBIAN NGI
+	{runeRange{0x2CE2, 0x2CE2}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER OLD NUBIAN WAU
+	{runeRange{0x2CEC, 0x2CEC}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER CRYPTOGRAMMIC SHEI
+	{runeRange{0x2CF2, 0x2CF2}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER BOHAIRIC KHEI
+	{runeRange{0x2CFE, 0x2CFF}, ScriptCoptic},                  // Po   [2] COPTIC FULL STOP..COPTIC MORPHOLOGICAL DIVIDER
+	{runeRange{0x2D30, 0x2D67}, ScriptTifinagh},                // Lo  [56] TIFINAGH LETTER YA..TIFINAGH LETTER YO
+	{runeRange{0x2D80, 0x2D96}, ScriptEthiopic},                // Lo  [23] ETHIOPIC SYLLABLE LOA..ETHIOPIC SYLLABLE GGWE
+	{runeRange{0x2DB8, 0x2DBE}, ScriptEthiopic},                // Lo   [7] ETHIOPIC SYLLABLE CCHA..ETHIOPIC SYLLABLE CCHO
+	{runeRange{0x2DD8, 0x2DDE}, ScriptEthiopic},                // Lo   [7] ETHIOPIC SYLLABLE GYA..ETHIOPIC SYLLABLE GYO
+	{runeRange{0x2E03, 0x2E03}, ScriptCommon},                  // Pf       RIGHT SUBSTITUTION BRACKET
+	{runeRange{0x2E09, 0x2E09}, ScriptCommon},                  // Pi       LEFT TRANSPOSITION BRACKET
+	{runeRange{0x2E0D, 0x2E0D}, ScriptCommon},                  // Pf       RIGHT RAISED OMISSION BRACKET
+	{runeRange{0x2E1A, 0x2E1A}, ScriptCommon},                  // Pd       HYPHEN WITH DIAERESIS
+	{runeRange{0x2E1E, 0x2E1F}, ScriptCommon},                  // Po   [2] TILDE WITH DOT ABOVE..TILDE WITH DOT BELOW
+	{runeRange{0x2E23, 0x2E23}, ScriptCommon},                  // Pe       TOP RIGHT HALF BRACKET
+	{runeRange{0x2E27, 0x2E27}, ScriptCommon},                  // Pe       RIGHT SIDEWAYS U BRACKET
+	{runeRange{0x2E2F, 0x2E2F}, ScriptCommon},                  // Lm       VERTICAL TILDE
+	{runeRange{0x2E40, 0x2E40}, ScriptCommon},                  // Pd       DOUBLE HYPHEN
+	{runeRange{0x2E50, 0x2E51}, ScriptCommon},                  // So   [2] CROSS PATTY WITH RIGHT CROSSBAR..CROSS PATTY WITH LEFT CROSSBAR
+	{runeRange{0x2E57, 0x2E57}, ScriptCommon},                  // Ps       LEFT SQUARE BRACKET WITH DOUBLE STROKE
+	{runeRange{0x2E5B, 0x2E5B}, ScriptCommon},                  // Ps       BOTTOM HALF LEFT PARENTHESIS
+	{runeRange{0x2E9B, 0x2EF3}, ScriptHan},                     // So  [89] CJK RADICAL CHOKE..CJK RADICAL C-SIMPLIFIED TURTLE
+	{runeRange{0x3001, 0x3003}, ScriptCommon},                  // Po   [3] IDEOGRAPHIC COMMA..DITTO MARK
+	{runeRange{0x3007, 0x3007}, ScriptHan},                     // Nl       IDEOGRAPHIC NUMBER ZERO
+	{runeRange{0x300B, 0x300B}, ScriptCommon},                  // Pe       RIGHT DOUBLE ANGLE BRACKET
+	{runeRange{0x300F, 0x300F}, ScriptCommon},                  // Pe       RIGHT WHITE CORNER BRACKET
+	{runeRange{0x3014, 0x3014}, ScriptCommon},                  // Ps       LEFT TORTOISE SHELL BRACKET
+	{runeRange{0x3018, 0x3018}, ScriptCommon},                  // Ps       LEFT WHITE TORTOISE SHELL BRACKET
+	{runeRange{0x301C, 0x301C}, ScriptCommon},                  // Pd       WAVE DASH
+	{runeRange{0x3021, 0x3029}, ScriptHan},                     // Nl   [9] HANGZHOU NUMERAL ONE..HANGZHOU NUMERAL NINE
+	{runeRange{0x3031, 0x3035}, ScriptCommon},                  // Lm   [5] VERTICAL KANA REPEAT MARK..VERTICAL KANA REPEAT MARK LOWER HALF
+	{runeRange{0x303C, 0x303C}, ScriptCommon},                  // Lo       MASU MARK
+	{runeRange{0x3099, 0x309A}, ScriptInherited},               // Mn   [2] COMBINING KATAKANA-HIRAGANA VOICED SOUND MARK..COMBINING KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK
+	{runeRange{0x30A0, 0x30A0}, ScriptCommon},                  // Pd       KATAKANA-HIRAGANA DOUBLE HYPHEN
+	{runeRange{0x30FD, 0x30FE}, ScriptKatakana},                // Lm   [2] KATAKANA ITERATION MARK..KATAKANA VOICED ITERATION MARK
+	{runeRange{0x3190, 0x3191}, ScriptCommon},                  // So   [2] IDEOGRAPHIC ANNOTATION LINKING MARK..IDEOGRAPHIC ANNOTATION REVERSE MARK
+	{runeRange{0x31C0, 0x31E5}, ScriptCommon},                  // So  [38] CJK STROKE T..CJK STROKE SZP
+	{runeRange{0x3220, 0x3229}, ScriptCommon},                  // No  [10] PARENTHESIZED IDEOGRAPH ONE..PARENTHESIZED IDEOGRAPH TEN
+	{runeRange{0x3251, 0x325F}, ScriptCommon},                  // No  [15] CIRCLED NUMBER TWENTY ONE..CIRCLED NUMBER THIRTY FIVE
+	{runeRange{0x328A, 0x32B0}, ScriptCommon},                  // So  [39] CIRCLED IDEOGRAPH MOON..CIRCLED IDEOGRAPH NIGHT
+	{runeRange{0x32FF, 0x32FF}, ScriptCommon},                  // So       SQUARE ERA NAME REIWA
+	{runeRange{0x4DC0, 0x4DFF}, ScriptCommon},                  // So  [64] HEXAGRAM FOR THE CREATIVE HEAVEN..HEXAGRAM FOR BEFORE COMPLETION
+	{runeRange{0xA016, 0xA48C}, ScriptYi},                      // Lo [1143] YI SYLLABLE BIT..YI SYLLABLE YYR
+	{runeRange{0xA4FE, 0xA4FF}, ScriptLisu},                    // Po   [2] LISU PUNCTUATION COMMA..LISU PUNCTUATION FULL STOP
+	{runeRange{0xA610, 0xA61F}, ScriptVai},                     // Lo  [16] VAI SYLLABLE NDOLE FA..VAI SYMBOL JONG
+	{runeRange{0xA641, 0xA641}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER ZEMLYA
+	{runeRange{0xA645, 0xA645}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER REVERSED DZE
+	{runeRange{0xA649, 0xA649}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER DJERV
+	{runeRange{0xA64D, 0xA64D}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER BROAD OMEGA
+	{runeRange{0xA651, 0xA651}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER YERU WITH BACK YER
+	{runeRange{0xA655, 0xA655}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER REVERSED YU
+	{runeRange{0xA659, 0xA659}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER CLOSED LITTLE YUS
+	{runeRange{0xA65D, 0xA65D}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER IOTIFIED CLOSED LITTLE YUS
+	{runeRange{0xA661, 0xA661}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER REVERSED TSE
+	{runeRange{0xA665, 0xA665}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER SOFT EL
+	{runeRange{0xA669, 0xA669}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER MONOCULAR O
+	{runeRange{0xA66D, 0xA66D}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER DOUBLE MONOCULAR O
+	{runeRange{0xA673, 0xA673}, ScriptCyrillic},                // Po       SLAVONIC ASTERISK
+	{runeRange{0xA680, 0xA680}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER DWE
+	{runeRange{0xA684, 0xA684}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER ZHWE
+	{runeRange{0xA688, 0xA688}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER DZZE
+	{runeRange{0xA68C, 0xA68C}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER TWE
+	{runeRange{0xA690, 0xA690}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER TSSE
+	{runeRange{0xA694, 0xA694}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER HWE
+	{runeRange{0xA698, 0xA698}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER DOUBLE O
+	{runeRange{0xA69C, 0xA69D}, ScriptCyrillic},                // Lm   [2] MODIFIER LETTER CYRILLIC HARD SIGN..MODIFIER LETTER CYRILLIC SOFT SIGN
+	{runeRange{0xA6F0, 0xA6F1}, ScriptBamum},                   // Mn   [2] BAMUM COMBINING MARK KOQNDON..BAMUM COMBINING MARK TUKWENTIS
+	{runeRange{0xA720, 0xA721}, ScriptCommon},                  // Sk   [2] MODIFIER LETTER STRESS AND HIGH TONE..MODIFIER LETTER STRESS AND LOW TONE
+	{runeRange{0xA725, 0xA725}, ScriptLatin},                   // Ll       LATIN SMALL LETTER EGYPTOLOGICAL AIN
+	{runeRange{0xA729, 0xA729}, ScriptLatin},                   // Ll       LATIN SMALL LETTER TZ
+	{runeRange{0xA72D, 0xA72D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER CUATRILLO
+	{runeRange{0xA733, 0xA733}, ScriptLatin},                   // Ll       LATIN SMALL LETTER AA
+	{runeRange{0xA737, 0xA737}, ScriptLatin},                   // Ll       LATIN SMALL LETTER AU
+	{runeRange{0xA73B, 0xA73B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER AV WITH HORIZONTAL BAR
+	{runeRange{0xA73F, 0xA73F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER REVERSED C WITH DOT
+	{runeRange{0xA743, 0xA743}, ScriptLatin},                   // Ll       LATIN SMALL LETTER K WITH DIAGONAL STROKE
+	{runeRange{0xA747, 0xA747}, ScriptLatin},                   // Ll       LATIN SMALL LETTER BROKEN L
+	{runeRange{0xA74B, 0xA74B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH LONG STROKE OVERLAY
+	{runeRange{0xA74F, 0xA74F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER OO
+	{runeRange{0xA753, 0xA753}, ScriptLatin},                   // Ll       LATIN SMALL LETTER P WITH FLOURISH
+	{runeRange{0xA757, 0xA757}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Q WITH STROKE THROUGH DESCENDER
+	{runeRange{0xA75B, 0xA75B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER R ROTUNDA
+	{runeRange{0xA75F, 0xA75F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER V WITH DIAGONAL STROKE
+	{runeRange{0xA763, 0xA763}, ScriptLatin},                   // Ll       LATIN SMALL LETTER VISIGOTHIC Z
+	{runeRange{0xA767, 0xA767}, ScriptLatin},                   // Ll       LATIN SMALL LETTER THORN WITH STROKE THROUGH DESCENDER
+	{runeRange{0xA76B, 0xA76B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER ET
+	{runeRange{0xA76F, 0xA76F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER CON
+	{runeRange{0xA77A, 0xA77A}, ScriptLatin},                   // Ll       LATIN SMALL LETTER INSULAR D
+	{runeRange{0xA77F, 0xA77F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER TURNED INSULAR G
+	{runeRange{0xA783, 0xA783}, ScriptLatin},                   // Ll       LATIN SMALL LETTER INSULAR R
+	{runeRange{0xA787, 0xA787}, ScriptLatin},                   // Ll       LATIN SMALL LETTER INSULAR T
+	{runeRange{0xA78C, 0xA78C}, ScriptLatin},                   // Ll       LATIN SMALL LETTER SALTILLO
+	{runeRange{0xA790, 0xA790}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER N WITH DESCENDER
+	{runeRange{0xA796, 0xA796}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER B WITH FLOURISH
+	{runeRange{0xA79A, 0xA79A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER VOLAPUK AE
+	{runeRange{0xA79E, 0xA79E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER VOLAPUK UE
+	{runeRange{0xA7A2, 0xA7A2}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER K WITH OBLIQUE STROKE
+	{runeRange{0xA7A6, 0xA7A6}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER R WITH OBLIQUE STROKE
+	{runeRange{0xA7AA, 0xA7AE}, ScriptLatin},                   // Lu   [5] LATIN CAPITAL LETTER H WITH HOOK..LATIN CAPITAL LETTER SMALL CAPITAL I
+	{runeRange{0xA7B6, 0xA7B6}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER OMEGA
+	{runeRange{0xA7BA, 0xA7BA}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER GLOTTAL A
+	{runeRange{0xA7BE, 0xA7BE}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER GLOTTAL U
+	{runeRange{0xA7C2, 0xA7C2}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER ANGLICANA W
+	{runeRange{0xA7C9, 0xA7C9}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER S WITH SHORT STROKE OVERLAY
+	{runeRange{0xA7CE, 0xA7CE}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER PHARYNGEAL VOICED FRICATIVE
+	{runeRange{0xA7D2, 0xA7D2}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER DOUBLE THORN
+	{runeRange{0xA7D6, 0xA7D6}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER MIDDLE SCOTS S
+	{runeRange{0xA7DA, 0xA7DA}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER LAMBDA
+	{runeRange{0xA7F5, 0xA7F5}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER REVERSED HALF H
+	{runeRange{0xA7FA, 0xA7FA}, ScriptLatin},                   // Ll       LATIN LETTER SMALL CAPITAL TURNED M
+	{runeRange{0xA803, 0xA805}, ScriptSylotiNagri},             // Lo   [3] SYLOTI NAGRI LETTER U..SYLOTI NAGRI LETTER O
+	{runeRange{0xA80C, 0xA822}, ScriptSylotiNagri},             // Lo  [23] SYLOTI NAGRI LETTER CO..SYLOTI NAGRI LETTER HO
+	{runeRange{0xA828, 0xA82B}, ScriptSylotiNagri},             // So   [4] SYLOTI NAGRI POETRY MARK-1..SYLOTI NAGRI POETRY MARK-4
+	{runeRange{0xA838, 0xA838}, ScriptCommon},                  // Sc       NORTH INDIC RUPEE MARK
+	{runeRange{0xA880, 0xA881}, ScriptSaurashtra},              // Mc   [2] SAURASHTRA SIGN ANUSVARA..SAURASHTRA SIGN VISARGA
+	{runeRange{0xA8CE, 0xA8CF}, ScriptSaurashtra},              // Po   [2] SAURASHTRA DANDA..SAURASHTRA DOUBLE DANDA
+	{runeRange{0xA8F8, 0xA8FA}, ScriptDevanagari},              // Po   [3] DEVANAGARI SIGN PUSHPIKA..DEVANAGARI CARET
+	{runeRange{0xA8FF, 0xA8FF}, ScriptDevanagari},              // Mn       DEVANAGARI VOWEL SIGN AY
+	{runeRange{0xA92E, 0xA92E}, ScriptCommon},                  // Po       KAYAH LI SIGN CWI
+	{runeRange{0xA952, 0xA953}, ScriptRejang},                  // Mc   [2] REJANG CONSONANT SIGN H..REJANG VIRAMA
+	{runeRange{0xA983, 0xA983}, ScriptJavanese},                // Mc       JAVANESE SIGN WIGNYAN
+	{runeRange{0xA9B6, 0xA9B9}, ScriptJavanese},                // Mn   [4] JAVANESE VOWEL SIGN WULU..JAVANESE VOWEL SIGN SUKU MENDUT
+	{runeRange{0xA9C1, 0xA9CD}, ScriptJavanese},                // Po  [13] JAVANESE LEFT RERENGGAN..JAVANESE TURNED PADA PISELEH
+	{runeRange{0xA9E0, 0xA9E4}, ScriptMyanmar},                 // Lo   [5] MYANMAR LETTER SHAN GHA..MYANMAR LETTER SHAN BHA
+	{runeRange{0xA9F0, 0xA9F9}, ScriptMyanmar},                 // Nd  [10] MYANMAR TAI LAING DIGIT ZERO..MYANMAR TAI LAING DIGIT NINE
+	{runeRange{0xAA2F, 0xAA30}, ScriptCham},                    // Mc   [2] CHAM VOWEL SIGN O..CHAM VOWEL SIGN AI
+	{runeRange{0xAA40, 0xAA42}, ScriptCham},                    // Lo   [3] CHAM LETTER FINAL K..CHAM LETTER FINAL NG
+	{runeRange{0xAA4D, 0xAA4D}, ScriptCham},                    // Mc       CHAM CONSONANT SIGN FINAL H
+	{runeRange{0xAA70, 0xAA70}, ScriptMyanmar},                 // Lm       MYANMAR MODIFIER LETTER KHAMTI REDUPLICATION
+	{runeRange{0xAA7B, 0xAA7B}, ScriptMyanmar},                 // Mc       MYANMAR SIGN PAO KAREN TONE
+	{runeRange{0xAA80, 0xAAAF}, ScriptTaiViet},                 // Lo  [48] TAI VIET LETTER LOW KO..TAI VIET LETTER HIGH O
+	{runeRange{0xAAB5, 0xAAB6}, ScriptTaiViet},                 // Lo   [2] TAI VIET VOWEL E..TAI VIET VOWEL O
+	{runeRange{0xAAC0, 0xAAC0}, ScriptTaiViet},                 // Lo       TAI VIET TONE MAI NUENG
+	{runeRange{0xAADD, 0xAADD}, ScriptTaiViet},                 // Lm       TAI VIET SYMBOL SAM
+	{runeRange{0xAAEC, 0xAAED}, ScriptMeeteiMayek},             // Mn   [2] MEETEI MAYEK VOWEL SIGN UU..MEETEI MAYEK VOWEL SIGN AAI
+	{runeRange{0xAAF3, 0xAAF4}, ScriptMeeteiMayek},             // Lm   [2] MEETEI MAYEK SYLLABLE REPETITION MARK..MEETEI MAYEK WORD REPETITION MARK
+	{runeRange{0xAB09, 0xAB0E}, ScriptEthiopic},                // Lo   [6] ETHIOPIC SYLLABLE DDHU..ETHIOPIC SYLLABLE DDHO
+	{runeRange{0xAB30, 0xAB5A}, ScriptLatin},                   // Ll  [43] LATIN SMALL LETTER BARRED ALPHA..LATIN SMALL LETTER Y WITH SHORT RIGHT LEG
+	{runeRange{0xAB65, 0xAB65}, ScriptGreek},                   // Ll       GREEK LETTER SMALL CAPITAL OMEGA
+	{runeRange{0xAB70, 0xABBF}, ScriptCherokee},                // Ll  [80] CHEROKEE SMALL LETTER A..CHEROKEE SMALL LETTER YA
+	{runeRange{0xABE6, 0xABE7}, ScriptMeeteiMayek},             // Mc   [2] MEETEI MAYEK VOWEL SIGN YENAP..MEETEI MAYEK VOWEL SIGN SOUNAP
+	{runeRange{0xABEC, 0xABEC}, ScriptMeeteiMayek},             // Mc       MEETEI MAYEK LUM IYEK
+	{runeRange{0xD7B0, 0xD7C6}, ScriptHangul},                  // Lo  [23] HANGUL JUNGSEONG O-YEO..HANGUL JUNGSEONG ARAEA-E
+	{runeRange{0xFB00, 0xFB06}, ScriptLatin},                   // Ll   [7] LATIN SMALL LIGATURE FF..LATIN SMALL LIGATURE ST
+	{runeRange{0xFB1F, 0xFB28}, ScriptHebrew},                  // Lo  [10] HEBREW LIGATURE YIDDISH YOD YOD PATAH..HEBREW LETTER WIDE TAV
+	{runeRange{0xFB3E, 0xFB3E}, ScriptHebrew},                  // Lo       HEBREW LETTER MEM WITH DAGESH
+	{runeRange{0xFB50, 0xFBB1}, ScriptArabic},                  // Lo  [98] ARABIC LETTER ALEF WASLA ISOLATED FORM..ARABIC LETTER YEH BARREE WITH HAMZA ABOVE FINAL FORM
+	{runeRange{0xFD3E, 0xFD3E}, ScriptCommon},                  // Pe       ORNATE LEFT PARENTHESIS
+	{runeRange{0xFD90, 0xFD91}, ScriptArabic},                  // So   [2] ARABIC LIGATURE RAHMATU ALLAAHI ALAYH..ARABIC LIGATURE RAHMATU ALLAAHI ALAYHAA
+	{runeRange{0xFDFC, 0xFDFC}, ScriptArabic},                  // Sc       RIAL SIGN
+	{runeRange{0xFE17, 0xFE17}, ScriptCommon},                  // Ps       PRESENTATION FORM FOR VERTICAL LEFT WHITE LENTICULAR BRACKET
+	{runeRange{0xFE2E, 0xFE2F}, ScriptCyrillic},                // Mn   [2] COMBINING CYRILLIC TITLO LEFT HALF..COMBINING CYRILLIC TITLO RIGHT HALF
+	{runeRange{0xFE35, 0xFE35}, ScriptCommon},                  // Ps       PRESENTATION FORM FOR VERTICAL LEFT PARENTHESIS
+	{runeRange{0xFE39, 0xFE39}, ScriptCommon},                  // Ps       PRESENTATION FORM FOR VERTICAL LEFT TORTOISE SHELL BRACKET
+	{runeRange{0xFE3D, 0xFE3D}, ScriptCommon},                  // Ps       PRESENTATION FORM FOR VERTICAL LEFT DOUBLE ANGLE BRACKET
+	{runeRange{0xFE41, 0xFE41}, ScriptCommon},                  // Ps       PRESENTATION FORM FOR VERTICAL LEFT CORNER BRACKET
+	{runeRange{0xFE45, 0xFE46}, ScriptCommon},                  // Po   [2] SESAME DOT..WHITE SESAME DOT
+	{runeRange{0xFE4D, 0xFE4F}, ScriptCommon},                  // Pc   [3] DASHED LOW LINE..WAVY LOW LINE
+	{runeRange{0xFE59, 0xFE59}, ScriptCommon},                  // Ps       SMALL LEFT PARENTHESIS
+	{runeRange{0xFE5D, 0xFE5D}, ScriptCommon},                  // Ps       SMALL LEFT TORTOISE SHELL BRACKET
+	{runeRange{0xFE63, 0xFE63}, ScriptCommon},                  // Pd       SMALL HYPHEN-MINUS
+	{runeRange{0xFE6A, 0xFE6B}, ScriptCommon},                  // Po   [2] SMALL PERCENT SIGN..SMALL COMMERCIAL AT
+	{runeRange{0xFF01, 0xFF03}, ScriptCommon},                  // Po   [3] FULLWIDTH EXCLAMATION MARK..FULLWIDTH NUMBER SIGN
+	{runeRange{0xFF09, 0xFF09}, ScriptCommon},                  // Pe       FULLWIDTH RIGHT PARENTHESIS
+	{runeRange{0xFF0D, 0xFF0D}, ScriptCommon},                  // Pd       FULLWIDTH HYPHEN-MINUS
+	{runeRange{0xFF1C, 0xFF1E}, ScriptCommon},                  // Sm   [3] FULLWIDTH LESS-THAN SIGN..FULLWIDTH GREATER-THAN SIGN
+	{runeRange{0xFF3C, 0xFF3C}, ScriptCommon},                  // Po       FULLWIDTH REVERSE SOLIDUS
+	{runeRange{0xFF40, 0xFF40}, ScriptCommon},                  // Sk       FULLWIDTH GRAVE ACCENT
+	{runeRange{0xFF5D, 0xFF5D}, ScriptCommon},                  // Pe       FULLWIDTH RIGHT CURLY BRACKET
+	{runeRange{0xFF61, 0xFF61}, ScriptCommon},                  // Po       HALFWIDTH IDEOGRAPHIC FULL STOP
+	{runeRange{0xFF66, 0xFF6F}, ScriptKatakana},                // Lo  [10] HALFWIDTH KATAKANA LETTER WO..HALFWIDTH KATAKANA LETTER SMALL TU
+	{runeRange{0xFFA0, 0xFFBE}, ScriptHangul},                  // Lo  [31] HALFWIDTH HANGUL FILLER..HALFWIDTH HANGUL LETTER HIEUH
+	{runeRange{0xFFDA, 0xFFDC}, ScriptHangul},                  // Lo   [3] HALFWIDTH HANGUL LETTER EU..HALFWIDTH HANGUL LETTER I
+	{runeRange{0xFFE4, 0xFFE4}, ScriptCommon},                  // So       FULLWIDTH BROKEN BAR
+	{runeRange{0xFFED, 0xFFEE}, ScriptCommon},                  // So   [2] HALFWIDTH BLACK SQUARE..HALFWIDTH WHITE CIRCLE
+	{runeRange{0x1000D, 0x10026}, ScriptLinearB},               // Lo  [26] LINEAR B SYLLABLE B036 JO..LINEAR B SYLLABLE B032 QO
+	{runeRange{0x10050, 0x1005D}, ScriptLinearB},               // Lo  [14] LINEAR B SYMBOL B018..LINEAR B SYMBOL B089
+	{runeRange{0x10137, 0x1013F}, ScriptCommon},                // So   [9] AEGEAN WEIGHT BASE UNIT..AEGEAN MEASURE THIRD SUBUNIT
+	{runeRange{0x1018A, 0x1018B}, ScriptGreek},                 // No   [2] GREEK ZERO SIGN..GREEK ONE QUARTER SIGN
+	{runeRange{0x101D0, 0x101FC}, ScriptCommon},                // So  [45] PHAISTOS DISC SIGN PEDESTRIAN..PHAISTOS DISC SIGN WAVY BAND
+	{runeRange{0x102E0, 0x102E0}, ScriptInherited},             // Mn       COPTIC EPACT THOUSANDS MARK
+	{runeRange{0x1032D, 0x1032F}, ScriptOldItalic},             // Lo   [3] OLD ITALIC LETTER YE..OLD ITALIC LETTER SOUTHERN TSE
+	{runeRange{0x1034A, 0x1034A}, ScriptGothic},                // Nl       GOTHIC LETTER NINE HUNDRED
+	{runeRange{0x1039F, 0x1039F}, ScriptUgaritic},              // Po       UGARITIC WORD DIVIDER
+	{runeRange{0x103D1, 0x103D5}, ScriptOldPersian},            // Nl   [5] OLD PERSIAN NUMBER ONE..OLD PERSIAN NUMBER HUNDRED
+	{runeRange{0x10480, 0x1049D}, ScriptOsmanya},               // Lo  [30] OSMANYA LETTER ALEF..OSMANYA LETTER OO
+	{runeRange{0x10500, 0x10527}, ScriptElbasan},               // Lo  [40] ELBASAN LETTER A..ELBASAN LETTER KHE
+	{runeRange{0x1057C, 0x1058A}, ScriptVithkuqi},              // Lu  [15] VITHKUQI CAPITAL LETTER HA..VITHKUQI CAPITAL LETTER RE
+	{runeRange{0x105A3, 0x105B1}, ScriptVithkuqi},              // Ll  [15] VITHKUQI SMALL LETTER HA..VITHKUQI SMALL LETTER RE
+	{runeRange{0x10600, 0x10736}, ScriptLinearA},               // Lo [311] LINEAR A SIGN AB001..LINEAR A SIGN A664
+	{runeRange{0x10787, 0x107B0}, ScriptLatin},                 // Lm  [42] MODIFIER LETTER SMALL DZ DIGRAPH..MODIFIER LETTER SMALL V WITH RIGHT HOOK
+	{runeRange{0x1080A, 0x10835}, ScriptCypriot},               // Lo  [44] CYPRIOT SYLLABLE KA..CYPRIOT SYLLABLE WO
+	{runeRange{0x10840, 0x10855}, ScriptImperialAramaic},       // Lo  [22] IMPERIAL ARAMAIC LETTER ALEPH..IMPERIAL ARAMAIC LETTER TAW
+	{runeRange{0x10877, 0x10878}, ScriptPalmyrene},             // So   [2] PALMYRENE LEFT-POINTING FLEURON..PALMYRENE RIGHT-POINTING FLEURON
+	{runeRange{0x108E0, 0x108F2}, ScriptHatran},                // Lo  [19] HATRAN LETTER ALEPH..HATRAN LETTER QOPH
+	{runeRange{0x10916, 0x1091B}, ScriptPhoenician},            // No   [6] PHOENICIAN NUMBER ONE..PHOENICIAN NUMBER THREE
+	{runeRange{0x10940, 0x10959}, ScriptSidetic},               // Lo  [26] SIDETIC LETTER N01..SIDETIC LETTER N26
+	{runeRange{0x109BE, 0x109BF}, ScriptMeroiticCursive},       // Lo   [2] MEROITIC CURSIVE LOGOGRAM RMT..MEROITIC CURSIVE LOGOGRAM IMN
+	{runeRange{0x10A01, 0x10A03}, ScriptKharoshthi},            // Mn   [3] KHAROSHTHI VOWEL SIGN I..KHAROSHTHI VOWEL SIGN VOCALIC R
+	{runeRange{0x10A15, 0x10A17}, ScriptKharoshthi},            // Lo   [3] KHAROSHTHI LETTER CA..KHAROSHTHI LETTER JA
+	{runeRange{0x10A40, 0x10A48}, ScriptKharoshthi},            // No   [9] KHAROSHTHI DIGIT ONE..KHAROSHTHI FRACTION ONE HALF
+	{runeRange{0x10A7F, 0x10A7F}, ScriptOldSouthArabian},       // Po       OLD SOUTH ARABIAN NUMERIC INDICATOR
+	{runeRange{0x10AC8, 0x10AC8}, ScriptManichaean},            // So       MANICHAEAN SIGN UD
+	{runeRange{0x10AF0, 0x10AF6}, ScriptManichaean},            // Po   [7] MANICHAEAN PUNCTUATION STAR..MANICHAEAN PUNCTUATION LINE FILLER
+	{runeRange{0x10B58, 0x10B5F}, ScriptInscriptionalParthian}, // No   [8] INSCRIPTIONAL PARTHIAN NUMBER ONE..INSCRIPTIONAL PARTHIAN NUMBER ONE THOUSAND
+	{runeRange{0x10B99, 0x10B9C}, ScriptPsalterPahlavi},        // Po   [4] PSALTER PAHLAVI SECTION MARK..PSALTER PAHLAVI FOUR DOTS WITH DOT
+	{runeRange{0x10CC0, 0x10CF2}, ScriptOldHungarian},          // Ll  [51] OLD HUNGARIAN SMALL LETTER A..OLD HUNGARIAN SMALL LETTER US
+	{runeRange{0x10D30, 0x10D39}, ScriptHanifiRohingya},        // Nd  [10] HANIFI ROHINGYA DIGIT ZERO..HANIFI ROHINGYA DIGIT NINE
+	{runeRange{0x10D4F, 0x10D4F}, ScriptGaray},                 // Lo       GARAY SUKUN
+	{runeRange{0x10D6F, 0x10D6F}, ScriptGaray},                 // Lm       GARAY REDUPLICATION MARK
+	{runeRange{0x10E80, 0x10EA9}, ScriptYezidi},                // Lo  [42] YEZIDI LETTER ELIF..YEZIDI LETTER ET
+	{runeRange{0x10EC2, 0x10EC4}, ScriptArabic},                // Lo   [3] ARABIC LETTER DAL WITH TWO DOTS VERTICALLY BELOW..ARABIC LETTER KAF WITH TWO DOTS VERTICALLY BELOW
+	{runeRange{0x10ED1, 0x10ED8}, ScriptArabic},                // So   [8] ARABIC LIGATURE ALAYHAA AS-SALAATU WAS-SALAAM..ARABIC LIGATURE NAWWARA ALLAAHU MARQADAH
+	{runeRange{0x10F27, 0x10F27}, ScriptOldSogdian},            // Lo       OLD SOGDIAN LIGATURE AYIN-DALETH
+	{runeRange{0x10F55, 0x10F59}, ScriptSogdian},               // Po   [5] SOGDIAN PUNCTUATION TWO VERTICAL BARS..SOGDIAN PUNCTUATION HALF CIRCLE WITH DOT
+	{runeRange{0x10FB0, 0x10FC4}, ScriptChorasmian},            // Lo  [21] CHORASMIAN LETTER ALEPH..CHORASMIAN LETTER TAW
+	{runeRange{0x11001, 0x11001}, ScriptBrahmi},                // Mn       BRAHMI SIGN ANUSVARA
+	{runeRange{0x11047, 0x1104D}, ScriptBrahmi},                // Po   [7] BRAHMI DANDA..BRAHMI PUNCTUATION LOTUS
+	{runeRange{0x11071, 0x11072}, ScriptBrahmi},                // Lo   [2] BRAHMI LETTER OLD TAMIL SHORT E..BRAHMI LETTER OLD TAMIL SHORT O
+	{runeRange{0x11080, 0x11081}, ScriptKaithi},                // Mn   [2] KAITHI SIGN CANDRABINDU..KAITHI SIGN ANUSVARA
+	{runeRange{0x110B3, 0x110B6}, ScriptKaithi},                // Mn   [4] KAITHI VOWEL SIGN U..KAITHI VOWEL SIGN AI
+	{runeRange{0x110BD, 0x110BD}, ScriptKaithi},                // Cf       KAITHI NUMBER SIGN
+	{runeRange{0x110D0, 0x110E8}, ScriptSoraSompeng},           // Lo  [25] SORA SOMPENG LETTER SAH..SORA SOMPENG LETTER MAE
+	{runeRange{0x11127, 0x1112B}, ScriptChakma},                // Mn   [5] CHAKMA VOWEL SIGN A..CHAKMA VOWEL SIGN UU
+	{runeRange{0x11140, 0x11143}, ScriptChakma},                // Po   [4] CHAKMA SECTION MARK..CHAKMA QUESTION MARK
+	{runeRange{0x11150, 0x11172}, ScriptMahajani},              // Lo  [35] MAHAJANI LETTER A..MAHAJANI LETTER RRA
+	{runeRange{0x11180, 0x11181}, ScriptSharada},               // Mn   [2] SHARADA SIGN CANDRABINDU..SHARADA SIGN ANUSVARA
+	{runeRange{0x111B6, 0x111BE}, ScriptSharada},               // Mn   [9] SHARADA VOWEL SIGN U..SHARADA VOWEL SIGN O
+	{runeRange{0x111C9, 0x111CC}, ScriptSharada},               // Mn   [4] SHARADA SANDHI MARK..SHARADA EXTRA SHORT VOWEL MARK
+	{runeRange{0x111D0, 0x111D9}, ScriptSharada},               // Nd  [10] SHARADA DIGIT ZERO..SHARADA DIGIT NINE
+	{runeRange{0x111DD, 0x111DF}, ScriptSharada},               // Po   [3] SHARADA CONTINUATION SIGN..SHARADA SECTION MARK-2
+	{runeRange{0x1122C, 0x1122E}, ScriptKhojki},                // Mc   [3] KHOJKI VOWEL SIGN AA..KHOJKI VOWEL SIGN II
+	{runeRange{0x11235, 0x11235}, ScriptKhojki},                // Mc       KHOJKI SIGN VIRAMA
+	{runeRange{0x11238, 0x1123D}, ScriptKhojki},                // Po   [6] KHOJKI DANDA..KHOJKI ABBREVIATION SIGN
+	{runeRange{0x1123F, 0x11240}, ScriptKhojki},                // Lo   [2] KHOJKI LETTER QA..KHOJKI LETTER SHORT I
+	{runeRange{0x11280, 0x11286}, ScriptMultani},               // Lo   [7] MULTANI LETTER A..MULTANI LETTER GA
+	{runeRange{0x1128A, 0x1128D}, ScriptMultani},               // Lo   [4] MULTANI LETTER CA..MULTANI LETTER JJA
+	{runeRange{0x1129F, 0x112A8}, ScriptMultani},               // Lo  [10] MULTANI LETTER BHA..MULTANI LETTER RHA
+	{runeRange{0x112B0, 0x112DE}, ScriptKhudawadi},             // Lo  [47] KHUDAWADI LETTER A..KHUDAWADI LETTER HA
+	{runeRange{0x112E0, 0x112E2}, ScriptKhudawadi},             // Mc   [3] KHUDAWADI VOWEL SIGN AA..KHUDAWADI VOWEL SIGN II
+	{runeRange{0x112F0, 0x112F9}, ScriptKhudawadi},             // Nd  [10] KHUDAWADI DIGIT ZERO..KHUDAWADI DIGIT NINE
+	{runeRange{0x11302, 0x11303}, ScriptGrantha},               // Mc   [2] GRANTHA SIGN ANUSVARA..GRANTHA SIGN VISARGA
+	{runeRange{0x1130F, 0x11310}, ScriptGrantha},               // Lo   [2] GRANTHA LETTER EE..GRANTHA LETTER AI
+	{runeRange{0x1132A, 0x11330}, ScriptGrantha},               // Lo   [7] GRANTHA LETTER PA..GRANTHA LETTER RA
+	{runeRange{0x11335, 0x11339}, ScriptGrantha},               // Lo   [5] GRANTHA LETTER VA..GRANTHA LETTER HA
+	{runeRange{0x1133C, 0x1133C}, ScriptGrantha},               // Mn       GRANTHA SIGN NUKTA
+	{runeRange{0x1133E, 0x1133F}, ScriptGrantha},               // Mc   [2] GRANTHA VOWEL SIGN AA..GRANTHA VOWEL SIGN I
+	{runeRange{0x11341, 0x11344}, ScriptGrantha},               // Mc   [4] GRANTHA VOWEL SIGN U..GRANTHA VOWEL SIGN VOCALIC RR
+	{runeRange{0x1134B, 0x1134D}, ScriptGrantha},               // Mc   [3] GRANTHA VOWEL SIGN OO..GRANTHA SIGN VIRAMA
+	{runeRange{0x11357, 0x11357}, ScriptGrantha},               // Mc       GRANTHA AU LENGTH MARK
+	{runeRange{0x11362, 0x11363}, ScriptGrantha},               // Mc   [2] GRANTHA VOWEL SIGN VOCALIC L..GRANTHA VOWEL SIGN VOCALIC LL
+	{runeRange{0x11370, 0x11374}, ScriptGrantha},               // Mn   [5] COMBINING GRANTHA LETTER A..COMBINING GRANTHA LETTER PA
+	{runeRange{0x1138B, 0x1138B}, ScriptTuluTigalari},          // Lo       TULU-TIGALARI LETTER EE
+	{runeRange{0x11390, 0x113B5}, ScriptTuluTigalari},          // Lo  [38] TULU-TIGALARI LETTER OO..TULU-TIGALARI LETTER LLLA
+	{runeRange{0x113B8, 0x113BA}, ScriptTuluTigalari},          // Mc   [3] TULU-TIGALARI VOWEL SIGN AA..TULU-TIGALARI VOWEL SIGN II
+	{runeRange{0x113C2, 0x113C2}, ScriptTuluTigalari},          // Mc       TULU-TIGALARI VOWEL SIGN EE
+	{runeRange{0x113C7, 0x113CA}, ScriptTuluTigalari},          // Mc   [4] TULU-TIGALARI VOWEL SIGN OO..TULU-TIGALARI SIGN CANDRA ANUNASIKA
+	{runeRange{0x113CE, 0x113CE}, ScriptTuluTigalari},          // Mn       TULU-TIGALARI SIGN VIRAMA
+	{runeRange{0x113D0, 0x113D0}, ScriptTuluTigalari},          // Mn       TULU-TIGALARI CONJOINER
+	{runeRange{0x113D2, 0x113D2}, ScriptTuluTigalari},          // Mn       TULU-TIGALARI GEMINATION MARK
+	{runeRange{0x113D4, 0x113D5}, ScriptTuluTigalari},          // Po   [2] TULU-TIGALARI DANDA..TULU-TIGALARI DOUBLE DANDA
+	{runeRange{0x113E1, 0x113E2}, ScriptTuluTigalari},          // Mn   [2] TULU-TIGALARI VEDIC TONE SVARITA..TULU-TIGALARI VEDIC TONE ANUDATTA
+	{runeRange{0x11435, 0x11437}, ScriptNewa},                  // Mc   [3] NEWA VOWEL SIGN AA..NEWA VOWEL SIGN II
+	{runeRange{0x11440, 0x11441}, ScriptNewa},                  // Mc   [2] NEWA VOWEL SIGN O..NEWA VOWEL SIGN AU
+	{runeRange{0x11445, 0x11445}, ScriptNewa},                  // Mc       NEWA SIGN VISARGA
+	{runeRange{0x11447, 0x1144A}, ScriptNewa},                  // Lo   [4] NEWA SIGN AVAGRAHA..NEWA SIDDHI
+	{runeRange{0x11450, 0x11459}, ScriptNewa},                  // Nd  [10] NEWA DIGIT ZERO..NEWA DIGIT NINE
+	{runeRange{0x1145D, 0x1145D}, ScriptNewa},                  // Po       NEWA INSERTION SIGN
+	{runeRange{0x1145F, 0x11461}, ScriptNewa},                  // Lo   [3] NEWA LETTER VEDIC ANUSVARA..NEWA SIGN UPADHMANIYA
+	{runeRange{0x114B0, 0x114B2}, ScriptTirhuta},               // Mc   [3] TIRHUTA VOWEL SIGN AA..TIRHUTA VOWEL SIGN II
+	{runeRange{0x114B9, 0x114B9}, ScriptTirhuta},               // Mc       TIRHUTA VOWEL SIGN E
+	{runeRange{0x114BB, 0x114BE}, ScriptTirhuta},               // Mc   [4] TIRHUTA VOWEL SIGN AI..TIRHUTA VOWEL SIGN AU
+	{runeRange{0x114C1, 0x114C1}, ScriptTirhuta},               // Mc       TIRHUTA SIGN VISARGA
+	{runeRange{0x114C4, 0x114C5}, ScriptTirhuta},               // Lo   [2] TIRHUTA SIGN AVAGRAHA..TIRHUTA GVANG
+	{runeRange{0x114C7, 0x114C7}, ScriptTirhuta},               // Lo       TIRHUTA OM
+	{runeRange{0x11580, 0x115AE}, ScriptSiddham},               // Lo  [47] SIDDHAM LETTER A..SIDDHAM LETTER HA
+	{runeRange{0x115B2, 0x115B5}, ScriptSiddham},               // Mn   [4] SIDDHAM VOWEL SIGN U..SIDDHAM VOWEL SIGN VOCALIC RR
+	{runeRange{0x115BC, 0x115BD}, ScriptSiddham},               // Mn   [2] SIDDHAM SIGN CANDRABINDU..SIDDHAM SIGN ANUSVARA
+	{runeRange{0x115BF, 0x115C0}, ScriptSiddham},               // Mn   [2] SIDDHAM SIGN VIRAMA..SIDDHAM SIGN NUKTA
+	{runeRange{0x115D8, 0x115DB}, ScriptSiddham},               // Lo   [4] SIDDHAM LETTER THREE-CIRCLE ALTERNATE I..SIDDHAM LETTER ALTERNATE U
+	{runeRange{0x11600, 0x1162F}, ScriptModi},                  // Lo  [48] MODI LETTER A..MODI LETTER LLA
+	{runeRange{0x11633, 0x1163A}, ScriptModi},                  // Mn   [8] MODI VOWEL SIGN U..MODI VOWEL SIGN AI
+	{runeRange{0x1163D, 0x1163D}, ScriptModi},                  // Mn       MODI SIGN ANUSVARA
+	{runeRange{0x1163F, 0x11640}, ScriptModi},                  // Mn   [2] MODI SIGN VIRAMA..MODI SIGN ARDHACANDRA
+	{runeRange{0x11644, 0x11644}, ScriptModi},                  // Lo       MODI SIGN HUVA
+	{runeRange{0x11660, 0x1166C}, ScriptMongolian},             // Po  [13] MONGOLIAN BIRGA WITH ORNAMENT..MONGOLIAN TURNED SWIRL BIRGA WITH DOUBLE ORNAMENT
+	{runeRange{0x116AB, 0x116AB}, ScriptTakri},                 // Mn       TAKRI SIGN ANUSVARA
+	{runeRange{0x116AD, 0x116AD}, ScriptTakri},                 // Mn       TAKRI VOWEL SIGN AA
+	{runeRange{0x116B0, 0x116B5}, ScriptTakri},                 // Mn   [6] TAKRI VOWEL SIGN U..TAKRI VOWEL SIGN AU
+	{runeRange{0x116B7, 0x116B7}, ScriptTakri},                 // Mn       TAKRI SIGN NUKTA
+	{runeRange{0x116B9, 0x116B9}, ScriptTakri},                 // Po       TAKRI ABBREVIATION SIGN
+	{runeRange{0x116D0, 0x116E3}, ScriptMyanmar},               // Nd  [20] MYANMAR PAO DIGIT ZERO..MYANMAR EASTERN PWO KAREN DIGIT NINE
+	{runeRange{0x1171D, 0x1171D}, ScriptAhom},                  // Mn       AHOM CONSONANT SIGN MEDIAL LA
+	{runeRange{0x1171F, 0x1171F}, ScriptAhom},                  // Mn       AHOM CONSONANT SIGN MEDIAL LIGATING RA
+	{runeRange{0x11722, 0x11725}, ScriptAhom},                  // Mn   [4] AHOM VOWEL SIGN I..AHOM VOWEL SIGN UU
+	{runeRange{0x11727, 0x1172B}, ScriptAhom},                  // Mn   [5] AHOM VOWEL SIGN AW..AHOM SIGN KILLER
+	{runeRange{0x1173A, 0x1173B}, ScriptAhom},                  // No   [2] AHOM NUMBER TEN..AHOM NUMBER TWENTY
+	{runeRange{0x1173F, 0x1173F}, ScriptAhom},                  // So       AHOM SYMBOL VI
+	{runeRange{0x11800, 0x1182B}, ScriptDogra},                 // Lo  [44] DOGRA LETTER A..DOGRA LETTER RRA
+	{runeRange{0x1182F, 0x11837}, ScriptDogra},                 // Mn   [9] DOGRA VOWEL SIGN U..DOGRA SIGN ANUSVARA
+	{runeRange{0x11839, 0x1183A}, ScriptDogra},                 // Mn   [2] DOGRA SIGN VIRAMA..DOGRA SIGN NUKTA
+	{runeRange{0x118A0, 0x118BF}, ScriptWarangCiti},            // Lu  [32] WARANG CITI CAPITAL LETTER NGAA..WARANG CITI CAPITAL LETTER VIYO
+	{runeRange{0x118E0, 0x118E9}, ScriptWarangCiti},            // Nd  [10] WARANG CITI DIGIT ZERO..WARANG CITI DIGIT NINE
+	{runeRange{0x118FF, 0x118FF}, ScriptWarangCiti},            // Lo       WARANG CITI OM
+	{runeRange{0x11909, 0x11909}, ScriptDivesAkuru},            // Lo       DIVES AKURU LETTER O
+	{runeRange{0x11915, 0x11916}, ScriptDivesAkuru},            // Lo   [2] DIVES AKURU LETTER NYA..DIVES AKURU LETTER TTA
+	{runeRange{0x11930, 0x11935}, ScriptDivesAkuru},            // Mc   [6] DIVES AKURU VOWEL SIGN AA..DIVES AKURU VOWEL SIGN E
+	{runeRange{0x1193B, 0x1193C}, ScriptDivesAkuru},            // Mn   [2] DIVES AKURU SIGN ANUSVARA..DIVES AKURU SIGN CANDRABINDU
+	{runeRange{0x1193E, 0x1193E}, ScriptDivesAkuru},            // Mn       DIVES AKURU VIRAMA
+	{runeRange{0x11940, 0x11940}, ScriptDivesAkuru},            // Mc       DIVES AKURU MEDIAL YA
+	{runeRange{0x11942, 0x11942}, ScriptDivesAkuru},            // Mc       DIVES AKURU MEDIAL RA
+	{runeRange{0x11944, 0x11946}, ScriptDivesAkuru},            // Po   [3] DIVES AKURU DOUBLE DANDA..DIVES AKURU END OF TEXT MARK
+	{runeRange{0x119A0, 0x119A7}, ScriptNandinagari},           // Lo   [8] NANDINAGARI LETTER A..NANDINAGARI LETTER VOCALIC RR
+	{runeRange{0x119D1, 0x119D3}, ScriptNandinagari},           // Mc   [3] NANDINAGARI VOWEL SIGN AA..NANDINAGARI VOWEL SIGN II
+	{runeRange{0x119DA, 0x119DB}, ScriptNandinagari},           // Mn   [2] NANDINAGARI VOWEL SIGN E..NANDINAGARI VOWEL SIGN AI
+	{runeRange{0x119E0, 0x119E0}, ScriptNandinagari},           // Mn       NANDINAGARI SIGN VIRAMA
+	{runeRange{0x119E2, 0x119E2}, ScriptNandinagari},           // Po       NANDINAGARI SIGN SIDDHAM
+	{runeRange{0x119E4, 0x119E4}, ScriptNandinagari},           // Mc       NANDINAGARI VOWEL SIGN PRISHTHAMATRA E
+	{runeRange{0x11A01, 0x11A0A}, ScriptZanabazarSquare},       // Mn  [10] ZANABAZAR SQUARE VOWEL SIGN I..ZANABAZAR SQUARE VOWEL LENGTH MARK
+	{runeRange{0x11A33, 0x11A38}, ScriptZanabazarSquare},       // Mn   [6] ZANABAZAR SQUARE FINAL CONSONANT MARK..ZANABAZAR SQUARE SIGN ANUSVARA
+	{runeRange{0x11A3A, 0x11A3A}, ScriptZanabazarSquare},       // Lo       ZANABAZAR SQUARE CLUSTER-INITIAL LETTER RA
+	{runeRange{0x11A3F, 0x11A46}, ScriptZanabazarSquare},       // Po   [8] ZANABAZAR SQUARE INITIAL HEAD MARK..ZANABAZAR SQUARE CLOSING DOUBLE-LINED HEAD MARK
+	{runeRange{0x11A50, 0x11A50}, ScriptSoyombo},               // Lo       SOYOMBO LETTER A
+	{runeRange{0x11A57, 0x11A58}, ScriptSoyombo},               // Mc   [2] SOYOMBO VOWEL SIGN AI..SOYOMBO VOWEL SIGN AU
+	{runeRange{0x11A5C, 0x11A89}, ScriptSoyombo},               // Lo  [46] SOYOMBO LETTER KA..SOYOMBO CLUSTER-INITIAL LETTER SA
+	{runeRange{0x11A97, 0x11A97}, ScriptSoyombo},               // Mc       SOYOMBO SIGN VISARGA
+	{runeRange{0x11A9A, 0x11A9C}, ScriptSoyombo},               // Po   [3] SOYOMBO MARK TSHEG..SOYOMBO MARK DOUBLE SHAD
+	{runeRange{0x11A9E, 0x11AA2}, ScriptSoyombo},               // Po   [5] SOYOMBO HEAD MARK WITH MOON AND SUN AND TRIPLE FLAME..SOYOMBO TERMINAL MARK-2
+	{runeRange{0x11AC0, 0x11AF8}, ScriptPauCinHau},             // Lo  [57] PAU CIN HAU LETTER PA..PAU CIN HAU GLOTTAL STOP FINAL
+	{runeRange{0x11B60, 0x11B60}, ScriptSharada},               // Mn       SHARADA VOWEL SIGN OE
+	{runeRange{0x11B62, 0x11B64}, ScriptSharada},               // Mn   [3] SHARADA VOWEL SIGN UE..SHARADA VOWEL SIGN SHORT E
+	{runeRange{0x11B66, 0x11B66}, ScriptSharada},               // Mn       SHARADA VOWEL SIGN CANDRA E
+	{runeRange{0x11BC0, 0x11BE0}, ScriptSunuwar},               // Lo  [33] SUNUWAR LETTER DEVI..SUNUWAR LETTER KLOKO
+	{runeRange{0x11BF0, 0x11BF9}, ScriptSunuwar},               // Nd  [10] SUNUWAR DIGIT ZERO..SUNUWAR DIGIT NINE
+	{runeRange{0x11C0A, 0x11C2E}, ScriptBhaiksuki},             // Lo  [37] BHAIKSUKI LETTER E..BHAIKSUKI LETTER HA
+	{runeRange{0x11C30, 0x11C36}, ScriptBhaiksuki},             // Mn   [7] BHAIKSUKI VOWEL SIGN I..BHAIKSUKI VOWEL SIGN VOCALIC L
+	{runeRange{0x11C3E, 0x11C3E}, ScriptBhaiksuki},             // Mc       BHAIKSUKI SIGN VISARGA
+	{runeRange{0x11C40, 0x11C40}, ScriptBhaiksuki},             // Lo       BHAIKSUKI SIGN AVAGRAHA
+	{runeRange{0x11C50, 0x11C59}, ScriptBhaiksuki},             // Nd  [10] BHAIKSUKI DIGIT ZERO..BHAIKSUKI DIGIT NINE
+	{runeRange{0x11C70, 0x11C71}, ScriptMarchen},               // Po   [2] MARCHEN HEAD MARK..MARCHEN MARK SHAD
+	{runeRange{0x11C92, 0x11CA7}, ScriptMarchen},               // Mn  [22] MARCHEN SUBJOINED LETTER KA..MARCHEN SUBJOINED LETTER ZA
+	{runeRange{0x11CAA, 0x11CB0}, ScriptMarchen},               // Mn   [7] MARCHEN SUBJOINED LETTER RA..MARCHEN VOWEL SIGN AA
+	{runeRange{0x11CB2, 0x11CB3}, ScriptMarchen},               // Mn   [2] MARCHEN VOWEL SIGN U..MARCHEN VOWEL SIGN E
+	{runeRange{0x11CB5, 0x11CB6}, ScriptMarchen},               // Mn   [2] MARCHEN SIGN ANUSVARA..MARCHEN SIGN CANDRABINDU
+	{runeRange{0x11D08, 0x11D09}, ScriptMasaramGondi},          // Lo   [2] MASARAM GONDI LETTER AI..MASARAM GONDI LETTER O
+	{runeRange{0x11D31, 0x11D36}, ScriptMasaramGondi},          // Mn   [6] MASARAM GONDI VOWEL SIGN AA..MASARAM GONDI VOWEL SIGN VOCALIC R
+	{runeRange{0x11D3C, 0x11D3D}, ScriptMasaramGondi},          // Mn   [2] MASARAM GONDI VOWEL SIGN AI..MASARAM GONDI VOWEL SIGN O
+	{runeRange{0x11D46, 0x11D46}, ScriptMasaramGondi},          // Lo       MASARAM GONDI REPHA
+	{runeRange{0x11D50, 0x11D59}, ScriptMasaramGondi},          // Nd  [10] MASARAM GONDI DIGIT ZERO..MASARAM GONDI DIGIT NINE
+	{runeRange{0x11D67, 0x11D68}, ScriptGunjalaGondi},          // Lo   [2] GUNJALA GONDI LETTER EE..GUNJALA GONDI LETTER AI
+	{runeRange{0x11D8A, 0x11D8E}, ScriptGunjalaGondi},          // Mc   [5] GUNJALA GONDI VOWEL SIGN AA..GUNJALA GONDI VOWEL SIGN UU
+	{runeRange{0x11D93, 0x11D94}, ScriptGunjalaGondi},          // Mc   [2] GUNJALA GONDI VOWEL SIGN OO..GUNJALA GONDI VOWEL SIGN AU
+	{runeRange{0x11D96, 0x11D96}, ScriptGunjalaGondi},          // Mc       GUNJALA GONDI SIGN VISARGA
+	{runeRange{0x11D98, 0x11D98}, ScriptGunjalaGondi},          // Lo       GUNJALA GONDI OM
+	{runeRange{0x11DB0, 0x11DD8}, ScriptTolongSiki},            // Lo  [41] TOLONG SIKI LETTER I..TOLONG SIKI LETTER RRH
+	{runeRange{0x11DDA, 0x11DDB}, ScriptTolongSiki},            // Lo   [2] TOLONG SIKI SIGN HECAKA..TOLONG SIKI UNGGA
+	{runeRange{0x11EE0, 0x11EF2}, ScriptMakasar},               // Lo  [19] MAKASAR LETTER KA..MAKASAR ANGKA
+	{runeRange{0x11EF5, 0x11EF6}, ScriptMakasar},               // Mc   [2] MAKASAR VOWEL SIGN E..MAKASAR VOWEL SIGN O
+	{runeRange{0x11F00, 0x11F01}, ScriptKawi},                  // Mn   [2] KAWI SIGN CANDRABINDU..KAWI SIGN ANUSVARA
+	{runeRange{0x11F03, 0x11F03}, ScriptKawi},                  // Mc       KAWI SIGN VISARGA
+	{runeRange{0x11F12, 0x11F33}, ScriptKawi},                  // Lo  [34] KAWI LETTER KA..KAWI LETTER JNYA
+	{runeRange{0x11F36, 0x11F3A}, ScriptKawi},                  // Mn   [5] KAWI VOWEL SIGN I..KAWI VOWEL SIGN VOCALIC R
+	{runeRange{0x11F40, 0x11F40}, ScriptKawi},                  // Mn       KAWI VOWEL SIGN EU
+	{runeRange{0x11F42, 0x11F42}, ScriptKawi},                  // Mn       KAWI CONJOINER
+	{runeRange{0x11F50, 0x11F59}, ScriptKawi},                  // Nd  [10] KAWI DIGIT ZERO..KAWI DIGIT NINE
+	{runeRange{0x11FB0, 0x11FB0}, ScriptLisu},                  // Lo       LISU LETTER YHA
+	{runeRange{0x11FD5, 0x11FDC}, ScriptTamil},                 // So   [8] TAMIL SIGN NEL..TAMIL SIGN MUKKURUNI
+	{runeRange{0x11FE1, 0x11FF1}, ScriptTamil},                 // So  [17] TAMIL SIGN PAARAM..TAMIL SIGN VAKAIYARAA
+	{runeRange{0x12000, 0x12399}, ScriptCuneiform},             // Lo [922] CUNEIFORM SIGN A..CUNEIFORM SIGN U U
+	{runeRange{0x12470, 0x12474}, ScriptCuneiform},             // Po   [5] CUNEIFORM PUNCTUATION SIGN OLD ASSYRIAN WORD DIVIDER..CUNEIFORM PUNCTUATION SIGN DIAGONAL QUADCOLON
+	{runeRange{0x12F90, 0x12FF0}, ScriptCyproMinoan},           // Lo  [97] CYPRO-MINOAN SIGN CM001..CYPRO-MINOAN SIGN CM114
+	{runeRange{0x13000, 0x1342F}, ScriptEgyptianHieroglyphs},   // Lo [1072] EGYPTIAN HIEROGLYPH A001..EGYPTIAN HIEROGLYPH V011D
+	{runeRange{0x13440, 0x13440}, ScriptEgyptianHieroglyphs},   // Mn       EGYPTIAN HIEROGLYPH MIRROR HORIZONTALLY
+	{runeRange{0x13447, 0x13455}, ScriptEgyptianHieroglyphs},   // Mn  [15] EGYPTIAN HIEROGLYPH MODIFIER DAMAGED AT TOP START..EGYPTIAN HIEROGLYPH MODIFIER DAMAGED
+	{runeRange{0x14400, 0x14646}, ScriptAnatolianHieroglyphs},  // Lo [583] ANATOLIAN HIEROGLYPH A001..ANATOLIAN HIEROGLYPH A530
+	{runeRange{0x1611E, 0x16129}, ScriptGurungKhema},           // Mn  [12] GURUNG KHEMA VOWEL SIGN AA..GURUNG KHEMA VOWEL LENGTH MARK
+	{runeRange{0x1612D, 0x1612F}, ScriptGurungKhema},           // Mn   [3] GURUNG KHEMA SIGN ANUSVARA..GURUNG KHEMA SIGN THOLHOMA
+	{runeRange{0x16800, 0x16A38}, ScriptBamum},                 // Lo [569] BAMUM LETTER PHASE-A NGKUE MFON..BAMUM LETTER PHASE-F VUEQ
+	{runeRange{0x16A60, 0x16A69}, ScriptMro},                   // Nd  [10] MRO DIGIT ZERO..MRO DIGIT NINE
+	{runeRange{0x16A70, 0x16ABE}, ScriptTangsa},                // Lo  [79] TANGSA LETTER OZ..TANGSA LETTER ZA
+	{runeRange{0x16AD0, 0x16AED}, ScriptBassaVah},              // Lo  [30] BASSA VAH LETTER ENNI..BASSA VAH LETTER I
+	{runeRange{0x16AF5, 0x16AF5}, ScriptBassaVah},              // Po       BASSA VAH FULL STOP
+	{runeRange{0x16B30, 0x16B36}, ScriptPahawhHmong},           // Mn   [7] PAHAWH HMONG MARK CIM TUB..PAHAWH HMONG MARK CIM TAUM
+	{runeRange{0x16B3C, 0x16B3F}, ScriptPahawhHmong},           // So   [4] PAHAWH HMONG SIGN XYEEM NTXIV..PAHAWH HMONG SIGN XYEEM FAIB
+	{runeRange{0x16B44, 0x16B44}, ScriptPahawhHmong},           // Po       PAHAWH HMONG SIGN XAUS
+	{runeRange{0x16B50, 0x16B59}, ScriptPahawhHmong},           // Nd  [10] PAHAWH HMONG DIGIT ZERO..PAHAWH HMONG DIGIT NINE
+	{runeRange{0x16B63, 0x16B77}, ScriptPahawhHmong},           // Lo  [21] PAHAWH HMONG SIGN VOS LUB..PAHAWH HMONG SIGN CIM NRES TOS
+	{runeRange{0x16D40, 0x16D42}, ScriptKiratRai},              // Lm   [3] KIRAT RAI SIGN ANUSVARA..KIRAT RAI SIGN VISARGA
+	{runeRange{0x16D6B, 0x16D6C}, ScriptKiratRai},              // Lm   [2] KIRAT RAI SIGN VIRAMA..KIRAT RAI SIGN SAAT
+	{runeRange{0x16D70, 0x16D79}, ScriptKiratRai},              // Nd  [10] KIRAT RAI DIGIT ZERO..KIRAT RAI DIGIT NINE
+	{runeRange{0x16E60, 0x16E7F}, ScriptMedefaidrin},           // Ll  [32] MEDEFAIDRIN SMALL LETTER M..MEDEFAIDRIN SMALL LETTER Y
+	{runeRange{0x16E97, 0x16E9A}, ScriptMedefaidrin},           // Po   [4] MEDEFAIDRIN COMMA..MEDEFAIDRIN EXCLAMATION OH
+	{runeRange{0x16EBB, 0x16ED3}, ScriptBeriaErfe},             // Ll  [25] BERIA ERFE SMALL LETTER ARKAB..BERIA ERFE SMALL LETTER AY
+	{runeRange{0x16F4F, 0x16F4F}, ScriptMiao},                  // Mn       MIAO SIGN CONSONANT MODIFIER BAR
+	{runeRange{0x16F51, 0x16F87}, ScriptMiao},                  // Mc  [55] MIAO SIGN ASPIRATION..MIAO VOWEL SIGN UI
+	{runeRange{0x16F93, 0x16F9F}, ScriptMiao},                  // Lm  [13] MIAO LETTER TONE-2..MIAO LETTER REFORMED TONE-8
+	{runeRange{0x16FE1, 0x16FE1}, ScriptNushu},                 // Lm       NUSHU ITERATION MARK
+	{runeRange{0x16FE3, 0x16FE3}, ScriptHan},                   // Lm       OLD CHINESE ITERATION MARK
+	{runeRange{0x16FF0, 0x16FF1}, ScriptHan},                   // Mc   [2] VIETNAMESE ALTERNATE READING MARK CA..VIETNAMESE ALTERNATE READING MARK NHAY
+	{runeRange{0x16FF4, 0x16FF6}, ScriptHan},                   // Nl   [3] YANGQIN SIGN SLOW ONE BEAT..YANGQIN SIGN SLOW TWO BEATS
+	{runeRange{0x18B00, 0x18CD5}, ScriptKhitanSmallScript},     // Lo [470] KHITAN SMALL SCRIPT CHARACTER-18B00..KHITAN SMALL SCRIPT CHARACTER-18CD5
+	{runeRange{0x18D00, 0x18D1E}, ScriptTangut},                // Lo  [31] TANGUT IDEOGRAPH-18D00..TANGUT IDEOGRAPH-18D1E
+	{runeRange{0x1AFF0, 0x1AFF3}, ScriptKatakana},              // Lm   [4] KATAKANA LETTER MINNAN TONE-2..KATAKANA LETTER MINNAN TONE-5
+	{runeRange{0x1AFFD, 0x1AFFE}, ScriptKatakana},              // Lm   [2] KATAKANA LETTER MINNAN NASALIZED TONE-7..KATAKANA LETTER MINNAN NASALIZED TONE-8
+	{runeRange{0x1B001, 0x1B11F}, ScriptHiragana},              // Lo [287] HIRAGANA LETTER ARCHAIC YE..HIRAGANA LETTER ARCHAIC WU
+	{runeRange{0x1B132, 0x1B132}, ScriptHiragana},              // Lo       HIRAGANA LETTER SMALL KO
+	{runeRange{0x1B155, 0x1B155}, ScriptKatakana},              // Lo       KATAKANA LETTER SMALL KO
+	{runeRange{0x1B170, 0x1B2FB}, ScriptNushu},                 // Lo [396] NUSHU CHARACTER-1B170..NUSHU CHARACTER-1B2FB
+	{runeRange{0x1BC70, 0x1BC7C}, ScriptDuployan},              // Lo  [13] DUPLOYAN AFFIX LEFT HORIZONTAL SECANT..DUPLOYAN AFFIX ATTACHED TANGENT HOOK
+	{runeRange{0x1BC90, 0x1BC99}, ScriptDuployan},              // Lo  [10] DUPLOYAN AFFIX LOW ACUTE..DUPLOYAN AFFIX LOW ARROW
+	{runeRange{0x1BC9D, 0x1BC9E}, ScriptDuployan},              // Mn   [2] DUPLOYAN THICK LETTER SELECTOR..DUPLOYAN DOUBLE MARK
+	{runeRange{0x1BCA0, 0x1BCA3}, ScriptCommon},                // Cf   [4] SHORTHAND FORMAT LETTER OVERLAP..SHORTHAND FORMAT UP STEP
+	{runeRange{0x1CCF0, 0x1CCF9}, ScriptCommon},                // Nd  [10] OUTLINED DIGIT ZERO..OUTLINED DIGIT NINE
+	{runeRange{0x1CD00, 0x1CEB3}, ScriptCommon},                // So [436] BLOCK OCTANT-3..BLACK RIGHT TRIANGLE CARET
+	{runeRange{0x1CEE0, 0x1CEEF}, ScriptCommon},                // So  [16] GEOMANTIC FIGURE POPULUS..GEOMANTIC FIGURE VIA
+	{runeRange{0x1CF00, 0x1CF2D}, ScriptInherited},             // Mn  [46] ZNAMENNY COMBINING MARK GORAZDO NIZKO S KRYZHEM ON LEFT..ZNAMENNY COMBINING MARK KRYZH ON LEFT
+	{runeRange{0x1CF50, 0x1CFC3}, ScriptCommon},                // So [116] ZNAMENNY NEUME KRYUK..ZNAMENNY NEUME PAUK
+	{runeRange{0x1D100, 0x1D126}, ScriptCommon},                // So  [39] MUSICAL SYMBOL SINGLE BARLINE..MUSICAL SYMBOL DRUM CLEF-2
+	{runeRange{0x1D165, 0x1D166}, ScriptCommon},                // Mc   [2] MUSICAL SYMBOL COMBINING STEM..MUSICAL SYMBOL COMBINING SPRECHGESANG STEM
+	{runeRange{0x1D16A, 0x1D16C}, ScriptCommon},                // So   [3] MUSICAL SYMBOL FINGERED TREMOLO-1..MUSICAL SYMBOL FINGERED TREMOLO-3
+	{runeRange{0x1D173, 0x1D17A}, ScriptCommon},                // Cf   [8] MUSICAL SYMBOL BEGIN BEAM..MUSICAL SYMBOL END PHRASE
+	{runeRange{0x1D183, 0x1D184}, ScriptCommon},                // So   [2] MUSICAL SYMBOL ARPEGGIATO UP..MUSICAL SYMBOL ARPEGGIATO DOWN
+	{runeRange{0x1D18C, 0x1D1A9}, ScriptCommon},                // So  [30] MUSICAL SYMBOL RINFORZANDO..MUSICAL SYMBOL DEGREE SLASH
+	{runeRange{0x1D1AE, 0x1D1EA}, ScriptCommon},                // So  [61] MUSICAL SYMBOL PEDAL MARK..MUSICAL SYMBOL KORON
+	{runeRange{0x1D242, 0x1D244}, ScriptGreek},                 // Mn   [3] COMBINING GREEK MUSICAL TRISEME..COMBINING GREEK MUSICAL PENTASEME
+	{runeRange{0x1D2C0, 0x1D2D3}, ScriptCommon},                // No  [20] KAKTOVIK NUMERAL ZERO..KAKTOVIK NUMERAL NINETEEN
+	{runeRange{0x1D300, 0x1D356}, ScriptCommon},                // So  [87] MONOGRAM FOR EARTH..TETRAGRAM FOR FOSTERING
+	{runeRange{0x1D400, 0x1D419}, ScriptCommon},                // Lu  [26] MATHEMATICAL BOLD CAPITAL A..MATHEMATICAL BOLD CAPITAL Z
+	{runeRange{0x1D434, 0x1D44D}, ScriptCommon},                // Lu  [26] MATHEMATICAL ITALIC CAPITAL A..MATHEMATICAL ITALIC CAPITAL Z
+	{runeRange{0x1D456, 0x1D467}, ScriptCommon},                // Ll  [18] MATHEMATICAL ITALIC SMALL I..MATHEMATICAL ITALIC SMALL Z
+	{runeRange{0x1D482, 0x1D49B}, ScriptCommon},                // Ll  [26] MATHEMATICAL BOLD ITALIC SMALL A..MATHEMATICAL BOLD ITALIC SMALL Z
+	{runeRange{0x1D49E, 0x1D49F}, ScriptCommon},                // Lu   [2] MATHEMATICAL SCRIPT CAPITAL C..MATHEMATICAL SCRIPT CAPITAL D
+	{runeRange{0x1D4A5, 0x1D4A6}, ScriptCommon},                // Lu   [2] MATHEMATICAL SCRIPT CAPITAL J..MATHEMATICAL SCRIPT CAPITAL K
+	{runeRange{0x1D4AE, 0x1D4B5}, ScriptCommon},                // Lu   [8] MATHEMATICAL SCRIPT CAPITAL S..MATHEMATICAL SCRIPT CAPITAL Z
+	{runeRange{0x1D4BB, 0x1D4BB}, ScriptCommon},                // Ll       MATHEMATICAL SCRIPT SMALL F
+	{runeRange{0x1D4C5, 0x1D4CF}, ScriptCommon},                // Ll  [11] MATHEMATICAL SCRIPT SMALL P..MATHEMATICAL SCRIPT SMALL Z
+	{runeRange{0x1D4EA, 0x1D503}, ScriptCommon},                // Ll  [26] MATHEMATICAL BOLD SCRIPT SMALL A..MATHEMATICAL BOLD SCRIPT SMALL Z
+	{runeRange{0x1D507, 0x1D50A}, ScriptCommon},                // Lu   [4] MATHEMATICAL FRAKTUR CAPITAL D..MATHEMATICAL FRAKTUR CAPITAL G
+	{runeRange{0x1D516, 0x1D51C}, ScriptCommon},                // Lu   [7] MATHEMATICAL FRAKTUR CAPITAL S..MATHEMATICAL FRAKTUR CAPITAL Y
+	{runeRange{0x1D538, 0x1D539}, ScriptCommon},                // Lu   [2] MATHEMATICAL DOUBLE-STRUCK CAPITAL A..MATHEMATICAL DOUBLE-STRUCK CAPITAL B
+	{runeRange{0x1D540, 0x1D544}, ScriptCommon},                // Lu   [5] MATHEMATICAL DOUBLE-STRUCK CAPITAL I..MATHEMATICAL DOUBLE-STRUCK CAPITAL M
+	{runeRange{0x1D54A, 0x1D550}, ScriptCommon},                // Lu   [7] MATHEMATICAL DOUBLE-STRUCK CAPITAL S..MATHEMATICAL DOUBLE-STRUCK CAPITAL Y
+	{runeRange{0x1D56C, 0x1D585}, ScriptCommon},                // Lu  [26] MATHEMATICAL BOLD FRAKTUR CAPITAL A..MATHEMATICAL BOLD FRAKTUR CAPITAL Z
+	{runeRange{0x1D5A0, 0x1D5B9}, ScriptCommon},                // Lu  [26] MATHEMATICAL SANS-SERIF CAPITAL A..MATHEMATICAL SANS-SERIF CAPITAL Z
+	{runeRange{0x1D5D4, 0x1D5ED}, ScriptCommon},                // Lu  [26] MATHEMATICAL SANS-SERIF BOLD CAPITAL A..MATHEMATICAL SANS-SERIF BOLD CAPITAL Z
+	{runeRange{0x1D608, 0x1D621}, ScriptCommon},                // Lu  [26] MATHEMATICAL SANS-SERIF ITALIC CAPITAL A..MATHEMATICAL SANS-SERIF ITALIC CAPITAL Z
+	{runeRange{0x1D63C, 0x1D655}, ScriptCommon},                // Lu  [26] MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL A..MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL Z
+	{runeRange{0x1D670, 0x1D689}, ScriptCommon},                // Lu  [26] MATHEMATICAL MONOSPACE CAPITAL A..MATHEMATICAL MONOSPACE CAPITAL Z
+	{runeRange{0x1D6A8, 0x1D6C0}, ScriptCommon},                // Lu  [25] MATHEMATICAL BOLD CAPITAL ALPHA..MATHEMATICAL BOLD CAPITAL OMEGA
+	{runeRange{0x1D6C2, 0x1D6DA}, ScriptCommon},                // Ll  [25] MATHEMATICAL BOLD SMALL ALPHA..MATHEMATICAL BOLD SMALL OMEGA
+	{runeRange{0x1D6DC, 0x1D6E1}, ScriptCommon},                // Ll   [6] MATHEMATICAL BOLD EPSILON SYMBOL..MATHEMATICAL BOLD PI SYMBOL
+	{runeRange{0x1D6FB, 0x1D6FB}, ScriptCommon},                // Sm       MATHEMATICAL ITALIC NABLA
+	{runeRange{0x1D715, 0x1D715}, ScriptCommon},                // Sm       MATHEMATICAL ITALIC PARTIAL DIFFERENTIAL
+	{runeRange{0x1D71C, 0x1D734}, ScriptCommon},                // Lu  [25] MATHEMATICAL BOLD ITALIC CAPITAL ALPHA..MATHEMATICAL BOLD ITALIC CAPITAL OMEGA
+	{runeRange{0x1D736, 0x1D74E}, ScriptCommon},                // Ll  [25] MATHEMATICAL BOLD ITALIC SMALL ALPHA..MATHEMATICAL BOLD ITALIC SMALL OMEGA
+	{runeRange{0x1D750, 0x1D755}, ScriptCommon},                // Ll   [6] MATHEMATICAL BOLD ITALIC EPSILON SYMBOL..MATHEMATICAL BOLD ITALIC PI SYMBOL
+	{runeRange{0x1D76F, 0x1D76F}, ScriptCommon},                // Sm       MATHEMATICAL SANS-SERIF BOLD NABLA
+	{runeRange{0x1D789, 0x1D789}, ScriptCommon},                // Sm       MATHEMATICAL SANS-SERIF BOLD PARTIAL DIFFERENTIAL
+	{runeRange{0x1D790, 0x1D7A8}, ScriptCommon},                // Lu  [25] MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL ALPHA..MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL OMEGA
+	{runeRange{0x1D7AA, 0x1D7C2}, ScriptCommon},                // Ll  [25] MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL ALPHA..MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL OMEGA
+	{runeRange{0x1D7C4, 0x1D7C9}, ScriptCommon},                // Ll   [6] MATHEMATICAL SANS-SERIF BOLD ITALIC EPSILON SYMBOL..MATHEMATICAL SANS-SERIF BOLD ITALIC PI SYMBOL
+	{runeRange{0x1D7CB, 0x1D7CB}, ScriptCommon},                // Ll       MATHEMATICAL BOLD SMALL DIGAMMA
+	{runeRange{0x1D800, 0x1D9FF}, ScriptSignWriting},           // So [512] SIGNWRITING HAND-FIST INDEX..SIGNWRITING HEAD
+	{runeRange{0x1DA37, 0x1DA3A}, ScriptSignWriting},           // So   [4] SIGNWRITING AIR BLOW SMALL ROTATIONS..SIGNWRITING BREATH EXHALE
+	{runeRange{0x1DA6D, 0x1DA74}, ScriptSignWriting},           // So   [8] SIGNWRITING SHOULDER HIP SPINE..SIGNWRITING TORSO-FLOORPLANE TWISTING
+	{runeRange{0x1DA76, 0x1DA83}, ScriptSignWriting},           // So  [14] SIGNWRITING LIMB COMBINATION..SIGNWRITING LOCATION DEPTH
+	{runeRange{0x1DA85, 0x1DA86}, ScriptSignWriting},           // So   [2] SIGNWRITING LOCATION TORSO..SIGNWRITING LOCATION LIMBS DIGITS
+	{runeRange{0x1DA9B, 0x1DA9F}, ScriptSignWriting},           // Mn   [5] SIGNWRITING FILL MODIFIER-2..SIGNWRITING FILL MODIFIER-6
+	{runeRange{0x1DF00, 0x1DF09}, ScriptLatin},                 // Ll  [10] LATIN SMALL LETTER FENG DIGRAPH WITH TRILL..LATIN SMALL LETTER T WITH HOOK AND RETROFLEX HOOK
+	{runeRange{0x1DF0B, 0x1DF1E}, ScriptLatin},                 // Ll  [20] LATIN SMALL LETTER ESH WITH DOUBLE BAR..LATIN SMALL LETTER S WITH CURL
+	{runeRange{0x1E000, 0x1E006}, ScriptGlagolitic},            // Mn   [7] COMBINING GLAGOLITIC LETTER AZU..COMBINING GLAGOLITIC LETTER ZHIVETE
+	{runeRange{0x1E01B, 0x1E021}, ScriptGlagolitic},            // Mn   [7] COMBINING GLAGOLITIC LETTER SHTA..COMBINING GLAGOLITIC LETTER YATI
+	{runeRange{0x1E026, 0x1E02A}, ScriptGlagolitic},            // Mn   [5] COMBINING GLAGOLITIC LETTER YO..COMBINING GLAGOLITIC LETTER FITA
+	{runeRange{0x1E08F, 0x1E08F}, ScriptCyrillic},              // Mn       COMBINING CYRILLIC SMALL LETTER BYELORUSSIAN-UKRAINIAN I
+	{runeRange{0x1E130, 0x1E136}, ScriptNyiakengPuachueHmong},  // Mn   [7] NYIAKENG PUACHUE HMONG TONE-B..NYIAKENG PUACHUE HMONG TONE-D
+	{runeRange{0x1E140, 0x1E149}, ScriptNyiakengPuachueHmong},  // Nd  [10] NYIAKENG PUACHUE HMONG DIGIT ZERO..NYIAKENG PUACHUE HMONG DIGIT NINE
+	{runeRange{0x1E14F, 0x1E14F}, ScriptNyiakengPuachueHmong},  // So       NYIAKENG PUACHUE HMONG CIRCLED CA
+	{runeRange{0x1E2AE, 0x1E2AE}, ScriptToto},                  // Mn       TOTO SIGN RISING TONE
+	{runeRange{0x1E2EC, 0x1E2EF}, ScriptWancho},                // Mn   [4] WANCHO TONE TUP..WANCHO TONE KOINI
+	{runeRange{0x1E2FF, 0x1E2FF}, ScriptWancho},                // Sc       WANCHO NGUN SIGN
+	{runeRange{0x1E4EB, 0x1E4EB}, ScriptNagMundari},            // Lm       NAG MUNDARI SIGN OJOD
+	{runeRange{0x1E4F0, 0x1E4F9}, ScriptNagMundari},            // Nd  [10] NAG MUNDARI DIGIT ZERO..NAG MUNDARI DIGIT NINE
+	{runeRange{0x1E5EE, 0x1E5EF}, ScriptOlOnal},                // Mn   [2] OL ONAL SIGN MU..OL ONAL SIGN IKIR
+	{runeRange{0x1E5F1, 0x1E5FA}, ScriptOlOnal},                // Nd  [10] OL ONAL DIGIT ZERO..OL ONAL DIGIT NINE
+	{runeRange{0x1E6C0, 0x1E6DE}, ScriptTaiYo},                 // Lo  [31] TAI YO LETTER LOW KO..TAI YO LETTER HIGH KVO
+	{runeRange{0x1E6E3, 0x1E6E3}, ScriptTaiYo},                 // Mn       TAI YO SIGN UE
+	{runeRange{0x1E6E6, 0x1E6E6}, ScriptTaiYo},                 // Mn       TAI YO SIGN AU
+	{runeRange{0x1E6EE, 0x1E6EF}, ScriptTaiYo},                 // Mn   [2] TAI YO SIGN AY..TAI YO SIGN ANG
+	{runeRange{0x1E6F5, 0x1E6F5}, ScriptTaiYo},                 // Mn       TAI YO SIGN OM
+	{runeRange{0x1E6FF, 0x1E6FF}, ScriptTaiYo},                 // Lm       TAI YO XAM LAI
+	{runeRange{0x1E7E8, 0x1E7EB}, ScriptEthiopic},              // Lo   [4] ETHIOPIC SYLLABLE GURAGE HHWA..ETHIOPIC SYLLABLE HHWE
+	{runeRange{0x1E7F0, 0x1E7FE}, ScriptEthiopic},              // Lo  [15] ETHIOPIC SYLLABLE GURAGE QWI..ETHIOPIC SYLLABLE GURAGE PWEE
+	{runeRange{0x1E8C7, 0x1E8CF}, ScriptMendeKikakui},          // No   [9] MENDE KIKAKUI DIGIT ONE..MENDE KIKAKUI DIGIT NINE
+	{runeRange{0x1E900, 0x1E921}, ScriptAdlam},                 // Lu  [34] ADLAM CAPITAL LETTER ALIF..ADLAM CAPITAL LETTER SHA
+	{runeRange{0x1E944, 0x1E94A}, ScriptAdlam},                 // Mn   [7] ADLAM ALIF LENGTHENER..ADLAM NUKTA
+	{runeRange{0x1E950, 0x1E959}, ScriptAdlam},                 // Nd  [10] ADLAM DIGIT ZERO..ADLAM DIGIT NINE
+	{runeRange{0x1EC71, 0x1ECAB}, ScriptCommon},                // No  [59] INDIC SIYAQ NUMBER ONE..INDIC SIYAQ NUMBER PREFIXED NINE
+	{runeRange{0x1ECAD, 0x1ECAF}, ScriptCommon},                // No   [3] INDIC SIYAQ FRACTION ONE QUARTER..INDIC SIYAQ FRACTION THREE QUARTERS
+	{runeRange{0x1ECB1, 0x1ECB4}, ScriptCommon},                // No   [4] INDIC SIYAQ NUMBER ALTERNATE ONE..INDIC SIYAQ ALTERNATE LAKH MARK
+	{runeRange{0x1ED2E, 0x1ED2E}, ScriptCommon},                // So       OTTOMAN SIYAQ MARRATAN
+	{runeRange{0x1EE00, 0x1EE03}, ScriptArabic},                // Lo   [4] ARABIC MATHEMATICAL ALEF..ARABIC MATHEMATICAL DAL
+	{runeRange{0x1EE21, 0x1EE22}, ScriptArabic},                // Lo   [2] ARABIC MATHEMATICAL INITIAL BEH..ARABIC MATHEMATICAL INITIAL JEEM
+	{runeRange{0x1EE27, 0x1EE27}, ScriptArabic},                // Lo       ARABIC MATHEMATICAL INITIAL HAH
+	{runeRange{0x1EE34, 0x1EE37}, ScriptArabic},                // Lo   [4] ARABIC MATHEMATICAL INITIAL SHEEN..ARABIC MATHEMATICAL INITIAL KHAH
+	{runeRange{0x1EE3B, 0x1EE3B}, ScriptArabic},                // Lo       ARABIC MATHEMATICAL INITIAL GHAIN
+	{runeRange{0x1EE47, 0x1EE47}, ScriptArabic},                // Lo       ARABIC MATHEMATICAL TAILED HAH
+	{runeRange{0x1EE4B, 0x1EE4B}, ScriptArabic},                // Lo       ARABIC MATHEMATICAL TAILED LAM
+	{runeRange{0x1EE51, 0x1EE52}, ScriptArabic},                // Lo   [2] ARABIC MATHEMATICAL TAILED SAD..ARABIC MATHEMATICAL TAILED QAF
+	{runeRange{0x1EE57, 0x1EE57}, ScriptArabic},                // Lo       ARABIC MATHEMATICAL TAILED KHAH
+	{runeRange{0x1EE5B, 0x1EE5B}, ScriptArabic},                // Lo       ARABIC MATHEMATICAL TAILED GHAIN
+	{runeRange{0x1EE5F, 0x1EE5F}, ScriptArabic},                // Lo       ARABIC MATHEMATICAL TAILED DOTLESS QAF
+	{runeRange{0x1EE64, 0x1EE64}, ScriptArabic},                // Lo       ARABIC MATHEMATICAL STRETCHED HEH
+	{runeRange{0x1EE6C, 0x1EE72}, ScriptArabic},                // Lo   [7] ARABIC MATHEMATICAL STRETCHED MEEM..ARABIC MATHEMATICAL STRETCHED QAF
+	{runeRange{0x1EE79, 0x1EE7C}, ScriptArabic},                // Lo   [4] ARABIC MATHEMATICAL STRETCHED DAD..ARABIC MATHEMATICAL STRETCHED DOTLESS BEH
+	{runeRange{0x1EE80, 0x1EE89}, ScriptArabic},                // Lo  [10] ARABIC MATHEMATICAL LOOPED ALEF..ARABIC MATHEMATICAL LOOPED YEH
+	{runeRange{0x1EEA1, 0x1EEA3}, ScriptArabic},                // Lo   [3] ARABIC MATHEMATICAL DOUBLE-STRUCK BEH..ARABIC MATHEMATICAL DOUBLE-STRUCK DAL
+	{runeRange{0x1EEAB, 0x1EEBB}, ScriptArabic},                // Lo  [17] ARABIC MATHEMATICAL DOUBLE-STRUCK LAM..ARABIC MATHEMATICAL DOUBLE-STRUCK GHAIN
+	{runeRange{0x1F000, 0x1F02B}, ScriptCommon},                // So  [44] MAHJONG TILE EAST WIND..MAHJONG TILE BACK
+	{runeRange{0x1F0A0, 0x1F0AE}, ScriptCommon},                // So  [15] PLAYING CARD BACK..PLAYING CARD KING OF SPADES
+	{runeRange{0x1F0C1, 0x1F0CF}, ScriptCommon},                // So  [15] PLAYING CARD ACE OF DIAMONDS..PLAYING CARD BLACK JOKER
+	{runeRange{0x1F100, 0x1F10C}, ScriptCommon},                // No  [13] DIGIT ZERO FULL STOP..DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT ZERO
+	{runeRange{0x1F1E6, 0x1F1FF}, ScriptCommon},                // So  [26] REGIONAL INDICATOR SYMBOL LETTER A..REGIONAL INDICATOR SYMBOL LETTER Z
+	{runeRange{0x1F201, 0x1F202}, ScriptCommon},                // So   [2] SQUARED KATAKANA KOKO..SQUARED KATAKANA SA
+	{runeRange{0x1F240, 0x1F248}, ScriptCommon},                // So   [9] TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-672C..TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-6557
+	{runeRange{0x1F260, 0x1F265}, ScriptCommon},                // So   [6] ROUNDED SYMBOL FOR FU..ROUNDED SYMBOL FOR CAI
+	{runeRange{0x1F3FB, 0x1F3FF}, ScriptCommon},                // Sk   [5] EMOJI MODIFIER FITZPATRICK TYPE-1-2..EMOJI MODIFIER FITZPATRICK TYPE-6
+	{runeRange{0x1F6DC, 0x1F6EC}, ScriptCommon},                // So  [17] WIRELESS..AIRPLANE ARRIVING
+	{runeRange{0x1F700, 0x1F7D9}, ScriptCommon},                // So [218] ALCHEMICAL SYMBOL FOR QUINTESSENCE..NINE POINTED WHITE STAR
+	{runeRange{0x1F7F0, 0x1F7F0}, ScriptCommon},                // So       HEAVY EQUALS SIGN
+	{runeRange{0x1F810, 0x1F847}, ScriptCommon},                // So  [56] LEFTWARDS ARROW WITH SMALL EQUILATERAL ARROWHEAD..DOWNWARDS HEAVY ARROW
+	{runeRange{0x1F860, 0x1F887}, ScriptCommon},                // So  [40] WIDE-HEADED LEFTWARDS LIGHT BARB ARROW..WIDE-HEADED SOUTH WEST VERY HEAVY BARB ARROW
+	{runeRange{0x1F8B0, 0x1F8BB}, ScriptCommon},                // So  [12] ARROW POINTING UPWARDS THEN NORTH WEST..SOUTH WEST ARROW FROM BAR
+	{runeRange{0x1F8D0, 0x1F8D8}, ScriptCommon},                // Sm   [9] LONG RIGHTWARDS ARROW OVER LONG LEFTWARDS ARROW..LONG LEFT RIGHT ARROW WITH DEPENDENT LOBE
+	{runeRange{0x1FA60, 0x1FA6D}, ScriptCommon},                // So  [14] XIANGQI RED GENERAL..XIANGQI BLACK SOLDIER
+	{runeRange{0x1FA80, 0x1FA8A}, ScriptCommon},                // So  [11] YO-YO..TROMBONE
+	{runeRange{0x1FAC8, 0x1FAC8}, ScriptCommon},                // So       HAIRY CREATURE
+	{runeRange{0x1FADF, 0x1FAEA}, ScriptCommon},                // So  [12] SPLATTER..DISTORTED FACE
+	{runeRange{0x1FB00, 0x1FB92}, ScriptCommon},                // So [147] BLOCK SEXTANT-1..UPPER HALF INVERSE MEDIUM SHADE AND LOWER HALF BLOCK
+	{runeRange{0x1FBF0, 0x1FBF9}, ScriptCommon},                // Nd  [10] SEGMENTED DIGIT ZERO..SEGMENTED DIGIT NINE
+	{runeRange{0x20000, 0x2A6DF}, ScriptHan},                   // Lo [42720] CJK UNIFIED IDEOGRAPH-20000..CJK UNIFIED IDEOGRAPH-2A6DF
+	{runeRange{0x2B820, 0x2CEAD}, ScriptHan},                   // Lo [5774] CJK UNIFIED IDEOGRAPH-2B820..CJK UNIFIED IDEOGRAPH-2CEAD
+	{runeRange{0x2EBF0, 0x2EE5D}, ScriptHan},                   // Lo [622] CJK UNIFIED IDEOGRAPH-2EBF0..CJK UNIFIED IDEOGRAPH-2EE5D
+	{runeRange{0x30000, 0x3134A}, ScriptHan},                   // Lo [4939] CJK UNIFIED IDEOGRAPH-30000..CJK UNIFIED IDEOGRAPH-3134A
+	{runeRange{0xE0001, 0xE0001}, ScriptCommon},                // Cf       LANGUAGE TAG
+	{runeRange{0xE0100, 0xE01EF}, ScriptInherited},             // Mn [240] VARIATION SELECTOR-17..VARIATION SELECTOR-256
+	{runeRange{0x0000, 0x001F}, ScriptCommon},                  // Cc  [32] <control-0000>..<control-001F>
+	{runeRange{0x0021, 0x0023}, ScriptCommon},                  // Po   [3] EXCLAMATION MARK..NUMBER SIGN
+	{runeRange{0x0025, 0x0027}, ScriptCommon},                  // Po   [3] PERCENT SIGN..APOSTROPHE
+	{runeRange{0x0029, 0x0029}, ScriptCommon},                  // Pe       RIGHT PARENTHESIS
+	{runeRange{0x002B, 0x002B}, ScriptCommon},                  // Sm       PLUS SIGN
+	{runeRange{0x002D, 0x002D}, ScriptCommon},                  // Pd       HYPHEN-MINUS
+	{runeRange{0x0030, 0x0039}, ScriptCommon},                  // Nd  [10] DIGIT ZERO..DIGIT NINE
+	{runeRange{0x003C, 0x003E}, ScriptCommon},                  // Sm   [3] LESS-THAN SIGN..GREATER-THAN SIGN
+	{runeRange{0x0041, 0x005A}, ScriptLatin},                   // Lu  [26] LATIN CAPITAL LETTER A..LATIN CAPITAL LETTER Z
+	{runeRange{0x005C, 0x005C}, ScriptCommon},                  // Po       REVERSE SOLIDUS
+	{runeRange{0x005E, 0x005E}, ScriptCommon},                  // Sk       CIRCUMFLEX ACCENT
+	{runeRange{0x0060, 0x0060}, ScriptCommon},                  // Sk       GRAVE ACCENT
+	{runeRange{0x007B, 0x007B}, ScriptCommon},                  // Ps       LEFT CURLY BRACKET
+	{runeRange{0x007D, 0x007D}, ScriptCommon},                  // Pe       RIGHT CURLY BRACKET
+	{runeRange{0x007F, 0x009F}, ScriptCommon},                  // Cc  [33] <control-007F>..<control-009F>
+	{runeRange{0x00A1, 0x00A1}, ScriptCommon},                  // Po       INVERTED EXCLAMATION MARK
+	{runeRange{0x00A6, 0x00A6}, ScriptCommon},                  // So       BROKEN BAR
+	{runeRange{0x00A8, 0x00A8}, ScriptCommon},                  // Sk       DIAERESIS
+	{runeRange{0x00AA, 0x00AA}, ScriptLatin},                   // Lo       FEMININE ORDINAL INDICATOR
+	{runeRange{0x00AC, 0x00AC}, ScriptCommon},                  // Sm       NOT SIGN
+	{runeRange{0x00AE, 0x00AE}, ScriptCommon},                  // So       REGISTERED SIGN
+	{runeRange{0x00B0, 0x00B0}, ScriptCommon},                  // So       DEGREE SIGN
+	{runeRange{0x00B2, 0x00B3}, ScriptCommon},                  // No   [2] SUPERSCRIPT TWO..SUPERSCRIPT THREE
+	{runeRange{0x00B5, 0x00B5}, ScriptCommon},                  // Ll       MICRO SIGN
+	{runeRange{0x00B8, 0x00B8}, ScriptCommon},                  // Sk       CEDILLA
+	{runeRange{0x00BA, 0x00BA}, ScriptLatin},                   // Lo       MASCULINE ORDINAL INDICATOR
+	{runeRange{0x00BC, 0x00BE}, ScriptCommon},                  // No   [3] VULGAR FRACTION ONE QUARTER..VULGAR FRACTION THREE QUARTERS
+	{runeRange{0x00C0, 0x00D6}, ScriptLatin},                   // Lu  [23] LATIN CAPITAL LETTER A WITH GRAVE..LATIN CAPITAL LETTER O WITH DIAERESIS
+	{runeRange{0x00D8, 0x00DE}, ScriptLatin},                   // Lu   [7] LATIN CAPITAL LETTER O WITH STROKE..LATIN CAPITAL LETTER THORN
+	{runeRange{0x00F7, 0x00F7}, ScriptCommon},                  // Sm       DIVISION SIGN
+	{runeRange{0x0100, 0x0100}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH MACRON
+	{runeRange{0x0102, 0x0102}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH BREVE
+	{runeRange{0x0104, 0x0104}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH OGONEK
+	{runeRange{0x0106, 0x0106}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER C WITH ACUTE
+	{runeRange{0x0108, 0x0108}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER C WITH CIRCUMFLEX
+	{runeRange{0x010A, 0x010A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER C WITH DOT ABOVE
+	{runeRange{0x010C, 0x010C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER C WITH CARON
+	{runeRange{0x010E, 0x010E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER D WITH CARON
+	{runeRange{0x0110, 0x0110}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER D WITH STROKE
+	{runeRange{0x0112, 0x0112}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH MACRON
+	{runeRange{0x0114, 0x0114}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH BREVE
+	{runeRange{0x0116, 0x0116}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH DOT ABOVE
+	{runeRange{0x0118, 0x0118}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH OGONEK
+	{runeRange{0x011A, 0x011A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH CARON
+	{runeRange{0x011C, 0x011C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER G WITH CIRCUMFLEX
+	{runeRange{0x011E, 0x011E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER G WITH BREVE
+	{runeRange{0x0120, 0x0120}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER G WITH DOT ABOVE
+	{runeRange{0x0122, 0x0122}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER G WITH CEDILLA
+	{runeRange{0x0124, 0x0124}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER H WITH CIRCUMFLEX
+	{runeRange{0x0126, 0x0126}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER H WITH STROKE
+	{runeRange{0x0128, 0x0128}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER I WITH TILDE
+	{runeRange{0x012A, 0x012A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER I WITH MACRON
+	{runeRange{0x012C, 0x012C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER I WITH BREVE
+	{runeRange{0x012E, 0x012E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER I WITH OGONEK
+	{runeRange{0x0130, 0x0130}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER I WITH DOT ABOVE
+	{runeRange{0x0132, 0x0132}, ScriptLatin},                   // Lu       LATIN CAPITAL LIGATURE IJ
+	{runeRange{0x0134, 0x0134}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER J WITH CIRCUMFLEX
+	{runeRange{0x0136, 0x0136}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER K WITH CEDILLA
+	{runeRange{0x0139, 0x0139}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER L WITH ACUTE
+	{runeRange{0x013B, 0x013B}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER L WITH CEDILLA
+	{runeRange{0x013D, 0x013D}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER L WITH CARON
+	{runeRange{0x013F, 0x013F}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER L WITH MIDDLE DOT
+	{runeRange{0x0141, 0x0141}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER L WITH STROKE
+	{runeRange{0x0143, 0x0143}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER N WITH ACUTE
+	{runeRange{0x0145, 0x0145}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER N WITH CEDILLA
+	{runeRange{0x0147, 0x0147}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER N WITH CARON
+	{runeRange{0x014A, 0x014A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER ENG
+	{runeRange{0x014C, 0x014C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH MACRON
+	{runeRange{0x014E, 0x014E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH BREVE
+	{runeRange{0x0150, 0x0150}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH DOUBLE ACUTE
+	{runeRange{0x0152, 0x0152}, ScriptLatin},                   // Lu       LATIN CAPITAL LIGATURE OE
+	{runeRange{0x0154, 0x0154}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER R WITH ACUTE
+	{runeRange{0x0156, 0x0156}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER R WITH CEDILLA
+	{runeRange{0x0158, 0x0158}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER R WITH CARON
+	{runeRange{0x015A, 0x015A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER S WITH ACUTE
+	{runeRange{0x015C, 0x015C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER S WITH CIRCUMFLEX
+	{runeRange{0x015E, 0x015E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER S WITH CEDILLA
+	{runeRange{0x0160, 0x0160}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER S WITH CARON
+	{runeRange{0x0162, 0x0162}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER T WITH CEDILLA
+	{runeRange{0x0164, 0x0164}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER T WITH CARON
+	{runeRange{0x0166, 0x0166}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER T WITH STROKE
+	{runeRange{0x0168, 0x0168}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH TILDE
+	{runeRange{0x016A, 0x016A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH MACRON
+	{runeRange{0x016C, 0x016C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH BREVE
+	{runeRange{0x016E, 0x016E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH RING ABOVE
+	{runeRange{0x0170, 0x0170}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH DOUBLE ACUTE
+	{runeRange{0x0172, 0x0172}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH OGONEK
+	{runeRange{0x0174, 0x0174}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER W WITH CIRCUMFLEX
+	{runeRange{0x0176, 0x0176}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Y WITH CIRCUMFLEX
+	{runeRange{0x0178, 0x0179}, ScriptLatin},                   // Lu   [2] LATIN CAPITAL LETTER Y WITH DIAERESIS..LATIN CAPITAL LETTER Z WITH ACUTE
+	{runeRange{0x017B, 0x017B}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Z WITH DOT ABOVE
+	{runeRange{0x017D, 0x017D}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Z WITH CARON
+	{runeRange{0x0181, 0x0182}, ScriptLatin},                   // Lu   [2] LATIN CAPITAL LETTER B WITH HOOK..LATIN CAPITAL LETTER B WITH TOPBAR
+	{runeRange{0x0184, 0x0184}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER TONE SIX
+	{runeRange{0x0186, 0x0187}, ScriptLatin},                   // Lu   [2] LATIN CAPITAL LETTER OPEN O..LATIN CAPITAL LETTER C WITH HOOK
+	{runeRange{0x0189, 0x018B}, ScriptLatin},                   // Lu   [3] LATIN CAPITAL LETTER AFRICAN D..LATIN CAPITAL LETTER D WITH TOPBAR
+	{runeRange{0x018E, 0x0191}, ScriptLatin},                   // Lu   [4] LATIN CAPITAL LETTER REVERSED E..LATIN CAPITAL LETTER F WITH HOOK
+	{runeRange{0x0193, 0x0194}, ScriptLatin},                   // Lu   [2] LATIN CAPITAL LETTER G WITH HOOK..LATIN CAPITAL LETTER GAMMA
+	{runeRange{0x0196, 0x0198}, ScriptLatin},                   // Lu   [3] LATIN CAPITAL LETTER IOTA..LATIN CAPITAL LETTER K WITH HOOK
+	{runeRange{0x019C, 0x019D}, ScriptLatin},                   // Lu   [2] LATIN CAPITAL LETTER TURNED M..LATIN CAPITAL LETTER N WITH LEFT HOOK
+	{runeRange{0x019F, 0x01A0}, ScriptLatin},                   // Lu   [2] LATIN CAPITAL LETTER O WITH MIDDLE TILDE..LATIN CAPITAL LETTER O WITH HORN
+	{runeRange{0x01A2, 0x01A2}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER OI
+	{runeRange{0x01A4, 0x01A4}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER P WITH HOOK
+	{runeRange{0x01A6, 0x01A7}, ScriptLatin},                   // Lu   [2] LATIN LETTER YR..LATIN CAPITAL LETTER TONE TWO
+	{runeRange{0x01A9, 0x01A9}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER ESH
+	{runeRange{0x01AC, 0x01AC}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER T WITH HOOK
+	{runeRange{0x01AE, 0x01AF}, ScriptLatin},                   // Lu   [2] LATIN CAPITAL LETTER T WITH RETROFLEX HOOK..LATIN CAPITAL LETTER U WITH HORN
+	{runeRange{0x01B1, 0x01B3}, ScriptLatin},                   // Lu   [3] LATIN CAPITAL LETTER UPSILON..LATIN CAPITAL LETTER Y WITH HOOK
+	{runeRange{0x01B5, 0x01B5}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Z WITH STROKE
+	{runeRange{0x01B7, 0x01B8}, ScriptLatin},                   // Lu   [2] LATIN CAPITAL LETTER EZH..LATIN CAPITAL LETTER EZH REVERSED
+	{runeRange{0x01BB, 0x01BB}, ScriptLatin},                   // Lo       LATIN LETTER TWO WITH STROKE
+	{runeRange{0x01BD, 0x01BF}, ScriptLatin},                   // Ll   [3] LATIN SMALL LETTER TONE FIVE..LATIN LETTER WYNN
+	{runeRange{0x01C4, 0x01C4}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER DZ WITH CARON
+	{runeRange{0x01C6, 0x01C6}, ScriptLatin},                   // Ll       LATIN SMALL LETTER DZ WITH CARON
+	{runeRange{0x01C8, 0x01C8}, ScriptLatin},                   // Lt       LATIN CAPITAL LETTER L WITH SMALL LETTER J
+	{runeRange{0x01CA, 0x01CA}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER NJ
+	{runeRange{0x01CC, 0x01CC}, ScriptLatin},                   // Ll       LATIN SMALL LETTER NJ
+	{runeRange{0x01CE, 0x01CE}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH CARON
+	{runeRange{0x01D0, 0x01D0}, ScriptLatin},                   // Ll       LATIN SMALL LETTER I WITH CARON
+	{runeRange{0x01D2, 0x01D2}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH CARON
+	{runeRange{0x01D4, 0x01D4}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH CARON
+	{runeRange{0x01D6, 0x01D6}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH DIAERESIS AND MACRON
+	{runeRange{0x01D8, 0x01D8}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH DIAERESIS AND ACUTE
+	{runeRange{0x01DA, 0x01DA}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH DIAERESIS AND CARON
+	{runeRange{0x01DC, 0x01DD}, ScriptLatin},                   // Ll   [2] LATIN SMALL LETTER U WITH DIAERESIS AND GRAVE..LATIN SMALL LETTER TURNED E
+	{runeRange{0x01DF, 0x01DF}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH DIAERESIS AND MACRON
+	{runeRange{0x01E1, 0x01E1}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH DOT ABOVE AND MACRON
+	{runeRange{0x01E3, 0x01E3}, ScriptLatin},                   // Ll       LATIN SMALL LETTER AE WITH MACRON
+	{runeRange{0x01E5, 0x01E5}, ScriptLatin},                   // Ll       LATIN SMALL LETTER G WITH STROKE
+	{runeRange{0x01E7, 0x01E7}, ScriptLatin},                   // Ll       LATIN SMALL LETTER G WITH CARON
+	{runeRange{0x01E9, 0x01E9}, ScriptLatin},                   // Ll       LATIN SMALL LETTER K WITH CARON
+	{runeRange{0x01EB, 0x01EB}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH OGONEK
+	{runeRange{0x01ED, 0x01ED}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH OGONEK AND MACRON
+	{runeRange{0x01EF, 0x01F0}, ScriptLatin},                   // Ll   [2] LATIN SMALL LETTER EZH WITH CARON..LATIN SMALL LETTER J WITH CARON
+	{runeRange{0x01F2, 0x01F2}, ScriptLatin},                   // Lt       LATIN CAPITAL LETTER D WITH SMALL LETTER Z
+	{runeRange{0x01F4, 0x01F4}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER G WITH ACUTE
+	{runeRange{0x01F6, 0x01F8}, ScriptLatin},                   // Lu   [3] LATIN CAPITAL LETTER HWAIR..LATIN CAPITAL LETTER N WITH GRAVE
+	{runeRange{0x01FA, 0x01FA}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH RING ABOVE AND ACUTE
+	{runeRange{0x01FC, 0x01FC}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER AE WITH ACUTE
+	{runeRange{0x01FE, 0x01FE}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH STROKE AND ACUTE
+	{runeRange{0x0200, 0x0200}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH DOUBLE GRAVE
+	{runeRange{0x0202, 0x0202}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH INVERTED BREVE
+	{runeRange{0x0204, 0x0204}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH DOUBLE GRAVE
+	{runeRange{0x0206, 0x0206}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH INVERTED BREVE
+	{runeRange{0x0208, 0x0208}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER I WITH DOUBLE GRAVE
+	{runeRange{0x020A, 0x020A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER I WITH INVERTED BREVE
+	{runeRange{0x020C, 0x020C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH DOUBLE GRAVE
+	{runeRange{0x020E, 0x020E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH INVERTED BREVE
+	{runeRange{0x0210, 0x0210}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER R WITH DOUBLE GRAVE
+	{runeRange{0x0212, 0x0212}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER R WITH INVERTED BREVE
+	{runeRange{0x0214, 0x0214}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH DOUBLE GRAVE
+	{runeRange{0x0216, 0x0216}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER U WITH INVERTED BREVE
+	{runeRange{0x0218, 0x0218}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER S WITH COMMA BELOW
+	{runeRange{0x021A, 0x021A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER T WITH COMMA BELOW
+	{runeRange{0x021C, 0x021C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER YOGH
+	{runeRange{0x021E, 0x021E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER H WITH CARON
+	{runeRange{0x0220, 0x0220}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER N WITH LONG RIGHT LEG
+	{runeRange{0x0222, 0x0222}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER OU
+	{runeRange{0x0224, 0x0224}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Z WITH HOOK
+	{runeRange{0x0226, 0x0226}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER A WITH DOT ABOVE
+	{runeRange{0x0228, 0x0228}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER E WITH CEDILLA
+	{runeRange{0x022A, 0x022A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH DIAERESIS AND MACRON
+	{runeRange{0x022C, 0x022C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH TILDE AND MACRON
+	{runeRange{0x022E, 0x022E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH DOT ABOVE
+	{runeRange{0x0230, 0x0230}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH DOT ABOVE AND MACRON
+	{runeRange{0x0232, 0x0232}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Y WITH MACRON
+	{runeRange{0x023A, 0x023B}, ScriptLatin},                   // Lu   [2] LATIN CAPITAL LETTER A WITH STROKE..LATIN CAPITAL LETTER C WITH STROKE
+	{runeRange{0x023D, 0x023E}, ScriptLatin},                   // Lu   [2] LATIN CAPITAL LETTER L WITH BAR..LATIN CAPITAL LETTER T WITH DIAGONAL STROKE
+	{runeRange{0x0241, 0x0241}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER GLOTTAL STOP
+	{runeRange{0x0243, 0x0246}, ScriptLatin},                   // Lu   [4] LATIN CAPITAL LETTER B WITH STROKE..LATIN CAPITAL LETTER E WITH STROKE
+	{runeRange{0x0248, 0x0248}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER J WITH STROKE
+	{runeRange{0x024A, 0x024A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER SMALL Q WITH HOOK TAIL
+	{runeRange{0x024C, 0x024C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER R WITH STROKE
+	{runeRange{0x024E, 0x024E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Y WITH STROKE
+	{runeRange{0x0294, 0x0295}, ScriptLatin},                   // Lo   [2] LATIN LETTER GLOTTAL STOP..LATIN LETTER PHARYNGEAL VOICED FRICATIVE
+	{runeRange{0x02B0, 0x02B8}, ScriptLatin},                   // Lm   [9] MODIFIER LETTER SMALL H..MODIFIER LETTER SMALL Y
+	{runeRange{0x02C2, 0x02C5}, ScriptCommon},                  // Sk   [4] MODIFIER LETTER LEFT ARROWHEAD..MODIFIER LETTER DOWN ARROWHEAD
+	{runeRange{0x02D2, 0x02DF}, ScriptCommon},                  // Sk  [14] MODIFIER LETTER CENTRED RIGHT HALF RING..MODIFIER LETTER CROSS ACCENT
+	{runeRange{0x02E5, 0x02E9}, ScriptCommon},                  // Sk   [5] MODIFIER LETTER EXTRA-HIGH TONE BAR..MODIFIER LETTER EXTRA-LOW TONE BAR
+	{runeRange{0x02EC, 0x02EC}, ScriptCommon},                  // Lm       MODIFIER LETTER VOICING
+	{runeRange{0x02EE, 0x02EE}, ScriptCommon},                  // Lm       MODIFIER LETTER DOUBLE APOSTROPHE
+	{runeRange{0x0300, 0x036F}, ScriptInherited},               // Mn [112] COMBINING GRAVE ACCENT..COMBINING LATIN SMALL LETTER X
+	{runeRange{0x0371, 0x0371}, ScriptGreek},                   // Ll       GREEK SMALL LETTER HETA
+	{runeRange{0x0373, 0x0373}, ScriptGreek},                   // Ll       GREEK SMALL LETTER ARCHAIC SAMPI
+	{runeRange{0x0375, 0x0375}, ScriptGreek},                   // Sk       GREEK LOWER NUMERAL SIGN
+	{runeRange{0x0377, 0x0377}, ScriptGreek},                   // Ll       GREEK SMALL LETTER PAMPHYLIAN DIGAMMA
+	{runeRange{0x037B, 0x037D}, ScriptGreek},                   // Ll   [3] GREEK SMALL REVERSED LUNATE SIGMA SYMBOL..GREEK SMALL REVERSED DOTTED LUNATE SIGMA SYMBOL
+	{runeRange{0x037F, 0x037F}, ScriptGreek},                   // Lu       GREEK CAPITAL LETTER YOT
+	{runeRange{0x0385, 0x0385}, ScriptCommon},                  // Sk       GREEK DIALYTIKA TONOS
+	{runeRange{0x0387, 0x0387}, ScriptCommon},                  // Po       GREEK ANO TELEIA
+	{runeRange{0x038C, 0x038C}, ScriptGreek},                   // Lu       GREEK CAPITAL LETTER OMICRON WITH TONOS
+	{runeRange{0x0390, 0x0390}, ScriptGreek},                   // Ll       GREEK SMALL LETTER IOTA WITH DIALYTIKA AND TONOS
+	{runeRange{0x03A3, 0x03AB}, ScriptGreek},                   // Lu   [9] GREEK CAPITAL LETTER SIGMA..GREEK CAPITAL LETTER UPSILON WITH DIALYTIKA
+	{runeRange{0x03CF, 0x03CF}, ScriptGreek},                   // Lu       GREEK CAPITAL KAI SYMBOL
+	{runeRange{0x03D2, 0x03D4}, ScriptGreek},                   // Lu   [3] GREEK UPSILON WITH HOOK SYMBOL..GREEK UPSILON WITH DIAERESIS AND HOOK SYMBOL
+	{runeRange{0x03D8, 0x03D8}, ScriptGreek},                   // Lu       GREEK LETTER ARCHAIC KOPPA
+	{runeRange{0x03DA, 0x03DA}, ScriptGreek},                   // Lu       GREEK LETTER STIGMA
+	{runeRange{0x03DC, 0x03DC}, ScriptGreek},                   // Lu       GREEK LETTER DIGAMMA
+	{runeRange{0x03DE, 0x03DE}, ScriptGreek},                   // Lu       GREEK LETTER KOPPA
+	{runeRange{0x03E0, 0x03E0}, ScriptGreek},                   // Lu       GREEK LETTER SAMPI
+	{runeRange{0x03E2, 0x03E2}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER SHEI
+	{runeRange{0x03E4, 0x03E4}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER FEI
+	{runeRange{0x03E6, 0x03E6}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER KHEI
+	{runeRange{0x03E8, 0x03E8}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER HORI
+	{runeRange{0x03EA, 0x03EA}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER GANGIA
+	{runeRange{0x03EC, 0x03EC}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER SHIMA
+	{runeRange{0x03EE, 0x03EE}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER DEI
+	{runeRange{0x03F0, 0x03F3}, ScriptGreek},                   // Ll   [4] GREEK KAPPA SYMBOL..GREEK LETTER YOT
+	{runeRange{0x03F5, 0x03F5}, ScriptGreek},                   // Ll       GREEK LUNATE EPSILON SYMBOL
+	{runeRange{0x03F7, 0x03F7}, ScriptGreek},                   // Lu       GREEK CAPITAL LETTER SHO
+	{runeRange{0x03F9, 0x03FA}, ScriptGreek},                   // Lu   [2] GREEK CAPITAL LUNATE SIGMA SYMBOL..GREEK CAPITAL LETTER SAN
+	{runeRange{0x03FD, 0x03FF}, ScriptGreek},                   // Lu   [3] GREEK CAPITAL REVERSED LUNATE SIGMA SYMBOL..GREEK CAPITAL REVERSED DOTTED LUNATE SIGMA SYMBOL
+	{runeRange{0x0430, 0x045F}, ScriptCyrillic},                // Ll  [48] CYRILLIC SMALL LETTER A..CYRILLIC SMALL LETTER DZHE
+	{runeRange{0x0461, 0x0461}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER OMEGA
+	{runeRange{0x0463, 0x0463}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER YAT
+	{runeRange{0x0465, 0x0465}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER IOTIFIED E
+	{runeRange{0x0467, 0x0467}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER LITTLE YUS
+	{runeRange{0x0469, 0x0469}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER IOTIFIED LITTLE YUS
+	{runeRange{0x046B, 0x046B}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER BIG YUS
+	{runeRange{0x046D, 0x046D}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER IOTIFIED BIG YUS
+	{runeRange{0x046F, 0x046F}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER KSI
+	{runeRange{0x0471, 0x0471}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER PSI
+	{runeRange{0x0473, 0x0473}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER FITA
+	{runeRange{0x0475, 0x0475}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER IZHITSA
+	{runeRange{0x0477, 0x0477}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER IZHITSA WITH DOUBLE GRAVE ACCENT
+	{runeRange{0x0479, 0x0479}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER UK
+	{runeRange{0x047B, 0x047B}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER ROUND OMEGA
+	{runeRange{0x047D, 0x047D}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER OMEGA WITH TITLO
+	{runeRange{0x047F, 0x047F}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER OT
+	{runeRange{0x0481, 0x0481}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER KOPPA
+	{runeRange{0x0483, 0x0484}, ScriptCyrillic},                // Mn   [2] COMBINING CYRILLIC TITLO..COMBINING CYRILLIC PALATALIZATION
+	{runeRange{0x0487, 0x0487}, ScriptCyrillic},                // Mn       COMBINING CYRILLIC POKRYTIE
+	{runeRange{0x048A, 0x048A}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER SHORT I WITH TAIL
+	{runeRange{0x048C, 0x048C}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER SEMISOFT SIGN
+	{runeRange{0x048E, 0x048E}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER ER WITH TICK
+	{runeRange{0x0490, 0x0490}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER GHE WITH UPTURN
+	{runeRange{0x0492, 0x0492}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER GHE WITH STROKE
+	{runeRange{0x0494, 0x0494}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER GHE WITH MIDDLE HOOK
+	{runeRange{0x0496, 0x0496}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER ZHE WITH DESCENDER
+	{runeRange{0x0498, 0x0498}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER ZE WITH DESCENDER
+	{runeRange{0x049A, 0x049A}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER KA WITH DESCENDER
+	{runeRange{0x049C, 0x049C}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER KA WITH VERTICAL STROKE
+	{runeRange{0x049E, 0x049E}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER KA WITH STROKE
+	{runeRange{0x04A0, 0x04A0}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER BASHKIR KA
+	{runeRange{0x04A2, 0x04A2}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER EN WITH DESCENDER
+	{runeRange{0x04A4, 0x04A4}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LIGATURE EN GHE
+	{runeRange{0x04A6, 0x04A6}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER PE WITH MIDDLE HOOK
+	{runeRange{0x04A8, 0x04A8}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER ABKHASIAN HA
+	{runeRange{0x04AA, 0x04AA}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER ES WITH DESCENDER
+	{runeRange{0x04AC, 0x04AC}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER TE WITH DESCENDER
+	{runeRange{0x04AE, 0x04AE}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER STRAIGHT U
+	{runeRange{0x04B0, 0x04B0}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER STRAIGHT U WITH STROKE
+	{runeRange{0x04B2, 0x04B2}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER HA WITH DESCENDER
+	{runeRange{0x04B4, 0x04B4}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LIGATURE TE TSE
+	{runeRange{0x04B6, 0x04B6}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER CHE WITH DESCENDER
+	{runeRange{0x04B8, 0x04B8}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER CHE WITH VERTICAL STROKE
+	{runeRange{0x04BA, 0x04BA}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER SHHA
+	{runeRange{0x04BC, 0x04BC}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER ABKHASIAN CHE
+	{runeRange{0x04BE, 0x04BE}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER ABKHASIAN CHE WITH DESCENDER
+	{runeRange{0x04C0, 0x04C1}, ScriptCyrillic},                // Lu   [2] CYRILLIC LETTER PALOCHKA..CYRILLIC CAPITAL LETTER ZHE WITH BREVE
+	{runeRange{0x04C3, 0x04C3}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER KA WITH HOOK
+	{runeRange{0x04C5, 0x04C5}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER EL WITH TAIL
+	{runeRange{0x04C7, 0x04C7}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER EN WITH HOOK
+	{runeRange{0x04C9, 0x04C9}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER EN WITH TAIL
+	{runeRange{0x04CB, 0x04CB}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER KHAKASSIAN CHE
+	{runeRange{0x04CD, 0x04CD}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER EM WITH TAIL
+	{runeRange{0x04D0, 0x04D0}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER A WITH BREVE
+	{runeRange{0x04D2, 0x04D2}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER A WITH DIAERESIS
+	{runeRange{0x04D4, 0x04D4}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LIGATURE A IE
+	{runeRange{0x04D6, 0x04D6}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER IE WITH BREVE
+	{runeRange{0x04D8, 0x04D8}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER SCHWA
+	{runeRange{0x04DA, 0x04DA}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER SCHWA WITH DIAERESIS
+	{runeRange{0x04DC, 0x04DC}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER ZHE WITH DIAERESIS
+	{runeRange{0x04DE, 0x04DE}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER ZE WITH DIAERESIS
+	{runeRange{0x04E0, 0x04E0}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER ABKHASIAN DZE
+	{runeRange{0x04E2, 0x04E2}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER I WITH MACRON
+	{runeRange{0x04E4, 0x04E4}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER I WITH DIAERESIS
+	{runeRange{0x04E6, 0x04E6}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER O WITH DIAERESIS
+	{runeRange{0x04E8, 0x04E8}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER BARRED O
+	{runeRange{0x04EA, 0x04EA}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER BARRED O WITH DIAERESIS
+	{runeRange{0x04EC, 0x04EC}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER E WITH DIAERESIS
+	{runeRange{0x04EE, 0x04EE}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER U WITH MACRON
+	{runeRange{0x04F0, 0x04F0}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER U WITH DIAERESIS
+	{runeRange{0x04F2, 0x04F2}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER U WITH DOUBLE ACUTE
+	{runeRange{0x04F4, 0x04F4}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER CHE WITH DIAERESIS
+	{runeRange{0x04F6, 0x04F6}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER GHE WITH DESCENDER
+	{runeRange{0x04F8, 0x04F8}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER YERU WITH DIAERESIS
+	{runeRange{0x04FA, 0x04FA}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER GHE WITH STROKE AND HOOK
+	{runeRange{0x04FC, 0x04FC}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER HA WITH HOOK
+	{runeRange{0x04FE, 0x04FE}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER HA WITH STROKE
+	{runeRange{0x0500, 0x0500}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER KOMI DE
+	{runeRange{0x0502, 0x0502}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER KOMI DJE
+	{runeRange{0x0504, 0x0504}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER KOMI ZJE
+	{runeRange{0x0506, 0x0506}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER KOMI DZJE
+	{runeRange{0x0508, 0x0508}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER KOMI LJE
+	{runeRange{0x050A, 0x050A}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER KOMI NJE
+	{runeRange{0x050C, 0x050C}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER KOMI SJE
+	{runeRange{0x050E, 0x050E}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER KOMI TJE
+	{runeRange{0x0510, 0x0510}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER REVERSED ZE
+	{runeRange{0x0512, 0x0512}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER EL WITH HOOK
+	{runeRange{0x0514, 0x0514}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER LHA
+	{runeRange{0x0516, 0x0516}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER RHA
+	{runeRange{0x0518, 0x0518}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER YAE
+	{runeRange{0x051A, 0x051A}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER QA
+	{runeRange{0x051C, 0x051C}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER WE
+	{runeRange{0x051E, 0x051E}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER ALEUT KA
+	{runeRange{0x0520, 0x0520}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER EL WITH MIDDLE HOOK
+	{runeRange{0x0522, 0x0522}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER EN WITH MIDDLE HOOK
+	{runeRange{0x0524, 0x0524}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER PE WITH DESCENDER
+	{runeRange{0x0526, 0x0526}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER SHHA WITH DESCENDER
+	{runeRange{0x0528, 0x0528}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER EN WITH LEFT HOOK
+	{runeRange{0x052A, 0x052A}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER DZZHE
+	{runeRange{0x052C, 0x052C}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER DCHE
+	{runeRange{0x052E, 0x052E}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER EL WITH DESCENDER
+	{runeRange{0x0531, 0x0556}, ScriptArmenian},                // Lu  [38] ARMENIAN CAPITAL LETTER AYB..ARMENIAN CAPITAL LETTER FEH
+	{runeRange{0x055A, 0x055F}, ScriptArmenian},                // Po   [6] ARMENIAN APOSTROPHE..ARMENIAN ABBREVIATION MARK
+	{runeRange{0x0589, 0x0589}, ScriptArmenian},                // Po       ARMENIAN FULL STOP
+	{runeRange{0x058D, 0x058E}, ScriptArmenian},                // So   [2] RIGHT-FACING ARMENIAN ETERNITY SIGN..LEFT-FACING ARMENIAN ETERNITY SIGN
+	{runeRange{0x0591, 0x05BD}, ScriptHebrew},                  // Mn  [45] HEBREW ACCENT ETNAHTA..HEBREW POINT METEG
+	{runeRange{0x05BF, 0x05BF}, ScriptHebrew},                  // Mn       HEBREW POINT RAFE
+	{runeRange{0x05C1, 0x05C2}, ScriptHebrew},                  // Mn   [2] HEBREW POINT SHIN DOT..HEBREW POINT SIN DOT
+	{runeRange{0x05C4, 0x05C5}, ScriptHebrew},                  // Mn   [2] HEBREW MARK UPPER DOT..HEBREW MARK LOWER DOT
+	{runeRange{0x05C7, 0x05C7}, ScriptHebrew},                  // Mn       HEBREW POINT QAMATS QATAN
+	{runeRange{0x05EF, 0x05F2}, ScriptHebrew},                  // Lo   [4] HEBREW YOD TRIANGLE..HEBREW LIGATURE YIDDISH DOUBLE YOD
+	{runeRange{0x0600, 0x0604}, ScriptArabic},                  // Cf   [5] ARABIC NUMBER SIGN..ARABIC SIGN SAMVAT
+	{runeRange{0x0606, 0x0608}, ScriptArabic},                  // Sm   [3] ARABIC-INDIC CUBE ROOT..ARABIC RAY
+	{runeRange{0x060B, 0x060B}, ScriptArabic},                  // Sc       AFGHANI SIGN
+	{runeRange{0x060D, 0x060D}, ScriptArabic},                  // Po       ARABIC DATE SEPARATOR
+	{runeRange{0x0610, 0x061A}, ScriptArabic},                  // Mn  [11] ARABIC SIGN SALLALLAHOU ALAYHE WASSALLAM..ARABIC SMALL KASRA
+	{runeRange{0x061C, 0x061C}, ScriptArabic},                  // Cf       ARABIC LETTER MARK
+	{runeRange{0x061F, 0x061F}, ScriptCommon},                  // Po       ARABIC QUESTION MARK
+	{runeRange{0x0640, 0x0640}, ScriptCommon},                  // Lm       ARABIC TATWEEL
+	{runeRange{0x064B, 0x0655}, ScriptInherited},               // Mn  [11] ARABIC FATHATAN..ARABIC HAMZA BELOW
+	{runeRange{0x0660, 0x0669}, ScriptArabic},                  // Nd  [10] ARABIC-INDIC DIGIT ZERO..ARABIC-INDIC DIGIT NINE
+	{runeRange{0x066E, 0x066F}, ScriptArabic},                  // Lo   [2] ARABIC LETTER DOTLESS BEH..ARABIC LETTER DOTLESS QAF
+	{runeRange{0x0671, 0x06D3}, ScriptArabic},                  // Lo  [99] ARABIC LETTER ALEF WASLA..ARABIC LETTER YEH BARREE WITH HAMZA ABOVE
+	{runeRange{0x06D5, 0x06D5}, ScriptArabic},                  // Lo       ARABIC LETTER AE
+	{runeRange{0x06DD, 0x06DD}, ScriptCommon},                  // Cf       ARABIC END OF AYAH
+	{runeRange{0x06DF, 0x06E4}, ScriptArabic},                  // Mn   [6] ARABIC SMALL HIGH ROUNDED ZERO..ARABIC SMALL HIGH MADDA
+	{runeRange{0x06E7, 0x06E8}, ScriptArabic},                  // Mn   [2] ARABIC SMALL HIGH YEH..ARABIC SMALL HIGH NOON
+	{runeRange{0x06EA, 0x06ED}, ScriptArabic},                  // Mn   [4] ARABIC EMPTY CENTRE LOW STOP..ARABIC SMALL LOW MEEM
+	{runeRange{0x06F0, 0x06F9}, ScriptArabic},                  // Nd  [10] EXTENDED ARABIC-INDIC DIGIT ZERO..EXTENDED ARABIC-INDIC DIGIT NINE
+	{runeRange{0x06FD, 0x06FE}, ScriptArabic},                  // So   [2] ARABIC SIGN SINDHI AMPERSAND..ARABIC SIGN SINDHI POSTPOSITION MEN
+	{runeRange{0x0700, 0x070D}, ScriptSyriac},                  // Po  [14] SYRIAC END OF PARAGRAPH..SYRIAC HARKLEAN ASTERISCUS
+	{runeRange{0x0710, 0x0710}, ScriptSyriac},                  // Lo       SYRIAC LETTER ALAPH
+	{runeRange{0x0712, 0x072F}, ScriptSyriac},                  // Lo  [30] SYRIAC LETTER BETH..SYRIAC LETTER PERSIAN DHALATH
+	{runeRange{0x074D, 0x074F}, ScriptSyriac},                  // Lo   [3] SYRIAC LETTER SOGDIAN ZHAIN..SYRIAC LETTER SOGDIAN FE
+	{runeRange{0x0780, 0x07A5}, ScriptThaana},                  // Lo  [38] THAANA LETTER HAA..THAANA LETTER WAAVU
+	{runeRange{0x07B1, 0x07B1}, ScriptThaana},                  // Lo       THAANA LETTER NAA
+	{runeRange{0x07CA, 0x07EA}, ScriptNko},                     // Lo  [33] NKO LETTER A..NKO LETTER JONA RA
+	{runeRange{0x07F4, 0x07F5}, ScriptNko},                     // Lm   [2] NKO HIGH TONE APOSTROPHE..NKO LOW TONE APOSTROPHE
+	{runeRange{0x07F7, 0x07F9}, ScriptNko},                     // Po   [3] NKO SYMBOL GBAKURUNEN..NKO EXCLAMATION MARK
+	{runeRange{0x07FD, 0x07FD}, ScriptNko},                     // Mn       NKO DANTAYALAN
+	{runeRange{0x0800, 0x0815}, ScriptSamaritan},               // Lo  [22] SAMARITAN LETTER ALAF..SAMARITAN LETTER TAAF
+	{runeRange{0x081A, 0x081A}, ScriptSamaritan},               // Lm       SAMARITAN MODIFIER LETTER EPENTHETIC YUT
+	{runeRange{0x0824, 0x0824}, ScriptSamaritan},               // Lm       SAMARITAN MODIFIER LETTER SHORT A
+	{runeRange{0x0828, 0x0828}, ScriptSamaritan},               // Lm       SAMARITAN MODIFIER LETTER I
+	{runeRange{0x0830, 0x083E}, ScriptSamaritan},               // Po  [15] SAMARITAN PUNCTUATION NEQUDAA..SAMARITAN PUNCTUATION ANNAAU
+	{runeRange{0x0859, 0x085B}, ScriptMandaic},                 // Mn   [3] MANDAIC AFFRICATION MARK..MANDAIC GEMINATION MARK
+	{runeRange{0x0860, 0x086A}, ScriptSyriac},                  // Lo  [11] SYRIAC LETTER MALAYALAM NGA..SYRIAC LETTER MALAYALAM SSA
+	{runeRange{0x0888, 0x0888}, ScriptArabic},                  // Sk       ARABIC RAISED ROUND DOT
+	{runeRange{0x0890, 0x0891}, ScriptArabic},                  // Cf   [2] ARABIC POUND MARK ABOVE..ARABIC PIASTRE MARK ABOVE
+	{runeRange{0x08A0, 0x08C8}, ScriptArabic},                  // Lo  [41] ARABIC LETTER BEH WITH SMALL V BELOW..ARABIC LETTER GRAF
+	{runeRange{0x08CA, 0x08E1}, ScriptArabic},                  // Mn  [24] ARABIC SMALL HIGH FARSI YEH..ARABIC SMALL HIGH SIGN SAFHA
+	{runeRange{0x08E3, 0x08FF}, ScriptArabic},                  // Mn  [29] ARABIC TURNED DAMMA BELOW..ARABIC MARK SIDEWAYS NOON GHUNNA
+	{runeRange{0x0903, 0x0903}, ScriptDevanagari},              // Mc       DEVANAGARI SIGN VISARGA
+	{runeRange{0x093A, 0x093A}, ScriptDevanagari},              // Mn       DEVANAGARI VOWEL SIGN OE
+	{runeRange{0x093C, 0x093C}, ScriptDevanagari},              // Mn       DEVANAGARI SIGN NUKTA
+	{runeRange{0x093E, 0x0940}, ScriptDevanagari},              // Mc   [3] DEVANAGARI VOWEL SIGN AA..DEVANAGARI VOWEL SIGN II
+	{runeRange{0x0949, 0x094C}, ScriptDevanagari},              // Mc   [4] DEVANAGARI VOWEL SIGN CANDRA O..DEVANAGARI VOWEL SIGN AU
+	{runeRange{0x094E, 0x094F}, ScriptDevanagari},              // Mc   [2] DEVANAGARI VOWEL SIGN PRISHTHAMATRA E..DEVANAGARI VOWEL SIGN AW
+	{runeRange{0x0951, 0x0954}, ScriptInherited},               // Mn   [4] DEVANAGARI STRESS SIGN UDATTA..DEVANAGARI ACUTE ACCENT
+	{runeRange{0x0958, 0x0961}, ScriptDevanagari},              // Lo  [10] DEVANAGARI LETTER QA..DEVANAGARI LETTER VOCALIC LL
+	{runeRange{0x0964, 0x0965}, ScriptCommon},                  // Po   [2] DEVANAGARI DANDA..DEVANAGARI DOUBLE DANDA
+	{runeRange{0x0970, 0x0970}, ScriptDevanagari},              // Po       DEVANAGARI ABBREVIATION SIGN
+	{runeRange{0x0972, 0x097F}, ScriptDevanagari},              // Lo  [14] DEVANAGARI LETTER CANDRA A..DEVANAGARI LETTER BBA
+	{runeRange{0x0981, 0x0981}, ScriptBengali},                 // Mn       BENGALI SIGN CANDRABINDU
+	{runeRange{0x0985, 0x098C}, ScriptBengali},                 // Lo   [8] BENGALI LETTER A..BENGALI LETTER VOCALIC L
+	{runeRange{0x0993, 0x09A8}, ScriptBengali},                 // Lo  [22] BENGALI LETTER O..BENGALI LETTER NA
+	{runeRange{0x09B2, 0x09B2}, ScriptBengali},                 // Lo       BENGALI LETTER LA
+	{runeRange{0x09BC, 0x09BC}, ScriptBengali},                 // Mn       BENGALI SIGN NUKTA
+	{runeRange{0x09BE, 0x09C0}, ScriptBengali},                 // Mc   [3] BENGALI VOWEL SIGN AA..BENGALI VOWEL SIGN II
+	{runeRange{0x09C7, 0x09C8}, ScriptBengali},                 // Mc   [2] BENGALI VOWEL SIGN E..BENGALI VOWEL SIGN AI
+	{runeRange{0x09CD, 0x09CD}, ScriptBengali},                 // Mn       BENGALI SIGN VIRAMA
+	{runeRange{0x09D7, 0x09D7}, ScriptBengali},                 // Mc       BENGALI AU LENGTH MARK
+	{runeRange{0x09DF, 0x09E1}, ScriptBengali},                 // Lo   [3] BENGALI LETTER YYA..BENGALI LETTER VOCALIC LL
+	{runeRange{0x09E6, 0x09EF}, ScriptBengali},                 // Nd  [10] BENGALI DIGIT ZERO..BENGALI DIGIT NINE
+	{runeRange{0x09F2, 0x09F3}, ScriptBengali},                 // Sc   [2] BENGALI RUPEE MARK..BENGALI RUPEE SIGN
+	{runeRange{0x09FA, 0x09FA}, ScriptBengali},                 // So       BENGALI ISSHAR
+	{runeRange{0x09FC, 0x09FC}, ScriptBengali},                 // Lo       BENGALI LETTER VEDIC ANUSVARA
+	{runeRange{0x09FE, 0x09FE}, ScriptBengali},                 // Mn       BENGALI SANDHI MARK
+	{runeRange{0x0A03, 0x0A03}, ScriptGurmukhi},                // Mc       GURMUKHI SIGN VISARGA
+	{runeRange{0x0A0F, 0x0A10}, ScriptGurmukhi},                // Lo   [2] GURMUKHI LETTER EE..GURMUKHI LETTER AI
+	{runeRange{0x0A2A, 0x0A30}, ScriptGurmukhi},                // Lo   [7] GURMUKHI LETTER PA..GURMUKHI LETTER RA
+	{runeRange{0x0A35, 0x0A36}, ScriptGurmukhi},                // Lo   [2] GURMUKHI LETTER VA..GURMUKHI LETTER SHA
+	{runeRange{0x0A3C, 0x0A3C}, ScriptGurmukhi},                // Mn       GURMUKHI SIGN NUKTA
+	{runeRange{0x0A41, 0x0A42}, ScriptGurmukhi},                // Mn   [2] GURMUKHI VOWEL SIGN U..GURMUKHI VOWEL SIGN UU
+	{runeRange{0x0A4B, 0x0A4D}, ScriptGurmukhi},                // Mn   [3] GURMUKHI VOWEL SIGN OO..GURMUKHI SIGN VIRAMA
+	{runeRange{0x0A59, 0x0A5C}, ScriptGurmukhi},                // Lo   [4] GURMUKHI LETTER KHHA..GURMUKHI LETTER RRA
+	{runeRange{0x0A66, 0x0A6F}, ScriptGurmukhi},                // Nd  [10] GURMUKHI DIGIT ZERO..GURMUKHI DIGIT NINE
+	{runeRange{0x0A72, 0x0A74}, ScriptGurmukhi},                // Lo   [3] GURMUKHI IRI..GURMUKHI EK ONKAR
+	{runeRange{0x0A76, 0x0A76}, ScriptGurmukhi},                // Po       GURMUKHI ABBREVIATION SIGN
+	{runeRange{0x0A83, 0x0A83}, ScriptGujarati},                // Mc       GUJARATI SIGN VISARGA
+	{runeRange{0x0A8F, 0x0A91}, ScriptGujarati},                // Lo   [3] GUJARATI LETTER E..GUJARATI VOWEL CANDRA O
+	{runeRange{0x0AAA, 0x0AB0}, ScriptGujarati},                // Lo   [7] GUJARATI LETTER PA..GUJARATI LETTER RA
+	{runeRange{0x0AB5, 0x0AB9}, ScriptGujarati},                // Lo   [5] GUJARATI LETTER VA..GUJARATI LETTER HA
+	{runeRange{0x0ABD, 0x0ABD}, ScriptGujarati},                // Lo       GUJARATI SIGN AVAGRAHA
+	{runeRange{0x0AC1, 0x0AC5}, ScriptGujarati},                // Mn   [5] GUJARATI VOWEL SIGN U..GUJARATI VOWEL SIGN CANDRA E
+	{runeRange{0x0AC9, 0x0AC9}, ScriptGujarati},                // Mc       GUJARATI VOWEL SIGN CANDRA O
+	{runeRange{0x0ACD, 0x0ACD}, ScriptGujarati},                // Mn       GUJARATI SIGN VIRAMA
+	{runeRange{0x0AE0, 0x0AE1}, ScriptGujarati},                // Lo   [2] GUJARATI LETTER VOCALIC RR..GUJARATI LETTER VOCALIC LL
+	{runeRange{0x0AE6, 0x0AEF}, ScriptGujarati},                // Nd  [10] GUJARATI DIGIT ZERO..GUJARATI DIGIT NINE
+	{runeRange{0x0AF1, 0x0AF1}, ScriptGujarati},                // Sc       GUJARATI RUPEE SIGN
+	{runeRange{0x0AFA, 0x0AFF}, ScriptGujarati},                // Mn   [6] GUJARATI SIGN SUKUN..GUJARATI SIGN TWO-CIRCLE NUKTA ABOVE
+	{runeRange{0x0B02, 0x0B03}, ScriptOriya},                   // Mc   [2] ORIYA SIGN ANUSVARA..ORIYA SIGN VISARGA
+	{runeRange{0x0B0F, 0x0B10}, ScriptOriya},                   // Lo   [2] ORIYA LETTER E..ORIYA LETTER AI
+	{runeRange{0x0B2A, 0x0B30}, ScriptOriya},                   // Lo   [7] ORIYA LETTER PA..ORIYA LETTER RA
+	{runeRange{0x0B35, 0x0B39}, ScriptOriya},                   // Lo   [5] ORIYA LETTER VA..ORIYA LETTER HA
+	{runeRange{0x0B3D, 0x0B3D}, ScriptOriya},                   // Lo       ORIYA SIGN AVAGRAHA
+	{runeRange{0x0B3F, 0x0B3F}, ScriptOriya},                   // Mn       ORIYA VOWEL SIGN I
+	{runeRange{0x0B41, 0x0B44}, ScriptOriya},                   // Mn   [4] ORIYA VOWEL SIGN U..ORIYA VOWEL SIGN VOCALIC RR
+	{runeRange{0x0B4B, 0x0B4C}, ScriptOriya},                   // Mc   [2] ORIYA VOWEL SIGN O..ORIYA VOWEL SIGN AU
+	{runeRange{0x0B55, 0x0B56}, ScriptOriya},                   // Mn   [2] ORIYA SIGN OVERLINE..ORIYA AI LENGTH MARK
+	{runeRange{0x0B5C, 0x0B5D}, ScriptOriya},                   // Lo   [2] ORIYA LETTER RRA..ORIYA LETTER RHA
+	{runeRange{0x0B62, 0x0B63}, ScriptOriya},                   // Mn   [2] ORIYA VOWEL SIGN VOCALIC L..ORIYA VOWEL SIGN VOCALIC LL
+	{runeRange{0x0B70, 0x0B70}, ScriptOriya},                   // So       ORIYA ISSHAR
+	{runeRange{0x0B72, 0x0B77}, ScriptOriya},                   // No   [6] ORIYA FRACTION ONE QUARTER..ORIYA FRACTION THREE SIXTEENTHS
+	{runeRange{0x0B83, 0x0B83}, ScriptTamil},                   // Lo       TAMIL SIGN VISARGA
+	{runeRange{0x0B8E, 0x0B90}, ScriptTamil},                   // Lo   [3] TAMIL LETTER E..TAMIL LETTER AI
+	{runeRange{0x0B99, 0x0B9A}, ScriptTamil},                   // Lo   [2] TAMIL LETTER NGA..TAMIL LETTER CA
+	{runeRange{0x0B9E, 0x0B9F}, ScriptTamil},                   // Lo   [2] TAMIL LETTER NYA..TAMIL LETTER TTA
+	{runeRange{0x0BA8, 0x0BAA}, ScriptTamil},                   // Lo   [3] TAMIL LETTER NA..TAMIL LETTER PA
+	{runeRange{0x0BBE, 0x0BBF}, ScriptTamil},                   // Mc   [2] TAMIL VOWEL SIGN AA..TAMIL VOWEL SIGN I
+	{runeRange{0x0BC1, 0x0BC2}, ScriptTamil},                   // Mc   [2] TAMIL VOWEL SIGN U..TAMIL VOWEL SIGN UU
+	{runeRange{0x0BCA, 0x0BCC}, ScriptTamil},                   // Mc   [3] TAMIL VOWEL SIGN O..TAMIL VOWEL SIGN AU
+	{runeRange{0x0BD0, 0x0BD0}, ScriptTamil},                   // Lo       TAMIL OM
+	{runeRange{0x0BE6, 0x0BEF}, ScriptTamil},                   // Nd  [10] TAMIL DIGIT ZERO..TAMIL DIGIT NINE
+	{runeRange{0x0BF3, 0x0BF8}, ScriptTamil},                   // So   [6] TAMIL DAY SIGN..TAMIL AS ABOVE SIGN
+	{runeRange{0x0BFA, 0x0BFA}, ScriptTamil},                   // So       TAMIL NUMBER SIGN
+	{runeRange{0x0C01, 0x0C03}, ScriptTelugu},                  // Mc   [3] TELUGU SIGN CANDRABINDU..TELUGU SIGN VISARGA
+	{runeRange{0x0C05, 0x0C0C}, ScriptTelugu},                  // Lo   [8] TELUGU LETTER A..TELUGU LETTER VOCALIC L
+	{runeRange{0x0C12, 0x0C28}, ScriptTelugu},                  // Lo  [23] TELUGU LETTER O..TELUGU LETTER NA
+	{runeRange{0x0C3C, 0x0C3C}, ScriptTelugu},                  // Mn       TELUGU SIGN NUKTA
+	{runeRange{0x0C3E, 0x0C40}, ScriptTelugu},                  // Mn   [3] TELUGU VOWEL SIGN AA..TELUGU VOWEL SIGN II
+	{runeRange{0x0C46, 0x0C48}, ScriptTelugu},                  // Mn   [3] TELUGU VOWEL SIGN E..TELUGU VOWEL SIGN AI
+	{runeRange{0x0C55, 0x0C56}, ScriptTelugu},                  // Mn   [2] TELUGU LENGTH MARK..TELUGU AI LENGTH MARK
+	{runeRange{0x0C5C, 0x0C5D}, ScriptTelugu},                  // Lo   [2] TELUGU ARCHAIC SHRII..TELUGU LETTER NAKAARA POLLU
+	{runeRange{0x0C62, 0x0C63}, ScriptTelugu},                  // Mn   [2] TELUGU VOWEL SIGN VOCALIC L..TELUGU VOWEL SIGN VOCALIC LL
+	{runeRange{0x0C77, 0x0C77}, ScriptTelugu},                  // Po       TELUGU SIGN SIDDHAM
+	{runeRange{0x0C7F, 0x0C7F}, ScriptTelugu},                  // So       TELUGU SIGN TUUMU
+	{runeRange{0x0C81, 0x0C81}, ScriptKannada},                 // Mn       KANNADA SIGN CANDRABINDU
+	{runeRange{0x0C84, 0x0C84}, ScriptKannada},                 // Po       KANNADA SIGN SIDDHAM
+	{runeRange{0x0C8E, 0x0C90}, ScriptKannada},                 // Lo   [3] KANNADA LETTER E..KANNADA LETTER AI
+	{runeRange{0x0CAA, 0x0CB3}, ScriptKannada},                 // Lo  [10] KANNADA LETTER PA..KANNADA LETTER LLA
+	{runeRange{0x0CBC, 0x0CBC}, ScriptKannada},                 // Mn       KANNADA SIGN NUKTA
+	{runeRange{0x0CBE, 0x0CBE}, ScriptKannada},                 // Mc       KANNADA VOWEL SIGN AA
+	{runeRange{0x0CC0, 0x0CC4}, ScriptKannada},                 // Mc   [5] KANNADA VOWEL SIGN II..KANNADA VOWEL SIGN VOCALIC RR
+	{runeRange{0x0CC7, 0x0CC8}, ScriptKannada},                 // Mc   [2] KANNADA VOWEL SIGN EE..KANNADA VOWEL SIGN AI
+	{runeRange{0x0CCC, 0x0CCD}, ScriptKannada},                 // Mn   [2] KANNADA VOWEL SIGN AU..KANNADA SIGN VIRAMA
+	{runeRange{0x0CDC, 0x0CDE}, ScriptKannada},                 // Lo   [3] KANNADA ARCHAIC SHRII..KANNADA LETTER FA
+	{runeRange{0x0CE2, 0x0CE3}, ScriptKannada},                 // Mn   [2] KANNADA VOWEL SIGN VOCALIC L..KANNADA VOWEL SIGN VOCALIC LL
+	{runeRange{0x0CF1, 0x0CF2}, ScriptKannada},                 // Lo   [2] KANNADA SIGN JIHVAMULIYA..KANNADA SIGN UPADHMANIYA
+	{runeRange{0x0D00, 0x0D01}, ScriptMalayalam},               // Mn   [2] MALAYALAM SIGN COMBINING ANUSVARA ABOVE..MALAYALAM SIGN CANDRABINDU
+	{runeRange{0x0D04, 0x0D0C}, ScriptMalayalam},               // Lo   [9] MALAYALAM LETTER VEDIC ANUSVARA..MALAYALAM LETTER VOCALIC L
+	{runeRange{0x0D12, 0x0D3A}, ScriptMalayalam},               // Lo  [41] MALAYALAM LETTER O..MALAYALAM LETTER TTTA
+	{runeRange{0x0D3D, 0x0D3D}, ScriptMalayalam},               // Lo       MALAYALAM SIGN AVAGRAHA
+	{runeRange{0x0D41, 0x0D44}, ScriptMalayalam},               // Mn   [4] MALAYALAM VOWEL SIGN U..MALAYALAM VOWEL SIGN VOCALIC RR
+	{runeRange{0x0D4A, 0x0D4C}, ScriptMalayalam},               // Mc   [3] MALAYALAM VOWEL SIGN O..MALAYALAM VOWEL SIGN AU
+	{runeRange{0x0D4E, 0x0D4E}, ScriptMalayalam},               // Lo       MALAYALAM LETTER DOT REPH
+	{runeRange{0x0D54, 0x0D56}, ScriptMalayalam},               // Lo   [3] MALAYALAM LETTER CHILLU M..MALAYALAM LETTER CHILLU LLL
+	{runeRange{0x0D58, 0x0D5E}, ScriptMalayalam},               // No   [7] MALAYALAM FRACTION ONE ONE-HUNDRED-AND-SIXTIETH..MALAYALAM FRACTION ONE FIFTH
+	{runeRange{0x0D62, 0x0D63}, ScriptMalayalam},               // Mn   [2] MALAYALAM VOWEL SIGN VOCALIC L..MALAYALAM VOWEL SIGN VOCALIC LL
+	{runeRange{0x0D70, 0x0D78}, ScriptMalayalam},               // No   [9] MALAYALAM NUMBER TEN..MALAYALAM FRACTION THREE SIXTEENTHS
+	{runeRange{0x0D7A, 0x0D7F}, ScriptMalayalam},               // Lo   [6] MALAYALAM LETTER CHILLU NN..MALAYALAM LETTER CHILLU K
+	{runeRange{0x0D82, 0x0D83}, ScriptSinhala},                 // Mc   [2] SINHALA SIGN ANUSVARAYA..SINHALA SIGN VISARGAYA
+	{runeRange{0x0D9A, 0x0DB1}, ScriptSinhala},                 // Lo  [24] SINHALA LETTER ALPAPRAANA KAYANNA..SINHALA LETTER DANTAJA NAYANNA
+	{runeRange{0x0DBD, 0x0DBD}, ScriptSinhala},                 // Lo       SINHALA LETTER DANTAJA LAYANNA
+	{runeRange{0x0DCA, 0x0DCA}, ScriptSinhala},                 // Mn       SINHALA SIGN AL-LAKUNA
+	{runeRange{0x0DD2, 0x0DD4}, ScriptSinhala},                 // Mn   [3] SINHALA VOWEL SIGN KETTI IS-PILLA..SINHALA VOWEL SIGN KETTI PAA-PILLA
+	{runeRange{0x0DD8, 0x0DDF}, ScriptSinhala},                 // Mc   [8] SINHALA VOWEL SIGN GAETTA-PILLA..SINHALA VOWEL SIGN GAYANUKITTA
+	{runeRange{0x0DF2, 0x0DF3}, ScriptSinhala},                 // Mc   [2] SINHALA VOWEL SIGN DIGA GAETTA-PILLA..SINHALA VOWEL SIGN DIGA GAYANUKITTA
+	{runeRange{0x0E01, 0x0E30}, ScriptThai},                    // Lo  [48] THAI CHARACTER KO KAI..THAI CHARACTER SARA A
+	{runeRange{0x0E32, 0x0E33}, ScriptThai},                    // Lo   [2] THAI CHARACTER SARA AA..THAI CHARACTER SARA AM
+	{runeRange{0x0E3F, 0x0E3F}, ScriptCommon},                  // Sc       THAI CURRENCY SYMBOL BAHT
+	{runeRange{0x0E46, 0x0E46}, ScriptThai},                    // Lm       THAI CHARACTER MAIYAMOK
+	{runeRange{0x0E4F, 0x0E4F}, ScriptThai},                    // Po       THAI CHARACTER FONGMAN
+	{runeRange{0x0E5A, 0x0E5B}, ScriptThai},                    // Po   [2] THAI CHARACTER ANGKHANKHU..THAI CHARACTER KHOMUT
+	{runeRange{0x0E84, 0x0E84}, ScriptLao},                     // Lo       LAO LETTER KHO TAM
+	{runeRange{0x0E8C, 0x0EA3}, ScriptLao},                     // Lo  [24] LAO LETTER PALI JHA..LAO LETTER LO LING
+	{runeRange{0x0EA7, 0x0EB0}, ScriptLao},                     // Lo  [10] LAO LETTER WO..LAO VOWEL SIGN A
+	{runeRange{0x0EB2, 0x0EB3}, ScriptLao},                     // Lo   [2] LAO VOWEL SIGN AA..LAO VOWEL SIGN AM
+	{runeRange{0x0EBD, 0x0EBD}, ScriptLao},                     // Lo       LAO SEMIVOWEL SIGN NYO
+	{runeRange{0x0EC6, 0x0EC6}, ScriptLao},                     // Lm       LAO KO LA
+	{runeRange{0x0ED0, 0x0ED9}, ScriptLao},                     // Nd  [10] LAO DIGIT ZERO..LAO DIGIT NINE
+	{runeRange{0x0F00, 0x0F00}, ScriptTibetan},                 // Lo       TIBETAN SYLLABLE OM
+	{runeRange{0x0F04, 0x0F12}, ScriptTibetan},                 // Po  [15] TIBETAN MARK INITIAL YIG MGO MDUN MA..TIBETAN MARK RGYA GRAM SHAD
+	{runeRange{0x0F14, 0x0F14}, ScriptTibetan},                 // Po       TIBETAN MARK GTER TSHEG
+	{runeRange{0x0F18, 0x0F19}, ScriptTibetan},                 // Mn   [2] TIBETAN ASTROLOGICAL SIGN -KHYUD PA..TIBETAN ASTROLOGICAL SIGN SDONG TSHUGS
+	{runeRange{0x0F20, 0x0F29}, ScriptTibetan},                 // Nd  [10] TIBETAN DIGIT ZERO..TIBETAN DIGIT NINE
+	{runeRange{0x0F34, 0x0F34}, ScriptTibetan},                 // So       TIBETAN MARK BSDUS RTAGS
+	{runeRange{0x0F36, 0x0F36}, ScriptTibetan},                 // So       TIBETAN MARK CARET -DZUD RTAGS BZHI MIG CAN
+	{runeRange{0x0F38, 0x0F38}, ScriptTibetan},                 // So       TIBETAN MARK CHE MGO
+	{runeRange{0x0F3A, 0x0F3A}, ScriptTibetan},                 // Ps       TIBETAN MARK GUG RTAGS GYON
+	{runeRange{0x0F3C, 0x0F3C}, ScriptTibetan},                 // Ps       TIBETAN MARK ANG KHANG GYON
+	{runeRange{0x0F3E, 0x0F3F}, ScriptTibetan},                 // Mc   [2] TIBETAN SIGN YAR TSHES..TIBETAN SIGN MAR TSHES
+	{runeRange{0x0F49, 0x0F6C}, ScriptTibetan},                 // Lo  [36] TIBETAN LETTER NYA..TIBETAN LETTER RRA
+	{runeRange{0x0F7F, 0x0F7F}, ScriptTibetan},                 // Mc       TIBETAN SIGN RNAM BCAD
+	{runeRange{0x0F85, 0x0F85}, ScriptTibetan},                 // Po       TIBETAN MARK PALUTA
+	{runeRange{0x0F88, 0x0F8C}, ScriptTibetan},                 // Lo   [5] TIBETAN SIGN LCE TSA CAN..TIBETAN SIGN INVERTED MCHU CAN
+	{runeRange{0x0F99, 0x0FBC}, ScriptTibetan},                 // Mn  [36] TIBETAN SUBJOINED LETTER NYA..TIBETAN SUBJOINED LETTER FIXED-FORM RA
+	{runeRange{0x0FC6, 0x0FC6}, ScriptTibetan},                 // Mn       TIBETAN SYMBOL PADMA GDAN
+	{runeRange{0x0FCE, 0x0FCF}, ScriptTibetan},                 // So   [2] TIBETAN SIGN RDEL NAG RDEL DKAR..TIBETAN SIGN RDEL NAG GSUM
+	{runeRange{0x0FD5, 0x0FD8}, ScriptCommon},                  // So   [4] RIGHT-FACING SVASTI SIGN..LEFT-FACING SVASTI SIGN WITH DOTS
+	{runeRange{0x1000, 0x102A}, ScriptMyanmar},                 // Lo  [43] MYANMAR LETTER KA..MYANMAR LETTER AU
+	{runeRange{0x102D, 0x1030}, ScriptMyanmar},                 // Mn   [4] MYANMAR VOWEL SIGN I..MYANMAR VOWEL SIGN UU
+	{runeRange{0x1032, 0x1037}, ScriptMyanmar},                 // Mn   [6] MYANMAR VOWEL SIGN AI..MYANMAR SIGN DOT BELOW
+	{runeRange{0x1039, 0x103A}, ScriptMyanmar},                 // Mn   [2] MYANMAR SIGN VIRAMA..MYANMAR SIGN ASAT
+	{runeRange{0x103D, 0x103E}, ScriptMyanmar},                 // Mn   [2] MYANMAR CONSONANT SIGN MEDIAL WA..MYANMAR CONSONANT SIGN MEDIAL HA
+	{runeRange{0x1040, 0x1049}, ScriptMyanmar},                 // Nd  [10] MYANMAR DIGIT ZERO..MYANMAR DIGIT NINE
+	{runeRange{0x1050, 0x1055}, ScriptMyanmar},                 // Lo   [6] MYANMAR LETTER SHA..MYANMAR LETTER VOCALIC LL
+	{runeRange{0x1058, 0x1059}, ScriptMyanmar},                 // Mn   [2] MYANMAR VOWEL SIGN VOCALIC L..MYANMAR VOWEL SIGN VOCALIC LL
+	{runeRange{0x105E, 0x1060}, ScriptMyanmar},                 // Mn   [3] MYANMAR CONSONANT SIGN MON MEDIAL NA..MYANMAR CONSONANT SIGN MON MEDIAL LA
+	{runeRange{0x1062, 0x1064}, ScriptMyanmar},                 // Mc   [3] MYANMAR VOWEL SIGN SGAW KAREN EU..MYANMAR TONE MARK SGAW KAREN KE PHO
+	{runeRange{0x1067, 0x106D}, ScriptMyanmar},                 // Mc   [7] MYANMAR VOWEL SIGN WESTERN PWO KAREN EU..MYANMAR SIGN WESTERN PWO KAREN TONE-5
+	{runeRange{0x1071, 0x1074}, ScriptMyanmar},                 // Mn   [4] MYANMAR VOWEL SIGN GEBA KAREN I..MYANMAR VOWEL SIGN KAYAH EE
+	{runeRange{0x1082, 0x1082}, ScriptMyanmar},                 // Mn       MYANMAR CONSONANT SIGN SHAN MEDIAL WA
+	{runeRange{0x1085, 0x1086}, ScriptMyanmar},                 // Mn   [2] MYANMAR VOWEL SIGN SHAN E ABOVE..MYANMAR VOWEL SIGN SHAN FINAL Y
+	{runeRange{0x108D, 0x108D}, ScriptMyanmar},                 // Mn       MYANMAR SIGN SHAN COUNCIL EMPHATIC TONE
+	{runeRange{0x108F, 0x108F}, ScriptMyanmar},                 // Mc       MYANMAR SIGN RUMAI PALAUNG TONE-5
+	{runeRange{0x109A, 0x109C}, ScriptMyanmar},                 // Mc   [3] MYANMAR SIGN KHAMTI TONE-1..MYANMAR VOWEL SIGN AITON A
+	{runeRange{0x109E, 0x109F}, ScriptMyanmar},                 // So   [2] MYANMAR SYMBOL SHAN ONE..MYANMAR SYMBOL SHAN EXCLAMATION
+	{runeRange{0x10C7, 0x10C7}, ScriptGeorgian},                // Lu       GEORGIAN CAPITAL LETTER YN
+	{runeRange{0x10D0, 0x10FA}, ScriptGeorgian},                // Ll  [43] GEORGIAN LETTER AN..GEORGIAN LETTER AIN
+	{runeRange{0x10FC, 0x10FC}, ScriptGeorgian},                // Lm       MODIFIER LETTER GEORGIAN NAR
+	{runeRange{0x1100, 0x11FF}, ScriptHangul},                  // Lo [256] HANGUL CHOSEONG KIYEOK..HANGUL JONGSEONG SSANGNIEUN
+	{runeRange{0x124A, 0x124D}, ScriptEthiopic},                // Lo   [4] ETHIOPIC SYLLABLE QWI..ETHIOPIC SYLLABLE QWE
+	{runeRange{0x1258, 0x1258}, ScriptEthiopic},                // Lo       ETHIOPIC SYLLABLE QHWA
+	{runeRange{0x1260, 0x1288}, ScriptEthiopic},                // Lo  [41] ETHIOPIC SYLLABLE BA..ETHIOPIC SYLLABLE XWA
+	{runeRange{0x1290, 0x12B0}, ScriptEthiopic},                // Lo  [33] ETHIOPIC SYLLABLE NA..ETHIOPIC SYLLABLE KWA
+	{runeRange{0x12B8, 0x12BE}, ScriptEthiopic},                // Lo   [7] ETHIOPIC SYLLABLE KXA..ETHIOPIC SYLLABLE KXO
+	{runeRange{0x12C2, 0x12C5}, ScriptEthiopic},                // Lo   [4] ETHIOPIC SYLLABLE KXWI..ETHIOPIC SYLLABLE KXWE
+	{runeRange{0x12D8, 0x1310}, ScriptEthiopic},                // Lo  [57] ETHIOPIC SYLLABLE ZA..ETHIOPIC SYLLABLE GWA
+	{runeRange{0x1318, 0x135A}, ScriptEthiopic},                // Lo  [67] ETHIOPIC SYLLABLE GGA..ETHIOPIC SYLLABLE FYA
+	{runeRange{0x1360, 0x1368}, ScriptEthiopic},                // Po   [9] ETHIOPIC SECTION MARK..ETHIOPIC PARAGRAPH SEPARATOR
+	{runeRange{0x1380, 0x138F}, ScriptEthiopic},                // Lo  [16] ETHIOPIC SYLLABLE SEBATBEIT MWA..ETHIOPIC SYLLABLE PWE
+	{runeRange{0x13A0, 0x13F5}, ScriptCherokee},                // Lu  [86] CHEROKEE LETTER A..CHEROKEE LETTER MV
+	{runeRange{0x1400, 0x1400}, ScriptCanadianAboriginal},      // Pd       CANADIAN SYLLABICS HYPHEN
+	{runeRange{0x166D, 0x166D}, ScriptCanadianAboriginal},      // So       CANADIAN SYLLABICS CHI SIGN
+	{runeRange{0x166F, 0x167F}, ScriptCanadianAboriginal},      // Lo  [17] CANADIAN SYLLABICS QAI..CANADIAN SYLLABICS BLACKFOOT W
+	{runeRange{0x1681, 0x169A}, ScriptOgham},                   // Lo  [26] OGHAM LETTER BEITH..OGHAM LETTER PEITH
+	{runeRange{0x169C, 0x169C}, ScriptOgham},                   // Pe       OGHAM REVERSED FEATHER MARK
+	{runeRange{0x16EB, 0x16ED}, ScriptCommon},                  // Po   [3] RUNIC SINGLE PUNCTUATION..RUNIC CROSS PUNCTUATION
+	{runeRange{0x16F1, 0x16F8}, ScriptRunic},                   // Lo   [8] RUNIC LETTER K..RUNIC LETTER FRANKS CASKET AESC
+	{runeRange{0x1712, 0x1714}, ScriptTagalog},                 // Mn   [3] TAGALOG VOWEL SIGN I..TAGALOG SIGN VIRAMA
+	{runeRange{0x171F, 0x171F}, ScriptTagalog},                 // Lo       TAGALOG LETTER ARCHAIC RA
+	{runeRange{0x1732, 0x1733}, ScriptHanunoo},                 // Mn   [2] HANUNOO VOWEL SIGN I..HANUNOO VOWEL SIGN U
+	{runeRange{0x1735, 0x1736}, ScriptCommon},                  // Po   [2] PHILIPPINE SINGLE PUNCTUATION..PHILIPPINE DOUBLE PUNCTUATION
+	{runeRange{0x1752, 0x1753}, ScriptBuhid},                   // Mn   [2] BUHID VOWEL SIGN I..BUHID VOWEL SIGN U
+	{runeRange{0x176E, 0x1770}, ScriptTagbanwa},                // Lo   [3] TAGBANWA LETTER LA..TAGBANWA LETTER SA
+	{runeRange{0x1780, 0x17B3}, ScriptKhmer},                   // Lo  [52] KHMER LETTER KA..KHMER INDEPENDENT VOWEL QAU
+	{runeRange{0x17B6, 0x17B6}, ScriptKhmer},                   // Mc       KHMER VOWEL SIGN AA
+	{runeRange{0x17BE, 0x17C5}, ScriptKhmer},                   // Mc   [8] KHMER VOWEL SIGN OE..KHMER VOWEL SIGN AU
+	{runeRange{0x17C7, 0x17C8}, ScriptKhmer},                   // Mc   [2] KHMER SIGN REAHMUK..KHMER SIGN YUUKALEAPINTU
+	{runeRange{0x17D4, 0x17D6}, ScriptKhmer},                   // Po   [3] KHMER SIGN KHAN..KHMER SIGN CAMNUC PII KUUH
+	{runeRange{0x17D8, 0x17DA}, ScriptKhmer},                   // Po   [3] KHMER SIGN BEYYAL..KHMER SIGN KOOMUUT
+	{runeRange{0x17DC, 0x17DC}, ScriptKhmer},                   // Lo       KHMER SIGN AVAKRAHASANYA
+	{runeRange{0x17E0, 0x17E9}, ScriptKhmer},                   // Nd  [10] KHMER DIGIT ZERO..KHMER DIGIT NINE
+	{runeRange{0x1800, 0x1801}, ScriptMongolian},               // Po   [2] MONGOLIAN BIRGA..MONGOLIAN ELLIPSIS
+	{runeRange{0x1804, 0x1804}, ScriptMongolian},               // Po       MONGOLIAN COLON
+	{runeRange{0x1806, 0x1806}, ScriptMongolian},               // Pd       MONGOLIAN TODO SOFT HYPHEN
+	{runeRange{0x180B, 0x180D}, ScriptMongolian},               // Mn   [3] MONGOLIAN FREE VARIATION SELECTOR ONE..MONGOLIAN FREE VARIATION SELECTOR THREE
+	{runeRange{0x180F, 0x180F}, ScriptMongolian},               // Mn       MONGOLIAN FREE VARIATION SELECTOR FOUR
+	{runeRange{0x1820, 0x1842}, ScriptMongolian},               // Lo  [35] MONGOLIAN LETTER A..MONGOLIAN LETTER CHI
+	{runeRange{0x1844, 0x1878}, ScriptMongolian},               // Lo  [53] MONGOLIAN LETTER TODO E..MONGOLIAN LETTER CHA WITH TWO DOTS
+	{runeRange{0x1885, 0x1886}, ScriptMongolian},               // Mn   [2] MONGOLIAN LETTER ALI GALI BALUDA..MONGOLIAN LETTER ALI GALI THREE BALUDA
+	{runeRange{0x18A9, 0x18A9}, ScriptMongolian},               // Mn       MONGOLIAN LETTER ALI GALI DAGALGA
+	{runeRange{0x18B0, 0x18F5}, ScriptCanadianAboriginal},      // Lo  [70] CANADIAN SYLLABICS OY..CANADIAN SYLLABICS CARRIER DENTAL S
+	{runeRange{0x1920, 0x1922}, ScriptLimbu},                   // Mn   [3] LIMBU VOWEL SIGN A..LIMBU VOWEL SIGN U
+	{runeRange{0x1927, 0x1928}, ScriptLimbu},                   // Mn   [2] LIMBU VOWEL SIGN E..LIMBU VOWEL SIGN O
+	{runeRange{0x1930, 0x1931}, ScriptLimbu},                   // Mc   [2] LIMBU SMALL LETTER KA..LIMBU SMALL LETTER NGA
+	{runeRange{0x1933, 0x1938}, ScriptLimbu},                   // Mc   [6] LIMBU SMALL LETTER TA..LIMBU SMALL LETTER LA
+	{runeRange{0x1940, 0x1940}, ScriptLimbu},                   // So       LIMBU SIGN LOO
+	{runeRange{0x1946, 0x194F}, ScriptLimbu},                   // Nd  [10] LIMBU DIGIT ZERO..LIMBU DIGIT NINE
+	{runeRange{0x1970, 0x1974}, ScriptTaiLe},                   // Lo   [5] TAI LE LETTER TONE-2..TAI LE LETTER TONE-6
+	{runeRange{0x19B0, 0x19C9}, ScriptNewTaiLue},               // Lo  [26] NEW TAI LUE VOWEL SIGN VOWEL SHORTENER..NEW TAI LUE TONE MARK-2
+	{runeRange{0x19DA, 0x19DA}, ScriptNewTaiLue},               // No       NEW TAI LUE THAM DIGIT ONE
+	{runeRange{0x19E0, 0x19FF}, ScriptKhmer},                   // So  [32] KHMER SYMBOL PATHAMASAT..KHMER SYMBOL DAP-PRAM ROC
+	{runeRange{0x1A17, 0x1A18}, ScriptBuginese},                // Mn   [2] BUGINESE VOWEL SIGN I..BUGINESE VOWEL SIGN U
+	{runeRange{0x1A1B, 0x1A1B}, ScriptBuginese},                // Mn       BUGINESE VOWEL SIGN AE
+	{runeRange{0x1A20, 0x1A54}, ScriptTaiTham},                 // Lo  [53] TAI THAM LETTER HIGH KA..TAI THAM LETTER GREAT SA
+	{runeRange{0x1A56, 0x1A56}, ScriptTaiTham},                 // Mn       TAI THAM CONSONANT SIGN MEDIAL LA
+	{runeRange{0x1A58, 0x1A5E}, ScriptTaiTham},                 // Mn   [7] TAI THAM SIGN MAI KANG LAI..TAI THAM CONSONANT SIGN SA
+	{runeRange{0x1A61, 0x1A61}, ScriptTaiTham},                 // Mc       TAI THAM VOWEL SIGN A
+	{runeRange{0x1A63, 0x1A64}, ScriptTaiTham},                 // Mc   [2] TAI THAM VOWEL SIGN AA..TAI THAM VOWEL SIGN TALL AA
+	{runeRange{0x1A6D, 0x1A72}, ScriptTaiTham},                 // Mc   [6] TAI THAM VOWEL SIGN OY..TAI THAM VOWEL SIGN THAM AI
+	{runeRange{0x1A7F, 0x1A7F}, ScriptTaiTham},                 // Mn       TAI THAM COMBINING CRYPTOGRAMMIC DOT
+	{runeRange{0x1A90, 0x1A99}, ScriptTaiTham},                 // Nd  [10] TAI THAM THAM DIGIT ZERO..TAI THAM THAM DIGIT NINE
+	{runeRange{0x1AA7, 0x1AA7}, ScriptTaiTham},                 // Lm       TAI THAM SIGN MAI YAMOK
+	{runeRange{0x1AB0, 0x1ABD}, ScriptInherited},               // Mn  [14] COMBINING DOUBLED CIRCUMFLEX ACCENT..COMBINING PARENTHESES BELOW
+	{runeRange{0x1ABF, 0x1ADD}, ScriptInherited},               // Mn  [31] COMBINING LATIN SMALL LETTER W BELOW..COMBINING DOT-AND-RING BELOW
+	{runeRange{0x1B00, 0x1B03}, ScriptBalinese},                // Mn   [4] BALINESE SIGN ULU RICEM..BALINESE SIGN SURANG
+	{runeRange{0x1B05, 0x1B33}, ScriptBalinese},                // Lo  [47] BALINESE LETTER AKARA..BALINESE LETTER HA
+	{runeRange{0x1B35, 0x1B35}, ScriptBalinese},                // Mc       BALINESE VOWEL SIGN TEDUNG
+	{runeRange{0x1B3B, 0x1B3B}, ScriptBalinese},                // Mc       BALINESE VOWEL SIGN RA REPA TEDUNG
+	{runeRange{0x1B3D, 0x1B41}, ScriptBalinese},                // Mc   [5] BALINESE VOWEL SIGN LA LENGA TEDUNG..BALINESE VOWEL SIGN TALING REPA TEDUNG
+	{runeRange{0x1B43, 0x1B44}, ScriptBalinese},                // Mc   [2] BALINESE VOWEL SIGN PEPET TEDUNG..BALINESE ADEG ADEG
+	{runeRange{0x1B4E, 0x1B4F}, ScriptBalinese},                // Po   [2] BALINESE INVERTED CARIK SIKI..BALINESE INVERTED CARIK PAREREN
+	{runeRange{0x1B5A, 0x1B60}, ScriptBalinese},                // Po   [7] BALINESE PANTI..BALINESE PAMENENG
+	{runeRange{0x1B6B, 0x1B73}, ScriptBalinese},                // Mn   [9] BALINESE MUSICAL SYMBOL COMBINING TEGEH..BALINESE MUSICAL SYMBOL COMBINING GONG
+	{runeRange{0x1B7D, 0x1B7F}, ScriptBalinese},                // Po   [3] BALINESE PANTI LANTANG..BALINESE PANTI BAWAK
+	{runeRange{0x1B82, 0x1B82}, ScriptSundanese},               // Mc       SUNDANESE SIGN PANGWISAD
+	{runeRange{0x1BA1, 0x1BA1}, ScriptSundanese},               // Mc       SUNDANESE CONSONANT SIGN PAMINGKAL
+	{runeRange{0x1BA6, 0x1BA7}, ScriptSundanese},               // Mc   [2] SUNDANESE VOWEL SIGN PANAELAENG..SUNDANESE VOWEL SIGN PANOLONG
+	{runeRange{0x1BAA, 0x1BAA}, ScriptSundanese},               // Mc       SUNDANESE SIGN PAMAAEH
+	{runeRange{0x1BAE, 0x1BAF}, ScriptSundanese},               // Lo   [2] SUNDANESE LETTER KHA..SUNDANESE LETTER SYA
+	{runeRange{0x1BBA, 0x1BBF}, ScriptSundanese},               // Lo   [6] SUNDANESE AVAGRAHA..SUNDANESE LETTER FINAL M
+	{runeRange{0x1BE6, 0x1BE6}, ScriptBatak},                   // Mn       BATAK SIGN TOMPI
+	{runeRange{0x1BE8, 0x1BE9}, ScriptBatak},                   // Mn   [2] BATAK VOWEL SIGN PAKPAK E..BATAK VOWEL SIGN EE
+	{runeRange{0x1BED, 0x1BED}, ScriptBatak},                   // Mn       BATAK VOWEL SIGN KARO O
+	{runeRange{0x1BEF, 0x1BF1}, ScriptBatak},                   // Mn   [3] BATAK VOWEL SIGN U FOR SIMALUNGUN SA..BATAK CONSONANT SIGN H
+	{runeRange{0x1BFC, 0x1BFF}, ScriptBatak},                   // Po   [4] BATAK SYMBOL BINDU NA METEK..BATAK SYMBOL BINDU PANGOLAT
+	{runeRange{0x1C24, 0x1C2B}, ScriptLepcha},                  // Mc   [8] LEPCHA SUBJOINED LETTER YA..LEPCHA VOWEL SIGN UU
+	{runeRange{0x1C34, 0x1C35}, ScriptLepcha},                  // Mc   [2] LEPCHA CONSONANT SIGN NYIN-DO..LEPCHA CONSONANT SIGN KANG
+	{runeRange{0x1C3B, 0x1C3F}, ScriptLepcha},                  // Po   [5] LEPCHA PUNCTUATION TA-ROL..LEPCHA PUNCTUATION TSHOOK
+	{runeRange{0x1C4D, 0x1C4F}, ScriptLepcha},                  // Lo   [3] LEPCHA LETTER TTA..LEPCHA LETTER DDA
+	{runeRange{0x1C5A, 0x1C77}, ScriptOlChiki},                 // Lo  [30] OL CHIKI LETTER LA..OL CHIKI LETTER OH
+	{runeRange{0x1C7E, 0x1C7F}, ScriptOlChiki},                 // Po   [2] OL CHIKI PUNCTUATION MUCAAD..OL CHIKI PUNCTUATION DOUBLE MUCAAD
+	{runeRange{0x1C89, 0x1C89}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER TJE
+	{runeRange{0x1C90, 0x1CBA}, ScriptGeorgian},                // Lu  [43] GEORGIAN MTAVRULI CAPITAL LETTER AN..GEORGIAN MTAVRULI CAPITAL LETTER AIN
+	{runeRange{0x1CC0, 0x1CC7}, ScriptSundanese},               // Po   [8] SUNDANESE PUNCTUATION BINDU SURYA..SUNDANESE PUNCTUATION BINDU BA SATANGA
+	{runeRange{0x1CD3, 0x1CD3}, ScriptCommon},                  // Po       VEDIC SIGN NIHSHVASA
+	{runeRange{0x1CE1, 0x1CE1}, ScriptCommon},                  // Mc       VEDIC TONE ATHARVAVEDIC INDEPENDENT SVARITA
+	{runeRange{0x1CE9, 0x1CEC}, ScriptCommon},                  // Lo   [4] VEDIC SIGN ANUSVARA ANTARGOMUKHA..VEDIC SIGN ANUSVARA VAMAGOMUKHA WITH TAIL
+	{runeRange{0x1CEE, 0x1CF3}, ScriptCommon},                  // Lo   [6] VEDIC SIGN HEXIFORM LONG ANUSVARA..VEDIC SIGN ROTATED ARDHAVISARGA
+	{runeRange{0x1CF5, 0x1CF6}, ScriptCommon},                  // Lo   [2] VEDIC SIGN JIHVAMULIYA..VEDIC SIGN UPADHMANIYA
+	{runeRange{0x1CF8, 0x1CF9}, ScriptInherited},               // Mn   [2] VEDIC TONE RING ABOVE..VEDIC TONE DOUBLE RING ABOVE
+	{runeRange{0x1D00, 0x1D25}, ScriptLatin},                   // Ll  [38] LATIN LETTER SMALL CAPITAL A..LATIN LETTER AIN
+	{runeRange{0x1D2B, 0x1D2B}, ScriptCyrillic},                // Ll       CYRILLIC LETTER SMALL CAPITAL EL
+	{runeRange{0x1D5D, 0x1D61}, ScriptGreek},                   // Lm   [5] MODIFIER LETTER SMALL BETA..MODIFIER LETTER SMALL CHI
+	{runeRange{0x1D66, 0x1D6A}, ScriptGreek},                   // Lm   [5] GREEK SUBSCRIPT SMALL LETTER BETA..GREEK SUBSCRIPT SMALL LETTER CHI
+	{runeRange{0x1D78, 0x1D78}, ScriptCyrillic},                // Lm       MODIFIER LETTER CYRILLIC EN
+	{runeRange{0x1D9B, 0x1DBE}, ScriptLatin},                   // Lm  [36] MODIFIER LETTER SMALL TURNED ALPHA..MODIFIER LETTER SMALL EZH
+	{runeRange{0x1DC0, 0x1DFF}, ScriptInherited},               // Mn  [64] COMBINING DOTTED GRAVE ACCENT..COMBINING RIGHT ARROWHEAD AND DOWN ARROWHEAD BELOW
+	{runeRange{0x1E01, 0x1E01}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH RING BELOW
+	{runeRange{0x1E03, 0x1E03}, ScriptLatin},                   // Ll       LATIN SMALL LETTER B WITH DOT ABOVE
+	{runeRange{0x1E05, 0x1E05}, ScriptLatin},                   // Ll       LATIN SMALL LETTER B WITH DOT BELOW
+	{runeRange{0x1E07, 0x1E07}, ScriptLatin},                   // Ll       LATIN SMALL LETTER B WITH LINE BELOW
+	{runeRange{0x1E09, 0x1E09}, ScriptLatin},                   // Ll       LATIN SMALL LETTER C WITH CEDILLA AND ACUTE
+	{runeRange{0x1E0B, 0x1E0B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER D WITH DOT ABOVE
+	{runeRange{0x1E0D, 0x1E0D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER D WITH DOT BELOW
+	{runeRange{0x1E0F, 0x1E0F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER D WITH LINE BELOW
+	{runeRange{0x1E11, 0x1E11}, ScriptLatin},                   // Ll       LATIN SMALL LETTER D WITH CEDILLA
+	{runeRange{0x1E13, 0x1E13}, ScriptLatin},                   // Ll       LATIN SMALL LETTER D WITH CIRCUMFLEX BELOW
+	{runeRange{0x1E15, 0x1E15}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH MACRON AND GRAVE
+	{runeRange{0x1E17, 0x1E17}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH MACRON AND ACUTE
+	{runeRange{0x1E19, 0x1E19}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH CIRCUMFLEX BELOW
+	{runeRange{0x1E1B, 0x1E1B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH TILDE BELOW
+	{runeRange{0x1E1D, 0x1E1D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH CEDILLA AND BREVE
+	{runeRange{0x1E1F, 0x1E1F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER F WITH DOT ABOVE
+	{runeRange{0x1E21, 0x1E21}, ScriptLatin},                   // Ll       LATIN SMALL LETTER G WITH MACRON
+	{runeRange{0x1E23, 0x1E23}, ScriptLatin},                   // Ll       LATIN SMALL LETTER H WITH DOT ABOVE
+	{runeRange{0x1E25, 0x1E25}, ScriptLatin},                   // Ll       LATIN SMALL LETTER H WITH DOT BELOW
+	{runeRange{0x1E27, 0x1E27}, ScriptLatin},                   // Ll       LATIN SMALL LETTER H WITH DIAERESIS
+	{runeRange{0x1E29, 0x1E29}, ScriptLatin},                   // Ll       LATIN SMALL LETTER H WITH CEDILLA
+	{runeRange{0x1E2B, 0x1E2B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER H WITH BREVE BELOW
+	{runeRange{0x1E2D, 0x1E2D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER I WITH TILDE BELOW
+	{runeRange{0x1E2F, 0x1E2F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER I WITH DIAERESIS AND ACUTE
+	{runeRange{0x1E31, 0x1E31}, ScriptLatin},                   // Ll       LATIN SMALL LETTER K WITH ACUTE
+	{runeRange{0x1E33, 0x1E33}, ScriptLatin},                   // Ll       LATIN SMALL LETTER K WITH DOT BELOW
+	{runeRange{0x1E35, 0x1E35}, ScriptLatin},                   // Ll       LATIN SMALL LETTER K WITH LINE BELOW
+	{runeRange{0x1E37, 0x1E37}, ScriptLatin},                   // Ll       LATIN SMALL LETTER L WITH DOT BELOW
+	{runeRange{0x1E39, 0x1E39}, ScriptLatin},                   // Ll       LATIN SMALL LETTER L WITH DOT BELOW AND MACRON
+	{runeRange{0x1E3B, 0x1E3B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER L WITH LINE BELOW
+	{runeRange{0x1E3D, 0x1E3D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER L WITH CIRCUMFLEX BELOW
+	{runeRange{0x1E3F, 0x1E3F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER M WITH ACUTE
+	{runeRange{0x1E41, 0x1E41}, ScriptLatin},                   // Ll       LATIN SMALL LETTER M WITH DOT ABOVE
+	{runeRange{0x1E43, 0x1E43}, ScriptLatin},                   // Ll       LATIN SMALL LETTER M WITH DOT BELOW
+	{runeRange{0x1E45, 0x1E45}, ScriptLatin},                   // Ll       LATIN SMALL LETTER N WITH DOT ABOVE
+	{runeRange{0x1E47, 0x1E47}, ScriptLatin},                   // Ll       LATIN SMALL LETTER N WITH DOT BELOW
+	{runeRange{0x1E49, 0x1E49}, ScriptLatin},                   // Ll       LATIN SMALL LETTER N WITH LINE BELOW
+	{runeRange{0x1E4B, 0x1E4B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER N WITH CIRCUMFLEX BELOW
+	{runeRange{0x1E4D, 0x1E4D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH TILDE AND ACUTE
+	{runeRange{0x1E4F, 0x1E4F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH TILDE AND DIAERESIS
+	{runeRange{0x1E51, 0x1E51}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH MACRON AND GRAVE
+	{runeRange{0x1E53, 0x1E53}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH MACRON AND ACUTE
+	{runeRange{0x1E55, 0x1E55}, ScriptLatin},                   // Ll       LATIN SMALL LETTER P WITH ACUTE
+	{runeRange{0x1E57, 0x1E57}, ScriptLatin},                   // Ll       LATIN SMALL LETTER P WITH DOT ABOVE
+	{runeRange{0x1E59, 0x1E59}, ScriptLatin},                   // Ll       LATIN SMALL LETTER R WITH DOT ABOVE
+	{runeRange{0x1E5B, 0x1E5B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER R WITH DOT BELOW
+	{runeRange{0x1E5D, 0x1E5D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER R WITH DOT BELOW AND MACRON
+	{runeRange{0x1E5F, 0x1E5F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER R WITH LINE BELOW
+	{runeRange{0x1E61, 0x1E61}, ScriptLatin},                   // Ll       LATIN SMALL LETTER S WITH DOT ABOVE
+	{runeRange{0x1E63, 0x1E63}, ScriptLatin},                   // Ll       LATIN SMALL LETTER S WITH DOT BELOW
+	{runeRange{0x1E65, 0x1E65}, ScriptLatin},                   // Ll       LATIN SMALL LETTER S WITH ACUTE AND DOT ABOVE
+	{runeRange{0x1E67, 0x1E67}, ScriptLatin},                   // Ll       LATIN SMALL LETTER S WITH CARON AND DOT ABOVE
+	{runeRange{0x1E69, 0x1E69}, ScriptLatin},                   // Ll       LATIN SMALL LETTER S WITH DOT BELOW AND DOT ABOVE
+	{runeRange{0x1E6B, 0x1E6B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER T WITH DOT ABOVE
+	{runeRange{0x1E6D, 0x1E6D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER T WITH DOT BELOW
+	{runeRange{0x1E6F, 0x1E6F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER T WITH LINE BELOW
+	{runeRange{0x1E71, 0x1E71}, ScriptLatin},                   // Ll       LATIN SMALL LETTER T WITH CIRCUMFLEX BELOW
+	{runeRange{0x1E73, 0x1E73}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH DIAERESIS BELOW
+	{runeRange{0x1E75, 0x1E75}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH TILDE BELOW
+	{runeRange{0x1E77, 0x1E77}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH CIRCUMFLEX BELOW
+	{runeRange{0x1E79, 0x1E79}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH TILDE AND ACUTE
+	{runeRange{0x1E7B, 0x1E7B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH MACRON AND DIAERESIS
+	{runeRange{0x1E7D, 0x1E7D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER V WITH TILDE
+	{runeRange{0x1E7F, 0x1E7F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER V WITH DOT BELOW
+	{runeRange{0x1E81, 0x1E81}, ScriptLatin},                   // Ll       LATIN SMALL LETTER W WITH GRAVE
+	{runeRange{0x1E83, 0x1E83}, ScriptLatin},                   // Ll       LATIN SMALL LETTER W WITH ACUTE
+	{runeRange{0x1E85, 0x1E85}, ScriptLatin},                   // Ll       LATIN SMALL LETTER W WITH DIAERESIS
+	{runeRange{0x1E87, 0x1E87}, ScriptLatin},                   // Ll       LATIN SMALL LETTER W WITH DOT ABOVE
+	{runeRange{0x1E89, 0x1E89}, ScriptLatin},                   // Ll       LATIN SMALL LETTER W WITH DOT BELOW
+	{runeRange{0x1E8B, 0x1E8B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER X WITH DOT ABOVE
+	{runeRange{0x1E8D, 0x1E8D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER X WITH DIAERESIS
+	{runeRange{0x1E8F, 0x1E8F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Y WITH DOT ABOVE
+	{runeRange{0x1E91, 0x1E91}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Z WITH CIRCUMFLEX
+	{runeRange{0x1E93, 0x1E93}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Z WITH DOT BELOW
+	{runeRange{0x1E95, 0x1E9D}, ScriptLatin},                   // Ll   [9] LATIN SMALL LETTER Z WITH LINE BELOW..LATIN SMALL LETTER LONG S WITH HIGH STROKE
+	{runeRange{0x1E9F, 0x1E9F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER DELTA
+	{runeRange{0x1EA1, 0x1EA1}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH DOT BELOW
+	{runeRange{0x1EA3, 0x1EA3}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH HOOK ABOVE
+	{runeRange{0x1EA5, 0x1EA5}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH CIRCUMFLEX AND ACUTE
+	{runeRange{0x1EA7, 0x1EA7}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH CIRCUMFLEX AND GRAVE
+	{runeRange{0x1EA9, 0x1EA9}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH CIRCUMFLEX AND HOOK ABOVE
+	{runeRange{0x1EAB, 0x1EAB}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH CIRCUMFLEX AND TILDE
+	{runeRange{0x1EAD, 0x1EAD}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH CIRCUMFLEX AND DOT BELOW
+	{runeRange{0x1EAF, 0x1EAF}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH BREVE AND ACUTE
+	{runeRange{0x1EB1, 0x1EB1}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH BREVE AND GRAVE
+	{runeRange{0x1EB3, 0x1EB3}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH BREVE AND HOOK ABOVE
+	{runeRange{0x1EB5, 0x1EB5}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH BREVE AND TILDE
+	{runeRange{0x1EB7, 0x1EB7}, ScriptLatin},                   // Ll       LATIN SMALL LETTER A WITH BREVE AND DOT BELOW
+	{runeRange{0x1EB9, 0x1EB9}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH DOT BELOW
+	{runeRange{0x1EBB, 0x1EBB}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH HOOK ABOVE
+	{runeRange{0x1EBD, 0x1EBD}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH TILDE
+	{runeRange{0x1EBF, 0x1EBF}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH CIRCUMFLEX AND ACUTE
+	{runeRange{0x1EC1, 0x1EC1}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH CIRCUMFLEX AND GRAVE
+	{runeRange{0x1EC3, 0x1EC3}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH CIRCUMFLEX AND HOOK ABOVE
+	{runeRange{0x1EC5, 0x1EC5}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH CIRCUMFLEX AND TILDE
+	{runeRange{0x1EC7, 0x1EC7}, ScriptLatin},                   // Ll       LATIN SMALL LETTER E WITH CIRCUMFLEX AND DOT BELOW
+	{runeRange{0x1EC9, 0x1EC9}, ScriptLatin},                   // Ll       LATIN SMALL LETTER I WITH HOOK ABOVE
+	{runeRange{0x1ECB, 0x1ECB}, ScriptLatin},                   // Ll       LATIN SMALL LETTER I WITH DOT BELOW
+	{runeRange{0x1ECD, 0x1ECD}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH DOT BELOW
+	{runeRange{0x1ECF, 0x1ECF}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH HOOK ABOVE
+	{runeRange{0x1ED1, 0x1ED1}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH CIRCUMFLEX AND ACUTE
+	{runeRange{0x1ED3, 0x1ED3}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH CIRCUMFLEX AND GRAVE
+	{runeRange{0x1ED5, 0x1ED5}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH CIRCUMFLEX AND HOOK ABOVE
+	{runeRange{0x1ED7, 0x1ED7}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH CIRCUMFLEX AND TILDE
+	{runeRange{0x1ED9, 0x1ED9}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH CIRCUMFLEX AND DOT BELOW
+	{runeRange{0x1EDB, 0x1EDB}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH HORN AND ACUTE
+	{runeRange{0x1EDD, 0x1EDD}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH HORN AND GRAVE
+	{runeRange{0x1EDF, 0x1EDF}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH HORN AND HOOK ABOVE
+	{runeRange{0x1EE1, 0x1EE1}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH HORN AND TILDE
+	{runeRange{0x1EE3, 0x1EE3}, ScriptLatin},                   // Ll       LATIN SMALL LETTER O WITH HORN AND DOT BELOW
+	{runeRange{0x1EE5, 0x1EE5}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH DOT BELOW
+	{runeRange{0x1EE7, 0x1EE7}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH HOOK ABOVE
+	{runeRange{0x1EE9, 0x1EE9}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH HORN AND ACUTE
+	{runeRange{0x1EEB, 0x1EEB}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH HORN AND GRAVE
+	{runeRange{0x1EED, 0x1EED}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH HORN AND HOOK ABOVE
+	{runeRange{0x1EEF, 0x1EEF}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH HORN AND TILDE
+	{runeRange{0x1EF1, 0x1EF1}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH HORN AND DOT BELOW
+	{runeRange{0x1EF3, 0x1EF3}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Y WITH GRAVE
+	{runeRange{0x1EF5, 0x1EF5}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Y WITH DOT BELOW
+	{runeRange{0x1EF7, 0x1EF7}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Y WITH HOOK ABOVE
+	{runeRange{0x1EF9, 0x1EF9}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Y WITH TILDE
+	{runeRange{0x1EFB, 0x1EFB}, ScriptLatin},                   // Ll       LATIN SMALL LETTER MIDDLE-WELSH LL
+	{runeRange{0x1EFD, 0x1EFD}, ScriptLatin},                   // Ll       LATIN SMALL LETTER MIDDLE-WELSH V
+	{runeRange{0x1EFF, 0x1EFF}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Y WITH LOOP
+	{runeRange{0x1F08, 0x1F0F}, ScriptGreek},                   // Lu   [8] GREEK CAPITAL LETTER ALPHA WITH PSILI..GREEK CAPITAL LETTER ALPHA WITH DASIA AND PERISPOMENI
+	{runeRange{0x1F18, 0x1F1D}, ScriptGreek},                   // Lu   [6] GREEK CAPITAL LETTER EPSILON WITH PSILI..GREEK CAPITAL LETTER EPSILON WITH DASIA AND OXIA
+	{runeRange{0x1F28, 0x1F2F}, ScriptGreek},                   // Lu   [8] GREEK CAPITAL LETTER ETA WITH PSILI..GREEK CAPITAL LETTER ETA WITH DASIA AND PERISPOMENI
+	{runeRange{0x1F38, 0x1F3F}, ScriptGreek},                   // Lu   [8] GREEK CAPITAL LETTER IOTA WITH PSILI..GREEK CAPITAL LETTER IOTA WITH DASIA AND PERISPOMENI
+	{runeRange{0x1F48, 0x1F4D}, ScriptGreek},                   // Lu   [6] GREEK CAPITAL LETTER OMICRON WITH PSILI..GREEK CAPITAL LETTER OMICRON WITH DASIA AND OXIA
+	{runeRange{0x1F59, 0x1F59}, ScriptGreek},                   // Lu       GREEK CAPITAL LETTER UPSILON WITH DASIA
+	{runeRange{0x1F5D, 0x1F5D}, ScriptGreek},                   // Lu       GREEK CAPITAL LETTER UPSILON WITH DASIA AND OXIA
+	{runeRange{0x1F60, 0x1F67}, ScriptGreek},                   // Ll   [8] GREEK SMALL LETTER OMEGA WITH PSILI..GREEK SMALL LETTER OMEGA WITH DASIA AND PERISPOMENI
+	{runeRange{0x1F70, 0x1F7D}, ScriptGreek},                   // Ll  [14] GREEK SMALL LETTER ALPHA WITH VARIA..GREEK SMALL LETTER OMEGA WITH OXIA
+	{runeRange{0x1F88, 0x1F8F}, ScriptGreek},                   // Lt   [8] GREEK CAPITAL LETTER ALPHA WITH PSILI AND PROSGEGRAMMENI..GREEK CAPITAL LETTER ALPHA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	{runeRange{0x1F98, 0x1F9F}, ScriptGreek},                   // Lt   [8] GREEK CAPITAL LETTER ETA WITH PSILI AND PROSGEGRAMMENI..GREEK CAPITAL LETTER ETA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	{runeRange{0x1FA8, 0x1FAF}, ScriptGreek},                   // Lt   [8] GREEK CAPITAL LETTER OMEGA WITH PSILI AND PROSGEGRAMMENI..GREEK CAPITAL LETTER OMEGA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	{runeRange{0x1FB6, 0x1FB7}, ScriptGreek},                   // Ll   [2] GREEK SMALL LETTER ALPHA WITH PERISPOMENI..GREEK SMALL LETTER ALPHA WITH PERISPOMENI AND YPOGEGRAMMENI
+	{runeRange{0x1FBC, 0x1FBC}, ScriptGreek},                   // Lt       GREEK CAPITAL LETTER ALPHA WITH PROSGEGRAMMENI
+	{runeRange{0x1FBE, 0x1FBE}, ScriptGreek},                   // Ll       GREEK PROSGEGRAMMENI
+	{runeRange{0x1FC2, 0x1FC4}, ScriptGreek},                   // Ll   [3] GREEK SMALL LETTER ETA WITH VARIA AND YPOGEGRAMMENI..GREEK SMALL LETTER ETA WITH OXIA AND YPOGEGRAMMENI
+	{runeRange{0x1FC8, 0x1FCB}, ScriptGreek},                   // Lu   [4] GREEK CAPITAL LETTER EPSILON WITH VARIA..GREEK CAPITAL LETTER ETA WITH OXIA
+	{runeRange{0x1FCD, 0x1FCF}, ScriptGreek},                   // Sk   [3] GREEK PSILI AND VARIA..GREEK PSILI AND PERISPOMENI
+	{runeRange{0x1FD6, 0x1FD7}, ScriptGreek},                   // Ll   [2] GREEK SMALL LETTER IOTA WITH PERISPOMENI..GREEK SMALL LETTER IOTA WITH DIALYTIKA AND PERISPOMENI
+	{runeRange{0x1FDD, 0x1FDF}, ScriptGreek},                   // Sk   [3] GREEK DASIA AND VARIA..GREEK DASIA AND PERISPOMENI
+	{runeRange{0x1FE8, 0x1FEC}, ScriptGreek},                   // Lu   [5] GREEK CAPITAL LETTER UPSILON WITH VRACHY..GREEK CAPITAL LETTER RHO WITH DASIA
+	{runeRange{0x1FF2, 0x1FF4}, ScriptGreek},                   // Ll   [3] GREEK SMALL LETTER OMEGA WITH VARIA AND YPOGEGRAMMENI..GREEK SMALL LETTER OMEGA WITH OXIA AND YPOGEGRAMMENI
+	{runeRange{0x1FF8, 0x1FFB}, ScriptGreek},                   // Lu   [4] GREEK CAPITAL LETTER OMICRON WITH VARIA..GREEK CAPITAL LETTER OMEGA WITH OXIA
+	{runeRange{0x1FFD, 0x1FFE}, ScriptGreek},                   // Sk   [2] GREEK OXIA..GREEK DASIA
+	{runeRange{0x200B, 0x200B}, ScriptCommon},                  // Cf       ZERO WIDTH SPACE
+	{runeRange{0x200E, 0x200F}, ScriptCommon},                  // Cf   [2] LEFT-TO-RIGHT MARK..RIGHT-TO-LEFT MARK
+	{runeRange{0x2016, 0x2017}, ScriptCommon},                  // Po   [2] DOUBLE VERTICAL LINE..DOUBLE LOW LINE
+	{runeRange{0x2019, 0x2019}, ScriptCommon},                  // Pf       RIGHT SINGLE QUOTATION MARK
+	{runeRange{0x201B, 0x201C}, ScriptCommon},                  // Pi   [2] SINGLE HIGH-REVERSED-9 QUOTATION MARK..LEFT DOUBLE QUOTATION MARK
+	{runeRange{0x201E, 0x201E}, ScriptCommon},                  // Ps       DOUBLE LOW-9 QUOTATION MARK
+	{runeRange{0x2020, 0x2027}, ScriptCommon},                  // Po   [8] DAGGER..HYPHENATION POINT
+	{runeRange{0x2029, 0x2029}, ScriptCommon},                  // Zp       PARAGRAPH SEPARATOR
+	{runeRange{0x202F, 0x202F}, ScriptCommon},                  // Zs       NARROW NO-BREAK SPACE
+	{runeRange{0x2039, 0x2039}, ScriptCommon},                  // Pi       SINGLE LEFT-POINTING ANGLE QUOTATION MARK
+	{runeRange{0x203B, 0x203E}, ScriptCommon},                  // Po   [4] REFERENCE MARK..OVERLINE
+	{runeRange{0x2041, 0x2043}, ScriptCommon},                  // Po   [3] CARET INSERTION POINT..HYPHEN BULLET
+	{runeRange{0x2045, 0x2045}, ScriptCommon},                  // Ps       LEFT SQUARE BRACKET WITH QUILL
+	{runeRange{0x2047, 0x2051}, ScriptCommon},                  // Po  [11] DOUBLE QUESTION MARK..TWO ASTERISKS ALIGNED VERTICALLY
+	{runeRange{0x2053, 0x2053}, ScriptCommon},                  // Po       SWUNG DASH
+	{runeRange{0x2055, 0x205E}, ScriptCommon},                  // Po  [10] FLOWER PUNCTUATION MARK..VERTICAL FOUR DOTS
+	{runeRange{0x2060, 0x2064}, ScriptCommon},                  // Cf   [5] WORD JOINER..INVISIBLE PLUS
+	{runeRange{0x2070, 0x2070}, ScriptCommon},                  // No       SUPERSCRIPT ZERO
+	{runeRange{0x2074, 0x2079}, ScriptCommon},                  // No   [6] SUPERSCRIPT FOUR..SUPERSCRIPT NINE
+	{runeRange{0x207D, 0x207D}, ScriptCommon},                  // Ps       SUPERSCRIPT LEFT PARENTHESIS
+	{runeRange{0x207F, 0x207F}, ScriptLatin},                   // Lm       SUPERSCRIPT LATIN SMALL LETTER N
+	{runeRange{0x208A, 0x208C}, ScriptCommon},                  // Sm   [3] SUBSCRIPT PLUS SIGN..SUBSCRIPT EQUALS SIGN
+	{runeRange{0x208E, 0x208E}, ScriptCommon},                  // Pe       SUBSCRIPT RIGHT PARENTHESIS
+	{runeRange{0x20A0, 0x20C1}, ScriptCommon},                  // Sc  [34] EURO-CURRENCY SIGN..SAUDI RIYAL SIGN
+	{runeRange{0x20DD, 0x20E0}, ScriptInherited},               // Me   [4] COMBINING ENCLOSING CIRCLE..COMBINING ENCLOSING CIRCLE BACKSLASH
+	{runeRange{0x20E2, 0x20E4}, ScriptInherited},               // Me   [3] COMBINING ENCLOSING SCREEN..COMBINING ENCLOSING UPWARD POINTING TRIANGLE
+	{runeRange{0x2100, 0x2101}, ScriptCommon},                  // So   [2] ACCOUNT OF..ADDRESSED TO THE SUBJECT
+	{runeRange{0x2103, 0x2106}, ScriptCommon},                  // So   [4] DEGREE CELSIUS..CADA UNA
+	{runeRange{0x2108, 0x2109}, ScriptCommon},                  // So   [2] SCRUPLE..DEGREE FAHRENHEIT
+	{runeRange{0x210B, 0x210D}, ScriptCommon},                  // Lu   [3] SCRIPT CAPITAL H..DOUBLE-STRUCK CAPITAL H
+	{runeRange{0x2110, 0x2112}, ScriptCommon},                  // Lu   [3] SCRIPT CAPITAL I..SCRIPT CAPITAL L
+	{runeRange{0x2114, 0x2114}, ScriptCommon},                  // So       L B BAR SYMBOL
+	{runeRange{0x2116, 0x2117}, ScriptCommon},                  // So   [2] NUMERO SIGN..SOUND RECORDING COPYRIGHT
+	{runeRange{0x2119, 0x211D}, ScriptCommon},                  // Lu   [5] DOUBLE-STRUCK CAPITAL P..DOUBLE-STRUCK CAPITAL R
+	{runeRange{0x2124, 0x2124}, ScriptCommon},                  // Lu       DOUBLE-STRUCK CAPITAL Z
+	{runeRange{0x2126, 0x2126}, ScriptGreek},                   // Lu       OHM SIGN
+	{runeRange{0x2128, 0x2128}, ScriptCommon},                  // Lu       BLACK-LETTER CAPITAL Z
+	{runeRange{0x212A, 0x212B}, ScriptLatin},                   // Lu   [2] KELVIN SIGN..ANGSTROM SIGN
+	{runeRange{0x212E, 0x212E}, ScriptCommon},                  // So       ESTIMATED SYMBOL
+	{runeRange{0x2130, 0x2131}, ScriptCommon},                  // Lu   [2] SCRIPT CAPITAL E..SCRIPT CAPITAL F
+	{runeRange{0x2133, 0x2133}, ScriptCommon},                  // Lu       SCRIPT CAPITAL M
+	{runeRange{0x2135, 0x2138}, ScriptCommon},                  // Lo   [4] ALEF SYMBOL..DALET SYMBOL
+	{runeRange{0x213A, 0x213B}, ScriptCommon},                  // So   [2] ROTATED CAPITAL Q..FACSIMILE SIGN
+	{runeRange{0x213E, 0x213F}, ScriptCommon},                  // Lu   [2] DOUBLE-STRUCK CAPITAL GAMMA..DOUBLE-STRUCK CAPITAL PI
+	{runeRange{0x2145, 0x2145}, ScriptCommon},                  // Lu       DOUBLE-STRUCK ITALIC CAPITAL D
+	{runeRange{0x214A, 0x214A}, ScriptCommon},                  // So       PROPERTY LINE
+	{runeRange{0x214C, 0x214D}, ScriptCommon},                  // So   [2] PER SIGN..AKTIESELSKAB
+	{runeRange{0x214F, 0x214F}, ScriptCommon},                  // So       SYMBOL FOR SAMARITAN SOURCE
+	{runeRange{0x2160, 0x2182}, ScriptLatin},                   // Nl  [35] ROMAN NUMERAL ONE..ROMAN NUMERAL TEN THOUSAND
+	{runeRange{0x2184, 0x2184}, ScriptLatin},                   // Ll       LATIN SMALL LETTER REVERSED C
+	{runeRange{0x2189, 0x2189}, ScriptCommon},                  // No       VULGAR FRACTION ZERO THIRDS
+	{runeRange{0x2190, 0x2194}, ScriptCommon},                  // Sm   [5] LEFTWARDS ARROW..LEFT RIGHT ARROW
+	{runeRange{0x219A, 0x219B}, ScriptCommon},                  // Sm   [2] LEFTWARDS ARROW WITH STROKE..RIGHTWARDS ARROW WITH STROKE
+	{runeRange{0x21A0, 0x21A0}, ScriptCommon},                  // Sm       RIGHTWARDS TWO HEADED ARROW
+	{runeRange{0x21A3, 0x21A3}, ScriptCommon},                  // Sm       RIGHTWARDS ARROW WITH TAIL
+	{runeRange{0x21A6, 0x21A6}, ScriptCommon},                  // Sm       RIGHTWARDS ARROW FROM BAR
+	{runeRange{0x21AE, 0x21AE}, ScriptCommon},                  // Sm       LEFT RIGHT ARROW WITH STROKE
+	{runeRange{0x21CE, 0x21CF}, ScriptCommon},                  // Sm   [2] LEFT RIGHT DOUBLE ARROW WITH STROKE..RIGHTWARDS DOUBLE ARROW WITH STROKE
+	{runeRange{0x21D2, 0x21D2}, ScriptCommon},                  // Sm       RIGHTWARDS DOUBLE ARROW
+	{runeRange{0x21D4, 0x21D4}, ScriptCommon},                  // Sm       LEFT RIGHT DOUBLE ARROW
+	{runeRange{0x21F4, 0x22FF}, ScriptCommon},                  // Sm [268] RIGHT ARROW WITH SMALL CIRCLE..Z NOTATION BAG MEMBERSHIP
+	{runeRange{0x2308, 0x2308}, ScriptCommon},                  // Ps       LEFT CEILING
+	{runeRange{0x230A, 0x230A}, ScriptCommon},                  // Ps       LEFT FLOOR
+	{runeRange{0x230C, 0x231F}, ScriptCommon},                  // So  [20] BOTTOM RIGHT CROP..BOTTOM RIGHT CORNER
+	{runeRange{0x2322, 0x2328}, ScriptCommon},                  // So   [7] FROWN..KEYBOARD
+	{runeRange{0x232A, 0x232A}, ScriptCommon},                  // Pe       RIGHT-POINTING ANGLE BRACKET
+	{runeRange{0x237C, 0x237C}, ScriptCommon},                  // Sm       RIGHT ANGLE WITH DOWNWARDS ZIGZAG ARROW
+	{runeRange{0x239B, 0x23B3}, ScriptCommon},                  // Sm  [25] LEFT PARENTHESIS UPPER HOOK..SUMMATION BOTTOM
+	{runeRange{0x23DC, 0x23E1}, ScriptCommon},                  // Sm   [6] TOP PARENTHESIS..BOTTOM TORTOISE SHELL BRACKET
+	{runeRange{0x2440, 0x244A}, ScriptCommon},                  // So  [11] OCR HOOK..OCR DOUBLE BACKSLASH
+	{runeRange{0x249C, 0x24E9}, ScriptCommon},                  // So  [78] PARENTHESIZED LATIN SMALL LETTER A..CIRCLED LATIN SMALL LETTER Z
+	{runeRange{0x2500, 0x25B6}, ScriptCommon},                  // So [183] BOX DRAWINGS LIGHT HORIZONTAL..BLACK RIGHT-POINTING TRIANGLE
+	{runeRange{0x25B8, 0x25C0}, ScriptCommon},                  // So   [9] BLACK RIGHT-POINTING SMALL TRIANGLE..BLACK LEFT-POINTING TRIANGLE
+	{runeRange{0x25C2, 0x25F7}, ScriptCommon},                  // So  [54] BLACK LEFT-POINTING SMALL TRIANGLE..WHITE CIRCLE WITH UPPER RIGHT QUADRANT
+	{runeRange{0x2600, 0x266E}, ScriptCommon},                  // So [111] BLACK SUN WITH RAYS..MUSIC NATURAL SIGN
+	{runeRange{0x2670, 0x2767}, ScriptCommon},                  // So [248] WEST SYRIAC CROSS..ROTATED FLORAL HEART BULLET
+	{runeRange{0x2769, 0x2769}, ScriptCommon},                  // Pe       MEDIUM RIGHT PARENTHESIS ORNAMENT
+	{runeRange{0x276B, 0x276B}, ScriptCommon},                  // Pe       MEDIUM FLATTENED RIGHT PARENTHESIS ORNAMENT
+	{runeRange{0x276D, 0x276D}, ScriptCommon},                  // Pe       MEDIUM RIGHT-POINTING ANGLE BRACKET ORNAMENT
+	{runeRange{0x276F, 0x276F}, ScriptCommon},                  // Pe       HEAVY RIGHT-POINTING ANGLE QUOTATION MARK ORNAMENT
+	{runeRange{0x2771, 0x2771}, ScriptCommon},                  // Pe       HEAVY RIGHT-POINTING ANGLE BRACKET ORNAMENT
+	{runeRange{0x2773, 0x2773}, ScriptCommon},                  // Pe       LIGHT RIGHT TORTOISE SHELL BRACKET ORNAMENT
+	{runeRange{0x2775, 0x2775}, ScriptCommon},                  // Pe       MEDIUM RIGHT CURLY BRACKET ORNAMENT
+	{runeRange{0x2794, 0x27BF}, ScriptCommon},                  // So  [44] HEAVY WIDE-HEADED RIGHTWARDS ARROW..DOUBLE CURLY LOOP
+	{runeRange{0x27C5, 0x27C5}, ScriptCommon},                  // Ps       LEFT S-SHAPED BAG DELIMITER
+	{runeRange{0x27C7, 0x27E5}, ScriptCommon},                  // Sm  [31] OR WITH DOT INSIDE..WHITE SQUARE WITH RIGHTWARDS TICK
+	{runeRange{0x27E7, 0x27E7}, ScriptCommon},                  // Pe       MATHEMATICAL RIGHT WHITE SQUARE BRACKET
+	{runeRange{0x27E9, 0x27E9}, ScriptCommon},                  // Pe       MATHEMATICAL RIGHT ANGLE BRACKET
+	{runeRange{0x27EB, 0x27EB}, ScriptCommon},                  // Pe       MATHEMATICAL RIGHT DOUBLE ANGLE BRACKET
+	{runeRange{0x27ED, 0x27ED}, ScriptCommon},                  // Pe       MATHEMATICAL RIGHT WHITE TORTOISE SHELL BRACKET
+	{runeRange{0x27EF, 0x27EF}, ScriptCommon},                  // Pe       MATHEMATICAL RIGHT FLATTENED PARENTHESIS
+	{runeRange{0x2800, 0x28FF}, ScriptBraille},                 // So [256] BRAILLE PATTERN BLANK..BRAILLE PATTERN DOTS-12345678
+	{runeRange{0x2983, 0x2983}, ScriptCommon},                  // Ps       LEFT WHITE CURLY BRACKET
+	{runeRange{0x2985, 0x2985}, ScriptCommon},                  // Ps       LEFT WHITE PARENTHESIS
+	{runeRange{0x2987, 0x2987}, ScriptCommon},                  // Ps       Z NOTATION LEFT IMAGE BRACKET
+	{runeRange{0x2989, 0x2989}, ScriptCommon},                  // Ps       Z NOTATION LEFT BINDING BRACKET
+	{runeRange{0x298B, 0x298B}, ScriptCommon},                  // Ps       LEFT SQUARE BRACKET WITH UNDERBAR
+	{runeRange{0x298D, 0x298D}, ScriptCommon},                  // Ps       LEFT SQUARE BRACKET WITH TICK IN TOP CORNER
+	{runeRange{0x298F, 0x298F}, ScriptCommon},                  // Ps       LEFT SQUARE BRACKET WITH TICK IN BOTTOM CORNER
+	{runeRange{0x2991, 0x2991}, ScriptCommon},                  // Ps       LEFT ANGLE BRACKET WITH DOT
+	{runeRange{0x2993, 0x2993}, ScriptCommon},                  // Ps       LEFT ARC LESS-THAN BRACKET
+	{runeRange{0x2995, 0x2995}, ScriptCommon},                  // Ps       DOUBLE LEFT ARC GREATER-THAN BRACKET
+	{runeRange{0x2997, 0x2997}, ScriptCommon},                  // Ps       LEFT BLACK TORTOISE SHELL BRACKET
+	{runeRange{0x2999, 0x29D7}, ScriptCommon},                  // Sm  [63] DOTTED FENCE..BLACK HOURGLASS
+	{runeRange{0x29D9, 0x29D9}, ScriptCommon},                  // Pe       RIGHT WIGGLY FENCE
+	{runeRange{0x29DB, 0x29DB}, ScriptCommon},                  // Pe       RIGHT DOUBLE WIGGLY FENCE
+	{runeRange{0x29FC, 0x29FC}, ScriptCommon},                  // Ps       LEFT-POINTING CURVED ANGLE BRACKET
+	{runeRange{0x29FE, 0x2AFF}, ScriptCommon},                  // Sm [258] TINY..N-ARY WHITE VERTICAL BAR
+	{runeRange{0x2B30, 0x2B44}, ScriptCommon},                  // Sm  [21] LEFT ARROW WITH SMALL CIRCLE..RIGHTWARDS ARROW THROUGH SUPERSET
+	{runeRange{0x2B47, 0x2B4C}, ScriptCommon},                  // Sm   [6] REVERSE TILDE OPERATOR ABOVE RIGHTWARDS ARROW..RIGHTWARDS ARROW ABOVE REVERSE TILDE OPERATOR
+	{runeRange{0x2B76, 0x2BFF}, ScriptCommon},                  // So [138] NORTH WEST TRIANGLE-HEADED ARROW TO BAR..HELLSCHREIBER PAUSE SYMBOL
+	{runeRange{0x2C30, 0x2C5F}, ScriptGlagolitic},              // Ll  [48] GLAGOLITIC SMALL LETTER AZU..GLAGOLITIC SMALL LETTER CAUDATE CHRIVI
+	{runeRange{0x2C61, 0x2C61}, ScriptLatin},                   // Ll       LATIN SMALL LETTER L WITH DOUBLE BAR
+	{runeRange{0x2C65, 0x2C66}, ScriptLatin},                   // Ll   [2] LATIN SMALL LETTER A WITH STROKE..LATIN SMALL LETTER T WITH DIAGONAL STROKE
+	{runeRange{0x2C68, 0x2C68}, ScriptLatin},                   // Ll       LATIN SMALL LETTER H WITH DESCENDER
+	{runeRange{0x2C6A, 0x2C6A}, ScriptLatin},                   // Ll       LATIN SMALL LETTER K WITH DESCENDER
+	{runeRange{0x2C6C, 0x2C6C}, ScriptLatin},                   // Ll       LATIN SMALL LETTER Z WITH DESCENDER
+	{runeRange{0x2C71, 0x2C71}, ScriptLatin},                   // Ll       LATIN SMALL LETTER V WITH RIGHT HOOK
+	{runeRange{0x2C73, 0x2C74}, ScriptLatin},                   // Ll   [2] LATIN SMALL LETTER W WITH HOOK..LATIN SMALL LETTER V WITH CURL
+	{runeRange{0x2C76, 0x2C7B}, ScriptLatin},                   // Ll   [6] LATIN SMALL LETTER HALF H..LATIN LETTER SMALL CAPITAL TURNED E
+	{runeRange{0x2C7E, 0x2C7F}, ScriptLatin},                   // Lu   [2] LATIN CAPITAL LETTER S WITH SWASH TAIL..LATIN CAPITAL LETTER Z WITH SWASH TAIL
+	{runeRange{0x2C81, 0x2C81}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER ALFA
+	{runeRange{0x2C83, 0x2C83}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER VIDA
+	{runeRange{0x2C85, 0x2C85}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER GAMMA
+	{runeRange{0x2C87, 0x2C87}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER DALDA
+	{runeRange{0x2C89, 0x2C89}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER EIE
+	{runeRange{0x2C8B, 0x2C8B}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER SOU
+	{runeRange{0x2C8D, 0x2C8D}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER ZATA
+	{runeRange{0x2C8F, 0x2C8F}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER HATE
+	{runeRange{0x2C91, 0x2C91}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER THETHE
+	{runeRange{0x2C93, 0x2C93}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER IAUDA
+	{runeRange{0x2C95, 0x2C95}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER KAPA
+	{runeRange{0x2C97, 0x2C97}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER LAULA
+	{runeRange{0x2C99, 0x2C99}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER MI
+	{runeRange{0x2C9B, 0x2C9B}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER NI
+	{runeRange{0x2C9D, 0x2C9D}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER KSI
+	{runeRange{0x2C9F, 0x2C9F}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER O
+	{runeRange{0x2CA1, 0x2CA1}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER PI
+	{runeRange{0x2CA3, 0x2CA3}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER RO
+	{runeRange{0x2CA5, 0x2CA5}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER SIMA
+	{runeRange{0x2CA7, 0x2CA7}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER TAU
+	{runeRange{0x2CA9, 0x2CA9}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER UA
+	{runeRange{0x2CAB, 0x2CAB}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER FI
+	{runeRange{0x2CAD, 0x2CAD}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER KHI
+	{runeRange{0x2CAF, 0x2CAF}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER PSI
+	{runeRange{0x2CB1, 0x2CB1}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER OOU
+	{runeRange{0x2CB3, 0x2CB3}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER DIALECT-P ALEF
+	{runeRange{0x2CB5, 0x2CB5}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER OLD COPTIC AIN
+	{runeRange{0x2CB7, 0x2CB7}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER CRYPTOGRAMMIC EIE
+	{runeRange{0x2CB9, 0x2CB9}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER DIALECT-P KAPA
+	{runeRange{0x2CBB, 0x2CBB}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER DIALECT-P NI
+	{runeRange{0x2CBD, 0x2CBD}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER CRYPTOGRAMMIC NI
+	{runeRange{0x2CBF, 0x2CBF}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER OLD COPTIC OOU
+	{runeRange{0x2CC1, 0x2CC1}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER SAMPI
+	{runeRange{0x2CC3, 0x2CC3}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER CROSSED SHEI
+	{runeRange{0x2CC5, 0x2CC5}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER OLD COPTIC SHEI
+	{runeRange{0x2CC7, 0x2CC7}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER OLD COPTIC ESH
+	{runeRange{0x2CC9, 0x2CC9}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER AKHMIMIC KHEI
+	{runeRange{0x2CCB, 0x2CCB}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER DIALECT-P HORI
+	{runeRange{0x2CCD, 0x2CCD}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER OLD COPTIC HORI
+	{runeRange{0x2CCF, 0x2CCF}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER OLD COPTIC HA
+	{runeRange{0x2CD1, 0x2CD1}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER L-SHAPED HA
+	{runeRange{0x2CD3, 0x2CD3}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER OLD COPTIC HEI
+	{runeRange{0x2CD5, 0x2CD5}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER OLD COPTIC HAT
+	{runeRange{0x2CD7, 0x2CD7}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER OLD COPTIC GANGIA
+	{runeRange{0x2CD9, 0x2CD9}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER OLD COPTIC DJA
+	{runeRange{0x2CDB, 0x2CDB}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER OLD COPTIC SHIMA
+	{runeRange{0x2CDD, 0x2CDD}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER OLD NUBIAN SHIMA
+	{runeRange{0x2CDF, 0x2CDF}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER OLD NUBIAN NGI
+	{runeRange{0x2CE1, 0x2CE1}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER OLD NUBIAN NYI
+	{runeRange{0x2CE3, 0x2CE4}, ScriptCoptic},                  // Ll   [2] COPTIC SMALL LETTER OLD NUBIAN WAU..COPTIC SYMBOL KAI
+	{runeRange{0x2CEB, 0x2CEB}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER CRYPTOGRAMMIC SHEI
+	{runeRange{0x2CED, 0x2CED}, ScriptCoptic},                  // Lu       COPTIC CAPITAL LETTER CRYPTOGRAMMIC GANGIA
+	{runeRange{0x2CEF, 0x2CF1}, ScriptCoptic},                  // Mn   [3] COPTIC COMBINING NI ABOVE..COPTIC COMBINING SPIRITUS LENIS
+	{runeRange{0x2CF3, 0x2CF3}, ScriptCoptic},                  // Ll       COPTIC SMALL LETTER BOHAIRIC KHEI
+	{runeRange{0x2CFD, 0x2CFD}, ScriptCoptic},                  // No       COPTIC FRACTION ONE HALF
+	{runeRange{0x2D00, 0x2D25}, ScriptGeorgian},                // Ll  [38] GEORGIAN SMALL LETTER AN..GEORGIAN SMALL LETTER HOE
+	{runeRange{0x2D2D, 0x2D2D}, ScriptGeorgian},                // Ll       GEORGIAN SMALL LETTER AEN
+	{runeRange{0x2D6F, 0x2D6F}, ScriptTifinagh},                // Lm       TIFINAGH MODIFIER LETTER LABIALIZATION MARK
+	{runeRange{0x2D7F, 0x2D7F}, ScriptTifinagh},                // Mn       TIFINAGH CONSONANT JOINER
+	{runeRange{0x2DA0, 0x2DA6}, ScriptEthiopic},                // Lo   [7] ETHIOPIC SYLLABLE SSA..ETHIOPIC SYLLABLE SSO
+	{runeRange{0x2DB0, 0x2DB6}, ScriptEthiopic},                // Lo   [7] ETHIOPIC SYLLABLE ZZA..ETHIOPIC SYLLABLE ZZO
+	{runeRange{0x2DC0, 0x2DC6}, ScriptEthiopic},                // Lo   [7] ETHIOPIC SYLLABLE QYA..ETHIOPIC SYLLABLE QYO
+	{runeRange{0x2DD0, 0x2DD6}, ScriptEthiopic},                // Lo   [7] ETHIOPIC SYLLABLE XYA..ETHIOPIC SYLLABLE XYO
+	{runeRange{0x2DE0, 0x2DFF}, ScriptCyrillic},                // Mn  [32] COMBINING CYRILLIC LETTER BE..COMBINING CYRILLIC LETTER IOTIFIED BIG YUS
+	{runeRange{0x2E02, 0x2E02}, ScriptCommon},                  // Pi       LEFT SUBSTITUTION BRACKET
+	{runeRange{0x2E04, 0x2E04}, ScriptCommon},                  // Pi       LEFT DOTTED SUBSTITUTION BRACKET
+	{runeRange{0x2E06, 0x2E08}, ScriptCommon},                  // Po   [3] RAISED INTERPOLATION MARKER..DOTTED TRANSPOSITION MARKER
+	{runeRange{0x2E0A, 0x2E0A}, ScriptCommon},                  // Pf       RIGHT TRANSPOSITION BRACKET
+	{runeRange{0x2E0C, 0x2E0C}, ScriptCommon},                  // Pi       LEFT RAISED OMISSION BRACKET
+	{runeRange{0x2E0E, 0x2E16}, ScriptCommon},                  // Po   [9] EDITORIAL CORONIS..DOTTED RIGHT-POINTING ANGLE
+	{runeRange{0x2E18, 0x2E19}, ScriptCommon},                  // Po   [2] INVERTED INTERROBANG..PALM BRANCH
+	{runeRange{0x2E1B, 0x2E1B}, ScriptCommon},                  // Po       TILDE WITH RING ABOVE
+	{runeRange{0x2E1D, 0x2E1D}, ScriptCommon},                  // Pf       RIGHT LOW PARAPHRASE BRACKET
+	{runeRange{0x2E20, 0x2E20}, ScriptCommon},                  // Pi       LEFT VERTICAL BAR WITH QUILL
+	{runeRange{0x2E22, 0x2E22}, ScriptCommon},                  // Ps       TOP LEFT HALF BRACKET
+	{runeRange{0x2E24, 0x2E24}, ScriptCommon},                  // Ps       BOTTOM LEFT HALF BRACKET
+	{runeRange{0x2E26, 0x2E26}, ScriptCommon},                  // Ps       LEFT SIDEWAYS U BRACKET
+	{runeRange{0x2E28, 0x2E28}, ScriptCommon},                  // Ps       LEFT DOUBLE PARENTHESIS
+	{runeRange{0x2E2A, 0x2E2E}, ScriptCommon},                  // Po   [5] TWO DOTS OVER ONE DOT PUNCTUATION..REVERSED QUESTION MARK
+	{runeRange{0x2E30, 0x2E39}, ScriptCommon},                  // Po  [10] RING POINT..TOP HALF SECTION SIGN
+	{runeRange{0x2E3C, 0x2E3F}, ScriptCommon},                  // Po   [4] STENOGRAPHIC FULL STOP..CAPITULUM
+	{runeRange{0x2E41, 0x2E41}, ScriptCommon},                  // Po       REVERSED COMMA
+	{runeRange{0x2E43, 0x2E4F}, ScriptCommon},                  // Po  [13] DASH WITH LEFT UPTURN..CORNISH VERSE DIVIDER
+	{runeRange{0x2E52, 0x2E54}, ScriptCommon},                  // Po   [3] TIRONIAN SIGN CAPITAL ET..MEDIEVAL QUESTION MARK
+	{runeRange{0x2E56, 0x2E56}, ScriptCommon},                  // Pe       RIGHT SQUARE BRACKET WITH STROKE
+	{runeRange{0x2E58, 0x2E58}, ScriptCommon},                  // Pe       RIGHT SQUARE BRACKET WITH DOUBLE STROKE
+	{runeRange{0x2E5A, 0x2E5A}, ScriptCommon},                  // Pe       TOP HALF RIGHT PARENTHESIS
+	{runeRange{0x2E5C, 0x2E5C}, ScriptCommon},                  // Pe       BOTTOM HALF RIGHT PARENTHESIS
+	{runeRange{0x2E80, 0x2E99}, ScriptHan},                     // So  [26] CJK RADICAL REPEAT..CJK RADICAL RAP
+	{runeRange{0x2F00, 0x2FD5}, ScriptHan},                     // So [214] KANGXI RADICAL ONE..KANGXI RADICAL FLUTE
+	{runeRange{0x3000, 0x3000}, ScriptCommon},                  // Zs       IDEOGRAPHIC SPACE
+	{runeRange{0x3004, 0x3004}, ScriptCommon},                  // So       JAPANESE INDUSTRIAL STANDARD SYMBOL
+	{runeRange{0x3006, 0x3006}, ScriptCommon},                  // Lo       IDEOGRAPHIC CLOSING MARK
+	{runeRange{0x3008, 0x3008}, ScriptCommon},                  // Ps       LEFT ANGLE BRACKET
+	{runeRange{0x300A, 0x300A}, ScriptCommon},                  // Ps       LEFT DOUBLE ANGLE BRACKET
+	{runeRange{0x300C, 0x300C}, ScriptCommon},                  // Ps       LEFT CORNER BRACKET
+	{runeRange{0x300E, 0x300E}, ScriptCommon},                  // Ps       LEFT WHITE CORNER BRACKET
+	{runeRange{0x3010, 0x3010}, ScriptCommon},                  // Ps       LEFT BLACK LENTICULAR BRACKET
+	{runeRange{0x3012, 0x3013}, ScriptCommon},                  // So   [2] POSTAL MARK..GETA MARK
+	{runeRange{0x3015, 0x3015}, ScriptCommon},                  // Pe       RIGHT TORTOISE SHELL BRACKET
+	{runeRange{0x3017, 0x3017}, ScriptCommon},                  // Pe       RIGHT WHITE LENTICULAR BRACKET
+	{runeRange{0x3019, 0x3019}, ScriptCommon},                  // Pe       RIGHT WHITE TORTOISE SHELL BRACKET
+	{runeRange{0x301B, 0x301B}, ScriptCommon},                  // Pe       RIGHT WHITE SQUARE BRACKET
+	{runeRange{0x301D, 0x301D}, ScriptCommon},                  // Ps       REVERSED DOUBLE PRIME QUOTATION MARK
+	{runeRange{0x3020, 0x3020}, ScriptCommon},                  // So       POSTAL MARK FACE
+	{runeRange{0x302A, 0x302D}, ScriptInherited},               // Mn   [4] IDEOGRAPHIC LEVEL TONE MARK..IDEOGRAPHIC ENTERING TONE MARK
+	{runeRange{0x3030, 0x3030}, ScriptCommon},                  // Pd       WAVY DASH
+	{runeRange{0x3036, 0x3037}, ScriptCommon},                  // So   [2] CIRCLED POSTAL MARK..IDEOGRAPHIC TELEGRAPH LINE FEED SEPARATOR SYMBOL
+	{runeRange{0x303B, 0x303B}, ScriptHan},                     // Lm       VERTICAL IDEOGRAPHIC ITERATION MARK
+	{runeRange{0x303D, 0x303D}, ScriptCommon},                  // Po       PART ALTERNATION MARK
+	{runeRange{0x3041, 0x3096}, ScriptHiragana},                // Lo  [86] HIRAGANA LETTER SMALL A..HIRAGANA LETTER SMALL KE
+	{runeRange{0x309B, 0x309C}, ScriptCommon},                  // Sk   [2] KATAKANA-HIRAGANA VOICED SOUND MARK..KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK
+	{runeRange{0x309F, 0x309F}, ScriptHiragana},                // Lo       HIRAGANA DIGRAPH YORI
+	{runeRange{0x30A1, 0x30FA}, ScriptKatakana},                // Lo  [90] KATAKANA LETTER SMALL A..KATAKANA LETTER VO
+	{runeRange{0x30FC, 0x30FC}, ScriptCommon},                  // Lm       KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	{runeRange{0x30FF, 0x30FF}, ScriptKatakana},                // Lo       KATAKANA DIGRAPH KOTO
+	{runeRange{0x3131, 0x318E}, ScriptHangul},                  // Lo  [94] HANGUL LETTER KIYEOK..HANGUL LETTER ARAEAE
+	{runeRange{0x3192, 0x3195}, ScriptCommon},                  // No   [4] IDEOGRAPHIC ANNOTATION ONE MARK..IDEOGRAPHIC ANNOTATION FOUR MARK
+	{runeRange{0x31A0, 0x31BF}, ScriptBopomofo},                // Lo  [32] BOPOMOFO LETTER BU..BOPOMOFO LETTER AH
+	{runeRange{0x31EF, 0x31EF}, ScriptCommon},                  // So       IDEOGRAPHIC DESCRIPTION CHARACTER SUBTRACTION
+	{runeRange{0x3200, 0x321E}, ScriptHangul},                  // So  [31] PARENTHESIZED HANGUL KIYEOK..PARENTHESIZED KOREAN CHARACTER O HU
+	{runeRange{0x322A, 0x3247}, ScriptCommon},                  // So  [30] PARENTHESIZED IDEOGRAPH MOON..CIRCLED IDEOGRAPH KOTO
+	{runeRange{0x3250, 0x3250}, ScriptCommon},                  // So       PARTNERSHIP SIGN
+	{runeRange{0x3260, 0x327E}, ScriptHangul},                  // So  [31] CIRCLED HANGUL KIYEOK..CIRCLED HANGUL IEUNG U
+	{runeRange{0x3280, 0x3289}, ScriptCommon},                  // No  [10] CIRCLED IDEOGRAPH ONE..CIRCLED IDEOGRAPH TEN
+	{runeRange{0x32B1, 0x32BF}, ScriptCommon},                  // No  [15] CIRCLED NUMBER THIRTY SIX..CIRCLED NUMBER FIFTY
+	{runeRange{0x32D0, 0x32FE}, ScriptKatakana},                // So  [47] CIRCLED KATAKANA A..CIRCLED KATAKANA WO
+	{runeRange{0x3300, 0x3357}, ScriptKatakana},                // So  [88] SQUARE APAATO..SQUARE WATTO
+	{runeRange{0x3400, 0x4DBF}, ScriptHan},                     // Lo [6592] CJK UNIFIED IDEOGRAPH-3400..CJK UNIFIED IDEOGRAPH-4DBF
+	{runeRange{0x4E00, 0x9FFF}, ScriptHan},                     // Lo [20992] CJK UNIFIED IDEOGRAPH-4E00..CJK UNIFIED IDEOGRAPH-9FFF
+	{runeRange{0xA015, 0xA015}, ScriptYi},                      // Lm       YI SYLLABLE WU
+	{runeRange{0xA490, 0xA4C6}, ScriptYi},                      // So  [55] YI RADICAL QOT..YI RADICAL KE
+	{runeRange{0xA4F8, 0xA4FD}, ScriptLisu},                    // Lm   [6] LISU LETTER TONE MYA TI..LISU LETTER TONE MYA JEU
+	{runeRange{0xA500, 0xA60B}, ScriptVai},                     // Lo [268] VAI SYLLABLE EE..VAI SYLLABLE NG
+	{runeRange{0xA60D, 0xA60F}, ScriptVai},                     // Po   [3] VAI COMMA..VAI QUESTION MARK
+	{runeRange{0xA620, 0xA629}, ScriptVai},                     // Nd  [10] VAI DIGIT ZERO..VAI DIGIT NINE
+	{runeRange{0xA640, 0xA640}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER ZEMLYA
+	{runeRange{0xA642, 0xA642}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER DZELO
+	{runeRange{0xA644, 0xA644}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER REVERSED DZE
+	{runeRange{0xA646, 0xA646}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER IOTA
+	{runeRange{0xA648, 0xA648}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER DJERV
+	{runeRange{0xA64A, 0xA64A}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER MONOGRAPH UK
+	{runeRange{0xA64C, 0xA64C}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER BROAD OMEGA
+	{runeRange{0xA64E, 0xA64E}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER NEUTRAL YER
+	{runeRange{0xA650, 0xA650}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER YERU WITH BACK YER
+	{runeRange{0xA652, 0xA652}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER IOTIFIED YAT
+	{runeRange{0xA654, 0xA654}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER REVERSED YU
+	{runeRange{0xA656, 0xA656}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER IOTIFIED A
+	{runeRange{0xA658, 0xA658}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER CLOSED LITTLE YUS
+	{runeRange{0xA65A, 0xA65A}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER BLENDED YUS
+	{runeRange{0xA65C, 0xA65C}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER IOTIFIED CLOSED LITTLE YUS
+	{runeRange{0xA65E, 0xA65E}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER YN
+	{runeRange{0xA660, 0xA660}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER REVERSED TSE
+	{runeRange{0xA662, 0xA662}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER SOFT DE
+	{runeRange{0xA664, 0xA664}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER SOFT EL
+	{runeRange{0xA666, 0xA666}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER SOFT EM
+	{runeRange{0xA668, 0xA668}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER MONOCULAR O
+	{runeRange{0xA66A, 0xA66A}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER BINOCULAR O
+	{runeRange{0xA66C, 0xA66C}, ScriptCyrillic},                // Lu       CYRILLIC CAPITAL LETTER DOUBLE MONOCULAR O
+	{runeRange{0xA66E, 0xA66E}, ScriptCyrillic},                // Lo       CYRILLIC LETTER MULTIOCULAR O
+	{runeRange{0xA670, 0xA672}, ScriptCyrillic},                // Me   [3] COMBINING CYRILLIC TEN MILLIONS SIGN..COMBINING CYRILLIC THOUSAND MILLIONS SIGN
+	{runeRange{0xA674, 0xA67D}, ScriptCyrillic},                // Mn  [10] COMBINING CYRILLIC LETTER UKRAINIAN IE..COMBINING CYRILLIC PAYEROK
+	{runeRange{0xA67F, 0xA67F}, ScriptCyrillic},                // Lm       CYRILLIC PAYEROK
+	{runeRange{0xA681, 0xA681}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER DWE
+	{runeRange{0xA683, 0xA683}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER DZWE
+	{runeRange{0xA685, 0xA685}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER ZHWE
+	{runeRange{0xA687, 0xA687}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER CCHE
+	{runeRange{0xA689, 0xA689}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER DZZE
+	{runeRange{0xA68B, 0xA68B}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER TE WITH MIDDLE HOOK
+	{runeRange{0xA68D, 0xA68D}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER TWE
+	{runeRange{0xA68F, 0xA68F}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER TSWE
+	{runeRange{0xA691, 0xA691}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER TSSE
+	{runeRange{0xA693, 0xA693}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER TCHE
+	{runeRange{0xA695, 0xA695}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER HWE
+	{runeRange{0xA697, 0xA697}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER SHWE
+	{runeRange{0xA699, 0xA699}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER DOUBLE O
+	{runeRange{0xA69B, 0xA69B}, ScriptCyrillic},                // Ll       CYRILLIC SMALL LETTER CROSSED O
+	{runeRange{0xA69E, 0xA69F}, ScriptCyrillic},                // Mn   [2] COMBINING CYRILLIC LETTER EF..COMBINING CYRILLIC LETTER IOTIFIED E
+	{runeRange{0xA6E6, 0xA6EF}, ScriptBamum},                   // Nl  [10] BAMUM LETTER MO..BAMUM LETTER KOGHOM
+	{runeRange{0xA6F2, 0xA6F7}, ScriptBamum},                   // Po   [6] BAMUM NJAEMLI..BAMUM QUESTION MARK
+	{runeRange{0xA717, 0xA71F}, ScriptCommon},                  // Lm   [9] MODIFIER LETTER DOT VERTICAL BAR..MODIFIER LETTER LOW INVERTED EXCLAMATION MARK
+	{runeRange{0xA722, 0xA722}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER EGYPTOLOGICAL ALEF
+	{runeRange{0xA724, 0xA724}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER EGYPTOLOGICAL AIN
+	{runeRange{0xA726, 0xA726}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER HENG
+	{runeRange{0xA728, 0xA728}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER TZ
+	{runeRange{0xA72A, 0xA72A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER TRESILLO
+	{runeRange{0xA72C, 0xA72C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER CUATRILLO
+	{runeRange{0xA72E, 0xA72E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER CUATRILLO WITH COMMA
+	{runeRange{0xA732, 0xA732}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER AA
+	{runeRange{0xA734, 0xA734}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER AO
+	{runeRange{0xA736, 0xA736}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER AU
+	{runeRange{0xA738, 0xA738}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER AV
+	{runeRange{0xA73A, 0xA73A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER AV WITH HORIZONTAL BAR
+	{runeRange{0xA73C, 0xA73C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER AY
+	{runeRange{0xA73E, 0xA73E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER REVERSED C WITH DOT
+	{runeRange{0xA740, 0xA740}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER K WITH STROKE
+	{runeRange{0xA742, 0xA742}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER K WITH DIAGONAL STROKE
+	{runeRange{0xA744, 0xA744}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER K WITH STROKE AND DIAGONAL STROKE
+	{runeRange{0xA746, 0xA746}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER BROKEN L
+	{runeRange{0xA748, 0xA748}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER L WITH HIGH STROKE
+	{runeRange{0xA74A, 0xA74A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH LONG STROKE OVERLAY
+	{runeRange{0xA74C, 0xA74C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER O WITH LOOP
+	{runeRange{0xA74E, 0xA74E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER OO
+	{runeRange{0xA750, 0xA750}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER P WITH STROKE THROUGH DESCENDER
+	{runeRange{0xA752, 0xA752}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER P WITH FLOURISH
+	{runeRange{0xA754, 0xA754}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER P WITH SQUIRREL TAIL
+	{runeRange{0xA756, 0xA756}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Q WITH STROKE THROUGH DESCENDER
+	{runeRange{0xA758, 0xA758}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER Q WITH DIAGONAL STROKE
+	{runeRange{0xA75A, 0xA75A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER R ROTUNDA
+	{runeRange{0xA75C, 0xA75C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER RUM ROTUNDA
+	{runeRange{0xA75E, 0xA75E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER V WITH DIAGONAL STROKE
+	{runeRange{0xA760, 0xA760}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER VY
+	{runeRange{0xA762, 0xA762}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER VISIGOTHIC Z
+	{runeRange{0xA764, 0xA764}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER THORN WITH STROKE
+	{runeRange{0xA766, 0xA766}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER THORN WITH STROKE THROUGH DESCENDER
+	{runeRange{0xA768, 0xA768}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER VEND
+	{runeRange{0xA76A, 0xA76A}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER ET
+	{runeRange{0xA76C, 0xA76C}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER IS
+	{runeRange{0xA76E, 0xA76E}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER CON
+	{runeRange{0xA770, 0xA770}, ScriptLatin},                   // Lm       MODIFIER LETTER US
+	{runeRange{0xA779, 0xA779}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER INSULAR D
+	{runeRange{0xA77B, 0xA77B}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER INSULAR F
+	{runeRange{0xA77D, 0xA77E}, ScriptLatin},                   // Lu   [2] LATIN CAPITAL LETTER INSULAR G..LATIN CAPITAL LETTER TURNED INSULAR G
+	{runeRange{0xA780, 0xA780}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER TURNED L
+	{runeRange{0xA782, 0xA782}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER INSULAR R
+	{runeRange{0xA784, 0xA784}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER INSULAR S
+	{runeRange{0xA786, 0xA786}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER INSULAR T
+	{runeRange{0xA788, 0xA788}, ScriptCommon},                  // Lm       MODIFIER LETTER LOW CIRCUMFLEX ACCENT
+	{runeRange{0xA78B, 0xA78B}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER SALTILLO
+	{runeRange{0xA78D, 0xA78D}, ScriptLatin},                   // Lu       LATIN CAPITAL LETTER TURNED H
+	{runeRange{0xA78F, 0xA78F}, ScriptLatin},                   // Lo       LATIN LETTER SINOLOGICAL DOT
+	{runeRange{0xA791, 0xA791}, ScriptLatin},                   // Ll       LATIN SMALL LETTER N WITH DESCENDER
+	{runeRange{0xA793, 0xA795}, ScriptLatin},                   // Ll   [3] LATIN SMALL LETTER C WITH BAR..LATIN SMALL LETTER H WITH PALATAL HOOK
+	{runeRange{0xA797, 0xA797}, ScriptLatin},                   // Ll       LATIN SMALL LETTER B WITH FLOURISH
+	{runeRange{0xA799, 0xA799}, ScriptLatin},                   // Ll       LATIN SMALL LETTER F WITH STROKE
+	{runeRange{0xA79B, 0xA79B}, ScriptLatin},                   // Ll       LATIN SMALL LETTER VOLAPUK AE
+	{runeRange{0xA79D, 0xA79D}, ScriptLatin},                   // Ll       LATIN SMALL LETTER VOLAPUK OE
+	{runeRange{0xA79F, 0xA79F}, ScriptLatin},                   // Ll       LATIN SMALL LETTER VOLAPUK UE
+	{runeRange{0xA7A1, 0xA7A1}, ScriptLatin},                   // Ll       LATIN SMALL LETTER G WITH OBLIQUE STROKE
+	{runeRange{0xA7A3, 0xA7A3}, ScriptLatin},                   // Ll       LATIN SMALL LETTER K WITH OBLIQUE STROKE
+	{runeRange{0xA7A5, 0xA7A5}, ScriptLatin},                   // Ll       LATIN SMALL LETTER N WITH OBLIQUE STROKE
+	{runeRange{0xA7A7, 0xA7A7}, ScriptLatin},                   // Ll       LATIN SMALL LETTER R WITH OBLIQUE STROKE
+	{runeRange{0xA7A9, 0xA7A9}, ScriptLatin},                   // Ll       LATIN SMALL LETTER S WITH OBLIQUE STROKE
+	{runeRange{0xA7AF, 0xA7AF}, ScriptLatin},                   // Ll       LATIN LETTER SMALL CAPITAL Q
+	{runeRange{0xA7B5, 0xA7B5}, ScriptLatin},                   // Ll       LATIN SMALL LETTER BETA
+	{runeRange{0xA7B7, 0xA7B7}, ScriptLatin},                   // Ll       LATIN SMALL LETTER OMEGA
+	{runeRange{0xA7B9, 0xA7B9}, ScriptLatin},                   // Ll       LATIN SMALL LETTER U WITH STROKE
+	{runeRange{0xA7BB, 0xA7BB}, ScriptLatin},                   // Ll       LATIN SMALL LETTER GLOTTAL A
+	{runeRange{0xA7BD, 0xA7BD}, ScriptLatin},                   // Ll       LATIN SMALL LETTER GLOTTAL I
+	{runeRange{0xA7BF, 0xA7BF}, ScriptLatin},                   // Ll       LATIN SMALL LETTER GLOTTAL U
+	{runeRange{0xA7C1, 0xA7C1}, ScriptLatin},                   // Ll       LATIN SMALL LETTER OLD POLISH O
+	{runeRange{0xA7C3, 0xA7C3}, ScriptLatin},                   // Ll       LATIN SMALL LETTER ANGLICANA W
+	{runeRange{0xA7C8, 0xA7C8}, ScriptLatin},                   // Ll       LATIN SMALL LETTER D WITH SHORT STROKE OVERLAY
+	{runeRange{0xA7CA, 0xA7CA}, ScriptLatin},                   // Ll       LATIN SMALL LETTER S WITH SHORT STROKE OVERLAY
+	{runeRange{0xA7CD, 0xA7CD}, ScriptLatin},                   // Ll       LATIN SMALL LETTER S WITH DIAGONAL STROKE
+	{runeRange{0xA7CF, 0xA7CF}, ScriptLatin},                   // Ll       LATIN SMALL LETTER PHARYNGEAL VOICED FRICATIVE
+	{runeRange{0xA7D1, 0xA7D1}, ScriptLatin},                   // Ll       LATIN SMALL LETTER CLOSED INSULAR G
+	{runeRange{0xA7D3, 0xA7D3}, ScriptLatin},                   // Ll       LATIN SMALL LETTER DOUBLE THORN
+	{runeRange{0xA7D5, 0xA7D5}, ScriptLatin},                   // Ll       LATIN SMALL LETTER DOUBLE WYNN
+	{runeRange{0xA7D7, 0xA7D7}, ScriptLatin},                   // Ll       LATIN SMALL LETTER MIDDLE SCOTS S
+	{runeRange{0xA7D9, 0xA7D9}, ScriptLatin},                   // Ll       LATIN SMALL LETTER SIGMOID S
+	{runeRange{0xA7DB, 0xA7DB}, ScriptLatin},                   // Ll       LATIN SMALL LETTER LAMBDA
+	{runeRange{0xA7F1, 0xA7F4}, ScriptLatin},                   // Lm   [4] MODIFIER LETTER CAPITAL S..MODIFIER LETTER CAPITAL Q
+	{runeRange{0xA7F6, 0xA7F6}, ScriptLatin},                   // Ll       LATIN SMALL LETTER REVERSED HALF H
+	{runeRange{0xA7F8, 0xA7F9}, ScriptLatin},                   // Lm   [2] MODIFIER LETTER CAPITAL H WITH STROKE..MODIFIER LETTER SMALL LIGATURE OE
+	{runeRange{0xA7FB, 0xA7FF}, ScriptLatin},                   // Lo   [5] LATIN EPIGRAPHIC LETTER REVERSED F..LATIN EPIGRAPHIC LETTER ARCHAIC M
+	{runeRange{0xA802, 0xA802}, ScriptSylotiNagri},             // Mn       SYLOTI NAGRI SIGN DVISVARA
+	{runeRange{0xA806, 0xA806}, ScriptSylotiNagri},             // Mn       SYLOTI NAGRI SIGN HASANTA
+	{runeRange{0xA80B, 0xA80B}, ScriptSylotiNagri},             // Mn       SYLOTI NAGRI SIGN ANUSVARA
+	{runeRange{0xA823, 0xA824}, ScriptSylotiNagri},             // Mc   [2] SYLOTI NAGRI VOWEL SIGN A..SYLOTI NAGRI VOWEL SIGN I
+	{runeRange{0xA827, 0xA827}, ScriptSylotiNagri},             // Mc       SYLOTI NAGRI VOWEL SIGN OO
+	{runeRange{0xA82C, 0xA82C}, ScriptSylotiNagri},             // Mn       SYLOTI NAGRI SIGN ALTERNATE HASANTA
+	{runeRange{0xA836, 0xA837}, ScriptCommon},                  // So   [2] NORTH INDIC QUARTER MARK..NORTH INDIC PLACEHOLDER MARK
+	{runeRange{0xA839, 0xA839}, ScriptCommon},                  // So       NORTH INDIC QUANTITY MARK
+	{runeRange{0xA874, 0xA877}, ScriptPhagsPa},                 // Po   [4] PHAGS-PA SINGLE HEAD MARK..PHAGS-PA MARK DOUBLE SHAD
+	{runeRange{0xA882, 0xA8B3}, ScriptSaurashtra},              // Lo  [50] SAURASHTRA LETTER A..SAURASHTRA LETTER LLA
+	{runeRange{0xA8C4, 0xA8C5}, ScriptSaurashtra},              // Mn   [2] SAURASHTRA SIGN VIRAMA..SAURASHTRA SIGN CANDRABINDU
+	{runeRange{0xA8D0, 0xA8D9}, ScriptSaurashtra},              // Nd  [10] SAURASHTRA DIGIT ZERO..SAURASHTRA DIGIT NINE
+	{runeRange{0xA8F2, 0xA8F7}, ScriptDevanagari},              // Lo   [6] DEVANAGARI SIGN SPACING CANDRABINDU..DEVANAGARI SIGN CANDRABINDU AVAGRAHA
+	{runeRange{0xA8FB, 0xA8FB}, ScriptDevanagari},              // Lo       DEVANAGARI HEADSTROKE
+	{runeRange{0xA8FD, 0xA8FE}, ScriptDevanagari},              // Lo   [2] DEVANAGARI JAIN OM..DEVANAGARI LETTER AY
+	{runeRange{0xA900, 0xA909}, ScriptKayahLi},                 // Nd  [10] KAYAH LI DIGIT ZERO..KAYAH LI DIGIT NINE
+	{runeRange{0xA926, 0xA92D}, ScriptKayahLi},                 // Mn   [8] KAYAH LI VOWEL UE..KAYAH LI TONE CALYA PLOPHU
+	{runeRange{0xA92F, 0xA92F}, ScriptKayahLi},                 // Po       KAYAH LI SIGN SHYA
+	{runeRange{0xA947, 0xA951}, ScriptRejang},                  // Mn  [11] REJANG VOWEL SIGN I..REJANG CONSONANT SIGN R
+	{runeRange{0xA95F, 0xA95F}, ScriptRejang},                  // Po       REJANG SECTION MARK
+	{runeRange{0xA980, 0xA982}, ScriptJavanese},                // Mn   [3] JAVANESE SIGN PANYANGGA..JAVANESE SIGN LAYAR
+	{runeRange{0xA984, 0xA9B2}, ScriptJavanese},                // Lo  [47] JAVANESE LETTER A..JAVANESE LETTER HA
+	{runeRange{0xA9B4, 0xA9B5}, ScriptJavanese},                // Mc   [2] JAVANESE VOWEL SIGN TARUNG..JAVANESE VOWEL SIGN TOLONG
+	{runeRange{0xA9BA, 0xA9BB}, ScriptJavanese},                // Mc   [2] JAVANESE VOWEL SIGN TALING..JAVANESE VOWEL SIGN DIRGA MURE
+	{runeRange{0xA9BE, 0xA9C0}, ScriptJavanese},                // Mc   [3] JAVANESE CONSONANT SIGN PENGKAL..JAVANESE PANGKON
+	{runeRange{0xA9CF, 0xA9CF}, ScriptCommon},                  // Lm       JAVANESE PANGRANGKEP
+	{runeRange{0xA9DE, 0xA9DF}, ScriptJavanese},                // Po   [2] JAVANESE PADA TIRTA TUMETES..JAVANESE PADA ISEN-ISEN
+	{runeRange{0xA9E5, 0xA9E5}, ScriptMyanmar},                 // Mn       MYANMAR SIGN SHAN SAW
+	{runeRange{0xA9E7, 0xA9EF}, ScriptMyanmar},                 // Lo   [9] MYANMAR LETTER TAI LAING NYA..MYANMAR LETTER TAI LAING NNA
+	{runeRange{0xA9FA, 0xA9FE}, ScriptMyanmar},                 // Lo   [5] MYANMAR LETTER TAI LAING LLA..MYANMAR LETTER TAI LAING BHA
+	{runeRange{0xAA29, 0xAA2E}, ScriptCham},                    // Mn   [6] CHAM VOWEL SIGN AA..CHAM VOWEL SIGN OE
+	{runeRange{0xAA31, 0xAA32}, ScriptCham},                    // Mn   [2] CHAM VOWEL SIGN AU..CHAM VOWEL SIGN UE
+	{runeRange{0xAA35, 0xAA36}, ScriptCham},                    // Mn   [2] CHAM CONSONANT SIGN LA..CHAM CONSONANT SIGN WA
+	{runeRange{0xAA43, 0xAA43}, ScriptCham},                    // Mn       CHAM CONSONANT SIGN FINAL NG
+	{runeRange{0xAA4C, 0xAA4C}, ScriptCham},                    // Mn       CHAM CONSONANT SIGN FINAL M
+	{runeRange{0xAA50, 0xAA59}, ScriptCham},                    // Nd  [10] CHAM DIGIT ZERO..CHAM DIGIT NINE
+	{runeRange{0xAA60, 0xAA6F}, ScriptMyanmar},                 // Lo  [16] MYANMAR LETTER KHAMTI GA..MYANMAR LETTER KHAMTI FA
+	{runeRange{0xAA71, 0xAA76}, ScriptMyanmar},                 // Lo   [6] MYANMAR LETTER KHAMTI XA..MYANMAR LOGOGRAM KHAMTI HM
+	{runeRange{0xAA7A, 0xAA7A}, ScriptMyanmar},                 // Lo       MYANMAR LETTER AITON RA
+	{runeRange{0xAA7C, 0xAA7C}, ScriptMyanmar},                 // Mn       MYANMAR SIGN TAI LAING TONE-2
+	{runeRange{0xAA7E, 0xAA7F}, ScriptMyanmar},                 // Lo   [2] MYANMAR LETTER SHWE PALAUNG CHA..MYANMAR LETTER SHWE PALAUNG SHA
+	{runeRange{0xAAB0, 0xAAB0}, ScriptTaiViet},                 // Mn       TAI VIET MAI KANG
+	{runeRange{0xAAB2, 0xAAB4}, ScriptTaiViet},                 // Mn   [3] TAI VIET VOWEL I..TAI VIET VOWEL U
+	{runeRange{0xAAB7, 0xAAB8}, ScriptTaiViet},                 // Mn   [2] TAI VIET MAI KHIT..TAI VIET VOWEL IA
+	{runeRange{0xAABE, 0xAABF}, ScriptTaiViet},                 // Mn   [2] TAI VIET VOWEL AM..TAI VIET TONE MAI EK
+	{runeRange{0xAAC1, 0xAAC1}, ScriptTaiViet},                 // Mn       TAI VIET TONE MAI THO
+	{runeRange{0xAADB, 0xAADC}, ScriptTaiViet},                 // Lo   [2] TAI VIET SYMBOL KON..TAI VIET SYMBOL NUENG
+	{runeRange{0xAADE, 0xAADF}, ScriptTaiViet},                 // Po   [2] TAI VIET SYMBOL HO HOI..TAI VIET SYMBOL KOI KOI
+	{runeRange{0xAAEB, 0xAAEB}, ScriptMeeteiMayek},             // Mc       MEETEI MAYEK VOWEL SIGN II
+	{runeRange{0xAAEE, 0xAAEF}, ScriptMeeteiMayek},             // Mc   [2] MEETEI MAYEK VOWEL SIGN AU..MEETEI MAYEK VOWEL SIGN AAU
+	{runeRange{0xAAF2, 0xAAF2}, ScriptMeeteiMayek},             // Lo       MEETEI MAYEK ANJI
+	{runeRange{0xAAF5, 0xAAF5}, ScriptMeeteiMayek},             // Mc       MEETEI MAYEK VOWEL SIGN VISARGA
+	{runeRange{0xAB01, 0xAB06}, ScriptEthiopic},                // Lo   [6] ETHIOPIC SYLLABLE TTHU..ETHIOPIC SYLLABLE TTHO
+	{runeRange{0xAB11, 0xAB16}, ScriptEthiopic},                // Lo   [6] ETHIOPIC SYLLABLE DZU..ETHIOPIC SYLLABLE DZO
+	{runeRange{0xAB28, 0xAB2E}, ScriptEthiopic},                // Lo   [7] ETHIOPIC SYLLABLE BBA..ETHIOPIC SYLLABLE BBO
+	{runeRange{0xAB5B, 0xAB5B}, ScriptCommon},                  // Sk       MODIFIER BREVE WITH INVERTED BREVE
+	{runeRange{0xAB60, 0xAB64}, ScriptLatin},                   // Ll   [5] LATIN SMALL LETTER SAKHA YAT..LATIN SMALL LETTER INVERTED ALPHA
+	{runeRange{0xAB66, 0xAB68}, ScriptLatin},                   // Ll   [3] LATIN SMALL LETTER DZ DIGRAPH WITH RETROFLEX HOOK..LATIN SMALL LETTER TURNED R WITH MIDDLE TILDE
+	{runeRange{0xAB6A, 0xAB6B}, ScriptCommon},                  // Sk   [2] MODIFIER LETTER LEFT TACK..MODIFIER LETTER RIGHT TACK
+	{runeRange{0xABC0, 0xABE2}, ScriptMeeteiMayek},             // Lo  [35] MEETEI MAYEK LETTER KOK..MEETEI MAYEK LETTER I LONSUM
+	{runeRange{0xABE5, 0xABE5}, ScriptMeeteiMayek},             // Mn       MEETEI MAYEK VOWEL SIGN ANAP
+	{runeRange{0xABE8, 0xABE8}, ScriptMeeteiMayek},             // Mn       MEETEI MAYEK VOWEL SIGN UNAP
+	{runeRange{0xABEB, 0xABEB}, ScriptMeeteiMayek},             // Po       MEETEI MAYEK CHEIKHEI
+	{runeRange{0xABED, 0xABED}, ScriptMeeteiMayek},             // Mn       MEETEI MAYEK APUN IYEK
+	{runeRange{0xAC00, 0xD7A3}, ScriptHangul},                  // Lo [11172] HANGUL SYLLABLE GA..HANGUL SYLLABLE HIH
+	{runeRange{0xD7CB, 0xD7FB}, ScriptHangul},                  // Lo  [49] HANGUL JONGSEONG NIEUN-RIEUL..HANGUL JONGSEONG PHIEUPH-THIEUTH
+	{runeRange{0xFA70, 0xFAD9}, ScriptHan},                     // Lo [106] CJK COMPATIBILITY IDEOGRAPH-FA70..CJK COMPATIBILITY IDEOGRAPH-FAD9
+	{runeRange{0xFB13, 0xFB17}, ScriptArmenian},                // Ll   [5] ARMENIAN SMALL LIGATURE MEN NOW..ARMENIAN SMALL LIGATURE MEN XEH
+	{runeRange{0xFB1E, 0xFB1E}, ScriptHebrew},                  // Mn       HEBREW POINT JUDEO-SPANISH VARIKA
+	{runeRange{0xFB29, 0xFB29}, ScriptHebrew},                  // Sm       HEBREW LETTER ALTERNATIVE PLUS SIGN
+	{runeRange{0xFB38, 0xFB3C}, ScriptHebrew},                  // Lo   [5] HEBREW LETTER TET WITH DAGESH..HEBREW LETTER LAMED WITH DAGESH
+	{runeRange{0xFB40, 0xFB41}, ScriptHebrew},                  // Lo   [2] HEBREW LETTER NUN WITH DAGESH..HEBREW LETTER SAMEKH WITH DAGESH
+	{runeRange{0xFB46, 0xFB4F}, ScriptHebrew},                  // Lo  [10] HEBREW LETTER TSADI WITH DAGESH..HEBREW LIGATURE ALEF LAMED
+	{runeRange{0xFBB2, 0xFBC2}, ScriptArabic},                  // Sk  [17] ARABIC SYMBOL DOT ABOVE..ARABIC SYMBOL WASLA ABOVE
+	{runeRange{0xFBD3, 0xFD3D}, ScriptArabic},                  // Lo [363] ARABIC LETTER NG ISOLATED FORM..ARABIC LIGATURE ALEF WITH FATHATAN ISOLATED FORM
+	{runeRange{0xFD3F, 0xFD3F}, ScriptCommon},                  // Ps       ORNATE RIGHT PARENTHESIS
+	{runeRange{0xFD50, 0xFD8F}, ScriptArabic},                  // Lo  [64] ARABIC LIGATURE TEH WITH JEEM WITH MEEM INITIAL FORM..ARABIC LIGATURE MEEM WITH KHAH WITH MEEM INITIAL FORM
+	{runeRange{0xFD92, 0xFDC7}, ScriptArabic},                  // Lo  [54] ARABIC LIGATURE MEEM WITH JEEM WITH KHAH INITIAL FORM..ARABIC LIGATURE NOON WITH JEEM WITH YEH FINAL FORM
+	{runeRange{0xFDF0, 0xFDFB}, ScriptArabic},                  // Lo  [12] ARABIC LIGATURE SALLA USED AS KORANIC STOP SIGN ISOLATED FORM..ARABIC LIGATURE JALLAJALALOUHOU
+	{runeRange{0xFDFD, 0xFDFF}, ScriptArabic},                  // So   [3] ARABIC LIGATURE BISMILLAH AR-RAHMAN AR-RAHEEM..ARABIC LIGATURE AZZA WA JALL
+	{runeRange{0xFE10, 0xFE16}, ScriptCommon},                  // Po   [7] PRESENTATION FORM FOR VERTICAL COMMA..PRESENTATION FORM FOR VERTICAL QUESTION MARK
+	{runeRange{0xFE18, 0xFE18}, ScriptCommon},                  // Pe       PRESENTATION FORM FOR VERTICAL RIGHT WHITE LENTICULAR BRAKCET
+	{runeRange{0xFE20, 0xFE2D}, ScriptInherited},               // Mn  [14] COMBINING LIGATURE LEFT HALF..COMBINING CONJOINING MACRON BELOW
+	{runeRange{0xFE30, 0xFE30}, ScriptCommon},                  // Po       PRESENTATION FORM FOR VERTICAL TWO DOT LEADER
+	{runeRange{0xFE33, 0xFE34}, ScriptCommon},                  // Pc   [2] PRESENTATION FORM FOR VERTICAL LOW LINE..PRESENTATION FORM FOR VERTICAL WAVY LOW LINE
+	{runeRange{0xFE36, 0xFE36}, ScriptCommon},                  // Pe       PRESENTATION FORM FOR VERTICAL RIGHT PARENTHESIS
+	{runeRange{0xFE38, 0xFE38}, ScriptCommon},                  // Pe       PRESENTATION FORM FOR VERTICAL RIGHT CURLY BRACKET
+	{runeRange{0xFE3A, 0xFE3A}, ScriptCommon},                  // Pe       PRESENTATION FORM FOR VERTICAL RIGHT TORTOISE SHELL BRACKET
+	{runeRange{0xFE3C, 0xFE3C}, ScriptCommon},                  // Pe       PRESENTATION FORM FOR VERTICAL RIGHT BLACK LENTICULAR BRACKET
+	{runeRange{0xFE3E, 0xFE3E}, ScriptCommon},                  // Pe       PRESENTATION FORM FOR VERTICAL RIGHT DOUBLE ANGLE BRACKET
+	{runeRange{0xFE40, 0xFE40}, ScriptCommon},                  // Pe       PRESENTATION FORM FOR VERTICAL RIGHT ANGLE BRACKET
+	{runeRange{0xFE42, 0xFE42}, ScriptCommon},                  // Pe       PRESENTATION FORM FOR VERTICAL RIGHT CORNER BRACKET
+	{runeRange{0xFE44, 0xFE44}, ScriptCommon},                  // Pe       PRESENTATION FORM FOR VERTICAL RIGHT WHITE CORNER BRACKET
+	{runeRange{0xFE47, 0xFE47}, ScriptCommon},                  // Ps       PRESENTATION FORM FOR VERTICAL LEFT SQUARE BRACKET
+	{runeRange{0xFE49, 0xFE4C}, ScriptCommon},                  // Po   [4] DASHED OVERLINE..DOUBLE WAVY OVERLINE
+	{runeRange{0xFE50, 0xFE52}, ScriptCommon},                  // Po   [3] SMALL COMMA..SMALL FULL STOP
+	{runeRange{0xFE58, 0xFE58}, ScriptCommon},                  // Pd       SMALL EM DASH
+	{runeRange{0xFE5A, 0xFE5A}, ScriptCommon},                  // Pe       SMALL RIGHT PARENTHESIS
+	{runeRange{0xFE5C, 0xFE5C}, ScriptCommon},                  // Pe       SMALL RIGHT CURLY BRACKET
+	{runeRange{0xFE5E, 0xFE5E}, ScriptCommon},                  // Pe       SMALL RIGHT TORTOISE SHELL BRACKET
+	{runeRange{0xFE62, 0xFE62}, ScriptCommon},                  // Sm       SMALL PLUS SIGN
+	{runeRange{0xFE64, 0xFE66}, ScriptCommon},                  // Sm   [3] SMALL LESS-THAN SIGN..SMALL EQUALS SIGN
+	{runeRange{0xFE69, 0xFE69}, ScriptCommon},                  // Sc       SMALL DOLLAR SIGN
+	{runeRange{0xFE70, 0xFE74}, ScriptArabic},                  // Lo   [5] ARABIC FATHATAN ISOLATED FORM..ARABIC KASRATAN ISOLATED FORM
+	{runeRange{0xFEFF, 0xFEFF}, ScriptCommon},                  // Cf       ZERO WIDTH NO-BREAK SPACE
+	{runeRange{0xFF04, 0xFF04}, ScriptCommon},                  // Sc       FULLWIDTH DOLLAR SIGN
+	{runeRange{0xFF08, 0xFF08}, ScriptCommon},                  // Ps       FULLWIDTH LEFT PARENTHESIS
+	{runeRange{0xFF0A, 0xFF0A}, ScriptCommon},                  // Po       FULLWIDTH ASTERISK
+	{runeRange{0xFF0C, 0xFF0C}, ScriptCommon},                  // Po       FULLWIDTH COMMA
+	{runeRange{0xFF0E, 0xFF0F}, ScriptCommon},                  // Po   [2] FULLWIDTH FULL STOP..FULLWIDTH SOLIDUS
+	{runeRange{0xFF1A, 0xFF1B}, ScriptCommon},                  // Po   [2] FULLWIDTH COLON..FULLWIDTH SEMICOLON
+	{runeRange{0xFF1F, 0xFF20}, ScriptCommon},                  // Po   [2] FULLWIDTH QUESTION MARK..FULLWIDTH COMMERCIAL AT
+	{runeRange{0xFF3B, 0xFF3B}, ScriptCommon},                  // Ps       FULLWIDTH LEFT SQUARE BRACKET
+	{runeRange{0xFF3D, 0xFF3D}, ScriptCommon},                  // Pe       FULLWIDTH RIGHT SQUARE BRACKET
+	{runeRange{0xFF3F, 0xFF3F}, ScriptCommon},                  // Pc       FULLWIDTH LOW LINE
+	{runeRange{0xFF41, 0xFF5A}, ScriptLatin},                   // Ll  [26] FULLWIDTH LATIN SMALL LETTER A..FULLWIDTH LATIN SMALL LETTER Z
+	{runeRange{0xFF5C, 0xFF5C}, ScriptCommon},                  // Sm       FULLWIDTH VERTICAL LINE
+	{runeRange{0xFF5E, 0xFF5E}, ScriptCommon},                  // Sm       FULLWIDTH TILDE
+	{runeRange{0xFF60, 0xFF60}, ScriptCommon},                  // Pe       FULLWIDTH RIGHT WHITE PARENTHESIS
+	{runeRange{0xFF62, 0xFF62}, ScriptCommon},                  // Ps       HALFWIDTH LEFT CORNER BRACKET
+	{runeRange{0xFF64, 0xFF65}, ScriptCommon},                  // Po   [2] HALFWIDTH IDEOGRAPHIC COMMA..HALFWIDTH KATAKANA MIDDLE DOT
+	{runeRange{0xFF70, 0xFF70}, ScriptCommon},                  // Lm       HALFWIDTH KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	{runeRange{0xFF9E, 0xFF9F}, ScriptCommon},                  // Lm   [2] HALFWIDTH KATAKANA VOICED SOUND MARK..HALFWIDTH KATAKANA SEMI-VOICED SOUND MARK
+	{runeRange{0xFFC2, 0xFFC7}, ScriptHangul},                  // Lo   [6] HALFWIDTH HANGUL LETTER A..HALFWIDTH HANGUL LETTER E
+	{runeRange{0xFFD2, 0xFFD7}, ScriptHangul},                  // Lo   [6] HALFWIDTH HANGUL LETTER YO..HALFWIDTH HANGUL LETTER YU
+	{runeRange{0xFFE0, 0xFFE1}, ScriptCommon},                  // Sc   [2] FULLWIDTH CENT SIGN..FULLWIDTH POUND SIGN
+	{runeRange{0xFFE3, 0xFFE3}, ScriptCommon},                  // Sk       FULLWIDTH MACRON
+	{runeRange{0xFFE5, 0xFFE6}, ScriptCommon},                  // Sc   [2] FULLWIDTH YEN SIGN..FULLWIDTH WON SIGN
+	{runeRange{0xFFE9, 0xFFEC}, ScriptCommon},                  // Sm   [4] HALFWIDTH LEFTWARDS ARROW..HALFWIDTH DOWNWARDS ARROW
+	{runeRange{0xFFF9, 0xFFFB}, ScriptCommon},                  // Cf   [3] INTERLINEAR ANNOTATION ANCHOR..INTERLINEAR ANNOTATION TERMINATOR
+	{runeRange{0x10000, 0x1000B}, ScriptLinearB},               // Lo  [12] LINEAR B SYLLABLE B008 A..LINEAR B SYLLABLE B046 JE
+	{runeRange{0x10028, 0x1003A}, ScriptLinearB},               // Lo  [19] LINEAR B SYLLABLE B060 RA..LINEAR B SYLLABLE B042 WO
+	{runeRange{0x1003F, 0x1004D}, ScriptLinearB},               // Lo  [15] LINEAR B SYLLABLE B020 ZO..LINEAR B SYLLABLE B091 TWO
+	{runeRange{0x10080, 0x100FA}, ScriptLinearB},               // Lo [123] LINEAR B IDEOGRAM B100 MAN..LINEAR B IDEOGRAM VESSEL B305
+	{runeRange{0x10107, 0x10133}, ScriptCommon},                // No  [45] AEGEAN NUMBER ONE..AEGEAN NUMBER NINETY THOUSAND
+	{runeRange{0x10140, 0x10174}, ScriptGreek},                 // Nl  [53] GREEK ACROPHONIC ATTIC ONE QUARTER..GREEK ACROPHONIC STRATIAN FIFTY MNAS
+	{runeRange{0x10179, 0x10189}, ScriptGreek},                 // So  [17] GREEK YEAR SIGN..GREEK TRYBLION BASE SIGN
+	{runeRange{0x1018C, 0x1018E}, ScriptGreek},                 // So   [3] GREEK SINUSOID SIGN..NOMISMA SIGN
+	{runeRange{0x101A0, 0x101A0}, ScriptGreek},                 // So       GREEK SYMBOL TAU RHO
+	{runeRange{0x101FD, 0x101FD}, ScriptInherited},             // Mn       PHAISTOS DISC SIGN COMBINING OBLIQUE STROKE
+	{runeRange{0x102A0, 0x102D0}, ScriptCarian},                // Lo  [49] CARIAN LETTER A..CARIAN LETTER UUU3
+	{runeRange{0x102E1, 0x102FB}, ScriptCommon},                // No  [27] COPTIC EPACT DIGIT ONE..COPTIC EPACT NUMBER NINE HUNDRED
+	{runeRange{0x10320, 0x10323}, ScriptOldItalic},             // No   [4] OLD ITALIC NUMERAL ONE..OLD ITALIC NUMERAL FIFTY
+	{runeRange{0x10330, 0x10340}, ScriptGothic},                // Lo  [17] GOTHIC LETTER AHSA..GOTHIC LETTER PAIRTHRA
+	{runeRange{0x10342, 0x10349}, ScriptGothic},                // Lo   [8] GOTHIC LETTER RAIDA..GOTHIC LETTER OTHAL
+	{runeRange{0x10350, 0x10375}, ScriptOldPermic},             // Lo  [38] OLD PERMIC LETTER AN..OLD PERMIC LETTER IA
+	{runeRange{0x10380, 0x1039D}, ScriptUgaritic},              // Lo  [30] UGARITIC LETTER ALPA..UGARITIC LETTER SSU
+	{runeRange{0x103A0, 0x103C3}, ScriptOldPersian},            // Lo  [36] OLD PERSIAN SIGN A..OLD PERSIAN SIGN HA
+	{runeRange{0x103D0, 0x103D0}, ScriptOldPersian},            // Po       OLD PERSIAN WORD DIVIDER
+	{runeRange{0x10400, 0x10427}, ScriptDeseret},               // Lu  [40] DESERET CAPITAL LETTER LONG I..DESERET CAPITAL LETTER EW
+	{runeRange{0x10450, 0x1047F}, ScriptShavian},               // Lo  [48] SHAVIAN LETTER PEEP..SHAVIAN LETTER YEW
+	{runeRange{0x104A0, 0x104A9}, ScriptOsmanya},               // Nd  [10] OSMANYA DIGIT ZERO..OSMANYA DIGIT NINE
+	{runeRange{0x104D8, 0x104FB}, ScriptOsage},                 // Ll  [36] OSAGE SMALL LETTER A..OSAGE SMALL LETTER ZHA
+	{runeRange{0x10530, 0x10563}, ScriptCaucasianAlbanian},     // Lo  [52] CAUCASIAN ALBANIAN LETTER ALT..CAUCASIAN ALBANIAN LETTER KIW
+	{runeRange{0x10570, 0x1057A}, ScriptVithkuqi},              // Lu  [11] VITHKUQI CAPITAL LETTER A..VITHKUQI CAPITAL LETTER GA
+	{runeRange{0x1058C, 0x10592}, ScriptVithkuqi},              // Lu   [7] VITHKUQI CAPITAL LETTER SE..VITHKUQI CAPITAL LETTER XE
+	{runeRange{0x10597, 0x105A1}, ScriptVithkuqi},              // Ll  [11] VITHKUQI SMALL LETTER A..VITHKUQI SMALL LETTER GA
+	{runeRange{0x105B3, 0x105B9}, ScriptVithkuqi},              // Ll   [7] VITHKUQI SMALL LETTER SE..VITHKUQI SMALL LETTER XE
+	{runeRange{0x105C0, 0x105F3}, ScriptTodhri},                // Lo  [52] TODHRI LETTER A..TODHRI LETTER OO
+	{runeRange{0x10740, 0x10755}, ScriptLinearA},               // Lo  [22] LINEAR A SIGN A701 A..LINEAR A SIGN A732 JE
+	{runeRange{0x10780, 0x10785}, ScriptLatin},                 // Lm   [6] MODIFIER LETTER SMALL CAPITAL AA..MODIFIER LETTER SMALL B WITH HOOK
+	{runeRange{0x107B2, 0x107BA}, ScriptLatin},                 // Lm   [9] MODIFIER LETTER SMALL CAPITAL Y..MODIFIER LETTER SMALL S WITH CURL
+	{runeRange{0x10808, 0x10808}, ScriptCypriot},               // Lo       CYPRIOT SYLLABLE JO
+	{runeRange{0x10837, 0x10838}, ScriptCypriot},               // Lo   [2] CYPRIOT SYLLABLE XA..CYPRIOT SYLLABLE XE
+	{runeRange{0x1083F, 0x1083F}, ScriptCypriot},               // Lo       CYPRIOT SYLLABLE ZO
+	{runeRange{0x10857, 0x10857}, ScriptImperialAramaic},       // Po       IMPERIAL ARAMAIC SECTION SIGN
+	{runeRange{0x10860, 0x10876}, ScriptPalmyrene},             // Lo  [23] PALMYRENE LETTER ALEPH..PALMYRENE LETTER TAW
+	{runeRange{0x10879, 0x1087F}, ScriptPalmyrene},             // No   [7] PALMYRENE NUMBER ONE..PALMYRENE NUMBER TWENTY
+	{runeRange{0x108A7, 0x108AF}, ScriptNabataean},             // No   [9] NABATAEAN NUMBER ONE..NABATAEAN NUMBER ONE HUNDRED
+	{runeRange{0x108F4, 0x108F5}, ScriptHatran},                // Lo   [2] HATRAN LETTER SHIN..HATRAN LETTER TAW
+	{runeRange{0x10900, 0x10915}, ScriptPhoenician},            // Lo  [22] PHOENICIAN LETTER ALF..PHOENICIAN LETTER TAU
+	{runeRange{0x1091F, 0x1091F}, ScriptPhoenician},            // Po       PHOENICIAN WORD SEPARATOR
+	{runeRange{0x1093F, 0x1093F}, ScriptLydian},                // Po       LYDIAN TRIANGULAR MARK
+	{runeRange{0x10980, 0x1099F}, ScriptMeroiticHieroglyphs},   // Lo  [32] MEROITIC HIEROGLYPHIC LETTER A..MEROITIC HIEROGLYPHIC SYMBOL VIDJ-2
+	{runeRange{0x109BC, 0x109BD}, ScriptMeroiticCursive},       // No   [2] MEROITIC CURSIVE FRACTION ELEVEN TWELFTHS..MEROITIC CURSIVE FRACTION ONE HALF
+	{runeRange{0x109C0, 0x109CF}, ScriptMeroiticCursive},       // No  [16] MEROITIC CURSIVE NUMBER ONE..MEROITIC CURSIVE NUMBER SEVENTY
+	{runeRange{0x10A00, 0x10A00}, ScriptKharoshthi},            // Lo       KHAROSHTHI LETTER A
+	{runeRange{0x10A05, 0x10A06}, ScriptKharoshthi},            // Mn   [2] KHAROSHTHI VOWEL SIGN E..KHAROSHTHI VOWEL SIGN O
+	{runeRange{0x10A10, 0x10A13}, ScriptKharoshthi},            // Lo   [4] KHAROSHTHI LETTER KA..KHAROSHTHI LETTER GHA
+	{runeRange{0x10A19, 0x10A35}, ScriptKharoshthi},            // Lo  [29] KHAROSHTHI LETTER NYA..KHAROSHTHI LETTER VHA
+	{runeRange{0x10A3F, 0x10A3F}, ScriptKharoshthi},            // Mn       KHAROSHTHI VIRAMA
+	{runeRange{0x10A50, 0x10A58}, ScriptKharoshthi},            // Po   [9] KHAROSHTHI PUNCTUATION DOT..KHAROSHTHI PUNCTUATION LINES
+	{runeRange{0x10A7D, 0x10A7E}, ScriptOldSouthArabian},       // No   [2] OLD SOUTH ARABIAN NUMBER ONE..OLD SOUTH ARABIAN NUMBER FIFTY
+	{runeRange{0x10A80, 0x10A9C}, ScriptOldNorthArabian},       // Lo  [29] OLD NORTH ARABIAN LETTER HEH..OLD NORTH ARABIAN LETTER ZAH
+	{runeRange{0x10AC0, 0x10AC7}, ScriptManichaean},            // Lo   [8] MANICHAEAN LETTER ALEPH..MANICHAEAN LETTER WAW
+	{runeRange{0x10AC9, 0x10AE4}, ScriptManichaean},            // Lo  [28] MANICHAEAN LETTER ZAYIN..MANICHAEAN LETTER TAW
+	{runeRange{0x10AEB, 0x10AEF}, ScriptManichaean},            // No   [5] MANICHAEAN NUMBER ONE..MANICHAEAN NUMBER ONE HUNDRED
+	{runeRange{0x10B00, 0x10B35}, ScriptAvestan},               // Lo  [54] AVESTAN LETTER A..AVESTAN LETTER HE
+	{runeRange{0x10B40, 0x10B55}, ScriptInscriptionalParthian}, // Lo  [22] INSCRIPTIONAL PARTHIAN LETTER ALEPH..INSCRIPTIONAL PARTHIAN LETTER TAW
+	{runeRange{0x10B60, 0x10B72}, ScriptInscriptionalPahlavi},  // Lo  [19] INSCRIPTIONAL PAHLAVI LETTER ALEPH..INSCRIPTIONAL PAHLAVI LETTER TAW
+	{runeRange{0x10B80, 0x10B91}, ScriptPsalterPahlavi},        // Lo  [18] PSALTER PAHLAVI LETTER ALEPH..PSALTER PAHLAVI LETTER TAW
+	{runeRange{0x10BA9, 0x10BAF}, ScriptPsalterPahlavi},        // No   [7] PSALTER PAHLAVI NUMBER ONE..PSALTER PAHLAVI NUMBER ONE HUNDRED
+	{runeRange{0x10C80, 0x10CB2}, ScriptOldHungarian},          // Lu  [51] OLD HUNGARIAN CAPITAL LETTER A..OLD HUNGARIAN CAPITAL LETTER US
+	{runeRange{0x10CFA, 0x10CFF}, ScriptOldHungarian},          // No   [6] OLD HUNGARIAN NUMBER ONE..OLD HUNGARIAN NUMBER ONE THOUSAND
+	{runeRange{0x10D24, 0x10D27}, ScriptHanifiRohingya},        // Mn   [4] HANIFI ROHINGYA SIGN HARBAHAY..HANIFI ROHINGYA SIGN TASSI
+	{runeRange{0x10D40, 0x10D49}, ScriptGaray},                 // Nd  [10] GARAY DIGIT ZERO..GARAY DIGIT NINE
+	{runeRange{0x10D4E, 0x10D4E}, ScriptGaray},                 // Lm       GARAY VOWEL LENGTH MARK
+	{runeRange{0x10D50, 0x10D65}, ScriptGaray},                 // Lu  [22] GARAY CAPITAL LETTER A..GARAY CAPITAL LETTER OLD NA
+	{runeRange{0x10D6E, 0x10D6E}, ScriptGaray},                 // Pd       GARAY HYPHEN
+	{runeRange{0x10D70, 0x10D85}, ScriptGaray},                 // Ll  [22] GARAY SMALL LETTER A..GARAY SMALL LETTER OLD NA
+	{runeRange{0x10E60, 0x10E7E}, ScriptArabic},                // No  [31] RUMI DIGIT ONE..RUMI FRACTION TWO THIRDS
+	{runeRange{0x10EAB, 0x10EAC}, ScriptYezidi},                // Mn   [2] YEZIDI COMBINING HAMZA MARK..YEZIDI COMBINING MADDA MARK
+	{runeRange{0x10EB0, 0x10EB1}, ScriptYezidi},                // Lo   [2] YEZIDI LETTER LAM WITH DOT ABOVE..YEZIDI LETTER YOT WITH CIRCUMFLEX ABOVE
+	{runeRange{0x10EC5, 0x10EC5}, ScriptArabic},                // Lm       ARABIC SMALL YEH BARREE WITH TWO DOTS BELOW
+	{runeRange{0x10ED0, 0x10ED0}, ScriptArabic},                // Po       ARABIC BIBLICAL END OF VERSE
+	{runeRange{0x10EFA, 0x10EFF}, ScriptArabic},                // Mn   [6] ARABIC DOUBLE VERTICAL BAR BELOW..ARABIC SMALL LOW WORD MADDA
+	{runeRange{0x10F1D, 0x10F26}, ScriptOldSogdian},            // No  [10] OLD SOGDIAN NUMBER ONE..OLD SOGDIAN FRACTION ONE HALF
+	{runeRange{0x10F30, 0x10F45}, ScriptSogdian},               // Lo  [22] SOGDIAN LETTER ALEPH..SOGDIAN INDEPENDENT SHIN
+	{runeRange{0x10F51, 0x10F54}, ScriptSogdian},               // No   [4] SOGDIAN NUMBER ONE..SOGDIAN NUMBER ONE HUNDRED
+	{runeRange{0x10F70, 0x10F81}, ScriptOldUyghur},             // Lo  [18] OLD UYGHUR LETTER ALEPH..OLD UYGHUR LETTER LESH
+	{runeRange{0x10F86, 0x10F89}, ScriptOldUyghur},             // Po   [4] OLD UYGHUR PUNCTUATION BAR..OLD UYGHUR PUNCTUATION FOUR DOTS
+	{runeRange{0x10FC5, 0x10FCB}, ScriptChorasmian},            // No   [7] CHORASMIAN NUMBER ONE..CHORASMIAN NUMBER ONE HUNDRED
+	{runeRange{0x11000, 0x11000}, ScriptBrahmi},                // Mc       BRAHMI SIGN CANDRABINDU
+	{runeRange{0x11002, 0x11002}, ScriptBrahmi},                // Mc       BRAHMI SIGN VISARGA
+	{runeRange{0x11038, 0x11046}, ScriptBrahmi},                // Mn  [15] BRAHMI VOWEL SIGN AA..BRAHMI VIRAMA
+	{runeRange{0x11052, 0x11065}, ScriptBrahmi},                // No  [20] BRAHMI NUMBER ONE..BRAHMI NUMBER ONE THOUSAND
+	{runeRange{0x11070, 0x11070}, ScriptBrahmi},                // Mn       BRAHMI SIGN OLD TAMIL VIRAMA
+	{runeRange{0x11073, 0x11074}, ScriptBrahmi},                // Mn   [2] BRAHMI VOWEL SIGN OLD TAMIL SHORT E..BRAHMI VOWEL SIGN OLD TAMIL SHORT O
+	{runeRange{0x1107F, 0x1107F}, ScriptBrahmi},                // Mn       BRAHMI NUMBER JOINER
+	{runeRange{0x11082, 0x11082}, ScriptKaithi},                // Mc       KAITHI SIGN VISARGA
+	{runeRange{0x110B0, 0x110B2}, ScriptKaithi},                // Mc   [3] KAITHI VOWEL SIGN AA..KAITHI VOWEL SIGN II
+	{runeRange{0x110B7, 0x110B8}, ScriptKaithi},                // Mc   [2] KAITHI VOWEL SIGN O..KAITHI VOWEL SIGN AU
+	{runeRange{0x110BB, 0x110BC}, ScriptKaithi},                // Po   [2] KAITHI ABBREVIATION SIGN..KAITHI ENUMERATION SIGN
+	{runeRange{0x110BE, 0x110C1}, ScriptKaithi},                // Po   [4] KAITHI SECTION MARK..KAITHI DOUBLE DANDA
+	{runeRange{0x110CD, 0x110CD}, ScriptKaithi},                // Cf       KAITHI NUMBER SIGN ABOVE
+	{runeRange{0x110F0, 0x110F9}, ScriptSoraSompeng},           // Nd  [10] SORA SOMPENG DIGIT ZERO..SORA SOMPENG DIGIT NINE
+	{runeRange{0x11103, 0x11126}, ScriptChakma},                // Lo  [36] CHAKMA LETTER AA..CHAKMA LETTER HAA
+	{runeRange{0x1112C, 0x1112C}, ScriptChakma},                // Mc       CHAKMA VOWEL SIGN E
+	{runeRange{0x11136, 0x1113F}, ScriptChakma},                // Nd  [10] CHAKMA DIGIT ZERO..CHAKMA DIGIT NINE
+	{runeRange{0x11144, 0x11144}, ScriptChakma},                // Lo       CHAKMA LETTER LHAA
+	{runeRange{0x11147, 0x11147}, ScriptChakma},                // Lo       CHAKMA LETTER VAA
+	{runeRange{0x11173, 0x11173}, ScriptMahajani},              // Mn       MAHAJANI SIGN NUKTA
+	{runeRange{0x11176, 0x11176}, ScriptMahajani},              // Lo       MAHAJANI LIGATURE SHRI
+	{runeRange{0x11182, 0x11182}, ScriptSharada},               // Mc       SHARADA SIGN VISARGA
+	{runeRange{0x111B3, 0x111B5}, ScriptSharada},               // Mc   [3] SHARADA VOWEL SIGN AA..SHARADA VOWEL SIGN II
+	{runeRange{0x111BF, 0x111C0}, ScriptSharada},               // Mc   [2] SHARADA VOWEL SIGN AU..SHARADA SIGN VIRAMA
+	{runeRange{0x111C5, 0x111C8}, ScriptSharada},               // Po   [4] SHARADA DANDA..SHARADA SEPARATOR
+	{runeRange{0x111CD, 0x111CD}, ScriptSharada},               // Po       SHARADA SUTRA MARK
+	{runeRange{0x111CF, 0x111CF}, ScriptSharada},               // Mn       SHARADA SIGN INVERTED CANDRABINDU
+	{runeRange{0x111DA, 0x111DA}, ScriptSharada},               // Lo       SHARADA EKAM
+	{runeRange{0x111DC, 0x111DC}, ScriptSharada},               // Lo       SHARADA HEADSTROKE
+	{runeRange{0x111E1, 0x111F4}, ScriptSinhala},               // No  [20] SINHALA ARCHAIC DIGIT ONE..SINHALA ARCHAIC NUMBER ONE THOUSAND
+	{runeRange{0x11213, 0x1122B}, ScriptKhojki},                // Lo  [25] KHOJKI LETTER NYA..KHOJKI LETTER LLA
+	{runeRange{0x1122F, 0x11231}, ScriptKhojki},                // Mn   [3] KHOJKI VOWEL SIGN U..KHOJKI VOWEL SIGN AI
+	{runeRange{0x11234, 0x11234}, ScriptKhojki},                // Mn       KHOJKI SIGN ANUSVARA
+}
+
+// scriptExtensions are taken from
+// ScriptExtensions-17.0.0.txt
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+var scriptExtensions = dictionary[[]Script]{
+	{runeRange{0x300E, 0x300E}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}},                                                                                                 // Ps       LEFT WHITE CORNER BRACKET
+	{runeRange{0x0BF3, 0x0BF3}, []Script{ScriptGrantha, ScriptTamil}},                                                                                                                                                        // So       TAMIL DAY SIGN
+	{runeRange{0xA838, 0xA838}, []Script{ScriptDevanagari, ScriptDogra, ScriptGujarati, ScriptGurmukhi, ScriptKhojki, ScriptKaithi, ScriptMahajani, ScriptModi, ScriptSharada, ScriptKhudawadi, ScriptTakri, ScriptTirhuta}}, // Sc       NORTH INDIC RUPEE MARK
+	{runeRange{0x0331, 0x0331}, []Script{ScriptCaucasianAlbanian, ScriptCherokee, ScriptGothic, ScriptLatin, ScriptSunuwar, ScriptSyriac, ScriptThai}},                                                                       // Mn       COMBINING MACRON BELOW
+	{runeRange{0x1CF2, 0x1CF2}, []Script{ScriptBengali, ScriptDevanagari, ScriptGrantha, ScriptKannada, ScriptMalayalam, ScriptNandinagari, ScriptOriya, ScriptSinhala, ScriptTelugu, ScriptTirhuta, ScriptTuluTigalari}},    // Lo       VEDIC SIGN ARDHAVISARGA
+	{runeRange{0x31C0, 0x31E5}, []Script{ScriptHan}},                      // So  [38] CJK STROKE T..CJK STROKE SZP
+	{runeRange{0xFF9E, 0xFF9F}, []Script{ScriptHiragana, ScriptKatakana}}, // Lm   [2] HALFWIDTH KATAKANA VOICED SOUND MARK..HALFWIDTH KATAKANA SEMI-VOICED SOUND MARK
+	{runeRange{0x0308, 0x0308}, []Script{ScriptArmenian, ScriptCyrillic, ScriptDuployan, ScriptGothic, ScriptGreek, ScriptHebrew, ScriptLatin, ScriptOldPermic, ScriptSyriac, ScriptTaiLe, ScriptTifinagh}}, // Mn       COMBINING DIAERESIS
+	{runeRange{0x061F, 0x061F}, []Script{ScriptAdlam, ScriptArabic, ScriptGaray, ScriptNko, ScriptHanifiRohingya, ScriptSyriac, ScriptThaana, ScriptYezidi}},                                                // Po       ARABIC QUESTION MARK
+	{runeRange{0x1CD8, 0x1CD8}, []Script{ScriptBengali, ScriptDevanagari, ScriptNewa, ScriptTelugu}},                                                                                                        // Mn       VEDIC TONE CANDRA BELOW
+	{runeRange{0x2E30, 0x2E30}, []Script{ScriptAvestan, ScriptOldTurkic}},                                                                                                                                   // Po       RING POINT
+	{runeRange{0x302A, 0x302D}, []Script{ScriptBopomofo, ScriptHan}},                                                                                                                                        // Mn   [4] IDEOGRAPHIC LEVEL TONE MARK..IDEOGRAPHIC ENTERING TONE MARK
+	{runeRange{0x3358, 0x3370}, []Script{ScriptHan}},                                                                                                                                                        // So  [25] IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR ZERO..IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY-FOUR
+	{runeRange{0xFDF2, 0xFDF2}, []Script{ScriptArabic, ScriptThaana}},                                                                                                                                       // Lo       ARABIC LIGATURE ALLAH ISOLATED FORM
+	{runeRange{0x11301, 0x11301}, []Script{ScriptGrantha, ScriptTamil}},                                                                                                                                     // Mn       GRANTHA SIGN CANDRABINDU
+	{runeRange{0x0300, 0x0300}, []Script{ScriptCherokee, ScriptCoptic, ScriptCyrillic, ScriptGreek, ScriptLatin, ScriptOldPermic, ScriptSunuwar, ScriptTaiLe}},                                              // Mn       COMBINING GRAVE ACCENT
+	{runeRange{0x0311, 0x0311}, []Script{ScriptCyrillic, ScriptLatin, ScriptTodhri}},                                                                                                                        // Mn       COMBINING INVERTED BREVE
+	{runeRange{0x0483, 0x0483}, []Script{ScriptCyrillic, ScriptOldPermic}},                                                                                                                                  // Mn       COMBINING CYRILLIC TITLO
+	{runeRange{0x0964, 0x0964}, []Script{ScriptBengali, ScriptDevanagari, ScriptDogra, ScriptGunjalaGondi, ScriptMasaramGondi, ScriptGrantha, ScriptGujarati, ScriptGurmukhi, ScriptKannada, ScriptMahajani, ScriptMalayalam, ScriptNandinagari, ScriptOlOnal, ScriptOriya, ScriptKhudawadi, ScriptSinhala, ScriptSylotiNagri, ScriptTakri, ScriptTamil, ScriptTelugu, ScriptTirhuta}}, // Po       DEVANAGARI DANDA
+	{runeRange{0x1CD0, 0x1CD0}, []Script{ScriptBengali, ScriptDevanagari, ScriptGrantha, ScriptKannada}},                     // Mn       VEDIC TONE KARSHANA
+	{runeRange{0x1CE2, 0x1CE2}, []Script{ScriptDevanagari, ScriptNewa, ScriptTirhuta}},                                       // Mn       VEDIC SIGN VISARGA SVARITA
+	{runeRange{0x1DF8, 0x1DF8}, []Script{ScriptCyrillic, ScriptLatin, ScriptSyriac}},                                         // Mn       COMBINING DOT ABOVE LEFT
+	{runeRange{0x3003, 0x3003}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana}},           // Po       DITTO MARK
+	{runeRange{0x3017, 0x3017}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}}, // Pe       RIGHT WHITE LENTICULAR BRACKET
+	{runeRange{0x309B, 0x309C}, []Script{ScriptHiragana, ScriptKatakana}},                                                    // Sk   [2] KATAKANA-HIRAGANA VOICED SOUND MARK..KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK
+	{runeRange{0x3280, 0x3289}, []Script{ScriptHan}},                                                                         // No  [10] CIRCLED IDEOGRAPH ONE..CIRCLED IDEOGRAPH TEN
+	{runeRange{0xA700, 0xA707}, []Script{ScriptHan, ScriptLatin}},                                                            // Sk   [8] MODIFIER LETTER CHINESE TONE YIN PING..MODIFIER LETTER CHINESE TONE YANG RU
+	{runeRange{0xA92E, 0xA92E}, []Script{ScriptKayahLi, ScriptLatin, ScriptMyanmar}},                                         // Po       KAYAH LI SIGN CWI
+	{runeRange{0xFF62, 0xFF62}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}}, // Ps       HALFWIDTH LEFT CORNER BRACKET
+	{runeRange{0x10137, 0x1013F}, []Script{ScriptCypriot, ScriptLinearB}},                                                    // So   [9] AEGEAN WEIGHT BASE UNIT..AEGEAN MEASURE THIRD SUBUNIT
+	{runeRange{0x11FD3, 0x11FD3}, []Script{ScriptGrantha, ScriptTamil}},                                                      // No       TAMIL FRACTION THREE QUARTERS
+	{runeRange{0x02C9, 0x02CB}, []Script{ScriptBopomofo, ScriptLatin}},                                                       // Lm   [3] MODIFIER LETTER MACRON..MODIFIER LETTER GRAVE ACCENT
+	{runeRange{0x0304, 0x0304}, []Script{ScriptCaucasianAlbanian, ScriptCherokee, ScriptCoptic, ScriptCyrillic, ScriptGothic, ScriptGreek, ScriptLatin, ScriptOsage, ScriptSyriac, ScriptTifinagh, ScriptTodhri}}, // Mn       COMBINING MACRON
+	{runeRange{0x030C, 0x030C}, []Script{ScriptCherokee, ScriptLatin, ScriptTaiLe}},                                                                                      // Mn       COMBINING CARON
+	{runeRange{0x0325, 0x0325}, []Script{ScriptLatin, ScriptSyriac}},                                                                                                     // Mn       COMBINING RING BELOW
+	{runeRange{0x035E, 0x035E}, []Script{ScriptCaucasianAlbanian, ScriptLatin, ScriptTodhri}},                                                                            // Mn       COMBINING DOUBLE MACRON
+	{runeRange{0x0589, 0x0589}, []Script{ScriptArmenian, ScriptGeorgian, ScriptGlagolitic}},                                                                              // Po       ARMENIAN FULL STOP
+	{runeRange{0x0670, 0x0670}, []Script{ScriptArabic, ScriptSyriac}},                                                                                                    // Mn       ARABIC LETTER SUPERSCRIPT ALEF
+	{runeRange{0x0A66, 0x0A6F}, []Script{ScriptGurmukhi, ScriptMultani}},                                                                                                 // Nd  [10] GURMUKHI DIGIT ZERO..GURMUKHI DIGIT NINE
+	{runeRange{0x16EB, 0x16ED}, []Script{ScriptRunic}},                                                                                                                   // Po   [3] RUNIC SINGLE PUNCTUATION..RUNIC CROSS PUNCTUATION
+	{runeRange{0x1CD4, 0x1CD4}, []Script{ScriptDevanagari}},                                                                                                              // Mn       VEDIC SIGN YAJURVEDIC MIDLINE SVARITA
+	{runeRange{0x1CDC, 0x1CDD}, []Script{ScriptDevanagari, ScriptSharada}},                                                                                               // Mn   [2] VEDIC TONE KATHAKA ANUDATTA..VEDIC TONE DOT BELOW
+	{runeRange{0x1CEB, 0x1CEB}, []Script{ScriptDevanagari, ScriptNewa}},                                                                                                  // Lo       VEDIC SIGN ANUSVARA VAMAGOMUKHA
+	{runeRange{0x1CF7, 0x1CF7}, []Script{ScriptBengali}},                                                                                                                 // Mc       VEDIC SIGN ATIKRAMA
+	{runeRange{0x205A, 0x205A}, []Script{ScriptCarian, ScriptGeorgian, ScriptGlagolitic, ScriptOldHungarian, ScriptLycian, ScriptOldTurkic}},                             // Po       TWO DOT PUNCTUATION
+	{runeRange{0x2E43, 0x2E43}, []Script{ScriptCyrillic, ScriptGlagolitic}},                                                                                              // Po       DASH WITH LEFT UPTURN
+	{runeRange{0x300A, 0x300A}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptLisu, ScriptMongolian, ScriptTibetan, ScriptYi}}, // Ps       LEFT DOUBLE ANGLE BRACKET
+	{runeRange{0x3013, 0x3013}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana}},                                                       // So       GETA MARK
+	{runeRange{0x301B, 0x301B}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}},                                             // Pe       RIGHT WHITE SQUARE BRACKET
+	{runeRange{0x303C, 0x303C}, []Script{ScriptHan, ScriptHiragana, ScriptKatakana}},                                                                                     // Lo       MASU MARK
+	{runeRange{0x3190, 0x3191}, []Script{ScriptHan}},                                                                                                                     // So   [2] IDEOGRAPHIC ANNOTATION LINKING MARK..IDEOGRAPHIC ANNOTATION REVERSE MARK
+	{runeRange{0x3220, 0x3229}, []Script{ScriptHan}},                                                                                                                     // No  [10] PARENTHESIZED IDEOGRAPH ONE..PARENTHESIZED IDEOGRAPH TEN
+	{runeRange{0x32C0, 0x32CB}, []Script{ScriptHan}},                                                                                                                     // So  [12] IDEOGRAPHIC TELEGRAPH SYMBOL FOR JANUARY..IDEOGRAPHIC TELEGRAPH SYMBOL FOR DECEMBER
+	{runeRange{0x33E0, 0x33FE}, []Script{ScriptHan}},                                                                                                                     // So  [31] IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY ONE..IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY THIRTY-ONE
+	{runeRange{0xA833, 0xA835}, []Script{ScriptDevanagari, ScriptDogra, ScriptGujarati, ScriptGurmukhi, ScriptKhojki, ScriptKannada, ScriptKaithi, ScriptMahajani, ScriptModi, ScriptNandinagari, ScriptSharada, ScriptKhudawadi, ScriptTakri, ScriptTirhuta, ScriptTuluTigalari}}, // No   [3] NORTH INDIC FRACTION ONE SIXTEENTH..NORTH INDIC FRACTION THREE SIXTEENTHS
+	{runeRange{0xA8F1, 0xA8F1}, []Script{ScriptBengali, ScriptDevanagari, ScriptTuluTigalari}},                                                  // Mn       COMBINING DEVANAGARI SIGN AVAGRAHA
+	{runeRange{0xFD3E, 0xFD3E}, []Script{ScriptArabic, ScriptNko}},                                                                              // Pe       ORNATE LEFT PARENTHESIS
+	{runeRange{0xFE45, 0xFE46}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana}},                              // Po   [2] SESAME DOT..WHITE SESAME DOT
+	{runeRange{0xFF64, 0xFF65}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}},                    // Po   [2] HALFWIDTH IDEOGRAPHIC COMMA..HALFWIDTH KATAKANA MIDDLE DOT
+	{runeRange{0x10102, 0x10102}, []Script{ScriptCypriot, ScriptLinearB}},                                                                       // Po       AEGEAN CHECK MARK
+	{runeRange{0x102E1, 0x102FB}, []Script{ScriptArabic, ScriptCoptic}},                                                                         // No  [27] COPTIC EPACT DIGIT ONE..COPTIC EPACT NUMBER NINE HUNDRED
+	{runeRange{0x1133B, 0x1133C}, []Script{ScriptGrantha, ScriptTamil}},                                                                         // Mn   [2] COMBINING BINDU BELOW..GRANTHA SIGN NUKTA
+	{runeRange{0x1D360, 0x1D371}, []Script{ScriptHan}},                                                                                          // No  [18] COUNTING ROD UNIT DIGIT ONE..COUNTING ROD TENS DIGIT NINE
+	{runeRange{0x02BC, 0x02BC}, []Script{ScriptBengali, ScriptCyrillic, ScriptDevanagari, ScriptLatin, ScriptLisu, ScriptThai, ScriptToto}},     // Lm       MODIFIER LETTER APOSTROPHE
+	{runeRange{0x02D7, 0x02D7}, []Script{ScriptLatin, ScriptThai}},                                                                              // Sk       MODIFIER LETTER MINUS SIGN
+	{runeRange{0x0302, 0x0302}, []Script{ScriptCherokee, ScriptCyrillic, ScriptLatin, ScriptTifinagh}},                                          // Mn       COMBINING CIRCUMFLEX ACCENT
+	{runeRange{0x0306, 0x0306}, []Script{ScriptCyrillic, ScriptGreek, ScriptLatin, ScriptOldPermic, ScriptTifinagh}},                            // Mn       COMBINING BREVE
+	{runeRange{0x030A, 0x030A}, []Script{ScriptDuployan, ScriptLatin, ScriptSyriac}},                                                            // Mn       COMBINING RING ABOVE
+	{runeRange{0x030E, 0x030E}, []Script{ScriptEthiopic, ScriptLatin}},                                                                          // Mn       COMBINING DOUBLE VERTICAL LINE ABOVE
+	{runeRange{0x0323, 0x0323}, []Script{ScriptCherokee, ScriptDuployan, ScriptKatakana, ScriptLatin, ScriptSyriac, ScriptTifinagh}},            // Mn       COMBINING DOT BELOW
+	{runeRange{0x032E, 0x032E}, []Script{ScriptLatin, ScriptSyriac}},                                                                            // Mn       COMBINING BREVE BELOW
+	{runeRange{0x0345, 0x0345}, []Script{ScriptGreek}},                                                                                          // Mn       COMBINING GREEK YPOGEGRAMMENI
+	{runeRange{0x0374, 0x0374}, []Script{ScriptCoptic, ScriptGreek}},                                                                            // Lm       GREEK NUMERAL SIGN
+	{runeRange{0x0485, 0x0486}, []Script{ScriptCyrillic, ScriptLatin}},                                                                          // Mn   [2] COMBINING CYRILLIC DASIA PNEUMATA..COMBINING CYRILLIC PSILI PNEUMATA
+	{runeRange{0x061B, 0x061B}, []Script{ScriptArabic, ScriptGaray, ScriptNko, ScriptHanifiRohingya, ScriptSyriac, ScriptThaana, ScriptYezidi}}, // Po       ARABIC SEMICOLON
+	{runeRange{0x064B, 0x0655}, []Script{ScriptArabic, ScriptSyriac}},                                                                           // Mn  [11] ARABIC FATHATAN..ARABIC HAMZA BELOW
+	{runeRange{0x0951, 0x0951}, []Script{ScriptBengali, ScriptDevanagari, ScriptGrantha, ScriptGujarati, ScriptGurmukhi, ScriptKannada, ScriptLatin, ScriptMalayalam, ScriptNandinagari, ScriptNewa, ScriptOriya, ScriptSharada, ScriptTamil, ScriptTelugu, ScriptTirhuta}}, // Mn       DEVANAGARI STRESS SIGN UDATTA
+	{runeRange{0x0966, 0x096F}, []Script{ScriptDevanagari, ScriptDogra, ScriptKaithi, ScriptMahajani}},                                                       // Nd  [10] DEVANAGARI DIGIT ZERO..DEVANAGARI DIGIT NINE
+	{runeRange{0x0BE6, 0x0BEF}, []Script{ScriptGrantha, ScriptTamil}},                                                                                        // Nd  [10] TAMIL DIGIT ZERO..TAMIL DIGIT NINE
+	{runeRange{0x1040, 0x1049}, []Script{ScriptChakma, ScriptMyanmar, ScriptTaiLe}},                                                                          // Nd  [10] MYANMAR DIGIT ZERO..MYANMAR DIGIT NINE
+	{runeRange{0x1802, 0x1803}, []Script{ScriptMongolian, ScriptPhagsPa}},                                                                                    // Po   [2] MONGOLIAN COMMA..MONGOLIAN FULL STOP
+	{runeRange{0x1CD2, 0x1CD2}, []Script{ScriptBengali, ScriptDevanagari, ScriptGrantha, ScriptKannada}},                                                     // Mn       VEDIC TONE PRENKHA
+	{runeRange{0x1CD6, 0x1CD6}, []Script{ScriptBengali, ScriptDevanagari, ScriptTelugu}},                                                                     // Mn       VEDIC TONE YAJURVEDIC INDEPENDENT SVARITA
+	{runeRange{0x1CDA, 0x1CDA}, []Script{ScriptDevanagari, ScriptKannada, ScriptMalayalam, ScriptOriya, ScriptTamil, ScriptTelugu}},                          // Mn       VEDIC TONE DOUBLE SVARITA
+	{runeRange{0x1CE0, 0x1CE0}, []Script{ScriptDevanagari, ScriptSharada}},                                                                                   // Mn       VEDIC TONE RIGVEDIC KASHMIRI INDEPENDENT SVARITA
+	{runeRange{0x1CE9, 0x1CE9}, []Script{ScriptDevanagari, ScriptNandinagari, ScriptNewa}},                                                                   // Lo       VEDIC SIGN ANUSVARA ANTARGOMUKHA
+	{runeRange{0x1CED, 0x1CED}, []Script{ScriptBengali, ScriptDevanagari, ScriptNewa, ScriptSharada}},                                                        // Mn       VEDIC SIGN TIRYAK
+	{runeRange{0x1CF4, 0x1CF4}, []Script{ScriptDevanagari, ScriptGrantha, ScriptKannada, ScriptTuluTigalari}},                                                // Mn       VEDIC TONE CANDRA ABOVE
+	{runeRange{0x1CFA, 0x1CFA}, []Script{ScriptNandinagari}},                                                                                                 // Lo       VEDIC SIGN DOUBLE ANUSVARA ANTARGOMUKHA
+	{runeRange{0x202F, 0x202F}, []Script{ScriptLatin, ScriptMongolian, ScriptPhagsPa}},                                                                       // Zs       NARROW NO-BREAK SPACE
+	{runeRange{0x20F0, 0x20F0}, []Script{ScriptDevanagari, ScriptGrantha, ScriptLatin}},                                                                      // Mn       COMBINING ASTERISK ABOVE
+	{runeRange{0x2E3C, 0x2E3C}, []Script{ScriptDuployan}},                                                                                                    // Po       STENOGRAPHIC FULL STOP
+	{runeRange{0x3001, 0x3001}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptMongolian, ScriptYi}},                // Po       IDEOGRAPHIC COMMA
+	{runeRange{0x3008, 0x3008}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptMongolian, ScriptTibetan, ScriptYi}}, // Ps       LEFT ANGLE BRACKET
+	{runeRange{0x300C, 0x300C}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}},                                 // Ps       LEFT CORNER BRACKET
+	{runeRange{0x3010, 0x3010}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}},                                 // Ps       LEFT BLACK LENTICULAR BRACKET
+	{runeRange{0x3015, 0x3015}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}},                                 // Pe       RIGHT TORTOISE SHELL BRACKET
+	{runeRange{0x3019, 0x3019}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}},                                 // Pe       RIGHT WHITE TORTOISE SHELL BRACKET
+	{runeRange{0x301D, 0x301D}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana}},                                           // Ps       REVERSED DOUBLE PRIME QUOTATION MARK
+	{runeRange{0x3031, 0x3035}, []Script{ScriptHiragana, ScriptKatakana}},                                                                                    // Lm   [5] VERTICAL KANA REPEAT MARK..VERTICAL KANA REPEAT MARK LOWER HALF
+	{runeRange{0x303E, 0x303F}, []Script{ScriptHan}},                                                                                                         // So   [2] IDEOGRAPHIC VARIATION INDICATOR..IDEOGRAPHIC HALF FILL SPACE
+	{runeRange{0x30FB, 0x30FB}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}},                                 // Po       KATAKANA MIDDLE DOT
+	{runeRange{0x3196, 0x319F}, []Script{ScriptHan}},                                                                                                         // So  [10] IDEOGRAPHIC ANNOTATION TOP MARK..IDEOGRAPHIC ANNOTATION MAN MARK
+	{runeRange{0x31EF, 0x31EF}, []Script{ScriptHan, ScriptTangut}},                                                                                           // So       IDEOGRAPHIC DESCRIPTION CHARACTER SUBTRACTION
+	{runeRange{0x322A, 0x3247}, []Script{ScriptHan}},                                                                                                         // So  [30] PARENTHESIZED IDEOGRAPH MOON..CIRCLED IDEOGRAPH KOTO
+	{runeRange{0x328A, 0x32B0}, []Script{ScriptHan}},                                                                                                         // So  [39] CIRCLED IDEOGRAPH MOON..CIRCLED IDEOGRAPH NIGHT
+	{runeRange{0x32FF, 0x32FF}, []Script{ScriptHan}},                                                                                                         // So       SQUARE ERA NAME REIWA
+	{runeRange{0x337B, 0x337F}, []Script{ScriptHan}},                                                                                                         // So   [5] SQUARE ERA NAME HEISEI..SQUARE CORPORATION
+	{runeRange{0xA66F, 0xA66F}, []Script{ScriptCyrillic, ScriptGlagolitic}},                                                                                  // Mn       COMBINING CYRILLIC VZMET
+	{runeRange{0xA830, 0xA832}, []Script{ScriptDevanagari, ScriptDogra, ScriptGujarati, ScriptGurmukhi, ScriptKhojki, ScriptKannada, ScriptKaithi, ScriptMahajani, ScriptMalayalam, ScriptModi, ScriptNandinagari, ScriptSharada, ScriptKhudawadi, ScriptTakri, ScriptTirhuta, ScriptTuluTigalari}}, // No   [3] NORTH INDIC FRACTION ONE QUARTER..NORTH INDIC FRACTION THREE QUARTERS
+	{runeRange{0xA836, 0xA837}, []Script{ScriptDevanagari, ScriptDogra, ScriptGujarati, ScriptGurmukhi, ScriptKhojki, ScriptKaithi, ScriptMahajani, ScriptModi, ScriptKhudawadi, ScriptTakri, ScriptTirhuta}},                                                                                       // So   [2] NORTH INDIC QUARTER MARK..NORTH INDIC PLACEHOLDER MARK
+	{runeRange{0xA839, 0xA839}, []Script{ScriptDevanagari, ScriptDogra, ScriptGujarati, ScriptGurmukhi, ScriptKhojki, ScriptKaithi, ScriptMahajani, ScriptModi, ScriptKhudawadi, ScriptTakri, ScriptTirhuta}},                                                                                       // So       NORTH INDIC QUANTITY MARK
+	{runeRange{0xA8F3, 0xA8F3}, []Script{ScriptDevanagari, ScriptTamil}},                                                     // Lo       DEVANAGARI SIGN CANDRABINDU VIRAMA
+	{runeRange{0xA9CF, 0xA9CF}, []Script{ScriptBuginese, ScriptJavanese}},                                                    // Lm       JAVANESE PANGRANGKEP
+	{runeRange{0xFD3F, 0xFD3F}, []Script{ScriptArabic, ScriptNko}},                                                           // Ps       ORNATE RIGHT PARENTHESIS
+	{runeRange{0xFDFD, 0xFDFD}, []Script{ScriptArabic, ScriptThaana}},                                                        // So       ARABIC LIGATURE BISMILLAH AR-RAHMAN AR-RAHEEM
+	{runeRange{0xFF61, 0xFF61}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}}, // Po       HALFWIDTH IDEOGRAPHIC FULL STOP
+	{runeRange{0xFF63, 0xFF63}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}}, // Pe       HALFWIDTH RIGHT CORNER BRACKET
+	{runeRange{0xFF70, 0xFF70}, []Script{ScriptHiragana, ScriptKatakana}},                                                    // Lm       HALFWIDTH KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	{runeRange{0x10100, 0x10101}, []Script{ScriptCyproMinoan, ScriptCypriot, ScriptLinearB}},                                 // Po   [2] AEGEAN WORD SEPARATOR LINE..AEGEAN WORD SEPARATOR DOT
+	{runeRange{0x10107, 0x10133}, []Script{ScriptCypriot, ScriptLinearA, ScriptLinearB}},                                     // No  [45] AEGEAN NUMBER ONE..AEGEAN NUMBER NINETY THOUSAND
+	{runeRange{0x102E0, 0x102E0}, []Script{ScriptArabic, ScriptCoptic}},                                                      // Mn       COPTIC EPACT THOUSANDS MARK
+	{runeRange{0x10AF2, 0x10AF2}, []Script{ScriptManichaean, ScriptOldUyghur}},                                               // Po       MANICHAEAN PUNCTUATION DOUBLE DOT WITHIN DOT
+	{runeRange{0x11303, 0x11303}, []Script{ScriptGrantha, ScriptTamil}},                                                      // Mc       GRANTHA SIGN VISARGA
+	{runeRange{0x11FD0, 0x11FD1}, []Script{ScriptGrantha, ScriptTamil}},                                                      // No   [2] TAMIL FRACTION ONE QUARTER..TAMIL FRACTION ONE HALF-1
+	{runeRange{0x1BCA0, 0x1BCA3}, []Script{ScriptDuployan}},                                                                  // Cf   [4] SHORTHAND FORMAT LETTER OVERLAP..SHORTHAND FORMAT UP STEP
+	{runeRange{0x1F250, 0x1F251}, []Script{ScriptHan}},                                                                       // So   [2] CIRCLED IDEOGRAPH ADVANTAGE..CIRCLED IDEOGRAPH ACCEPT
+	{runeRange{0x00B7, 0x00B7}, []Script{ScriptAvestan, ScriptCarian, ScriptCoptic, ScriptDuployan, ScriptElbasan, ScriptGeorgian, ScriptGlagolitic, ScriptGunjalaGondi, ScriptGothic, ScriptGreek, ScriptHan, ScriptLatin, ScriptLydian, ScriptMahajani, ScriptOldPermic, ScriptShavian}}, // Po       MIDDLE DOT
+	{runeRange{0x02C7, 0x02C7}, []Script{ScriptBopomofo, ScriptLatin}},                                                                                                                          // Lm       CARON
+	{runeRange{0x02CD, 0x02CD}, []Script{ScriptLatin, ScriptLisu}},                                                                                                                              // Lm       MODIFIER LETTER LOW MACRON
+	{runeRange{0x02D9, 0x02D9}, []Script{ScriptBopomofo, ScriptLatin}},                                                                                                                          // Sk       DOT ABOVE
+	{runeRange{0x0301, 0x0301}, []Script{ScriptCherokee, ScriptCyrillic, ScriptGreek, ScriptLatin, ScriptOsage, ScriptSunuwar, ScriptTaiLe, ScriptTodhri}},                                      // Mn       COMBINING ACUTE ACCENT
+	{runeRange{0x0303, 0x0303}, []Script{ScriptGlagolitic, ScriptLatin, ScriptSunuwar, ScriptSyriac, ScriptThai}},                                                                               // Mn       COMBINING TILDE
+	{runeRange{0x0305, 0x0305}, []Script{ScriptCoptic, ScriptElbasan, ScriptGlagolitic, ScriptGothic, ScriptKatakana, ScriptLatin}},                                                             // Mn       COMBINING OVERLINE
+	{runeRange{0x0307, 0x0307}, []Script{ScriptCoptic, ScriptDuployan, ScriptHebrew, ScriptLatin, ScriptOldPermic, ScriptSyriac, ScriptTaiLe, ScriptTifinagh, ScriptTodhri}},                    // Mn       COMBINING DOT ABOVE
+	{runeRange{0x0309, 0x0309}, []Script{ScriptLatin, ScriptTifinagh}},                                                                                                                          // Mn       COMBINING HOOK ABOVE
+	{runeRange{0x030B, 0x030B}, []Script{ScriptCherokee, ScriptCyrillic, ScriptLatin, ScriptOsage}},                                                                                             // Mn       COMBINING DOUBLE ACUTE ACCENT
+	{runeRange{0x030D, 0x030D}, []Script{ScriptLatin, ScriptSunuwar}},                                                                                                                           // Mn       COMBINING VERTICAL LINE ABOVE
+	{runeRange{0x0310, 0x0310}, []Script{ScriptLatin, ScriptSunuwar}},                                                                                                                           // Mn       COMBINING CANDRABINDU
+	{runeRange{0x0313, 0x0313}, []Script{ScriptGreek, ScriptLatin, ScriptOldPermic, ScriptTodhri}},                                                                                              // Mn       COMBINING COMMA ABOVE
+	{runeRange{0x0324, 0x0324}, []Script{ScriptCherokee, ScriptDuployan, ScriptLatin, ScriptSyriac}},                                                                                            // Mn       COMBINING DIAERESIS BELOW
+	{runeRange{0x032D, 0x032D}, []Script{ScriptLatin, ScriptSunuwar, ScriptSyriac}},                                                                                                             // Mn       COMBINING CIRCUMFLEX ACCENT BELOW
+	{runeRange{0x0330, 0x0330}, []Script{ScriptCherokee, ScriptLatin, ScriptSyriac}},                                                                                                            // Mn       COMBINING TILDE BELOW
+	{runeRange{0x0342, 0x0342}, []Script{ScriptGreek}},                                                                                                                                          // Mn       COMBINING GREEK PERISPOMENI
+	{runeRange{0x0358, 0x0358}, []Script{ScriptLatin, ScriptOsage}},                                                                                                                             // Mn       COMBINING DOT ABOVE RIGHT
+	{runeRange{0x0363, 0x036F}, []Script{ScriptLatin}},                                                                                                                                          // Mn  [13] COMBINING LATIN SMALL LETTER A..COMBINING LATIN SMALL LETTER X
+	{runeRange{0x0375, 0x0375}, []Script{ScriptCoptic, ScriptGreek}},                                                                                                                            // Sk       GREEK LOWER NUMERAL SIGN
+	{runeRange{0x0484, 0x0484}, []Script{ScriptCyrillic, ScriptGlagolitic}},                                                                                                                     // Mn       COMBINING CYRILLIC PALATALIZATION
+	{runeRange{0x0487, 0x0487}, []Script{ScriptCyrillic, ScriptGlagolitic}},                                                                                                                     // Mn       COMBINING CYRILLIC POKRYTIE
+	{runeRange{0x060C, 0x060C}, []Script{ScriptArabic, ScriptGaray, ScriptNko, ScriptHanifiRohingya, ScriptSyriac, ScriptThaana, ScriptYezidi}},                                                 // Po       ARABIC COMMA
+	{runeRange{0x061C, 0x061C}, []Script{ScriptArabic, ScriptSyriac, ScriptThaana}},                                                                                                             // Cf       ARABIC LETTER MARK
+	{runeRange{0x0640, 0x0640}, []Script{ScriptAdlam, ScriptArabic, ScriptMandaic, ScriptManichaean, ScriptOldUyghur, ScriptPsalterPahlavi, ScriptHanifiRohingya, ScriptSogdian, ScriptSyriac}}, // Lm       ARABIC TATWEEL
+	{runeRange{0x0660, 0x0669}, []Script{ScriptArabic, ScriptThaana, ScriptYezidi}},                                                                                                             // Nd  [10] ARABIC-INDIC DIGIT ZERO..ARABIC-INDIC DIGIT NINE
+	{runeRange{0x06D4, 0x06D4}, []Script{ScriptArabic, ScriptHanifiRohingya}},                                                                                                                   // Po       ARABIC FULL STOP
+	{runeRange{0x0952, 0x0952}, []Script{ScriptBengali, ScriptDevanagari, ScriptGrantha, ScriptGujarati, ScriptGurmukhi, ScriptKannada, ScriptLatin, ScriptMalayalam, ScriptNewa, ScriptOriya, ScriptTamil, ScriptTelugu, ScriptTirhuta}},                                                                                                                                                                              // Mn       DEVANAGARI STRESS SIGN ANUDATTA
+	{runeRange{0x0965, 0x0965}, []Script{ScriptBengali, ScriptDevanagari, ScriptDogra, ScriptGunjalaGondi, ScriptMasaramGondi, ScriptGrantha, ScriptGujarati, ScriptGurungKhema, ScriptGurmukhi, ScriptKannada, ScriptLimbu, ScriptMahajani, ScriptMalayalam, ScriptNandinagari, ScriptOlOnal, ScriptOriya, ScriptKhudawadi, ScriptSinhala, ScriptSylotiNagri, ScriptTakri, ScriptTamil, ScriptTelugu, ScriptTirhuta}}, // Po       DEVANAGARI DOUBLE DANDA
+	{runeRange{0x09E6, 0x09EF}, []Script{ScriptBengali, ScriptChakma, ScriptSylotiNagri}},                                                                                // Nd  [10] BENGALI DIGIT ZERO..BENGALI DIGIT NINE
+	{runeRange{0x0AE6, 0x0AEF}, []Script{ScriptGujarati, ScriptKhojki}},                                                                                                  // Nd  [10] GUJARATI DIGIT ZERO..GUJARATI DIGIT NINE
+	{runeRange{0x0BF0, 0x0BF2}, []Script{ScriptGrantha, ScriptTamil}},                                                                                                    // No   [3] TAMIL NUMBER TEN..TAMIL NUMBER ONE THOUSAND
+	{runeRange{0x0CE6, 0x0CEF}, []Script{ScriptKannada, ScriptNandinagari, ScriptTuluTigalari}},                                                                          // Nd  [10] KANNADA DIGIT ZERO..KANNADA DIGIT NINE
+	{runeRange{0x10FB, 0x10FB}, []Script{ScriptGeorgian, ScriptGlagolitic, ScriptLatin}},                                                                                 // Po       GEORGIAN PARAGRAPH SEPARATOR
+	{runeRange{0x1735, 0x1736}, []Script{ScriptBuhid, ScriptHanunoo, ScriptTagbanwa, ScriptTagalog}},                                                                     // Po   [2] PHILIPPINE SINGLE PUNCTUATION..PHILIPPINE DOUBLE PUNCTUATION
+	{runeRange{0x1805, 0x1805}, []Script{ScriptMongolian, ScriptPhagsPa}},                                                                                                // Po       MONGOLIAN FOUR DOTS
+	{runeRange{0x1CD1, 0x1CD1}, []Script{ScriptDevanagari}},                                                                                                              // Mn       VEDIC TONE SHARA
+	{runeRange{0x1CD3, 0x1CD3}, []Script{ScriptDevanagari, ScriptGrantha, ScriptKannada}},                                                                                // Po       VEDIC SIGN NIHSHVASA
+	{runeRange{0x1CD5, 0x1CD5}, []Script{ScriptBengali, ScriptDevanagari, ScriptNewa, ScriptTelugu, ScriptTirhuta}},                                                      // Mn       VEDIC TONE YAJURVEDIC AGGRAVATED INDEPENDENT SVARITA
+	{runeRange{0x1CD7, 0x1CD7}, []Script{ScriptDevanagari, ScriptNewa, ScriptSharada}},                                                                                   // Mn       VEDIC TONE YAJURVEDIC KATHAKA INDEPENDENT SVARITA
+	{runeRange{0x1CD9, 0x1CD9}, []Script{ScriptDevanagari, ScriptSharada}},                                                                                               // Mn       VEDIC TONE YAJURVEDIC KATHAKA INDEPENDENT SVARITA SCHROEDER
+	{runeRange{0x1CDB, 0x1CDB}, []Script{ScriptDevanagari}},                                                                                                              // Mn       VEDIC TONE TRIPLE SVARITA
+	{runeRange{0x1CDE, 0x1CDF}, []Script{ScriptDevanagari}},                                                                                                              // Mn   [2] VEDIC TONE TWO DOTS BELOW..VEDIC TONE THREE DOTS BELOW
+	{runeRange{0x1CE1, 0x1CE1}, []Script{ScriptBengali, ScriptDevanagari}},                                                                                               // Mc       VEDIC TONE ATHARVAVEDIC INDEPENDENT SVARITA
+	{runeRange{0x1CE3, 0x1CE8}, []Script{ScriptDevanagari}},                                                                                                              // Mn   [6] VEDIC SIGN VISARGA UDATTA..VEDIC SIGN VISARGA ANUDATTA WITH TAIL
+	{runeRange{0x1CEA, 0x1CEA}, []Script{ScriptBengali, ScriptDevanagari, ScriptSharada}},                                                                                // Lo       VEDIC SIGN ANUSVARA BAHIRGOMUKHA
+	{runeRange{0x1CEC, 0x1CEC}, []Script{ScriptDevanagari}},                                                                                                              // Lo       VEDIC SIGN ANUSVARA VAMAGOMUKHA WITH TAIL
+	{runeRange{0x1CEE, 0x1CF1}, []Script{ScriptDevanagari}},                                                                                                              // Lo   [4] VEDIC SIGN HEXIFORM LONG ANUSVARA..VEDIC SIGN ANUSVARA UBHAYATO MUKHA
+	{runeRange{0x1CF3, 0x1CF3}, []Script{ScriptDevanagari, ScriptGrantha}},                                                                                               // Lo       VEDIC SIGN ROTATED ARDHAVISARGA
+	{runeRange{0x1CF5, 0x1CF6}, []Script{ScriptBengali, ScriptDevanagari}},                                                                                               // Lo   [2] VEDIC SIGN JIHVAMULIYA..VEDIC SIGN UPADHMANIYA
+	{runeRange{0x1CF8, 0x1CF9}, []Script{ScriptDevanagari, ScriptGrantha}},                                                                                               // Mn   [2] VEDIC TONE RING ABOVE..VEDIC TONE DOUBLE RING ABOVE
+	{runeRange{0x1DC0, 0x1DC1}, []Script{ScriptGreek}},                                                                                                                   // Mn   [2] COMBINING DOTTED GRAVE ACCENT..COMBINING DOTTED ACUTE ACCENT
+	{runeRange{0x1DFA, 0x1DFA}, []Script{ScriptSyriac}},                                                                                                                  // Mn       COMBINING DOT BELOW LEFT
+	{runeRange{0x204F, 0x204F}, []Script{ScriptAdlam, ScriptArabic}},                                                                                                     // Po       REVERSED SEMICOLON
+	{runeRange{0x205D, 0x205D}, []Script{ScriptCarian, ScriptGreek, ScriptOldHungarian, ScriptMeroiticHieroglyphs}},                                                      // Po       TRICOLON
+	{runeRange{0x2E17, 0x2E17}, []Script{ScriptCoptic, ScriptLatin}},                                                                                                     // Pd       DOUBLE OBLIQUE HYPHEN
+	{runeRange{0x2E31, 0x2E31}, []Script{ScriptAvestan, ScriptCarian, ScriptGeorgian, ScriptOldHungarian, ScriptKaithi, ScriptLydian, ScriptSamaritan}},                  // Po       WORD SEPARATOR MIDDLE DOT
+	{runeRange{0x2E41, 0x2E41}, []Script{ScriptAdlam, ScriptArabic, ScriptOldHungarian}},                                                                                 // Po       REVERSED COMMA
+	{runeRange{0x2FF0, 0x2FFF}, []Script{ScriptHan, ScriptTangut}},                                                                                                       // So  [16] IDEOGRAPHIC DESCRIPTION CHARACTER LEFT TO RIGHT..IDEOGRAPHIC DESCRIPTION CHARACTER ROTATION
+	{runeRange{0x3002, 0x3002}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptMongolian, ScriptPhagsPa, ScriptYi}},             // Po       IDEOGRAPHIC FULL STOP
+	{runeRange{0x3006, 0x3006}, []Script{ScriptHan}},                                                                                                                     // Lo       IDEOGRAPHIC CLOSING MARK
+	{runeRange{0x3009, 0x3009}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptMongolian, ScriptTibetan, ScriptYi}},             // Pe       RIGHT ANGLE BRACKET
+	{runeRange{0x300B, 0x300B}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptLisu, ScriptMongolian, ScriptTibetan, ScriptYi}}, // Pe       RIGHT DOUBLE ANGLE BRACKET
+	{runeRange{0x300D, 0x300D}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}},                                             // Pe       RIGHT CORNER BRACKET
+	{runeRange{0x300F, 0x300F}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}},                                             // Pe       RIGHT WHITE CORNER BRACKET
+	{runeRange{0x3011, 0x3011}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}},                                             // Pe       RIGHT BLACK LENTICULAR BRACKET
+	{runeRange{0x3014, 0x3014}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}},                                             // Ps       LEFT TORTOISE SHELL BRACKET
+	{runeRange{0x3016, 0x3016}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}},                                             // Ps       LEFT WHITE LENTICULAR BRACKET
+	{runeRange{0x3018, 0x3018}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}},                                             // Ps       LEFT WHITE TORTOISE SHELL BRACKET
+	{runeRange{0x301A, 0x301A}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana, ScriptYi}},                                             // Ps       LEFT WHITE SQUARE BRACKET
+	{runeRange{0x301C, 0x301C}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana}},                                                       // Pd       WAVE DASH
+	{runeRange{0x301E, 0x301F}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana}},                                                       // Pe   [2] DOUBLE PRIME QUOTATION MARK..LOW DOUBLE PRIME QUOTATION MARK
+	{runeRange{0x3030, 0x3030}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana}},                                                       // Pd       WAVY DASH
+	{runeRange{0x3037, 0x3037}, []Script{ScriptBopomofo, ScriptHangul, ScriptHan, ScriptHiragana, ScriptKatakana}},                                                       // So       IDEOGRAPHIC TELEGRAPH LINE FEED SEPARATOR SYMBOL
+	{runeRange{0x303D, 0x303D}, []Script{ScriptHan, ScriptHiragana, ScriptKatakana}},                                                                                     // Po       PART ALTERNATION MARK
+	{runeRange{0x3099, 0x309A}, []Script{ScriptHiragana, ScriptKatakana}},                                                                                                // Mn   [2] COMBINING KATAKANA-HIRAGANA VOICED SOUND MARK..COMBINING KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK
+	{runeRange{0x30A0, 0x30A0}, []Script{ScriptHiragana, ScriptKatakana}},                                                                                                // Pd       KATAKANA-HIRAGANA DOUBLE HYPHEN
+	{runeRange{0x30FC, 0x30FC}, []Script{ScriptHiragana, ScriptKatakana}},                                                                                                // Lm       KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	{runeRange{0x3192, 0x3195}, []Script{ScriptHan}},                                                                                                                     // No   [4] IDEOGRAPHIC ANNOTATION ONE MARK..IDEOGRAPHIC ANNOTATION FOUR MARK
+}
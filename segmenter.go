@@ -0,0 +1,107 @@
+package uniseg
+
+// WordSegmenter finds words in text which does not separate its words, such as
+// Thai, Lao, Khmer, Myanmar, Chinese, or Japanese text. Implementations must be
+// safe for concurrent use.
+type WordSegmenter interface {
+	// FirstWord returns the length in bytes of the first word of the given
+	// text. The text starts at a word boundary. If the returned length is
+	// shorter than the text, FirstWord is called again with the remaining text,
+	// so the boundaries found in the remaining text should agree with those
+	// found in the entire text. Lengths outside the range from 1 to len(text)
+	// are clamped to it.
+	//
+	// Long runs of text are passed in windows of about a hundred bytes, so the
+	// text may end in the middle of a word.
+	FirstWord(text string) int
+}
+
+// segmentWindow is the number of bytes of a run handed to a word segmenter at
+// a time. Segmenters are called once per word, so passing them the rest of a
+// long run each time would make its segmentation quadratic.
+const segmentWindow = 128
+
+// The kinds of runs handed to word segmenters.
+const (
+	segmentNone = iota
+	segmentComplexContext
+	segmentCJK
+)
+
+// segmenterFunc returns the kind of run the given rune belongs to and the
+// segmenter responsible for it, or segmentNone and nil if the parser has no
+// segmenter for the rune.
+type segmenterFunc func(p *Parser, r rune) (int, WordSegmenter)
+
+// wordSegmenter is the segmenterFunc for word boundaries.
+func wordSegmenter(p *Parser, r rune) (int, WordSegmenter) {
+	if p == nil || p.ComplexContext == nil && p.CJK == nil {
+		return segmentNone, nil
+	}
+	if p.ComplexContext != nil && p.lineBreakOf(r).lbProperty == lbprSA {
+		return segmentComplexContext, p.ComplexContext
+	}
+	if p.CJK != nil {
+		switch scripts.search(r) {
+		case ScriptHan, ScriptHiragana, ScriptKatakana:
+			return segmentCJK, p.CJK
+		}
+		if workBreakCodePoints.search(r) == wbprKatakana {
+			return segmentCJK, p.CJK // E.g. the prolonged sound mark.
+		}
+	}
+	return segmentNone, nil
+}
+
+// lineSegmenter is the segmenterFunc for line breaks.
+func lineSegmenter(p *Parser, r rune) (int, WordSegmenter) {
+	if p == nil {
+		return segmentNone, nil
+	}
+	segmenter := p.ComplexContextBreaker
+	if segmenter == nil {
+		segmenter = p.ComplexContext
+	}
+	if segmenter != nil && p.lineBreakOf(r).lbProperty == lbprSA {
+		return segmentComplexContext, segmenter
+	}
+	return segmentNone, nil
+}
+
+// startsSegment returns true if the given rune is part of a run handled by
+// the segmenter selected by "of".
+func startsSegment(p *Parser, r rune, of segmenterFunc) bool {
+	kind, _ := of(p, r)
+	return kind != segmentNone
+}
+
+// firstSegment returns the length in bytes of the first word found by the
+// segmenter selected by "of" at the start of the given string. It returns 0 if
+// the string does not start with a run handled by a segmenter. The returned
+// length never splits a grapheme cluster.
+func firstSegment[T bytes](p *Parser, str T, decoder runeDecoder[T], of segmenterFunc) int {
+	if len(str) == 0 {
+		return 0
+	}
+
+	// Find the run, up to the size of the window.
+	r, run := decoder(str)
+	kind, segmenter := of(p, r)
+	if kind == segmentNone {
+		return 0
+	}
+	for run < len(str) && run < segmentWindow {
+		r, l := decoder(str[run:])
+		if k, _ := of(p, r); k != kind && workBreakCodePoints.search(r) != wbprExtend {
+			break
+		}
+		run += l
+	}
+
+	// Ask the segmenter for the first word.
+	n := segmenter.FirstWord(string(str[:run]))
+	n = max(1, min(n, run, maskSegmentState))
+
+	// Extend it to the end of its last grapheme cluster.
+	return clusterEnd(p, str, n, decoder)
+}
@@ -20,7 +20,7 @@ import "unicode/utf8"
 //
 // [Unicode Standard Annex #29, Sentence Boundaries]: https://www.unicode.org/reports/tr29/tr29-45.html#Sentence_Boundaries
 func FirstSentence(b []byte, state SentenceBreakState) (sentence, rest []byte, newState SentenceBreakState) {
-	return firstSentence(b, state, utf8.DecodeRune)
+	return firstSentence(DefaultParser, b, state, utf8.DecodeRune)
 }
 
 // FirstSentence returns the first sentence found in the given byte slice
@@ -40,23 +40,23 @@ func FirstSentence(b []byte, state SentenceBreakState) (sentence, rest []byte, n
 // Given an empty byte slice "b", the function returns nil values.
 //
 // [Unicode Standard Annex #29, Sentence Boundaries]: https://www.unicode.org/reports/tr29/tr29-45.html#Sentence_Boundaries
-func (*Parser) FirstSentence(b []byte, state SentenceBreakState) (sentence, rest []byte, newState SentenceBreakState) {
-	return firstSentence(b, state, utf8.DecodeRune)
+func (p *Parser) FirstSentence(b []byte, state SentenceBreakState) (sentence, rest []byte, newState SentenceBreakState) {
+	return firstSentence(p, b, state, utf8.DecodeRune)
 }
 
 // FirstSentenceInString is like [FirstSentence] but its input and outputs are
 // strings.
 func FirstSentenceInString(str string, state SentenceBreakState) (sentence, rest string, newState SentenceBreakState) {
-	return firstSentence(str, state, utf8.DecodeRuneInString)
+	return firstSentence(DefaultParser, str, state, utf8.DecodeRuneInString)
 }
 
 // FirstSentenceInString is like [Parser.FirstSentence] but its input and outputs are
 // strings.
-func (*Parser) FirstSentenceInString(str string, state SentenceBreakState) (sentence, rest string, newState SentenceBreakState) {
-	return firstSentence(str, state, utf8.DecodeRuneInString)
+func (p *Parser) FirstSentenceInString(str string, state SentenceBreakState) (sentence, rest string, newState SentenceBreakState) {
+	return firstSentence(p, str, state, utf8.DecodeRuneInString)
 }
 
-func firstSentence[T bytes](str T, state SentenceBreakState, decoder runeDecoder[T]) (sentence, rest T, newState SentenceBreakState) {
+func firstSentence[T bytes](p *Parser, str T, state SentenceBreakState, decoder runeDecoder[T]) (sentence, rest T, newState SentenceBreakState) {
 	var zero T
 
 	// An empty byte slice returns nothing.
@@ -75,16 +75,19 @@ func firstSentence[T bytes](str T, state SentenceBreakState, decoder runeDecoder
 		state, _ = transitionSentenceBreakState(state, r, str[length:], decoder)
 	}
 
-	// Transition until we find a boundary.
+	// Transition until we find a boundary which does not follow an
+	// abbreviation.
 	var boundary bool
+	abbreviation := p.abbreviationState(abbreviationStart, r)
 	for {
 		r, l := decoder(str[length:])
 		state, boundary = transitionSentenceBreakState(state, r, str[length+l:], decoder)
 
-		if boundary {
+		if boundary && !p.suppressesSentenceBoundary(abbreviation) {
 			return str[:length], str[length:], state
 		}
 
+		abbreviation = p.abbreviationState(abbreviation, r)
 		length += l
 		if len(str) <= length {
 			return str, zero, sbAny
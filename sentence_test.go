@@ -1,6 +1,8 @@
 package uniseg
 
 import (
+	"fmt"
+	"reflect"
 	"runtime"
 	"testing"
 )
@@ -131,6 +133,146 @@ func TestSentenceCasesString(t *testing.T) {
 	}
 }
 
+// Test cases for sentence break suppressions after abbreviations.
+var abbreviationTestCases = []struct {
+	locale   string
+	original string
+	expected []string
+}{
+	{"en", "Mr. Smith arrived. He left.", []string{"Mr. Smith arrived. ", "He left."}},
+	{"en", "Dr.  Jones said so. Then e.g. Bob came.", []string{"Dr.  Jones said so. ", "Then e.g. Bob came."}},
+	{"en", "It was Dr. Who. Mr. Ed came.", []string{"It was Dr. Who. ", "Mr. Ed came."}},
+	{"en", "The U.S. Army won. It was late.", []string{"The U.S. Army won. ", "It was late."}},
+	{"en", "Call Mr.\nSmith now.", []string{"Call Mr.\n", "Smith now."}},             // Paragraph separators end sentences.
+	{"en", "He is a Mr. Done. Fine.", []string{"He is a Mr. Done. ", "Fine."}},       // Only whole words match.
+	{"en", "We met AMr. Bean. Fine.", []string{"We met AMr. ", "Bean. ", "Fine."}},   // Only whole words match.
+	{"en", "He said mr. Smith. Fine.", []string{"He said mr. ", "Smith. ", "Fine."}}, // Matching is case-sensitive.
+	{"en", "See (Fig. A) now. Fine.", []string{"See (Fig. A) now. ", "Fine."}},
+	{"en-GB", "Mrs. Smith arrived. Fine.", []string{"Mrs. Smith arrived. ", "Fine."}},
+	{"de", "Das ist z.B. Text. Dr. Müller kam.", []string{"Das ist z.B. Text. ", "Dr. Müller kam."}},
+	{"fr", "Voir p. ex. Paris. M. Dupont est là.", []string{"Voir p. ex. Paris. ", "M. Dupont est là."}},
+	{"es", "La Sra. García llegó. Sí.", []string{"La Sra. García llegó. ", "Sí."}},
+	{"es", "Mire p. ej. Madrid. Sí.", []string{"Mire p. ej. Madrid. ", "Sí."}},
+	{"es", "Mire p. Madrid. Sí.", []string{"Mire p. ", "Madrid. ", "Sí."}},
+	{"it", "Il Dott. Rossi è qui. Sì.", []string{"Il Dott. Rossi è qui. ", "Sì."}},
+	{"pt", "O Sr. Silva chegou. Sim.", []string{"O Sr. Silva chegou. ", "Sim."}},
+	{"ja", "詳細はFig. Aを参照。次へ。", []string{"詳細はFig. Aを参照。", "次へ。"}},
+}
+
+// Test sentence break suppressions after abbreviations.
+func TestAbbreviations(t *testing.T) {
+	for _, testCase := range abbreviationTestCases {
+		p := &Parser{Abbreviations: LocaleAbbreviations(testCase.locale)}
+		if p.Abbreviations == nil {
+			t.Fatalf("No abbreviations for locale %q", testCase.locale)
+		}
+
+		// FirstSentenceInString.
+		var (
+			sentences []string
+			state     SentenceBreakState
+		)
+		for str := testCase.original; len(str) > 0; {
+			var sentence string
+			sentence, str, state = p.FirstSentenceInString(str, state)
+			sentences = append(sentences, sentence)
+		}
+		if !reflect.DeepEqual(sentences, testCase.expected) {
+			t.Errorf("%s %q: FirstSentenceInString returned %q, expected %q", testCase.locale, testCase.original, sentences, testCase.expected)
+		}
+
+		// FirstSentence.
+		sentences, state = nil, 0
+		for b := []byte(testCase.original); len(b) > 0; {
+			var sentence []byte
+			sentence, b, state = p.FirstSentence(b, state)
+			sentences = append(sentences, string(sentence))
+		}
+		if !reflect.DeepEqual(sentences, testCase.expected) {
+			t.Errorf("%s %q: FirstSentence returned %q, expected %q", testCase.locale, testCase.original, sentences, testCase.expected)
+		}
+
+		// StepString.
+		var (
+			current string
+			s       State
+		)
+		sentences = nil
+		for str := testCase.original; len(str) > 0; {
+			var (
+				cluster    string
+				boundaries Boundaries
+			)
+			cluster, str, boundaries, s = p.StepString(str, s)
+			current += cluster
+			if boundaries.Sentence() {
+				sentences = append(sentences, current)
+				current = ""
+			}
+		}
+		if !reflect.DeepEqual(sentences, testCase.expected) {
+			t.Errorf("%s %q: StepString returned %q, expected %q", testCase.locale, testCase.original, sentences, testCase.expected)
+		}
+
+		// Graphemes.
+		sentences, current = nil, ""
+		g := p.NewGraphemes(testCase.original)
+		for g.Next() {
+			current += g.Str()
+			if g.IsSentenceBoundary() {
+				sentences = append(sentences, current)
+				current = ""
+			}
+		}
+		if !reflect.DeepEqual(sentences, testCase.expected) {
+			t.Errorf("%s %q: Graphemes returned %q, expected %q", testCase.locale, testCase.original, sentences, testCase.expected)
+		}
+	}
+}
+
+// Test the abbreviation lists.
+func TestAbbreviationLists(t *testing.T) {
+	for _, locale := range []string{"en", "de", "fr", "es", "it", "ja", "pt"} {
+		if a := LocaleAbbreviations(locale); a == nil || a.Len() == 0 {
+			t.Errorf("Expected abbreviations for locale %q", locale)
+		}
+	}
+	if a := LocaleAbbreviations("pt_BR"); a != LocaleAbbreviations("pt") {
+		t.Error("Expected the Portuguese list for pt_BR")
+	}
+	if a := LocaleAbbreviations("xx"); a != nil {
+		t.Errorf("Expected no abbreviations for an unknown locale, got %d", a.Len())
+	}
+
+	a, err := NewAbbreviations([]string{"b.", "a.", "", "b.", "\xff."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if words := a.Words(); !reflect.DeepEqual(words, []string{"a.", "b."}) {
+		t.Errorf("Expected words [a. b.], got %q", words)
+	}
+	words := append(LocaleAbbreviations("en").Words(), "Approx.")
+	if a, err = NewAbbreviations(words); err != nil {
+		t.Fatal(err)
+	}
+	p := &Parser{Abbreviations: a}
+	if sentence, _, _ := p.FirstSentenceInString("Approx. Ten. Fine.", 0); sentence != "Approx. Ten. " {
+		t.Errorf("Expected first sentence %q, got %q", "Approx. Ten. ", sentence)
+	}
+
+	// Lists which don't fit into the state are rejected.
+	words = nil
+	for i := range 200 {
+		words = append(words, fmt.Sprintf("W%03dabc.", i))
+	}
+	if _, err := NewAbbreviations(words[:100]); err != nil {
+		t.Errorf("Expected 100 words to fit, got %v", err)
+	}
+	if _, err := NewAbbreviations(words); err == nil {
+		t.Error("Expected an error for 200 words")
+	}
+}
+
 // Benchmark the use of the sentence break function for byte slices.
 func BenchmarkSentenceFunctionBytes(b *testing.B) {
 	input := []byte(benchmarkStr)
@@ -2,23 +2,32 @@ package uniseg
 
 import "unicode/utf8"
 
-// State is the type of the state of the [Step] parser.
-type State int
+// State is the type of the state of the [Step] parser. Besides the states of
+// the boundary algorithms, it holds the states of the parser's segmenters,
+// tokens, URLs, hyphenation, and abbreviations. It has 64 bits on all
+// platforms.
+type State int64
 
-func newState(gr grState, wb WordBreakState, sb SentenceBreakState, lb LineBreakState, prop property) State {
+func newState(gr grState, wb WordBreakState, sb SentenceBreakState, lb LineBreakState, wordSegment, lineSegment, abbreviation, flags int) State {
 	return State(gr) |
 		State(wb<<shiftWordState) |
 		State(sb<<shiftSentenceState) |
 		State(lb<<shiftLineState) |
-		State(prop<<shiftPropState)
+		State(wordSegment)<<shiftWordSegmentState |
+		State(lineSegment)<<shiftLineSegmentState |
+		State(flags)<<shiftFlagsState |
+		State(abbreviation)<<shiftAbbreviationState
 }
 
-func (s State) unpack() (gr grState, wb WordBreakState, sb SentenceBreakState, lb LineBreakState, prop property) {
+func (s State) unpack() (gr grState, wb WordBreakState, sb SentenceBreakState, lb LineBreakState, wordSegment, lineSegment, abbreviation, flags int) {
 	gr = grState(s & maskGraphemeState)
 	wb = WordBreakState((s >> shiftWordState) & maskWordState)
 	sb = SentenceBreakState((s >> shiftSentenceState) & maskSentenceState)
 	lb = LineBreakState((s >> shiftLineState) & maskLineState)
-	prop = property(s >> shiftPropState)
+	wordSegment = int((s >> shiftWordSegmentState) & maskSegmentState)
+	lineSegment = int((s >> shiftLineSegmentState) & maskSegmentState)
+	abbreviation = int((s >> shiftAbbreviationState) & maskAbbreviationState)
+	flags = int((s >> shiftFlagsState) & maskFlagsState)
 	return
 }
 
@@ -75,22 +84,35 @@ const (
 
 // The bit positions by which states are shifted by the [Step] function. These
 // values must ensure state values defined for each of the boundary algorithms
-// don't overlap (and that they all still fit in a single int). These must
-// correspond to the Mask constants.
+// don't overlap and that all of them fit in a [State]. These must correspond
+// to the Mask constants.
 const (
-	shiftWordState     = 8
-	shiftSentenceState = 13
-	shiftLineState     = 17
-	shiftPropState     = 25 // No mask as these are always the remaining bits.
+	shiftWordState         = 6
+	shiftSentenceState     = 11
+	shiftLineState         = 15
+	shiftWordSegmentState  = 30
+	shiftLineSegmentState  = 40
+	shiftFlagsState        = 50
+	shiftAbbreviationState = 53
 )
 
 // The bit mask used to extract the state returned by the [Step] function, after
 // shifting. These values must correspond to the shift constants.
 const (
-	maskGraphemeState = 0xff
-	maskWordState     = 0x1f
-	maskSentenceState = 0xf
-	maskLineState     = 0xff
+	maskGraphemeState     = 0x3f
+	maskWordState         = 0x1f
+	maskSentenceState     = 0xf
+	maskLineState         = 0x7fff // Including the lb*Bit flags.
+	maskSegmentState      = 0x3ff  // The remaining bytes of a segment found by a segmenter.
+	maskFlagsState        = 0x7
+	maskAbbreviationState = 0x3ff // The state of the matcher of Parser.Abbreviations.
+)
+
+// The flags stored in the [Step] state.
+const (
+	stateWordToken  = 1 << iota // The word segment is a token found by firstToken.
+	stateLineURL                // The line segment is a URL found by firstURL.
+	stateLineHyphen             // The segment counters hold the hyphenWindow of the current word.
 )
 
 // Step returns the first grapheme cluster (user-perceived character) found in
@@ -127,7 +149,7 @@ const (
 //
 // [UAX #14 LB3]: https://www.unicode.org/reports/tr14/tr14-53.html#Algorithm
 func Step(b []byte, state State) (cluster, rest []byte, boundaries Boundaries, newState State) {
-	return step(DefaultParser, b, state, utf8.DecodeRune)
+	return step(DefaultParser, b, state, nil, utf8.DecodeRune)
 }
 
 // Step returns the first grapheme cluster (user-perceived character) found in
@@ -164,20 +186,22 @@ func Step(b []byte, state State) (cluster, rest []byte, boundaries Boundaries, n
 //
 // [UAX #14 LB3]: https://www.unicode.org/reports/tr14/tr14-53.html#Algorithm
 func (p *Parser) Step(b []byte, state State) (cluster, rest []byte, boundaries Boundaries, newState State) {
-	return step(p, b, state, utf8.DecodeRune)
+	return step(p, b, state, nil, utf8.DecodeRune)
 }
 
 // StepString is like [Step] but its input and outputs are strings.
 func StepString(str string, state State) (cluster, rest string, boundaries Boundaries, newState State) {
-	return step(DefaultParser, str, state, utf8.DecodeRuneInString)
+	return step(DefaultParser, str, state, nil, utf8.DecodeRuneInString)
 }
 
 // StepString is like [Parser.Step] but its input and outputs are strings.
 func (p *Parser) StepString(str string, state State) (cluster, rest string, boundaries Boundaries, newState State) {
-	return step(p, str, state, utf8.DecodeRuneInString)
+	return step(p, str, state, nil, utf8.DecodeRuneInString)
 }
 
-func step[T bytes](p *Parser, str T, state State, decoder runeDecoder[T]) (cluster, rest T, boundaries Boundaries, _newState State) {
+// step implements [Step]. The hyphenation points of words are stored in
+// "hyphens" if it is not nil. Otherwise, the state holds their hyphenWindow.
+func step[T bytes](p *Parser, str T, state State, hyphens *hyphenationPoints, decoder runeDecoder[T]) (cluster, rest T, boundaries Boundaries, _newState State) {
 	var zero T
 
 	// An empty byte slice returns nothing.
@@ -190,7 +214,7 @@ func step[T bytes](p *Parser, str T, state State, decoder runeDecoder[T]) (clust
 	if len(str) <= length { // If we're already past the end, there is nothing else to parse.
 		prop := graphemeCodePoints.search(r)
 		boundaries := newBoundaries(LineMustBreak, true, true, runeWidth(p, r, prop))
-		_newState := newState(grAny, wbAny, sbAny, lbAny, prop)
+		_newState := newState(grAny, wbAny, sbAny, lbAny, 0, 0, 0, 0)
 		return str, zero, boundaries, _newState
 	}
 
@@ -199,19 +223,51 @@ func step[T bytes](p *Parser, str T, state State, decoder runeDecoder[T]) (clust
 	var wordState WordBreakState
 	var sentenceState SentenceBreakState
 	var lineState LineBreakState
+	var wordSegment, lineSegment int // The number of bytes left in the current segments found by segmenters.
+	var abbreviation int             // The state of the abbreviation matcher.
+	var flags int                    // The state flags describing the segments.
+	var hyphenation hyphenWindow     // The hyphenation points of the current word, see startHyphenation.
 	var firstProp property
 	remainder := str[length:]
 	if state <= 0 {
-		graphemeState, firstProp, _ = transitionGraphemeState(0, r)
+		graphemeState, firstProp, _ = transitionGraphemeState(p, 0, r)
 		wordState, _ = transitionWordBreakState(0, r, remainder, decoder)
 		sentenceState, _ = transitionSentenceBreakState(0, r, remainder, decoder)
-		lineState, _ = transitionLineBreakState(0, r, remainder, decoder)
+		lineState, _ = transitionLineBreakState(p, 0, r, remainder, decoder)
 	} else {
-		graphemeState, wordState, sentenceState, lineState, firstProp = state.unpack()
+		graphemeState, wordState, sentenceState, lineState, wordSegment, lineSegment, abbreviation, flags = state.unpack()
+		firstProp = graphemeCodePoints.search(r)
+		if flags&stateLineHyphen != 0 {
+			hyphenation = hyphenWindow(lineSegment | wordSegment<<10)
+			wordSegment, lineSegment = 0, 0
+		}
+	}
+	abbreviation = p.abbreviationState(abbreviation, r)
+	if state <= 0 {
+		var kind TokenKind
+		wordSegment, kind = firstToken(p, str, decoder)
+		if kind != TokenNone {
+			flags |= stateWordToken
+		}
+		if lineSegment = firstURL(p, str, -1, decoder); lineSegment > 0 {
+			flags |= stateLineURL
+		} else if wordSegment == 0 {
+			var found bool
+			if hyphenation, found = startHyphenation(p, str, -1, decoder, hyphens); found {
+				flags |= stateLineHyphen
+			}
+		}
+	}
+	if wordSegment == 0 {
+		wordSegment = firstSegment(p, str, decoder, wordSegmenter)
+	}
+	if lineSegment == 0 {
+		lineSegment = firstSegment(p, str, decoder, lineSegmenter)
 	}
 	width := runeWidth(p, r, firstProp)
 
 	// Transition until we find a grapheme cluster boundary.
+	prev := r
 	for {
 		var (
 			graphemeBoundary, wordBoundary, sentenceBoundary bool
@@ -222,31 +278,92 @@ func step[T bytes](p *Parser, str T, state State, decoder runeDecoder[T]) (clust
 		r, l := decoder(remainder)
 		remainder = str[length+l:]
 
-		graphemeState, prop, graphemeBoundary = transitionGraphemeState(graphemeState, r)
+		graphemeState, prop, graphemeBoundary = transitionGraphemeState(p, graphemeState, r)
 		wordState, wordBoundary = transitionWordBreakState(wordState, r, remainder, decoder)
 		sentenceState, sentenceBoundary = transitionSentenceBreakState(sentenceState, r, remainder, decoder)
-		lineState, lineBreak = transitionLineBreakState(lineState, r, remainder, decoder)
+		lineState, lineBreak = transitionLineBreakState(p, lineState, r, remainder, decoder)
 
 		if graphemeBoundary {
+			// Suppress sentence boundaries after abbreviations.
+			if sentenceBoundary && p.suppressesSentenceBoundary(abbreviation) {
+				sentenceBoundary = false
+			}
+
+			// Observe the boundaries found by segmenters.
+			if wordSegment > length {
+				wordBoundary = false
+				wordSegment = min(wordSegment-length, maskSegmentState)
+			} else if wordSegment > 0 {
+				if flags&stateWordToken != 0 || startsSegment(p, r, wordSegmenter) {
+					wordBoundary = true // The token ends or the segmenter continues with another word.
+				}
+				wordSegment, flags = 0, flags&^stateWordToken
+			}
+			if wordBoundary && wordSegment == 0 {
+				var kind TokenKind
+				if wordSegment, kind = firstToken(p, str[length:], decoder); kind != TokenNone {
+					flags |= stateWordToken
+				}
+			}
+			if lineSegment > length {
+				if flags&stateLineURL != 0 {
+					lineBreak = urlLineBreak(prev, r)
+				} else if lineBreak == LineCanBreak {
+					lineBreak = LineDontBreak
+				}
+				lineSegment = min(lineSegment-length, maskSegmentState)
+			} else if lineSegment > 0 {
+				if flags&stateLineURL == 0 && lineBreak == LineDontBreak && startsSegment(p, r, lineSegmenter) {
+					lineBreak = LineCanBreak // The segmenter continues with another segment.
+				}
+				lineSegment, flags = 0, flags&^stateLineURL
+			}
+			if lineSegment == 0 {
+				if lineSegment = firstURL(p, str[length:], prev, decoder); lineSegment > 0 {
+					flags |= stateLineURL
+				}
+			}
+
+			// Observe hyphenation points.
+			if flags&stateLineHyphen != 0 {
+				var point, more bool
+				if hyphens != nil {
+					point, more = hyphens.advance(length)
+				} else {
+					hyphenation, point, more = advanceHyphenWindow(p, str, hyphenation, runeCount(str[:length], decoder), decoder)
+				}
+				if point && lineBreak == LineDontBreak {
+					lineBreak = LineHyphenBreak
+				}
+				if !more || wordSegment != 0 || lineSegment != 0 {
+					hyphenation, flags = 0, flags&^stateLineHyphen
+				}
+			} else if wordSegment == 0 && lineSegment == 0 {
+				var found bool
+				if hyphenation, found = startHyphenation(p, str[length:], prev, decoder, hyphens); found {
+					flags |= stateLineHyphen
+				}
+			}
+			if prev == softHyphen && lineBreak == LineCanBreak && p.Hyphens >= HyphensManual {
+				lineBreak = LineHyphenBreak
+			}
+			if flags&stateLineHyphen != 0 {
+				wordSegment, lineSegment = int(hyphenation)>>10, int(hyphenation)&maskSegmentState
+			}
+
 			boundary := newBoundaries(lineBreak, wordBoundary, sentenceBoundary, width)
-			_newState := newState(graphemeState, wordState, sentenceState, lineState, prop)
+			_newState := newState(graphemeState, wordState, sentenceState, lineState, wordSegment, lineSegment, abbreviation, flags)
 			return str[:length], str[length:], boundary, _newState
 		}
 
-		if firstProp == prExtendedPictographic {
-			if r == vs15 {
-				width = 1
-			} else if r == vs16 {
-				width = 2
-			}
-		} else if firstProp != prRegionalIndicator && firstProp != prL {
-			width += runeWidth(p, r, prop)
-		}
+		width = clusterWidth(p, width, firstProp, prev, r, prop)
+		abbreviation = p.abbreviationState(abbreviation, r)
+		prev = r
 
 		length += l
 		if len(str) <= length {
 			boundaries := newBoundaries(LineMustBreak, true, true, width)
-			_newState := newState(grAny, wbAny, sbAny, lbAny, prop)
+			_newState := newState(grAny, wbAny, sbAny, lbAny, 0, 0, 0, 0)
 			return str, zero, boundaries, _newState
 		}
 	}
@@ -222,11 +222,48 @@ func TestStepBytesSentence(t *testing.T) {
 	}
 }
 
-// We don't test the [Step] function for UAX #14 line breaking because the rules
-// aren't really compatible. Specifically emoji modifiers and zero-width joiners
-// are kept together by the grapheme cluster rules while line breaking rules
-// will allow them to be broken apart. The handling of this limitation is
-// outlined in Section 8.2 Example 6 of UAX #14.
+// Test official line break Unicode test cases for grapheme clusters using the
+// [Step] function. The rules aren't fully compatible: emoji modifiers and
+// zero-width joiners are kept together by the grapheme cluster rules while line
+// breaking rules will allow them to be broken apart. The handling of this
+// limitation is outlined in Section 8.2 Example 6 of UAX #14. We therefore only
+// check line breaks at grapheme cluster boundaries.
+func TestStepBytesLine(t *testing.T) {
+	for testNum, testCase := range lineBreakTestCases {
+		breaks := lineBreakOffsets(testCase.expected)
+		b := []byte(testCase.original)
+		var (
+			offset     int
+			boundaries Boundaries
+			c          []byte
+			state      State
+		)
+		for len(b) > 0 {
+			c, b, boundaries, state = Step(b, state)
+			offset += len(c)
+			if len(b) == 0 {
+				if boundaries.Line() != LineMustBreak {
+					t.Errorf(`Test case %d %q: %q failed: Expected mandatory line break at the end, got %d`,
+						testNum,
+						testCase.name,
+						testCase.original,
+						boundaries.Line())
+				}
+				break
+			}
+			if (boundaries.Line() != LineDontBreak) != breaks[offset] {
+				t.Errorf(`Test case %d %q: %q failed: Line break at byte offset %d is %d, expected break %t`,
+					testNum,
+					testCase.name,
+					testCase.original,
+					offset,
+					boundaries.Line(),
+					breaks[offset])
+				break
+			}
+		}
+	}
+}
 
 // Test official Grapheme Cluster Unicode test cases for grapheme clusters using
 // the StepString() function.
@@ -441,6 +478,45 @@ func TestStepStringSentence(t *testing.T) {
 	}
 }
 
+// Test official line break Unicode test cases for grapheme clusters using the
+// [StepString] function. See [TestStepBytesLine] for details.
+func TestStepStringLine(t *testing.T) {
+	for testNum, testCase := range lineBreakTestCases {
+		breaks := lineBreakOffsets(testCase.expected)
+		str := testCase.original
+		var (
+			offset     int
+			boundaries Boundaries
+			c          string
+			state      State
+		)
+		for len(str) > 0 {
+			c, str, boundaries, state = StepString(str, state)
+			offset += len(c)
+			if len(str) == 0 {
+				if boundaries.Line() != LineMustBreak {
+					t.Errorf(`Test case %d %q: %q failed: Expected mandatory line break at the end, got %d`,
+						testNum,
+						testCase.name,
+						testCase.original,
+						boundaries.Line())
+				}
+				break
+			}
+			if (boundaries.Line() != LineDontBreak) != breaks[offset] {
+				t.Errorf(`Test case %d %q: %q failed: Line break at byte offset %d is %d, expected break %t`,
+					testNum,
+					testCase.name,
+					testCase.original,
+					offset,
+					boundaries.Line(),
+					breaks[offset])
+				break
+			}
+		}
+	}
+}
+
 // Benchmark the use of the [Step] function.
 func BenchmarkStepBytes(b *testing.B) {
 	input := []byte(benchmarkStr)
@@ -0,0 +1,96 @@
+package uniseg
+
+// SubstringGraphemes returns the substring of s from grapheme cluster index
+// "start" up to, but not including, grapheme cluster index "end", i.e. the
+// user-perceived characters start to end-1. Negative indexes count from the
+// end of the string: -1 is the last grapheme cluster. Like in Python, indexes
+// are clamped to the range of the string, and an empty string is returned if
+// "start" is not before "end". For example, SubstringGraphemes(s, 0, 20)
+// returns the first 20 user-perceived characters of s, or all of s if it is
+// shorter.
+//
+// This function does not allocate. If an index is negative, the string is
+// parsed twice.
+func SubstringGraphemes(s string, start, end int) string {
+	return DefaultParser.SubstringGraphemes(s, start, end)
+}
+
+// SubstringGraphemes is like [SubstringGraphemes] but uses the given parser.
+func (p *Parser) SubstringGraphemes(s string, start, end int) string {
+	if start < 0 || end < 0 {
+		n := p.GraphemeClusterCount(s)
+		start, end = graphemeIndex(start, n), graphemeIndex(end, n)
+	}
+	if end <= start {
+		return ""
+	}
+
+	var (
+		index, from, to int
+		cluster         string
+		state           GraphemeBreakState
+	)
+	for rest := s; len(rest) > 0 && index < end; index++ {
+		if index == start {
+			from = to
+		}
+		cluster, rest, _, state = p.FirstGraphemeClusterInString(rest, state)
+		to += len(cluster)
+	}
+	if index <= start {
+		return "" // The string has no more than "start" grapheme clusters.
+	}
+	return s[from:to]
+}
+
+// GraphemeAt returns the grapheme cluster of s at the given index. Negative
+// indexes count from the end of the string: -1 is the last grapheme cluster.
+// An empty string is returned if the index is out of range.
+//
+// This function does not allocate. If the index is negative, the string is
+// parsed twice.
+func GraphemeAt(s string, i int) string {
+	return DefaultParser.GraphemeAt(s, i)
+}
+
+// GraphemeAt is like [GraphemeAt] but uses the given parser.
+func (p *Parser) GraphemeAt(s string, i int) string {
+	if i < 0 {
+		if i += p.GraphemeClusterCount(s); i < 0 {
+			return ""
+		}
+	}
+	return p.SubstringGraphemes(s, i, i+1)
+}
+
+// GraphemeOffsets returns the byte offsets of the grapheme clusters of s,
+// followed by len(s). The grapheme cluster with index i is therefore
+// s[offsets[i]:offsets[i+1]], and the number of grapheme clusters is
+// len(offsets)-1. The offsets may be used to slice s repeatedly without
+// parsing it again. For an empty string, []int{0} is returned.
+func GraphemeOffsets(s string) []int {
+	return DefaultParser.GraphemeOffsets(s)
+}
+
+// GraphemeOffsets is like [GraphemeOffsets] but uses the given parser.
+func (p *Parser) GraphemeOffsets(s string) []int {
+	offsets := []int{0}
+	var (
+		cluster string
+		state   GraphemeBreakState
+	)
+	for rest := s; len(rest) > 0; {
+		cluster, rest, _, state = p.FirstGraphemeClusterInString(rest, state)
+		offsets = append(offsets, offsets[len(offsets)-1]+len(cluster))
+	}
+	return offsets
+}
+
+// graphemeIndex resolves a grapheme cluster index for a string with n grapheme
+// clusters. Negative indexes count from the end and are clamped to 0.
+func graphemeIndex(i, n int) int {
+	if i < 0 {
+		return max(i+n, 0)
+	}
+	return i
+}
@@ -0,0 +1,90 @@
+package uniseg
+
+import (
+	"reflect"
+	"testing"
+)
+
+// substringTestString has four grapheme clusters: "a", "o" with a combining
+// diaeresis, the rainbow flag, and the German flag.
+const substringTestString = "ao\u0308🏳️\u200d🌈🇩🇪"
+
+func TestSubstringGraphemes(t *testing.T) {
+	for _, tt := range []struct {
+		start, end int
+		expected   string
+	}{
+		{0, 4, substringTestString},
+		{0, 1, "a"},
+		{1, 3, "o\u0308🏳️\u200d🌈"},
+		{3, 4, "🇩🇪"},
+		{0, 100, substringTestString},
+		{2, 100, "🏳️\u200d🌈🇩🇪"},
+		{4, 100, ""},
+		{100, 200, ""},
+		{2, 2, ""},
+		{3, 1, ""},
+		{-1, 4, "🇩🇪"},
+		{-2, -1, "🏳️\u200d🌈"},
+		{0, -3, "a"},
+		{-100, 1, "a"},
+		{-100, -100, ""},
+		{1, -100, ""},
+	} {
+		if got := SubstringGraphemes(substringTestString, tt.start, tt.end); got != tt.expected {
+			t.Errorf("SubstringGraphemes(%d, %d) = %q, want %q", tt.start, tt.end, got, tt.expected)
+		}
+	}
+	if got := SubstringGraphemes("", 0, 1); got != "" {
+		t.Errorf("SubstringGraphemes of an empty string = %q, want an empty string", got)
+	}
+}
+
+func TestGraphemeAt(t *testing.T) {
+	for _, tt := range []struct {
+		index    int
+		expected string
+	}{
+		{0, "a"},
+		{1, "o\u0308"},
+		{3, "🇩🇪"},
+		{4, ""},
+		{-1, "🇩🇪"},
+		{-4, "a"},
+		{-5, ""},
+	} {
+		if got := GraphemeAt(substringTestString, tt.index); got != tt.expected {
+			t.Errorf("GraphemeAt(%d) = %q, want %q", tt.index, got, tt.expected)
+		}
+	}
+	if got := GraphemeAt("", -1); got != "" {
+		t.Errorf("GraphemeAt of an empty string = %q, want an empty string", got)
+	}
+}
+
+func TestGraphemeOffsets(t *testing.T) {
+	expected := []int{0, 1, 4, 18, 26}
+	if got := GraphemeOffsets(substringTestString); !reflect.DeepEqual(got, expected) {
+		t.Errorf("GraphemeOffsets = %v, want %v", got, expected)
+	}
+	if got := GraphemeOffsets(""); !reflect.DeepEqual(got, []int{0}) {
+		t.Errorf("GraphemeOffsets of an empty string = %v, want [0]", got)
+	}
+
+	// Legacy grapheme clusters.
+	p := &Parser{LegacyGraphemeClusters: true}
+	if got := p.GraphemeOffsets("ำำ"); !reflect.DeepEqual(got, []int{0, 3, 6}) {
+		t.Errorf("GraphemeOffsets of legacy clusters = %v, want [0 3 6]", got)
+	}
+}
+
+func TestSubstringGraphemesAllocations(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		SubstringGraphemes(substringTestString, 1, 3)
+		SubstringGraphemes(substringTestString, -2, -1)
+		GraphemeAt(substringTestString, -1)
+	})
+	if allocs > 0 {
+		t.Errorf("got %.1f allocations, want 0", allocs)
+	}
+}
@@ -0,0 +1,234 @@
+package uniseg
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Tables is a set of Unicode property tables which a [Parser] uses instead of
+// the built-in ones. This allows tailoring the East_Asian_Width and Line_Break
+// properties of individual code points, e.g. to match a specific font or
+// locale, without changing this package.
+//
+// Tables are usually not built by hand but generated by the gen_properties
+// command (see github.com/shogo82148/uniseg/cmd/gen_properties), which merges
+// a file of overrides into the Unicode Character Database and emits Go code
+// calling [NewTables].
+type Tables struct {
+	eastAsianWidth dictionary[eawProperty]
+	lineBreak      dictionary[propertyGeneralCategory]
+}
+
+// PropertyRange assigns a property value to the code points from Lo to Hi
+// (inclusive).
+type PropertyRange struct {
+	Lo rune
+	Hi rune
+
+	// Value is the short property value alias as used in the Unicode Character
+	// Database, e.g. "W" for East_Asian_Width or "AL" for Line_Break.
+	Value string
+
+	// GeneralCategory is the two-letter General_Category alias of the code
+	// points, e.g. "Lu". It is only used for the Line_Break property and may be
+	// empty.
+	GeneralCategory string
+}
+
+// NewTables returns a table set built from the given East_Asian_Width and
+// Line_Break ranges. The ranges may be given in any order but they must not
+// overlap. Code points not covered by any range have the default value of the
+// property ("N" and "XX", respectively). If a slice is nil, the built-in table
+// is used for that property.
+func NewTables(eastAsianWidth, lineBreak []PropertyRange) (*Tables, error) {
+	var t Tables
+	if eastAsianWidth != nil {
+		d, err := newDictionary(eastAsianWidth, func(r PropertyRange) (eawProperty, error) {
+			v, ok := eawPropertyAliases[r.Value]
+			if !ok {
+				return 0, fmt.Errorf("uniseg: unknown East_Asian_Width value %q for %04X..%04X", r.Value, r.Lo, r.Hi)
+			}
+			return v, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		t.eastAsianWidth = d
+	}
+	if lineBreak != nil {
+		d, err := newDictionary(lineBreak, func(r PropertyRange) (propertyGeneralCategory, error) {
+			v, ok := lbPropertyAliases[r.Value]
+			if !ok {
+				return propertyGeneralCategory{}, fmt.Errorf("uniseg: unknown Line_Break value %q for %04X..%04X", r.Value, r.Lo, r.Hi)
+			}
+			gc, ok := generalCategoryAliases[r.GeneralCategory]
+			if !ok {
+				return propertyGeneralCategory{}, fmt.Errorf("uniseg: unknown General_Category value %q for %04X..%04X", r.GeneralCategory, r.Lo, r.Hi)
+			}
+			return propertyGeneralCategory{v, gc}, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		t.lineBreak = d
+	}
+	return &t, nil
+}
+
+// newDictionary converts the given ranges into a dictionary, using the
+// function "value" to translate the property values.
+func newDictionary[T any](ranges []PropertyRange, value func(PropertyRange) (T, error)) (dictionary[T], error) {
+	sorted := make([]dictionaryEntry[T], 0, len(ranges))
+	for _, r := range ranges {
+		if r.Lo > r.Hi {
+			return nil, fmt.Errorf("uniseg: invalid range %04X..%04X", r.Lo, r.Hi)
+		}
+		v, err := value(r)
+		if err != nil {
+			return nil, err
+		}
+		sorted = append(sorted, dictionaryEntry[T]{runeRange{r.Lo, r.Hi}, v})
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].runeRange.Lo < sorted[j].runeRange.Lo
+	})
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].runeRange.Lo <= sorted[i-1].runeRange.Hi {
+			return nil, fmt.Errorf("uniseg: overlapping ranges %04X..%04X and %04X..%04X",
+				sorted[i-1].runeRange.Lo, sorted[i-1].runeRange.Hi, sorted[i].runeRange.Lo, sorted[i].runeRange.Hi)
+		}
+	}
+
+	// Arrange the entries in Eytzinger order as expected by dictionary.search.
+	d := make(dictionary[T], len(sorted))
+	var i int
+	var eytzinger func(k int)
+	eytzinger = func(k int) {
+		if k < len(sorted) {
+			eytzinger(2*k + 1)
+			d[k] = sorted[i]
+			i++
+			eytzinger(2*k + 2)
+		}
+	}
+	eytzinger(0)
+	return d, nil
+}
+
+// eastAsianWidthOf returns the East_Asian_Width property of the given rune,
+// using the parser's custom tables if present.
+func (p *Parser) eastAsianWidthOf(r rune) eawProperty {
+	if p != nil && p.Tables != nil && p.Tables.eastAsianWidth != nil {
+		return p.Tables.eastAsianWidth.search(r)
+	}
+	return eastAsianWidth.search(r)
+}
+
+// lineBreakOf returns the Line_Break property and the General_Category of the
+// given rune, using the parser's custom tables if present.
+func (p *Parser) lineBreakOf(r rune) propertyGeneralCategory {
+	if p != nil && p.Tables != nil && p.Tables.lineBreak != nil {
+		return p.Tables.lineBreak.search(r)
+	}
+	return lineBreakCodePoints.search(r)
+}
+
+// eawPropertyAliases maps East_Asian_Width value aliases to their properties.
+var eawPropertyAliases = map[string]eawProperty{
+	"N":  eawprN,
+	"Na": eawprNa,
+	"A":  eawprA,
+	"W":  eawprW,
+	"H":  eawprH,
+	"F":  eawprF,
+}
+
+// lbPropertyAliases maps Line_Break value aliases to their properties.
+var lbPropertyAliases = map[string]lbProperty{
+	"XX":  lbprXX,
+	"BK":  lbprBK,
+	"CR":  lbprCR,
+	"LF":  lbprLF,
+	"CM":  lbprCM,
+	"NL":  lbprNL,
+	"SG":  lbprSG,
+	"WJ":  lbprWJ,
+	"ZW":  lbprZW,
+	"GL":  lbprGL,
+	"SP":  lbprSP,
+	"ZWJ": lbprZWJ,
+	"B2":  lbprB2,
+	"BA":  lbprBA,
+	"BB":  lbprBB,
+	"HY":  lbprHY,
+	"HH":  lbprHH,
+	"CB":  lbprCB,
+	"CL":  lbprCL,
+	"CP":  lbprCP,
+	"EX":  lbprEX,
+	"IN":  lbprIN,
+	"NS":  lbprNS,
+	"OP":  lbprOP,
+	"QU":  lbprQU,
+	"IS":  lbprIS,
+	"NU":  lbprNU,
+	"PO":  lbprPO,
+	"PR":  lbprPR,
+	"SY":  lbprSY,
+	"AI":  lbprAI,
+	"AK":  lbprAK,
+	"AL":  lbprAL,
+	"AP":  lbprAP,
+	"AS":  lbprAS,
+	"CJ":  lbprCJ,
+	"EB":  lbprEB,
+	"EM":  lbprEM,
+	"H2":  lbprH2,
+	"H3":  lbprH3,
+	"HL":  lbprHL,
+	"ID":  lbprID,
+	"JL":  lbprJL,
+	"JT":  lbprJT,
+	"JV":  lbprJV,
+	"RI":  lbprRI,
+	"SA":  lbprSA,
+	"VF":  lbprVF,
+	"VI":  lbprVI,
+}
+
+// generalCategoryAliases maps General_Category value aliases to their
+// properties. The empty string stands for "unknown".
+var generalCategoryAliases = map[string]generalCategory{
+	"":   gcNone,
+	"Cc": gcCc,
+	"Zs": gcZs,
+	"Po": gcPo,
+	"Sc": gcSc,
+	"Ps": gcPs,
+	"Pe": gcPe,
+	"Sm": gcSm,
+	"Pd": gcPd,
+	"Nd": gcNd,
+	"Lu": gcLu,
+	"Sk": gcSk,
+	"Pc": gcPc,
+	"Ll": gcLl,
+	"So": gcSo,
+	"Lo": gcLo,
+	"Pi": gcPi,
+	"Cf": gcCf,
+	"No": gcNo,
+	"Pf": gcPf,
+	"LC": gcLC,
+	"L&": gcLC,
+	"Lm": gcLm,
+	"Mn": gcMn,
+	"Me": gcMe,
+	"Mc": gcMc,
+	"Nl": gcNl,
+	"Zl": gcZl,
+	"Zp": gcZp,
+	"Cn": gcCn,
+	"Cs": gcCs,
+	"Co": gcCo,
+}
@@ -0,0 +1,86 @@
+package uniseg
+
+import "testing"
+
+func TestNewTables(t *testing.T) {
+	tables, err := NewTables(
+		[]PropertyRange{
+			{Lo: 0x2470, Hi: 0x24ff, Value: "W"},
+			{Lo: 0x00b7, Hi: 0x00b7, Value: "A"},
+		},
+		[]PropertyRange{
+			{Lo: 0x0020, Hi: 0x0020, Value: "SP", GeneralCategory: "Zs"},
+			{Lo: 0x0041, Hi: 0x005a, Value: "AL", GeneralCategory: "Lu"},
+			{Lo: 0x002d, Hi: 0x002d, Value: "GL", GeneralCategory: "Pd"},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &Parser{Tables: tables}
+
+	// East_Asian_Width.
+	if got := p.StringWidth("⑰"); got != 2 {
+		t.Errorf("StringWidth(%q) = %d, want 2", "⑰", got)
+	}
+	if got := (&Parser{}).StringWidth("⑰"); got != 1 {
+		t.Errorf("StringWidth(%q) without tables = %d, want 1", "⑰", got)
+	}
+	if got := p.StringWidth("·"); got != 1 {
+		t.Errorf("StringWidth(%q) = %d, want 1", "·", got)
+	}
+	if got := (&Parser{Tables: tables, EastAsianWidth: true}).StringWidth("·"); got != 2 {
+		t.Errorf("StringWidth(%q) with EastAsianWidth = %d, want 2", "·", got)
+	}
+
+	// Line_Break: "-" is tailored to GL, so "A-B" must not be broken.
+	segment, rest, _, _ := p.FirstLineSegmentInString("A-B C", 0)
+	if segment != "A-B " || rest != "C" {
+		t.Errorf("FirstLineSegmentInString = %q, %q, want %q, %q", segment, rest, "A-B ", "C")
+	}
+	var boundaries Boundaries
+	var state State
+	str := "A-B C"
+	var lines []string
+	start := 0
+	for pos := 0; len(str) > 0; {
+		var cluster string
+		cluster, str, boundaries, state = p.StepString(str, state)
+		pos += len(cluster)
+		if boundaries.Line() != LineDontBreak {
+			lines = append(lines, "A-B C"[start:pos])
+			start = pos
+		}
+	}
+	if len(lines) != 2 || lines[0] != "A-B " {
+		t.Errorf("StepString gave line segments %q, want [\"A-B \" \"C\"]", lines)
+	}
+
+	// Built-in tables are used for properties without custom tables.
+	tables, err = NewTables(nil, []PropertyRange{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := (&Parser{Tables: tables}).StringWidth("あ"); got != 2 {
+		t.Errorf("StringWidth(%q) = %d, want 2", "あ", got)
+	}
+}
+
+func TestNewTablesErrors(t *testing.T) {
+	tests := []struct {
+		name           string
+		eastAsianWidth []PropertyRange
+		lineBreak      []PropertyRange
+	}{
+		{"unknown east asian width", []PropertyRange{{Lo: 0x41, Hi: 0x41, Value: "X"}}, nil},
+		{"unknown line break", nil, []PropertyRange{{Lo: 0x41, Hi: 0x41, Value: "XY"}}},
+		{"unknown general category", nil, []PropertyRange{{Lo: 0x41, Hi: 0x41, Value: "AL", GeneralCategory: "Xx"}}},
+		{"invalid range", []PropertyRange{{Lo: 0x42, Hi: 0x41, Value: "W"}}, nil},
+		{"overlapping ranges", []PropertyRange{{Lo: 0x41, Hi: 0x50, Value: "W"}, {Lo: 0x50, Hi: 0x60, Value: "N"}}, nil},
+	}
+	for _, tt := range tests {
+		if _, err := NewTables(tt.eastAsianWidth, tt.lineBreak); err == nil {
+			t.Errorf("%s: NewTables returned no error", tt.name)
+		}
+	}
+}
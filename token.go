@@ -0,0 +1,330 @@
+package uniseg
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TokenKind identifies kinds of tokens, such as URLs or email addresses, which
+// consist of several words according to [UAX #29] but are kept together as one
+// word if they are set in [Parser.Tokens]. Kinds may be combined with the |
+// operator.
+//
+// [UAX #29]: https://www.unicode.org/reports/tr29/tr29-45.html#Word_Boundaries
+type TokenKind int
+
+// The kinds of tokens.
+const (
+	TokenURL     TokenKind = 1 << iota // URLs such as "https://example.com/a?b=c" or "www.example.com".
+	TokenEmail                         // Email addresses such as "user@example.com".
+	TokenHashtag                       // Hashtags such as "#go-lang".
+	TokenMention                       // Mentions such as "@alice".
+
+	TokenNone TokenKind = 0                                                   // No token, i.e. an ordinary word.
+	AllTokens           = TokenURL | TokenEmail | TokenHashtag | TokenMention // All kinds of tokens.
+)
+
+// MaxTokenLength is the maximum length in bytes of the tokens selected by
+// [Parser.Tokens]. Longer tokens are split into words like other text by all
+// functions. The limit is the largest length the state of [Parser.Step] can
+// hold.
+const MaxTokenLength = 1023
+
+// String returns the name of the token kind, such as "url", or "none".
+// Combined kinds are separated by "|".
+func (k TokenKind) String() string {
+	if k == TokenNone {
+		return "none"
+	}
+	var names []string
+	for _, kind := range []struct {
+		kind TokenKind
+		name string
+	}{{TokenURL, "url"}, {TokenEmail, "email"}, {TokenHashtag, "hashtag"}, {TokenMention, "mention"}} {
+		if k&kind.kind != 0 {
+			names = append(names, kind.name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// FirstToken is like [Parser.FirstWord] but it also returns the kind of the
+// returned word, which is [TokenNone] for ordinary words or one of the kinds
+// set in [Parser.Tokens]. It does not allocate.
+func (p *Parser) FirstToken(b []byte, state WordBreakState) (word, rest []byte, kind TokenKind, newState WordBreakState) {
+	return firstWord(p, b, state, utf8.DecodeRune)
+}
+
+// FirstTokenInString is like [Parser.FirstToken] but its input and outputs
+// are strings.
+func (p *Parser) FirstTokenInString(str string, state WordBreakState) (word, rest string, kind TokenKind, newState WordBreakState) {
+	return firstWord(p, str, state, utf8.DecodeRuneInString)
+}
+
+// firstToken returns the length in bytes and the kind of the token of one of
+// the kinds set in the parser's Tokens field at the start of the given string,
+// or 0 and TokenNone if there is none. The returned length never splits a
+// grapheme cluster. Tokens longer than MaxTokenLength bytes are not
+// recognized, see matchURL.
+func firstToken[T bytes](p *Parser, str T, decoder runeDecoder[T]) (int, TokenKind) {
+	if p == nil || p.Tokens == TokenNone || len(str) == 0 {
+		return 0, TokenNone
+	}
+
+	var (
+		n    int
+		kind TokenKind
+	)
+	r, l := decoder(str)
+	switch {
+	case r == '#' || r == '＃':
+		if p.Tokens&TokenHashtag != 0 {
+			n, kind = matchTag(str, l, MaxTokenLength, decoder, true), TokenHashtag
+		}
+	case r == '@' || r == '＠':
+		if p.Tokens&TokenMention != 0 {
+			n, kind = matchTag(str, l, MaxTokenLength, decoder, false), TokenMention
+		}
+	default:
+		if p.Tokens&TokenURL != 0 {
+			n, kind = matchURL(str, MaxTokenLength, decoder), TokenURL
+		}
+		if n == 0 && p.Tokens&TokenEmail != 0 {
+			n, kind = matchEmail(str, MaxTokenLength, decoder), TokenEmail
+		}
+	}
+	if n == 0 {
+		return 0, TokenNone
+	}
+	if n = clusterEnd(p, str, n, decoder); n > MaxTokenLength {
+		return 0, TokenNone
+	}
+	return n, kind
+}
+
+// firstURL returns the length in bytes of the URL at the start of the given
+// string if the parser's URLLineBreaks field is set, or 0 if there is none. A
+// URL may not start right after an ASCII letter or digit, given by "prev" (or
+// a negative value at the start of the text). Like tokens, the returned length
+// never splits a grapheme cluster and is at most MaxTokenLength bytes.
+func firstURL[T bytes](p *Parser, str T, prev rune, decoder runeDecoder[T]) int {
+	if p == nil || !p.URLLineBreaks || len(str) == 0 {
+		return 0
+	}
+	if prev >= 0 && prev < utf8.RuneSelf && (isASCIILetter(byte(prev)) || isASCIIDigit(byte(prev))) {
+		return 0
+	}
+	n := matchURL(str, MaxTokenLength, decoder)
+	if n == 0 {
+		return 0
+	}
+	if n = clusterEnd(p, str, n, decoder); n > MaxTokenLength {
+		return 0
+	}
+	return n
+}
+
+// urlLineBreak returns the line break between the two given runes inside a
+// URL: a line may be broken after a slash (but not between two slashes) and
+// before a question mark, a number sign, or an ampersand.
+func urlLineBreak(prev, r rune) LineBreak {
+	if prev == '/' && r != '/' || r == '?' || r == '#' || r == '&' {
+		return LineCanBreak
+	}
+	return LineDontBreak
+}
+
+// clusterEnd returns the first grapheme cluster boundary of the given string
+// at or after byte offset n.
+func clusterEnd[T bytes](p *Parser, str T, n int, decoder runeDecoder[T]) int {
+	var (
+		length int
+		state  GraphemeBreakState
+	)
+	for length < n {
+		var cluster T
+		cluster, _, _, state = firstGraphemeCluster(p, str[length:], state, decoder)
+		length += len(cluster)
+	}
+	return length
+}
+
+// matchTag returns the length of the hashtag or mention starting with a sign
+// of the given length, or 0 if there is none. Tags consist of letters, digits,
+// marks, and underscores, and may contain hyphens and full stops between
+// them. Hashtags must contain at least one character which is not a digit.
+// Tags longer than "limit" bytes are not matched, see matchURL.
+func matchTag[T bytes](str T, sign, limit int, decoder runeDecoder[T], hashtag bool) int {
+	var (
+		end      int
+		nonDigit bool
+	)
+	for pos := sign; pos < len(str); {
+		if pos > limit {
+			return 0
+		}
+		r, l := decoder(str[pos:])
+		if r == '_' || unicode.IsLetter(r) || unicode.IsMark(r) {
+			nonDigit = true
+		} else if !unicode.IsDigit(r) {
+			if (r == '-' || r == '.') && end == pos {
+				pos += l // Only kept if followed by more tag characters.
+				continue
+			}
+			break
+		}
+		pos += l
+		end = pos
+	}
+	if end == 0 || hashtag && !nonDigit {
+		return 0
+	}
+	return end
+}
+
+// matchURL returns the length of the URL at the start of the given string, or
+// 0 if there is none. URLs start with a scheme followed by "://" or with
+// "www.". Punctuation at the end of a URL, such as a full stop ending the
+// sentence, and closing brackets without an opening one are not part of it.
+//
+// URLs longer than "limit" bytes are not matched. The scan stops after "limit"
+// bytes, which bounds the work spent on text which looks like a URL, so a URL
+// followed by a long run of punctuation may not be matched either.
+func matchURL[T bytes](str T, limit int, decoder runeDecoder[T]) int {
+	// Match the scheme or "www.".
+	var start int
+	if len(str) > 4 && str[0]|0x20 == 'w' && str[1]|0x20 == 'w' && str[2]|0x20 == 'w' && str[3] == '.' {
+		start = 4
+	} else {
+		for start < len(str) && start <= 32 && (isASCIILetter(str[start]) || start > 0 && (isASCIIDigit(str[start]) || str[start] == '+' || str[start] == '-' || str[start] == '.')) {
+			start++
+		}
+		if start == 0 || len(str) < start+3 || str[start] != ':' || str[start+1] != '/' || str[start+2] != '/' {
+			return 0
+		}
+		start += 3
+	}
+
+	// Match the rest of the URL.
+	var depth int
+	end := start
+	for pos := start; pos < len(str); {
+		if pos > limit {
+			return 0
+		}
+		r, l := decoder(str[pos:])
+		switch {
+		case r == '(' || r == '[':
+			depth++
+		case r == ')' || r == ']':
+			if depth == 0 {
+				return urlLength(start, end)
+			}
+			depth--
+		case strings.ContainsRune(".,:;!?'", r):
+			pos += l // Only kept if followed by more URL characters.
+			continue
+		case !isURLRune(r):
+			return urlLength(start, end)
+		}
+		pos += l
+		end = pos
+	}
+	return urlLength(start, end)
+}
+
+// urlLength returns the length of a URL ending at "end" whose scheme ends at
+// "start", or 0 if there is nothing after the scheme.
+func urlLength(start, end int) int {
+	if end == start {
+		return 0
+	}
+	return end
+}
+
+// isURLRune returns true if the given rune may be part of a URL. These are the
+// characters allowed in URLs by RFC 3986 and, for internationalized URLs,
+// letters, digits, and marks.
+func isURLRune(r rune) bool {
+	if r < utf8.RuneSelf {
+		return isASCIILetter(byte(r)) || isASCIIDigit(byte(r)) || strings.ContainsRune("-._~:/?#[]@!$&'()*+,;=%", r)
+	}
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+}
+
+// maxEmailLocalPart is the maximum length in bytes of the local part of an
+// email address, see RFC 5321, section 4.5.3.1.1.
+const maxEmailLocalPart = 64
+
+// matchEmail returns the length of the email address at the start of the
+// given string, or 0 if there is none. The local part may have at most 64
+// bytes, as in RFC 5321, and the domain must consist of at least two labels.
+// Addresses longer than "limit" bytes are not matched, see matchURL.
+func matchEmail[T bytes](str T, limit int, decoder runeDecoder[T]) int {
+	// Match the local part.
+	var pos int
+	for pos < len(str) {
+		if pos > maxEmailLocalPart {
+			return 0
+		}
+		r, l := decoder(str[pos:])
+		if r == '@' {
+			break
+		}
+		if !isEmailRune(r) && !strings.ContainsRune("._%+-'", r) {
+			return 0
+		}
+		pos += l
+	}
+	if pos == 0 || pos >= len(str) || str[pos-1] == '.' {
+		return 0
+	}
+	pos++ // The "@".
+
+	// Match the domain.
+	var (
+		end    int
+		labels int
+	)
+	for pos < len(str) {
+		if pos > limit {
+			return 0
+		}
+		r, l := decoder(str[pos:])
+		if r == '.' {
+			if end != pos {
+				break // Empty label.
+			}
+			pos += l
+			continue
+		}
+		if !isEmailRune(r) && r != '-' {
+			break
+		}
+		if end != pos {
+			labels++
+		}
+		pos += l
+		end = pos
+	}
+	if labels < 2 {
+		return 0
+	}
+	return end
+}
+
+// isEmailRune returns true if the given rune is a letter, a digit, or a mark.
+func isEmailRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+}
+
+// isASCIILetter returns true if the given byte is an ASCII letter.
+func isASCIILetter(c byte) bool {
+	return c|0x20 >= 'a' && c|0x20 <= 'z'
+}
+
+// isASCIIDigit returns true if the given byte is an ASCII digit.
+func isASCIIDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,192 @@
+package uniseg
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Test cases for tokens. Each word is followed by its kind.
+var tokenTestCases = []struct {
+	original string
+	expected []any
+}{
+	{"See https://example.com/a?b=c.", []any{"See", TokenNone, " ", TokenNone, "https://example.com/a?b=c", TokenURL, ".", TokenNone}},
+	{"(www.example.org/wiki/Go_(lang))", []any{"(", TokenNone, "www.example.org/wiki/Go_(lang)", TokenURL, ")", TokenNone}},
+	{"HTTP://EXAMPLE.COM/path#frag, ok", []any{"HTTP://EXAMPLE.COM/path#frag", TokenURL, ",", TokenNone, " ", TokenNone, "ok", TokenNone}},
+	{"https://例え.jp/パス!", []any{"https://例え.jp/パス", TokenURL, "!", TokenNone}},
+	{"http:// x", []any{"http", TokenNone, ":", TokenNone, "/", TokenNone, "/", TokenNone, " ", TokenNone, "x", TokenNone}},
+	{"Mail user.name+tag@example.co.uk.", []any{"Mail", TokenNone, " ", TokenNone, "user.name+tag@example.co.uk", TokenEmail, ".", TokenNone}},
+	{"user@localhost", []any{"user", TokenNone, "@localhost", TokenMention}},
+	{"#go-lang and #日本語!", []any{"#go-lang", TokenHashtag, " ", TokenNone, "and", TokenNone, " ", TokenNone, "#日本語", TokenHashtag, "!", TokenNone}},
+	{"#123 #a1-", []any{"#", TokenNone, "123", TokenNone, " ", TokenNone, "#a1", TokenHashtag, "-", TokenNone}},
+	{"@alice, @bob_b.", []any{"@alice", TokenMention, ",", TokenNone, " ", TokenNone, "@bob_b", TokenMention, ".", TokenNone}},
+	{"@", []any{"@", TokenNone}},
+}
+
+// Test the recognition of tokens by FirstToken and Step.
+func TestTokens(t *testing.T) {
+	p := &Parser{Tokens: AllTokens}
+	for _, testCase := range tokenTestCases {
+		// FirstTokenInString.
+		var (
+			tokens []any
+			state  WordBreakState
+		)
+		for str := testCase.original; len(str) > 0; {
+			var (
+				word string
+				kind TokenKind
+			)
+			word, str, kind, state = p.FirstTokenInString(str, state)
+			tokens = append(tokens, word, kind)
+		}
+		if !reflect.DeepEqual(tokens, testCase.expected) {
+			t.Errorf("%q: FirstTokenInString returned %v, expected %v", testCase.original, tokens, testCase.expected)
+		}
+
+		// FirstToken.
+		tokens, state = nil, 0
+		for b := []byte(testCase.original); len(b) > 0; {
+			var (
+				word []byte
+				kind TokenKind
+			)
+			word, b, kind, state = p.FirstToken(b, state)
+			tokens = append(tokens, string(word), kind)
+		}
+		if !reflect.DeepEqual(tokens, testCase.expected) {
+			t.Errorf("%q: FirstToken returned %v, expected %v", testCase.original, tokens, testCase.expected)
+		}
+
+		// StepString.
+		var (
+			words, expected []string
+			current         string
+			s               State
+		)
+		for i := 0; i < len(testCase.expected); i += 2 {
+			expected = append(expected, testCase.expected[i].(string))
+		}
+		for str := testCase.original; len(str) > 0; {
+			var (
+				cluster    string
+				boundaries Boundaries
+			)
+			cluster, str, boundaries, s = p.StepString(str, s)
+			current += cluster
+			if boundaries.Word() {
+				words = append(words, current)
+				current = ""
+			}
+		}
+		if !reflect.DeepEqual(words, expected) {
+			t.Errorf("%q: StepString returned %q, expected %q", testCase.original, words, expected)
+		}
+	}
+}
+
+// Test that only the selected kinds of tokens are recognized.
+func TestTokenKinds(t *testing.T) {
+	p := &Parser{Tokens: TokenEmail | TokenMention}
+	str := "https://example.com #go @alice a@b.cd"
+	var (
+		words []string
+		kinds []TokenKind
+		word  string
+		kind  TokenKind
+		state WordBreakState
+	)
+	for len(str) > 0 {
+		word, str, kind, state = p.FirstTokenInString(str, state)
+		if kind != TokenNone {
+			words = append(words, word)
+			kinds = append(kinds, kind)
+		}
+	}
+	if !reflect.DeepEqual(words, []string{"@alice", "a@b.cd"}) || !reflect.DeepEqual(kinds, []TokenKind{TokenMention, TokenEmail}) {
+		t.Errorf("Expected tokens [@alice a@b.cd] of kinds [mention email], got %q of kinds %v", words, kinds)
+	}
+
+	if s := (TokenURL | TokenHashtag).String(); s != "url|hashtag" {
+		t.Errorf(`Expected "url|hashtag", got %q`, s)
+	}
+}
+
+// Test that all functions recognize tokens of up to MaxTokenLength bytes and
+// no longer ones.
+func TestLongTokens(t *testing.T) {
+	p := &Parser{Tokens: AllTokens}
+	for _, testCase := range []struct {
+		token string
+		kind  TokenKind // The kind of the token, or TokenNone if it is too long.
+	}{
+		{"https://example.com/" + strings.Repeat("a", 1003), TokenURL},
+		{"https://example.com/" + strings.Repeat("a", 1004), TokenNone},
+		{"https://example.com/" + strings.Repeat("a", 5000), TokenNone},
+		{"https://a" + strings.Repeat(".", 2000) + "b", TokenNone},
+		{"#" + strings.Repeat("a", 1022), TokenHashtag},
+		{"#" + strings.Repeat("a", 1023), TokenNone},
+		{"@" + strings.Repeat("a", 1022), TokenMention},
+		{"@" + strings.Repeat("a", 1023), TokenNone},
+		{strings.Repeat("a", 64) + "@example.com", TokenEmail},
+		{strings.Repeat("a", 65) + "@example.com", TokenNone},
+	} {
+		str := testCase.token + " x"
+		recognized := testCase.kind != TokenNone
+		word, _, kind, _ := p.FirstTokenInString(str, 0)
+		if recognized && (word != testCase.token || kind != testCase.kind) {
+			t.Errorf("%d bytes: FirstTokenInString returned %d bytes of kind %v, expected the token of kind %v", len(testCase.token), len(word), kind, testCase.kind)
+		} else if !recognized && kind != TokenNone {
+			t.Errorf("%d bytes: FirstTokenInString returned kind %v, expected none", len(testCase.token), kind)
+		}
+
+		if word, _, _ := p.FirstWordInString(str, 0); (word == testCase.token) != recognized {
+			t.Errorf("%d bytes: FirstWordInString returned a first word of %d bytes", len(testCase.token), len(word))
+		}
+
+		var (
+			length     int
+			boundaries Boundaries
+			state      State
+		)
+		for rest := str; len(rest) > 0; {
+			var cluster string
+			cluster, rest, boundaries, state = p.StepString(rest, state)
+			length += len(cluster)
+			if boundaries.Word() {
+				break
+			}
+		}
+		if (length == len(testCase.token)) != recognized {
+			t.Errorf("%d bytes: StepString returned a first word of %d bytes", len(testCase.token), length)
+		}
+
+		length = 0
+		g := p.NewGraphemes(str)
+		for g.Next() {
+			length += len(g.Str())
+			if g.IsWordBoundary() {
+				break
+			}
+		}
+		if (length == len(testCase.token)) != recognized {
+			t.Errorf("%d bytes: Graphemes returned a first word of %d bytes", len(testCase.token), length)
+		}
+	}
+}
+
+// Test that finding tokens does not allocate.
+func TestTokensAllocations(t *testing.T) {
+	p := &Parser{Tokens: AllTokens}
+	input := []byte("See https://example.com/a?b=c or mail user@example.com, #go-lang and @alice!")
+	allocs := testing.AllocsPerRun(10, func() {
+		var state WordBreakState
+		for b := input; len(b) > 0; {
+			_, b, _, state = p.FirstToken(b, state)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Expected no allocations, got %v", allocs)
+	}
+}
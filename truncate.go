@@ -0,0 +1,183 @@
+package uniseg
+
+import (
+	"slices"
+	"unicode/utf8"
+)
+
+// Truncation selects the boundaries at which [TruncateBytesAt] and
+// [ChunkBytes] cut text.
+type Truncation int
+
+// The truncation modes.
+const (
+	// TruncateGraphemes cuts text at the last grapheme cluster boundary which
+	// fits the budget.
+	TruncateGraphemes Truncation = iota
+
+	// TruncateWords cuts text at the last word boundary which fits the
+	// budget. If the first word doesn't fit, it is cut at a grapheme cluster
+	// boundary.
+	TruncateWords
+
+	// TruncateSentences cuts text at the last sentence boundary which fits the
+	// budget. If the first sentence doesn't fit, it is cut like with
+	// [TruncateWords].
+	TruncateSentences
+)
+
+// TruncateBytes returns the longest prefix of s which is at most maxBytes
+// bytes long and which ends at a grapheme cluster boundary. Unlike s[:maxBytes],
+// it never splits a flag, an emoji sequence, or a character with combining
+// marks. If the first grapheme cluster of s is longer than maxBytes, an empty
+// string is returned.
+//
+// This function does not allocate. Strings of at most maxBytes bytes are
+// returned without being parsed.
+func TruncateBytes(s string, maxBytes int) string {
+	return DefaultParser.TruncateBytes(s, maxBytes)
+}
+
+// TruncateBytes is like [TruncateBytes] but uses the given parser.
+func (p *Parser) TruncateBytes(s string, maxBytes int) string {
+	return s[:truncateBytes(p, s, maxBytes, TruncateGraphemes, utf8.DecodeRuneInString)]
+}
+
+// TruncateByteSlice is like [TruncateBytes] but its input and output are byte
+// slices. The returned slice shares the underlying array of b.
+func TruncateByteSlice(b []byte, maxBytes int) []byte {
+	return DefaultParser.TruncateByteSlice(b, maxBytes)
+}
+
+// TruncateByteSlice is like [TruncateByteSlice] but uses the given parser.
+func (p *Parser) TruncateByteSlice(b []byte, maxBytes int) []byte {
+	return b[:truncateBytes(p, b, maxBytes, TruncateGraphemes, utf8.DecodeRune)]
+}
+
+// TruncateBytesAt is like [TruncateBytes] but prefers to cut s at the word or
+// sentence boundaries selected by t. For example, with [TruncateWords],
+// "Hello, world!" is truncated to 10 bytes as "Hello, " instead of
+// "Hello, wor". Note that the result may end with spaces.
+func TruncateBytesAt(s string, maxBytes int, t Truncation) string {
+	return DefaultParser.TruncateBytesAt(s, maxBytes, t)
+}
+
+// TruncateBytesAt is like [TruncateBytesAt] but uses the given parser.
+func (p *Parser) TruncateBytesAt(s string, maxBytes int, t Truncation) string {
+	return s[:truncateBytes(p, s, maxBytes, t, utf8.DecodeRuneInString)]
+}
+
+// TruncateByteSliceAt is like [TruncateBytesAt] but its input and output are
+// byte slices. The returned slice shares the underlying array of b.
+func TruncateByteSliceAt(b []byte, maxBytes int, t Truncation) []byte {
+	return DefaultParser.TruncateByteSliceAt(b, maxBytes, t)
+}
+
+// TruncateByteSliceAt is like [TruncateByteSliceAt] but uses the given parser.
+func (p *Parser) TruncateByteSliceAt(b []byte, maxBytes int, t Truncation) []byte {
+	return b[:truncateBytes(p, b, maxBytes, t, utf8.DecodeRune)]
+}
+
+// ChunkBytes splits s into consecutive chunks of at most maxBytes bytes each,
+// cut at the boundaries selected by t as by [TruncateBytesAt]. Concatenating
+// the chunks results in s again. A grapheme cluster longer than maxBytes, which
+// can't be split without breaking it, becomes a chunk of its own, exceeding
+// the budget. An empty string results in no chunks.
+func ChunkBytes(s string, maxBytes int, t Truncation) []string {
+	return DefaultParser.ChunkBytes(s, maxBytes, t)
+}
+
+// ChunkBytes is like [ChunkBytes] but uses the given parser.
+func (p *Parser) ChunkBytes(s string, maxBytes int, t Truncation) []string {
+	return chunkBytes(p, s, maxBytes, t, utf8.DecodeRuneInString)
+}
+
+// ChunkByteSlice is like [ChunkBytes] but its input and outputs are byte
+// slices. The returned slices share the underlying array of b but their
+// capacity is limited to their length.
+func ChunkByteSlice(b []byte, maxBytes int, t Truncation) [][]byte {
+	return DefaultParser.ChunkByteSlice(b, maxBytes, t)
+}
+
+// ChunkByteSlice is like [ChunkByteSlice] but uses the given parser.
+func (p *Parser) ChunkByteSlice(b []byte, maxBytes int, t Truncation) [][]byte {
+	chunks := chunkBytes(p, b, maxBytes, t, utf8.DecodeRune)
+	for i, chunk := range chunks {
+		chunks[i] = slices.Clip(chunk) // Appending to a chunk must not overwrite the next one.
+	}
+	return chunks
+}
+
+// chunkBytes splits the given string into chunks, see [ChunkBytes].
+func chunkBytes[T bytes](p *Parser, str T, maxBytes int, t Truncation, decoder runeDecoder[T]) []T {
+	var chunks []T
+	for len(str) > 0 {
+		length := truncateBytes(p, str, maxBytes, t, decoder)
+		if length == 0 {
+			// The first grapheme cluster doesn't fit.
+			var cluster T
+			cluster, _, _, _ = firstGraphemeCluster(p, str, 0, decoder)
+			length = len(cluster)
+		}
+		chunks = append(chunks, str[:length])
+		str = str[length:]
+	}
+	return chunks
+}
+
+// truncateBytes returns the length of the longest prefix of the given string
+// which is at most maxBytes bytes long and which ends at a boundary selected by
+// t, see [TruncateBytesAt].
+func truncateBytes[T bytes](p *Parser, str T, maxBytes int, t Truncation, decoder runeDecoder[T]) int {
+	if len(str) <= maxBytes {
+		return len(str)
+	}
+	if maxBytes <= 0 {
+		return 0
+	}
+
+	// Grapheme cluster boundaries only.
+	if t == TruncateGraphemes {
+		var (
+			length  int
+			cluster T
+			state   GraphemeBreakState
+		)
+		for rest := str; len(rest) > 0; {
+			cluster, rest, _, state = firstGraphemeCluster(p, rest, state, decoder)
+			if length+len(cluster) > maxBytes {
+				break
+			}
+			length += len(cluster)
+		}
+		return length
+	}
+
+	// Find the last grapheme cluster, word, and sentence boundaries.
+	var (
+		length, word, sentence int
+		cluster                T
+		boundaries             Boundaries
+		state                  State
+	)
+	for rest := str; len(rest) > 0; {
+		cluster, rest, boundaries, state = step(p, rest, state, nil, decoder)
+		if length+len(cluster) > maxBytes {
+			break
+		}
+		length += len(cluster)
+		if boundaries.Word() {
+			word = length
+		}
+		if boundaries.Sentence() {
+			sentence = length
+		}
+	}
+	if t == TruncateSentences && sentence > 0 {
+		return sentence
+	}
+	if word > 0 {
+		return word
+	}
+	return length
+}
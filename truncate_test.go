@@ -0,0 +1,112 @@
+package uniseg
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// truncateTestCases are texts with their expected prefixes when truncated.
+var truncateTestCases = []struct {
+	name       string
+	original   string
+	maxBytes   int
+	truncation Truncation
+	expected   string
+}{
+	{"empty", "", 10, TruncateGraphemes, ""},
+	{"fits", "Hello", 5, TruncateGraphemes, "Hello"},
+	{"zero", "Hello", 0, TruncateGraphemes, ""},
+	{"negative", "Hello", -1, TruncateGraphemes, ""},
+	{"ASCII", "Hello, world!", 10, TruncateGraphemes, "Hello, wor"},
+	{"combining mark", "Ka\u0308se", 2, TruncateGraphemes, "K"},
+	{"flag", "🇩🇪🇫🇷", 7, TruncateGraphemes, ""},
+	{"flags", "🇩🇪🇫🇷", 12, TruncateGraphemes, "🇩🇪"},
+	{"ZWJ sequence", "a👩\u200d👩\u200d👧", 15, TruncateGraphemes, "a"},
+	{"conjunct", "aक्षि", 10, TruncateGraphemes, "a"},
+	{"CRLF", "a\r\nb", 2, TruncateGraphemes, "a"},
+	{"words", "Hello, world!", 10, TruncateWords, "Hello, "},
+	{"words exact", "Hello, world!", 7, TruncateWords, "Hello, "},
+	{"long word", "Supercalifragilistic", 5, TruncateWords, "Super"},
+	{"words flag", "ab 🇩🇪🇫🇷", 12, TruncateWords, "ab 🇩🇪"},
+	{"sentences", "One. Two three. Four.", 19, TruncateSentences, "One. Two three. "},
+	{"long sentence", "One two three. Four.", 10, TruncateSentences, "One two "},
+	{"long word in sentence", "Supercalifragilistic.", 5, TruncateSentences, "Super"},
+}
+
+func TestTruncateBytes(t *testing.T) {
+	for _, tt := range truncateTestCases {
+		if got := TruncateBytesAt(tt.original, tt.maxBytes, tt.truncation); got != tt.expected {
+			t.Errorf("%s: TruncateBytesAt(%q, %d) = %q, want %q", tt.name, tt.original, tt.maxBytes, got, tt.expected)
+		}
+		if got := string(TruncateByteSliceAt([]byte(tt.original), tt.maxBytes, tt.truncation)); got != tt.expected {
+			t.Errorf("%s: TruncateByteSliceAt(%q, %d) = %q, want %q", tt.name, tt.original, tt.maxBytes, got, tt.expected)
+		}
+		if tt.truncation != TruncateGraphemes {
+			continue
+		}
+		if got := TruncateBytes(tt.original, tt.maxBytes); got != tt.expected {
+			t.Errorf("%s: TruncateBytes(%q, %d) = %q, want %q", tt.name, tt.original, tt.maxBytes, got, tt.expected)
+		}
+		if got := string(TruncateByteSlice([]byte(tt.original), tt.maxBytes)); got != tt.expected {
+			t.Errorf("%s: TruncateByteSlice(%q, %d) = %q, want %q", tt.name, tt.original, tt.maxBytes, got, tt.expected)
+		}
+	}
+
+	// Legacy grapheme clusters may be shorter.
+	p := &Parser{LegacyGraphemeClusters: true}
+	if got, expected := p.TruncateBytes("aक्षि", 10), "aक्ष"; got != expected {
+		t.Errorf("TruncateBytes with legacy grapheme clusters = %q, want %q", got, expected)
+	}
+}
+
+func TestChunkBytes(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		original   string
+		maxBytes   int
+		truncation Truncation
+		expected   []string
+	}{
+		{"empty", "", 10, TruncateGraphemes, nil},
+		{"graphemes", "Hello, world!", 5, TruncateGraphemes, []string{"Hello", ", wor", "ld!"}},
+		{"flags", "🇩🇪🇫🇷🇯🇵", 10, TruncateGraphemes, []string{"🇩🇪", "🇫🇷", "🇯🇵"}},
+		{"oversized cluster", "a🇩🇪b", 4, TruncateGraphemes, []string{"a", "🇩🇪", "b"}},
+		{"words", "The quick brown fox", 10, TruncateWords, []string{"The quick ", "brown fox"}},
+		{"long word", "a bcdefgh", 4, TruncateWords, []string{"a ", "bcde", "fgh"}},
+		{"sentences", "One. Two. Three four five.", 12, TruncateSentences, []string{"One. Two. ", "Three four ", "five."}},
+	} {
+		got := ChunkBytes(tt.original, tt.maxBytes, tt.truncation)
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%s: ChunkBytes(%q, %d) = %q, want %q", tt.name, tt.original, tt.maxBytes, got, tt.expected)
+		}
+		if strings.Join(got, "") != tt.original {
+			t.Errorf("%s: chunks %q don't add up to %q", tt.name, got, tt.original)
+		}
+		var gotBytes []string
+		for _, chunk := range ChunkByteSlice([]byte(tt.original), tt.maxBytes, tt.truncation) {
+			gotBytes = append(gotBytes, string(chunk))
+		}
+		if !reflect.DeepEqual(gotBytes, tt.expected) {
+			t.Errorf("%s: ChunkByteSlice(%q, %d) = %q, want %q", tt.name, tt.original, tt.maxBytes, gotBytes, tt.expected)
+		}
+	}
+
+	// Appending to a chunk must not overwrite the next one.
+	b := []byte("Hello, world!")
+	chunks := ChunkByteSlice(b, 5, TruncateGraphemes)
+	_ = append(chunks[0], '!')
+	if string(b) != "Hello, world!" {
+		t.Errorf("appending to a chunk modified the input: %q", b)
+	}
+}
+
+func TestTruncateBytesAllocations(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		TruncateBytes(benchmarkStr, 40)
+		TruncateBytesAt(benchmarkStr, 40, TruncateSentences)
+	})
+	if allocs > 0 {
+		t.Errorf("got %.1f allocations, want 0", allocs)
+	}
+}
@@ -6,15 +6,21 @@ import "os"
 //go:generate go run ./internal/cmd/gen_breaktest WordBreakTest wordbreak_test.go wordBreakTestCases words
 //go:generate go run ./internal/cmd/gen_breaktest SentenceBreakTest sentencebreak_test.go sentenceBreakTestCases sentences
 //go:generate go run ./internal/cmd/gen_breaktest LineBreakTest linebreak_test.go lineBreakTestCases lines
+//go:generate go run ./internal/cmd/gen_emojitest emoji-test emojitest_test.go emojiTestCases
+//go:generate go run ./internal/cmd/gen_biditest BidiTest biditest_test.go
+//go:generate go run ./internal/cmd/gen_biditest BidiCharacterTest bidicharactertest_test.go
 
-//go:generate go run ./internal/cmd/gen_properties -logprefix=graphemes -property=auxiliary/GraphemeBreakProperty -emojis=Extended_Pictographic graphemeproperties.go graphemeCodePoints
-//go:generate go run ./internal/cmd/gen_properties -logprefix=words -property=auxiliary/WordBreakProperty -prefix=wbpr -type=wbProperty wordproperties.go workBreakCodePoints
-//go:generate go run ./internal/cmd/gen_properties -logprefix=sentences -property=auxiliary/SentenceBreakProperty -prefix=sbpr -type=sbProperty sentenceproperties.go sentenceBreakCodePoints
-//go:generate go run ./internal/cmd/gen_properties -logprefix=lines -property=LineBreak -gencat -prefix=lbpr -type=propertyGeneralCategory lineproperties.go lineBreakCodePoints
-//go:generate go run ./internal/cmd/gen_properties -logprefix=eastasianwidth -property=EastAsianWidth -prefix=eawpr -type eawProperty eastasianwidth.go eastAsianWidth
-//go:generate go run ./internal/cmd/gen_properties -logprefix=emojipresentation -emojis=Emoji_Presentation -type=emojiProperty emojipresentation.go emojiPresentation
-//go:generate go run ./internal/cmd/gen_properties -logprefix=emoji -emojis=Emoji -type=emojiProperty emoji.go emoji
+//go:generate go run ./cmd/gen_properties -logprefix=graphemes -property=auxiliary/GraphemeBreakProperty -emojis=Extended_Pictographic graphemeproperties.go graphemeCodePoints
+//go:generate go run ./cmd/gen_properties -logprefix=words -property=auxiliary/WordBreakProperty -prefix=wbpr -type=wbProperty wordproperties.go workBreakCodePoints
+//go:generate go run ./cmd/gen_properties -logprefix=sentences -property=auxiliary/SentenceBreakProperty -prefix=sbpr -type=sbProperty sentenceproperties.go sentenceBreakCodePoints
+//go:generate go run ./cmd/gen_properties -logprefix=lines -property=LineBreak -gencat -prefix=lbpr -type=propertyGeneralCategory lineproperties.go lineBreakCodePoints
+//go:generate go run ./cmd/gen_properties -logprefix=eastasianwidth -property=EastAsianWidth -prefix=eawpr -type eawProperty eastasianwidth.go eastAsianWidth
+//go:generate go run ./cmd/gen_properties -logprefix=emojipresentation -emojis=Emoji_Presentation -type=emojiProperty emojipresentation.go emojiPresentation
+//go:generate go run ./cmd/gen_properties -logprefix=emoji -emojis=Emoji -type=emojiProperty emoji.go emoji
+//go:generate go run ./cmd/gen_properties -logprefix=defaultignorable -property=DerivedCoreProperties -only=Default_Ignorable_Code_Point -type=derivedCoreProperty defaultignorable.go defaultIgnorable
 //go:generate go run ./internal/cmd/gen_incb/gen_incb.go
+//go:generate go run ./internal/cmd/gen_scripts scriptproperties.go
+//go:generate go run ./internal/cmd/gen_bidi bidiproperties.go
 
 // Parser is a parser for Unicode text.
 type Parser struct {
@@ -38,10 +44,115 @@ type Parser struct {
 	//
 	// If it is true, the width of Emoji characters is 2.
 	// Otherwise, the width of Emoji characters is 1.
+	// This includes unqualified emoji keycap sequences (without U+FE0F).
 	// It is effective only when EastAsianWidth is true.
 	//
 	// [UAX #11]: https://www.unicode.org/reports/tr11/tr11-40.html
 	WideEmoji bool
+
+	// Tables are custom Unicode property tables used instead of the built-in
+	// ones, see [NewTables]. If nil, the built-in tables are used.
+	Tables *Tables
+
+	// LegacyGraphemeClusters selects legacy grapheme clusters instead of the
+	// extended grapheme clusters of [UAX #29]. Legacy clusters don't keep
+	// spacing marks with their base characters (rule GB9a), prepended
+	// characters with the following ones (rule GB9b), or Indic consonant
+	// conjuncts together (rule GB9c). They are used by some older collations
+	// and terminal cursor models.
+	//
+	// [UAX #29]: https://www.unicode.org/reports/tr29/tr29-45.html#Grapheme_Cluster_Boundaries
+	LegacyGraphemeClusters bool
+
+	// LineBreakStrictness controls which line breaks are allowed in Chinese
+	// and Japanese text, like the CSS line-break property. The zero value,
+	// [LineBreakStrict], follows the rules of [UAX #14].
+	//
+	// [UAX #14]: https://www.unicode.org/reports/tr14/tr14-55.html
+	LineBreakStrictness LineBreakStrictness
+
+	// WordBreak controls whether lines may be broken inside words, like the
+	// CSS word-break property. The zero value, [WordBreakNormal], follows the
+	// rules of UAX #14. Mandatory breaks and the rules for non-breaking
+	// characters such as U+00A0 (no-break space) and U+2060 (word joiner) are
+	// observed in all modes.
+	WordBreak WordBreakMode
+
+	// URLLineBreaks enables line breaks inside URLs such as
+	// "https://example.com/a?b=c", which the rules of [UAX #14] either don't
+	// break at all or break in unexpected places. If true, the line break
+	// opportunities inside a URL are exactly those after a slash (but not
+	// between two slashes) and before a question mark, a number sign, or an
+	// ampersand. URLs must start with a scheme followed by "://" or with
+	// "www.".
+	//
+	// The states of the parsing functions must hold the length of the rest of
+	// a URL. URLs longer than [MaxTokenLength] bytes are therefore broken like
+	// other text by all functions.
+	//
+	// [UAX #14]: https://www.unicode.org/reports/tr14/tr14-55.html
+	URLLineBreaks bool
+
+	// Hyphens controls whether lines may be broken inside words with a hyphen
+	// at the end of the line. The zero value, [HyphensDefault], follows the
+	// rules of [UAX #14], which only break lines after soft hyphens (U+00AD)
+	// and report these breaks as [LineCanBreak]. Other values report such
+	// breaks as [LineHyphenBreak], or [Parser.FirstLineSegment] reports them
+	// with [LineBreakState.HyphenBreak].
+	//
+	// [UAX #14]: https://www.unicode.org/reports/tr14/tr14-55.html
+	Hyphens Hyphens
+
+	// Hyphenator finds the points at which words may be hyphenated if
+	// [Parser.Hyphens] is [HyphensAuto]. [Parser.Step] and
+	// [Parser.FirstLineSegment] keep the points of the next 14 characters of
+	// a word in their states and find the others again from the rest of the
+	// word, which requires that no pattern has more than 14 characters.
+	Hyphenator *Hyphenator
+
+	// ComplexContext splits runs of characters with the Line_Break property
+	// Complex_Context (SA), such as Thai, Lao, Khmer, or Myanmar text, into
+	// words. [UAX #29] leaves this to dictionary-based methods. If nil, these
+	// runs are split according to the word boundary rules only, which break
+	// between nearly all of their characters. See [WordDictionary] for a
+	// built-in implementation.
+	//
+	// [UAX #29]: https://www.unicode.org/reports/tr29/tr29-45.html#Word_Boundaries
+	ComplexContext WordSegmenter
+
+	// ComplexContextBreaker supplies the line break opportunities inside runs
+	// of Complex_Context (SA) characters: a line may be broken after each word
+	// it finds. If nil, [Parser.ComplexContext] is used. If both are nil, such
+	// runs are never broken because rule LB1 of [UAX #14] treats their
+	// characters like alphabetic characters (AL).
+	//
+	// [UAX #14]: https://www.unicode.org/reports/tr14/tr14-55.html#LB1
+	ComplexContextBreaker WordSegmenter
+
+	// CJK splits runs of Han, Hiragana, and Katakana characters, as found in
+	// Chinese and Japanese text, into words. Without it, each ideograph and
+	// each Hiragana character is a word of its own while runs of Katakana are
+	// kept together. See [Lexicon] for a built-in implementation.
+	CJK WordSegmenter
+
+	// Tokens selects kinds of tokens, such as URLs or email addresses, which
+	// are kept together as one word instead of being split into several words
+	// according to [UAX #29]. Tokens are only recognized where a word starts.
+	// Use [Parser.FirstToken] to learn the kind of a word. If zero, no tokens
+	// are recognized.
+	//
+	// Tokens longer than [MaxTokenLength] bytes are split into words like
+	// other text because the state of [Parser.Step] can't hold their length.
+	//
+	// [UAX #29]: https://www.unicode.org/reports/tr29/tr29-45.html#Word_Boundaries
+	Tokens TokenKind
+
+	// Abbreviations lists abbreviations such as "Mr." after which a sentence
+	// must not end. See [LocaleAbbreviations] for the built-in lists. If nil,
+	// sentences are split according to the rules of [UAX #29] only.
+	//
+	// [UAX #29]: https://www.unicode.org/reports/tr29/tr29-45.html#Sentence_Boundaries
+	Abbreviations *Abbreviations
 }
 
 var DefaultParser = defaultParser()
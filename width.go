@@ -6,8 +6,15 @@ package uniseg
 func runeWidth(p *Parser, r rune, graphemeProperty property) int {
 	// Check the grapheme property of the rune.
 	switch graphemeProperty {
-	case prControl, prCR, prLF, prExtend, prZWJ:
-		// If the property is a control character, carriage return, line feed, extend character, or zero-width joiner, return a width of 0.
+	case prControl, prCR, prLF, prZWJ:
+		// If the property is a control character, carriage return, line feed, or zero-width joiner, return a width of 0.
+		return 0
+	case prExtend:
+		if isEmojiModifier(r) {
+			// Emoji modifiers are extend characters but they are displayed as color swatches when they don't follow an emoji.
+			return 2
+		}
+		// Otherwise, extend characters have a width of 0.
 		return 0
 	case prRegionalIndicator:
 		// If the property is a regional indicator, return a width of 2.
@@ -24,6 +31,12 @@ func runeWidth(p *Parser, r rune, graphemeProperty property) int {
 		return 1
 	}
 
+	// Default ignorable code points, such as the Hangul fillers, are not
+	// displayed. (Most of them have been handled above already.)
+	if defaultIgnorable.search(r) == prDefaultIgnorableCodePoint {
+		return 0
+	}
+
 	// Check for specific runes that have a fixed width.
 	switch r {
 	case '\u2e3a': // TWO-EM DASH: Width of 3
@@ -35,6 +48,41 @@ func runeWidth(p *Parser, r rune, graphemeProperty property) int {
 	return p.runeWidthAE(r, graphemeProperty)
 }
 
+// clusterWidth returns the monospace width of a grapheme cluster after
+// appending the rune r with the given grapheme property to it. "width" is the
+// width of the cluster so far, "firstProp" is the grapheme property of its
+// first rune, and "prev" is its last rune.
+func clusterWidth(p *Parser, width int, firstProp property, prev, r rune, prop property) int {
+	switch firstProp {
+	case prExtendedPictographic:
+		if r == vs15 {
+			// Text presentation.
+			return 1
+		}
+		if r == vs16 || isEmojiModifier(r) || prop == prExtendedPictographic {
+			// Emoji presentation, emoji modifier sequences, and emoji ZWJ sequences.
+			return 2
+		}
+		return width
+	case prRegionalIndicator:
+		// Only the first rune of flags counts.
+		return width
+	case prL:
+		// Only the first rune of Hangul syllables counts, unless it is the
+		// (invisible) choseong filler, in which case the syllable block is
+		// displayed if any other jamo follows.
+		if width == 0 && runeWidth(p, r, prop) > 0 {
+			return 2
+		}
+		return width
+	}
+	if r == keycap && prev == vs16 {
+		// Emoji keycap sequence.
+		return 2
+	}
+	return width + runeWidth(p, r, prop)
+}
+
 func (p *Parser) runeWidthAE(r rune, graphemeProperty property) int {
 	if p.EastAsianWidth && p.WideEmoji {
 		if graphemeProperty == prExtendedPictographic {
@@ -46,7 +94,7 @@ func (p *Parser) runeWidthAE(r rune, graphemeProperty property) int {
 	}
 
 	// Check the East Asian Width property of the rune.
-	switch eastAsianWidth.search(r) {
+	switch p.eastAsianWidthOf(r) {
 	case eawprW, eawprF:
 		// If the property is Wide or Fullwidth, return a width of 2.
 		return 2
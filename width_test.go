@@ -340,6 +340,32 @@ var widthTestCases = []struct {
 	{"\u231b", 2},                               // Hourglass
 	{"\u231b\ufe0e", 1},                         // Hourglass (with variation selector 15 = text presentation)
 	{"1\ufe0f", 1},                              // Emoji presentation of digit one.
+	{"\u115f", 0},                               // HANGUL CHOSEONG FILLER (Default_Ignorable, L)
+	{"\u1160", 0},                               // HANGUL JUNGSEONG FILLER (Default_Ignorable, V)
+	{"\u115f\u1160", 0},                         // Hangul syllable made of fillers only
+	{"\u115f\u1161", 2},                         // Hangul syllable with choseong filler and jungseong A
+	{"\u1100\u1160", 2},                         // Hangul syllable with choseong kiyeok and jungseong filler
+	{"\u3164", 0},                               // HANGUL FILLER (Default_Ignorable, W)
+	{"\uffa0", 0},                               // HALFWIDTH HANGUL FILLER (Default_Ignorable, H)
+	{"a\u3164b", 2},                             // Hangul filler between letters
+	{"\u00ad", 0},                               // SOFT HYPHEN (Default_Ignorable, Control)
+	{"\u034f", 0},                               // COMBINING GRAPHEME JOINER (Default_Ignorable, Extend)
+	{"\u061c", 0},                               // ARABIC LETTER MARK (Default_Ignorable, Control)
+	{"\u17b4", 0},                               // KHMER VOWEL INHERENT AQ (Default_Ignorable, Extend)
+	{"\u180e", 0},                               // MONGOLIAN VOWEL SEPARATOR (Default_Ignorable, Control)
+	{"\u200b", 0},                               // ZERO WIDTH SPACE (Default_Ignorable, Control)
+	{"\u200e", 0},                               // LEFT-TO-RIGHT MARK (Default_Ignorable, Control)
+	{"\u202e", 0},                               // RIGHT-TO-LEFT OVERRIDE (Default_Ignorable, Control)
+	{"\u2060", 0},                               // WORD JOINER (Default_Ignorable, Control)
+	{"\u2064", 0},                               // INVISIBLE PLUS (Default_Ignorable, Control)
+	{"\ufe00", 0},                               // VARIATION SELECTOR-1 (Default_Ignorable, Extend)
+	{"\ufeff", 0},                               // ZERO WIDTH NO-BREAK SPACE (Default_Ignorable, Control)
+	{"\ufff0", 0},                               // Unassigned (Default_Ignorable, Control)
+	{"\U0001bca0", 0},                           // SHORTHAND FORMAT LETTER OVERLAP (Default_Ignorable, Control)
+	{"\U0001d173", 0},                           // MUSICAL SYMBOL BEGIN BEAM (Default_Ignorable, Control)
+	{"\U000e0001", 0},                           // LANGUAGE TAG (Default_Ignorable, Control)
+	{"\U000e0100", 0},                           // VARIATION SELECTOR-17 (Default_Ignorable, Extend)
+	{"\U000e0fff", 0},                           // Unassigned (Default_Ignorable, Control)
 }
 
 // String width tests using the StringWidth function.
@@ -570,3 +596,98 @@ func FuzzStringWidth(f *testing.F) {
 		}
 	})
 }
+
+// emojiTestCase is a test case taken from the emoji-test.txt file.
+type emojiTestCase = struct {
+	name, original, status string
+}
+
+// emojiTestParsers are the parser configurations the emoji tests are run
+// against.
+var emojiTestParsers = []*Parser{
+	{},
+	{EastAsianWidth: true},
+	{EastAsianWidth: true, WideEmoji: true},
+}
+
+// Width of all emoji sequences from emoji-test.txt which are recommended for
+// display ("fully-qualified", "minimally-qualified", and "component") must be
+// a single grapheme cluster with a width of 2, regardless of the parser
+// configuration.
+func TestWidthEmojiTest(t *testing.T) {
+	for _, p := range emojiTestParsers {
+		for index, testCase := range emojiTestCases {
+			if testCase.status == "unqualified" {
+				continue
+			}
+			if actual := p.StringWidth(testCase.original); actual != 2 {
+				t.Errorf("%+v: StringWidth(%q) (%s, %s) is %d, expected 2 (test case %d)", *p, testCase.original, testCase.name, testCase.status, actual, index)
+			}
+
+			var (
+				graphemes, stepGraphemes int
+				width, stepWidth         int
+				state                    GraphemeBreakState
+				stepState                State
+			)
+			text := testCase.original
+			for len(text) > 0 {
+				var w int
+				_, text, w, state = p.FirstGraphemeClusterInString(text, state)
+				width += w
+				graphemes++
+			}
+			text = testCase.original
+			for len(text) > 0 {
+				var boundaries Boundaries
+				_, text, boundaries, stepState = p.StepString(text, stepState)
+				stepWidth += boundaries.Width()
+				stepGraphemes++
+			}
+			if graphemes != 1 || width != 2 {
+				t.Errorf("%+v: FirstGraphemeClusterInString(%q) (%s) gave %d clusters of width %d, expected 1 cluster of width 2 (test case %d)", *p, testCase.original, testCase.name, graphemes, width, index)
+			}
+			if stepGraphemes != 1 || stepWidth != 2 {
+				t.Errorf("%+v: StepString(%q) (%s) gave %d clusters of width %d, expected 1 cluster of width 2 (test case %d)", *p, testCase.original, testCase.name, stepGraphemes, stepWidth, index)
+			}
+		}
+	}
+}
+
+// unqualifiedEastAsianWide are the first code points of the unqualified single
+// code points and keycap sequences of emoji-test.txt whose East_Asian_Width is
+// Ambiguous (A) or Wide (W).
+var unqualifiedEastAsianWide = map[rune]bool{
+	0x00AE: true, 0x2122: true, 0x2194: true, 0x2195: true, 0x2196: true,
+	0x2197: true, 0x2198: true, 0x2199: true, 0x24C2: true, 0x25B6: true,
+	0x25C0: true, 0x260E: true, 0x2640: true, 0x2642: true, 0x2660: true,
+	0x2663: true, 0x2665: true, 0x2668: true, 0x26C8: true, 0x26CF: true,
+	0x26D1: true, 0x26D3: true, 0x26E9: true, 0x26F0: true, 0x26F1: true,
+	0x26F4: true, 0x26F7: true, 0x26F8: true, 0x26F9: true, 0x3030: true,
+	0x303D: true, 0x3297: true, 0x3299: true, 0x1F170: true, 0x1F171: true,
+	0x1F17E: true, 0x1F17F: true, 0x1F202: true, 0x1F237: true,
+}
+
+// Unqualified emoji from emoji-test.txt are not recommended for display. Single
+// code points and keycap sequences have a width of 1 (their first code point is
+// text presentation by default) unless WideEmoji is set or EastAsianWidth is
+// set and the first code point is ambiguous or wide. Emoji ZWJ sequences always
+// have a width of 2.
+func TestWidthEmojiTestUnqualified(t *testing.T) {
+	for _, p := range emojiTestParsers {
+		for index, testCase := range emojiTestCases {
+			if testCase.status != "unqualified" {
+				continue
+			}
+			runes := []rune(testCase.original)
+			expected := 2
+			if !p.WideEmoji && (len(runes) == 1 || runes[len(runes)-1] == keycap) &&
+				!(p.EastAsianWidth && unqualifiedEastAsianWide[runes[0]]) {
+				expected = 1
+			}
+			if actual := p.StringWidth(testCase.original); actual != expected {
+				t.Errorf("%+v: StringWidth(%q) (%s) is %d, expected %d (test case %d)", *p, testCase.original, testCase.name, actual, expected, index)
+			}
+		}
+	}
+}
@@ -20,7 +20,8 @@ import "unicode/utf8"
 //
 // [Unicode Standard Annex #29, Word Boundaries]: https://www.unicode.org/reports/tr29/tr29-45.html#Word_Boundaries
 func FirstWord(b []byte, state WordBreakState) (word, rest []byte, newState WordBreakState) {
-	return firstWord(b, state, utf8.DecodeRune)
+	word, rest, _, newState = firstWord(DefaultParser, b, state, utf8.DecodeRune)
+	return
 }
 
 // FirstWord returns the first word found in the given byte slice according to
@@ -40,21 +41,24 @@ func FirstWord(b []byte, state WordBreakState) (word, rest []byte, newState Word
 // Given an empty byte slice "b", the function returns nil values.
 //
 // [Unicode Standard Annex #29, Word Boundaries]: https://www.unicode.org/reports/tr29/tr29-45.html#Word_Boundaries
-func (*Parser) FirstWord(b []byte, state WordBreakState) (word, rest []byte, newState WordBreakState) {
-	return firstWord(b, state, utf8.DecodeRune)
+func (p *Parser) FirstWord(b []byte, state WordBreakState) (word, rest []byte, newState WordBreakState) {
+	word, rest, _, newState = firstWord(p, b, state, utf8.DecodeRune)
+	return
 }
 
 // FirstWordInString is like [FirstWord] but its input and outputs are strings.
 func FirstWordInString(str string, state WordBreakState) (word, rest string, newState WordBreakState) {
-	return firstWord(str, state, utf8.DecodeRuneInString)
+	word, rest, _, newState = firstWord(DefaultParser, str, state, utf8.DecodeRuneInString)
+	return
 }
 
 // FirstWordInString is like [Parser.FirstWord] but its input and outputs are strings.
-func (*Parser) FirstWordInString(str string, state WordBreakState) (word, rest string, newState WordBreakState) {
-	return firstWord(str, state, utf8.DecodeRuneInString)
+func (p *Parser) FirstWordInString(str string, state WordBreakState) (word, rest string, newState WordBreakState) {
+	word, rest, _, newState = firstWord(p, str, state, utf8.DecodeRuneInString)
+	return
 }
 
-func firstWord[T bytes](str T, state WordBreakState, decoder runeDecoder[T]) (word, rest T, newState WordBreakState) {
+func firstWord[T bytes](p *Parser, str T, state WordBreakState, decoder runeDecoder[T]) (word, rest T, kind TokenKind, newState WordBreakState) {
 	var zero T
 
 	// An empty byte slice returns nothing.
@@ -65,7 +69,7 @@ func firstWord[T bytes](str T, state WordBreakState, decoder runeDecoder[T]) (wo
 	// Extract the first rune.
 	r, length := decoder(str)
 	if len(str) <= length { // If we're already past the end, there is nothing else to parse.
-		return str, zero, wbAny
+		return str, zero, TokenNone, wbAny
 	}
 
 	// If we don't know the state, determine it now.
@@ -73,19 +77,36 @@ func firstWord[T bytes](str T, state WordBreakState, decoder runeDecoder[T]) (wo
 		state, _ = transitionWordBreakState(state, r, str[length:], decoder)
 	}
 
+	// The end of the current word found by one of the parser's word
+	// segmenters or of a token, if any.
+	segmentEnd, kind := firstToken(p, str, decoder)
+	if segmentEnd == 0 {
+		segmentEnd = firstSegment(p, str, decoder, wordSegmenter)
+	}
+
 	// Transition until we find a boundary.
 	var boundary bool
 	for {
 		r, l := decoder(str[length:])
 		state, boundary = transitionWordBreakState(state, r, str[length+l:], decoder)
+		if length < segmentEnd {
+			boundary = false
+		} else if length == segmentEnd && (kind != TokenNone || startsSegment(p, r, wordSegmenter)) {
+			boundary = true // The token ends or the segmenter continues with another word.
+		}
 
 		if boundary {
-			return str[:length], str[length:], state
+			return str[:length], str[length:], kind, state
+		}
+		if length >= segmentEnd {
+			if n := firstSegment(p, str[length:], decoder, wordSegmenter); n > 0 {
+				segmentEnd = length + n
+			}
 		}
 
 		length += l
 		if len(str) <= length {
-			return str, zero, wbAny
+			return str, zero, kind, wbAny
 		}
 	}
 }
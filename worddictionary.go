@@ -0,0 +1,242 @@
+package uniseg
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// wordDictionaryMagic identifies the binary format of a [WordDictionary].
+const wordDictionaryMagic = "UWD1"
+
+// WordDictionary is a [WordSegmenter] which splits text into words from a word
+// list. It uses maximal matching: of all ways to split the text into words
+// from the list, it chooses the one with the fewest unknown grapheme clusters
+// and, among those, the one with the fewest words. Ties are broken in favor of
+// the longer first word.
+//
+// Typically, one [WordDictionary] is created for each language and assigned to
+// [Parser.ComplexContext]:
+//
+//	p := &uniseg.Parser{ComplexContext: uniseg.NewWordDictionary(thaiWords)}
+//
+// A dictionary can be stored in a compact binary format using
+// [WordDictionary.MarshalBinary] and loaded again using
+// [WordDictionary.UnmarshalBinary]. The format starts with the four bytes
+// "UWD1", followed by the number of words, followed by the sorted words. Each
+// word is stored as the length of the prefix it shares with the previous word,
+// the length of the rest of the word, and the rest of the word. All lengths
+// are unsigned varints.
+type WordDictionary struct {
+	wordList
+}
+
+// wordList is a sorted list of words used by word segmenters.
+type wordList struct {
+	// The words, sorted and without duplicates.
+	words []string
+
+	// The length of the longest word in bytes.
+	maxLength int
+}
+
+// NewWordDictionary returns a new dictionary containing the given words. Empty
+// strings and invalid UTF-8 are ignored.
+func NewWordDictionary(words []string) *WordDictionary {
+	d := &WordDictionary{}
+	for _, word := range words {
+		if word != "" && utf8.ValidString(word) {
+			d.words = append(d.words, word)
+		}
+	}
+	d.init()
+	return d
+}
+
+// Len returns the number of words in the dictionary.
+func (d *WordDictionary) Len() int {
+	return len(d.words)
+}
+
+// Contains returns true if the dictionary contains the given word.
+func (d *WordDictionary) Contains(word string) bool {
+	return d.index(word) >= 0
+}
+
+// FirstWord returns the length in bytes of the first word of the given text.
+// Text which is not found in the dictionary is returned one grapheme cluster
+// at a time. FirstWord is part of the [WordSegmenter] interface.
+func (d *WordDictionary) FirstWord(text string) int {
+	return d.firstWord(text, nil)
+}
+
+// init sorts the words, removes duplicates, and determines the longest word.
+func (l *wordList) init() {
+	sort.Strings(l.words)
+	var n int
+	l.maxLength = 0
+	for i, word := range l.words {
+		if i > 0 && word == l.words[n-1] {
+			continue
+		}
+		l.words[n] = word
+		n++
+		l.maxLength = max(l.maxLength, len(word))
+	}
+	l.words = l.words[:n]
+}
+
+// index returns the index of the given word in the list, or -1 if the list
+// does not contain it.
+func (l *wordList) index(word string) int {
+	i := sort.SearchStrings(l.words, word)
+	if i < len(l.words) && l.words[i] == word {
+		return i
+	}
+	return -1
+}
+
+// firstWord returns the length in bytes of the first word of the given text
+// using maximal matching: of all ways to split the text into words from the
+// list, it chooses the one with the fewest unknown grapheme clusters and,
+// among those, the one with the fewest words. Remaining ties are broken by the
+// highest sum of word weights (if weight is not nil) and then by the longest
+// first word.
+func (l *wordList) firstWord(text string, weight func(word int) float64) int {
+	if text == "" {
+		return 0
+	}
+
+	// Find the best segmentation of each suffix of the text, starting with
+	// the shortest. Because the segmentation of a suffix does not depend on
+	// the text before it, calling firstWord again with the rest of the text
+	// continues this segmentation.
+	type segmentation struct {
+		unknown, words int     // The number of unknown clusters and of words.
+		weight         float64 // The sum of the word weights.
+		first          int     // The length of the first word.
+	}
+	best := make([]segmentation, len(text)+1)
+	better := func(a, b segmentation) bool {
+		if a.unknown != b.unknown {
+			return a.unknown < b.unknown
+		}
+		if a.words != b.words {
+			return a.words < b.words
+		}
+		if a.weight != b.weight {
+			return a.weight > b.weight
+		}
+		return a.first > b.first
+	}
+	for i := len(text) - 1; i >= 0; i-- {
+		if !utf8.RuneStart(text[i]) {
+			continue
+		}
+
+		// Skip an unknown grapheme cluster.
+		cluster, _, _, _ := FirstGraphemeClusterInString(text[i:], 0)
+		rest := best[i+len(cluster)]
+		candidate := segmentation{unknown: rest.unknown + 1, words: rest.words + 1, weight: rest.weight, first: len(cluster)}
+
+		// Try all words which are a prefix of the text.
+		for _, word := range l.prefixes(text[i:]) {
+			length := len(l.words[word])
+			rest := best[i+length]
+			match := segmentation{unknown: rest.unknown, words: rest.words + 1, weight: rest.weight, first: length}
+			if weight != nil {
+				match.weight += weight(word)
+			}
+			if better(match, candidate) {
+				candidate = match
+			}
+		}
+		best[i] = candidate
+	}
+	return best[0].first
+}
+
+// prefixes returns the indices of all words of the list which are a prefix of
+// the given text, shortest first.
+func (l *wordList) prefixes(text string) (words []int) {
+	for length := 0; length < len(text) && length < l.maxLength; {
+		_, n := utf8.DecodeRuneInString(text[length:])
+		length += n
+		prefix := text[:length]
+		i := sort.SearchStrings(l.words, prefix)
+		if i >= len(l.words) || !strings.HasPrefix(l.words[i], prefix) {
+			break // No longer words with this prefix.
+		}
+		if l.words[i] == prefix {
+			words = append(words, i)
+		}
+	}
+	return
+}
+
+// MarshalBinary encodes the dictionary in its compact binary format. It
+// implements the [encoding.BinaryMarshaler] interface.
+func (d *WordDictionary) MarshalBinary() ([]byte, error) {
+	data := []byte(wordDictionaryMagic)
+	data = binary.AppendUvarint(data, uint64(len(d.words)))
+	var previous string
+	for _, word := range d.words {
+		var shared int
+		for shared < len(word) && shared < len(previous) && word[shared] == previous[shared] {
+			shared++
+		}
+		data = binary.AppendUvarint(data, uint64(shared))
+		data = binary.AppendUvarint(data, uint64(len(word)-shared))
+		data = append(data, word[shared:]...)
+		previous = word
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes a dictionary in the binary format produced by
+// [WordDictionary.MarshalBinary], replacing the contents of d. It implements
+// the [encoding.BinaryUnmarshaler] interface.
+func (d *WordDictionary) UnmarshalBinary(data []byte) error {
+	errInvalid := errors.New("uniseg: invalid word dictionary")
+	if !strings.HasPrefix(string(data), wordDictionaryMagic) {
+		return errInvalid
+	}
+	data = data[len(wordDictionaryMagic):]
+	uvarint := func() (int, bool) {
+		v, n := binary.Uvarint(data)
+		if n <= 0 || v > math.MaxInt32 {
+			return 0, false
+		}
+		data = data[n:]
+		return int(v), true
+	}
+	count, ok := uvarint()
+	if !ok || count > len(data) {
+		return errInvalid
+	}
+	words := make([]string, 0, count)
+	var previous string
+	for range count {
+		shared, ok1 := uvarint()
+		length, ok2 := uvarint()
+		if !ok1 || !ok2 || shared > len(previous) || length > len(data) {
+			return errInvalid
+		}
+		word := previous[:shared] + string(data[:length])
+		data = data[length:]
+		if word == "" || !utf8.ValidString(word) {
+			return errInvalid
+		}
+		words = append(words, word)
+		previous = word
+	}
+	if len(data) > 0 {
+		return errInvalid
+	}
+	d.words = words
+	d.init()
+	return nil
+}
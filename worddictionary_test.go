@@ -0,0 +1,195 @@
+package uniseg
+
+import (
+	"strings"
+	"testing"
+)
+
+// thaiTestWords is a small Thai word list used for testing.
+var thaiTestWords = []string{
+	"ภาษา", "ไทย", "ง่าย", "นิด", "เดียว", "นิดเดียว", "ตา", "ตาก", "กลม", "ไป", "ทำงาน", "ที่", "ทำ", "งาน",
+}
+
+// complexContextTestCases are texts with their expected words when segmented
+// using thaiTestWords.
+var complexContextTestCases = []struct {
+	original string
+	expected []string
+}{
+	{"", nil},
+	{"ภาษาไทย", []string{"ภาษา", "ไทย"}},
+	{"ภาษาไทยง่ายนิดเดียว", []string{"ภาษา", "ไทย", "ง่าย", "นิดเดียว"}},
+	{"ตากลม", []string{"ตา", "กลม"}},               // Not "ตาก", "ล", "ม".
+	{"ไปทำงานที่", []string{"ไป", "ทำงาน", "ที่"}}, // Not "ทำ", "งาน".
+	{"ไทยxyzไทย", []string{"ไทย", "xyz", "ไทย"}},
+	{"Thai ภาษาไทย.", []string{"Thai", " ", "ภาษา", "ไทย", "."}},
+	{"ขไทย", []string{"ข", "ไทย"}}, // Unknown characters.
+	{"ไทย​ไทย", []string{"ไทย", "​", "ไทย"}},
+	{"ກິນເຂົ້າ", []string{"ກິ", "ນ", "ເ", "ຂົ້", "າ"}}, // Lao without a Lao dictionary.
+}
+
+func TestWordDictionaryFirstWord(t *testing.T) {
+	d := NewWordDictionary(thaiTestWords)
+	for _, testCase := range complexContextTestCases {
+		if !isComplexContext(testCase.original) {
+			continue
+		}
+		var words []string
+		for text := testCase.original; len(text) > 0; {
+			n := d.FirstWord(text)
+			if n <= 0 || n > len(text) {
+				t.Fatalf("FirstWord(%q) = %d", text, n)
+			}
+			words = append(words, text[:n])
+			text = text[n:]
+		}
+		if strings.Join(words, "|") != strings.Join(testCase.expected, "|") {
+			t.Errorf("FirstWord(%q) gave %q, want %q", testCase.original, words, testCase.expected)
+		}
+	}
+
+	if d.Len() != len(thaiTestWords) {
+		t.Errorf("Len() = %d, want %d", d.Len(), len(thaiTestWords))
+	}
+	if !d.Contains("ไทย") || d.Contains("ไท") {
+		t.Error("Contains returned wrong results")
+	}
+	if n := NewWordDictionary([]string{"", "\xff", "ไทย", "ไทย"}).Len(); n != 1 {
+		t.Errorf("Len() = %d, want 1", n)
+	}
+}
+
+func TestComplexContextWords(t *testing.T) {
+	p := &Parser{ComplexContext: NewWordDictionary(thaiTestWords)}
+	for _, testCase := range complexContextTestCases {
+		testSegmentedWords(t, p, testCase.original, testCase.expected)
+	}
+}
+
+// Long runs are segmented in windows, which must neither change the words nor
+// take quadratic time.
+func TestComplexContextLongRun(t *testing.T) {
+	p := &Parser{ComplexContext: NewWordDictionary(thaiTestWords)}
+	sentence := []string{"ภาษา", "ไทย", "ง่าย", "นิดเดียว", "ตา", "กลม", "ไป", "ทำงาน", "ที่"}
+	var expected []string
+	for range 500 {
+		expected = append(expected, sentence...)
+	}
+	testSegmentedWords(t, p, strings.Join(expected, ""), expected)
+}
+
+// testSegmentedWords checks that FirstWord, FirstWordInString, StepString, and
+// Graphemes split the given string into the expected words.
+func testSegmentedWords(t *testing.T, p *Parser, original string, expected []string) {
+	t.Helper()
+
+	// FirstWordInString.
+	var (
+		words []string
+		word  string
+		state WordBreakState
+	)
+	for str := original; len(str) > 0; {
+		word, str, state = p.FirstWordInString(str, state)
+		words = append(words, word)
+	}
+	if strings.Join(words, "|") != strings.Join(expected, "|") {
+		t.Errorf("FirstWordInString(%q) = %q, want %q", original, words, expected)
+	}
+
+	// FirstWord.
+	words, state = nil, 0
+	for b := []byte(original); len(b) > 0; {
+		var w []byte
+		w, b, state = p.FirstWord(b, state)
+		words = append(words, string(w))
+	}
+	if strings.Join(words, "|") != strings.Join(expected, "|") {
+		t.Errorf("FirstWord(%q) = %q, want %q", original, words, expected)
+	}
+
+	// StepString.
+	words = nil
+	var (
+		stepState  State
+		boundaries Boundaries
+		cluster    string
+		start      int
+	)
+	for str, pos := original, 0; len(str) > 0; {
+		cluster, str, boundaries, stepState = p.StepString(str, stepState)
+		pos += len(cluster)
+		if boundaries.Word() {
+			words = append(words, original[start:pos])
+			start = pos
+		}
+	}
+	if strings.Join(words, "|") != strings.Join(expected, "|") {
+		t.Errorf("StepString(%q) = %q, want %q", original, words, expected)
+	}
+
+	// Graphemes.
+	words = nil
+	start = 0
+	g := p.NewGraphemes(original)
+	for g.Next() {
+		if g.IsWordBoundary() {
+			_, to := g.Positions()
+			words = append(words, original[start:to])
+			start = to
+		}
+	}
+	if strings.Join(words, "|") != strings.Join(expected, "|") {
+		t.Errorf("Graphemes(%q) = %q, want %q", original, words, expected)
+	}
+}
+
+func TestWordDictionaryBinary(t *testing.T) {
+	d := NewWordDictionary(thaiTestWords)
+	data, err := d.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded WordDictionary
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(decoded.words, "|") != strings.Join(d.words, "|") || decoded.maxLength != d.maxLength {
+		t.Errorf("UnmarshalBinary gave %q, want %q", decoded.words, d.words)
+	}
+
+	// Shared prefixes are stored only once.
+	var total int
+	for _, word := range thaiTestWords {
+		total += len(word)
+	}
+	if len(data) >= total {
+		t.Errorf("MarshalBinary gave %d bytes for %d bytes of words", len(data), total)
+	}
+
+	// Invalid data.
+	for _, invalid := range [][]byte{
+		nil,
+		[]byte("XXXX"),
+		data[:len(data)-1],
+		append(append([]byte(nil), data...), 0),
+		[]byte("UWD1\x01\x01\x01a"),    // Shared prefix longer than the previous word.
+		[]byte("UWD1\x01\x00\x01\xff"), // Invalid UTF-8.
+		[]byte("UWD1\x01\x00\x00"),     // Empty word.
+	} {
+		if err := new(WordDictionary).UnmarshalBinary(invalid); err == nil {
+			t.Errorf("UnmarshalBinary(%q) succeeded", invalid)
+		}
+	}
+}
+
+// isComplexContext returns true if the given string only contains characters
+// with the Line_Break property Complex_Context (SA).
+func isComplexContext(str string) bool {
+	for _, r := range str {
+		if lineBreakCodePoints.search(r).lbProperty != lbprSA {
+			return false
+		}
+	}
+	return str != ""
+}
@@ -0,0 +1,551 @@
+package uniseg
+
+import (
+	"math"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Overflow selects how the line wrapping functions handle line segments which
+// are wider than a line, for example long URLs or file paths. It corresponds
+// to the values of the CSS [overflow-wrap] property and to the ellipsis of the
+// CSS [text-overflow] property.
+//
+// [overflow-wrap]: https://www.w3.org/TR/css-text-3/#overflow-wrap-property
+// [text-overflow]: https://www.w3.org/TR/css-overflow-3/#text-overflow
+type Overflow int
+
+// The overflow policies.
+const (
+	// OverflowVisible places such a segment on a line of its own which sticks
+	// out beyond the width.
+	OverflowVisible Overflow = iota
+
+	// OverflowAnywhere allows breaks between any two grapheme clusters of such
+	// a segment, so its beginning may fill the rest of the preceding line.
+	OverflowAnywhere
+
+	// OverflowBreakWord starts such a segment on a new line and breaks it
+	// between grapheme clusters only where it doesn't fit.
+	OverflowBreakWord
+
+	// OverflowEllipsis places such a segment on a line of its own, truncated
+	// after the grapheme clusters which fit together with an ellipsis
+	// ("\u2026"). The rest of the segment, including its trailing white space,
+	// is not part of any line.
+	OverflowEllipsis
+)
+
+// WrapOptions configures the line wrapping functions [WrapGreedy],
+// [WrapOptimal], and [WrapBalanced].
+type WrapOptions struct {
+	// Width is the maximum width of a line, in monospace cells (see
+	// [StringWidth]) or in the unit of Measure or MeasureSegment. If it is not
+	// positive, lines are only broken where they must be broken, for example
+	// after newline characters.
+	Width float64
+
+	// Measure, if not nil, returns the width of a grapheme cluster, for
+	// example in points when rendering text in a proportional font. The width
+	// of a hyphen displayed at the end of a line is Measure("-").
+	Measure func(cluster string) float64
+
+	// MeasureSegment, if not nil, returns the width of a line segment, that is
+	// the text between two line break opportunities, without its trailing
+	// white space, or the width of that white space. This allows for kerning
+	// and ligatures within segments. The width of a hyphen displayed at the end
+	// of a line is MeasureSegment("-"). If MeasureSegment is set, Measure is
+	// ignored.
+	MeasureSegment func(segment string) float64
+
+	// Overflow is the policy for line segments which are wider than Width.
+	Overflow Overflow
+
+	// HyphenPenalty is added to the demerits of a line ending with a
+	// hyphenation point, see [LineHyphenBreak]. Demerits are measured in
+	// squared units of width: a line which is 5 cells (or units of Measure)
+	// shorter than Width has 25 demerits.
+	HyphenPenalty float64
+
+	// ConsecutiveHyphenPenalty is added to the demerits of a line ending with a
+	// hyphenation point if the previous line also ended with one.
+	ConsecutiveHyphenPenalty float64
+
+	// WidowPenalty is added to the demerits of the last line of a paragraph if
+	// it contains only a single line segment (typically a single word) and the
+	// paragraph has more than one line.
+	WidowPenalty float64
+}
+
+// Line is a line of text returned by the line wrapping functions, given as the
+// byte offsets of its first byte and of the first byte after it. The line
+// includes the trailing white space and the mandatory line break at its end,
+// if any, which are not part of its width.
+type Line struct {
+	Start, End int
+
+	// Width is the width of the line without trailing white space, including
+	// the hyphen displayed at its end if Hyphen is true.
+	Width float64
+
+	// Hyphen is true if the line ends at a hyphenation point, where a hyphen
+	// must be displayed (see [LineHyphenBreak]).
+	Hyphen bool
+
+	// Ellipsis is true if the line was truncated according to
+	// [OverflowEllipsis] and an ellipsis must be displayed at its end. The
+	// width includes the ellipsis.
+	Ellipsis bool
+}
+
+// wrapItem is a line segment considered by the line wrapping functions, that
+// is the text between two line break opportunities.
+type wrapItem struct {
+	start, end int       // The byte offsets of the segment, including its trailing white space.
+	spaceStart int       // The byte offset of the trailing white space.
+	width      float64   // The width of the segment without trailing white space.
+	space      float64   // The width of the trailing white space.
+	hyphen     float64   // The width of the hyphen displayed if the line is broken after the segment.
+	lineBreak  LineBreak // The line break after the segment.
+	ellipsis   bool      // Whether the segment was truncated and an ellipsis is displayed after it.
+}
+
+// wrapLines holds the line segments of a text and the prefix sums of their
+// widths.
+type wrapLines struct {
+	items []wrapItem
+	sums  []float64 // sums[i] is the width of items[:i] including white space.
+	opts  *WrapOptions
+}
+
+// WrapGreedy breaks s into lines no wider than opts.Width, filling each line
+// with as many line segments as fit, like most text editors and terminals do.
+// Lines are broken only at the line break opportunities found by [Step],
+// trailing white space may extend beyond the width, and a single segment which
+// is wider than opts.Width is handled according to opts.Overflow. Lines always
+// end at mandatory line breaks. Unless segments are truncated with
+// [OverflowEllipsis], the returned lines are consecutive and cover all of s. An
+// empty string results in no lines.
+//
+// Widths are monospace widths unless opts.Measure or opts.MeasureSegment is
+// set, for example for proportional fonts. The penalties in opts are ignored.
+func WrapGreedy(s string, opts WrapOptions) []Line {
+	return DefaultParser.WrapGreedy(s, opts)
+}
+
+// WrapGreedy is like [WrapGreedy] but uses the given parser.
+func (p *Parser) WrapGreedy(s string, opts WrapOptions) []Line {
+	w := p.wrapLines(s, &opts)
+	var lines []Line
+	w.paragraphs(func(start, end int) {
+		lines = w.greedy(lines, start, end)
+	})
+	return lines
+}
+
+// WrapOptimal breaks s into lines no wider than opts.Width such that the
+// lines of each paragraph are as evenly filled as possible, using the total fit
+// algorithm of Knuth and Plass. It minimises the sum of the demerits of all
+// lines except the last one of each paragraph, which are the squares of the
+// space left at their ends, plus the penalties for hyphenation and widows set
+// in opts. The last line of a paragraph has no demerits except for
+// opts.WidowPenalty.
+//
+// The lines are otherwise subject to the same rules as with [WrapGreedy]. The
+// running time is proportional to the number of line segments times the
+// number of segments which fit on a line.
+func WrapOptimal(s string, opts WrapOptions) []Line {
+	return DefaultParser.WrapOptimal(s, opts)
+}
+
+// WrapOptimal is like [WrapOptimal] but uses the given parser.
+func (p *Parser) WrapOptimal(s string, opts WrapOptions) []Line {
+	w := p.wrapLines(s, &opts)
+	var lines []Line
+	w.paragraphs(func(start, end int) {
+		lines = w.optimal(lines, start, end)
+	})
+	return lines
+}
+
+// WrapBalanced breaks s into lines no wider than opts.Width such that each
+// paragraph has as many lines as with [WrapGreedy] but the lines are of
+// roughly equal width, like the CSS "text-wrap: balance" property. This is
+// useful for headings and short labels, where a short last line looks odd. It
+// minimises the sum of the squares of the space left at the ends of all lines
+// including the last one, plus the hyphenation penalties set in opts.
+// opts.WidowPenalty is ignored.
+//
+// The lines are otherwise subject to the same rules as with [WrapGreedy]. The
+// running time is usually similar to that of [WrapOptimal].
+func WrapBalanced(s string, opts WrapOptions) []Line {
+	return DefaultParser.WrapBalanced(s, opts)
+}
+
+// WrapBalanced is like [WrapBalanced] but uses the given parser.
+func (p *Parser) WrapBalanced(s string, opts WrapOptions) []Line {
+	w := p.wrapLines(s, &opts)
+	var lines []Line
+	w.paragraphs(func(start, end int) {
+		lines = w.balanced(lines, start, end)
+	})
+	return lines
+}
+
+// wrapLines splits s into line segments.
+func (p *Parser) wrapLines(s string, opts *WrapOptions) *wrapLines {
+	w := &wrapLines{sums: []float64{0}, opts: opts}
+	hyphen := opts.measure("-", 1)
+	var (
+		item       wrapItem
+		offset     int
+		spaceStart int // The byte offset of the trailing white space of the current segment.
+		cluster    string
+		boundaries Boundaries
+		state      State
+		hyphens    hyphenationPoints
+	)
+	for rest := s; len(rest) > 0; {
+		cluster, rest, boundaries, state = step(p, rest, state, &hyphens, utf8.DecodeRuneInString)
+		var width float64
+		switch {
+		case opts.MeasureSegment != nil:
+			// Segments are measured as a whole below.
+		case opts.Measure != nil:
+			width = opts.Measure(cluster)
+		default:
+			width = float64(boundaries.Width())
+		}
+		if r, _ := utf8.DecodeRuneInString(cluster); isWrapSpace(r) {
+			item.space += width
+		} else {
+			item.width += item.space + width
+			item.space = 0
+			spaceStart = offset + len(cluster)
+		}
+		offset += len(cluster)
+		lineBreak := boundaries.Line()
+		if lineBreak == LineDontBreak && len(rest) > 0 {
+			continue
+		}
+		if len(rest) == 0 {
+			lineBreak = LineMustBreak
+		}
+		item.end, item.lineBreak = offset, lineBreak
+		item.spaceStart = max(spaceStart, item.start)
+		if opts.MeasureSegment != nil {
+			if item.spaceStart > item.start {
+				item.width = opts.MeasureSegment(s[item.start:item.spaceStart])
+			}
+			if item.spaceStart < offset {
+				item.space = opts.MeasureSegment(s[item.spaceStart:offset])
+			}
+		}
+		if lineBreak == LineHyphenBreak {
+			item.hyphen = hyphen
+		}
+		if opts.Overflow != OverflowVisible && !w.fits(item.width+item.hyphen) {
+			w.overflow(p, s, item)
+		} else {
+			w.add(item)
+		}
+		item = wrapItem{start: offset}
+	}
+	return w
+}
+
+// add appends the given segment.
+func (w *wrapLines) add(item wrapItem) {
+	w.items = append(w.items, item)
+	w.sums = append(w.sums, w.sums[len(w.sums)-1]+item.width+item.space)
+}
+
+// overflow appends the given segment, which is wider than a line, according to
+// the overflow policy. The segment is split into grapheme clusters with
+// [Parser.FirstGraphemeClusterInString].
+func (w *wrapLines) overflow(p *Parser, s string, item wrapItem) {
+	var (
+		pieces  []wrapItem // The grapheme clusters before the trailing white space.
+		cluster string
+		cells   int
+		state   GraphemeBreakState
+	)
+	for offset := item.start; offset < item.spaceStart; offset += len(cluster) {
+		cluster, _, cells, state = p.FirstGraphemeClusterInString(s[offset:item.spaceStart], state)
+		pieces = append(pieces, wrapItem{
+			start:      offset,
+			end:        offset + len(cluster),
+			spaceStart: offset + len(cluster),
+			width:      w.opts.measure(cluster, cells),
+			lineBreak:  LineCanBreak,
+		})
+	}
+
+	if len(pieces) == 0 {
+		w.add(item) // Only white space.
+		return
+	}
+
+	// Truncate the segment.
+	if w.opts.Overflow == OverflowEllipsis {
+		const ellipsis = "\u2026"
+		truncated := wrapItem{
+			start:     item.start,
+			end:       item.start,
+			width:     w.opts.measure(ellipsis, p.StringWidth(ellipsis)),
+			lineBreak: LineMustBreak,
+			ellipsis:  true,
+		}
+		for _, piece := range pieces {
+			if !w.fits(truncated.width + piece.width) {
+				break
+			}
+			truncated.end = piece.end
+			truncated.width += piece.width
+		}
+		truncated.spaceStart = truncated.end
+		w.add(truncated)
+		return
+	}
+
+	// The last cluster keeps the trailing white space and the line break.
+	last := &pieces[len(pieces)-1]
+	last.end, last.space, last.hyphen, last.lineBreak = item.end, item.space, item.hyphen, item.lineBreak
+	if w.opts.Overflow == OverflowAnywhere {
+		for _, piece := range pieces {
+			w.add(piece)
+		}
+		return
+	}
+
+	// Combine the clusters into pieces which fill a line each.
+	combined := pieces[0]
+	for _, piece := range pieces[1:] {
+		if w.fits(combined.width + piece.width + piece.hyphen) {
+			combined.end, combined.spaceStart = piece.end, piece.spaceStart
+			combined.width += piece.width
+			combined.space, combined.hyphen, combined.lineBreak = piece.space, piece.hyphen, piece.lineBreak
+			continue
+		}
+		w.add(combined)
+		combined = piece
+	}
+	w.add(combined)
+}
+
+// paragraphs calls the given function for each paragraph, given as the
+// indices of its first segment and of the segment after its last one, which
+// ends with a mandatory line break.
+func (w *wrapLines) paragraphs(f func(start, end int)) {
+	var start int
+	for i, item := range w.items {
+		if item.lineBreak == LineMustBreak {
+			f(start, i+1)
+			start = i + 1
+		}
+	}
+}
+
+// width returns the width of a line made of the segments with the indices
+// from "start" (inclusive) to "end" (exclusive).
+func (w *wrapLines) width(start, end int) float64 {
+	last := &w.items[end-1]
+	return w.sums[end] - w.sums[start] - last.space + last.hyphen
+}
+
+// fits returns true if a line of the given width fits, allowing for rounding
+// errors in the sums of widths.
+func (w *wrapLines) fits(width float64) bool {
+	return w.opts.Width <= 0 || width <= w.opts.Width*(1+1e-9)
+}
+
+// line appends the line made of the segments with the indices from "start"
+// (inclusive) to "end" (exclusive) to the given lines.
+func (w *wrapLines) line(lines []Line, start, end int) []Line {
+	last := &w.items[end-1]
+	return append(lines, Line{
+		Start:    w.items[start].start,
+		End:      last.end,
+		Width:    w.width(start, end),
+		Hyphen:   last.lineBreak == LineHyphenBreak,
+		Ellipsis: last.ellipsis,
+	})
+}
+
+// greedy appends the lines of the paragraph made of the segments with the
+// indices from "start" (inclusive) to "end" (exclusive) to the given lines,
+// filling each line with as many segments as fit.
+func (w *wrapLines) greedy(lines []Line, start, end int) []Line {
+	for start < end {
+		next := start + 1
+		for i := next + 1; i <= end; i++ {
+			if !w.fits(w.sums[i] - w.sums[start] - w.items[i-1].space) {
+				break // No more segments fit, even without a hyphen.
+			}
+			if w.fits(w.width(start, i)) {
+				next = i
+			}
+		}
+		lines = w.line(lines, start, next)
+		start = next
+	}
+	return lines
+}
+
+// optimal appends the lines of the paragraph made of the segments with the
+// indices from "start" (inclusive) to "end" (exclusive) to the given lines,
+// minimising the total demerits of the paragraph.
+func (w *wrapLines) optimal(lines []Line, start, end int) []Line {
+	if w.opts.Width <= 0 {
+		return w.greedy(lines, start, end) // One line per paragraph.
+	}
+
+	// demerits[i] holds the minimum demerits of the lines before the segment
+	// start+i, and previous[i] the start of the last of these lines.
+	n := end - start
+	demerits := make([]float64, n+1)
+	previous := make([]int, n+1)
+	for i := 1; i <= n; i++ {
+		demerits[i] = math.Inf(1)
+		for j := i - 1; j >= 0; j-- {
+			width := w.width(start+j, start+i)
+			if !w.fits(width) && j < i-1 {
+				break // Segments wider than the line are on their own.
+			}
+			var d float64
+			if i < n {
+				d = w.demerits(start, start+j, start+i, width)
+			} else if j == i-1 && j > 0 {
+				d = w.opts.WidowPenalty
+			}
+			if d += demerits[j]; d < demerits[i] {
+				demerits[i], previous[i] = d, j
+			}
+		}
+	}
+
+	// Collect the lines from the end.
+	first := len(lines)
+	for i := n; i > 0; i = previous[i] {
+		lines = w.line(lines, start+previous[i], start+i)
+	}
+	slices.Reverse(lines[first:])
+	return lines
+}
+
+// balanced appends the lines of the paragraph made of the segments with the
+// indices from "start" (inclusive) to "end" (exclusive) to the given lines,
+// using as many lines as [wrapLines.greedy] and minimising their total
+// demerits, including those of the last line.
+func (w *wrapLines) balanced(lines []Line, start, end int) []Line {
+	first := len(lines)
+	lines = w.greedy(lines, start, end)
+	count := len(lines) - first
+	if count <= 1 {
+		return lines
+	}
+	lines = lines[:first]
+
+	// fewest[i] holds the minimum number of lines needed for the segments
+	// before start+i, and rest[i] for the segments from start+i on. The lines
+	// ending before start+i therefore number from fewest[i] to count-rest[i].
+	n := end - start
+	fewest := make([]int, n+1)
+	rest := make([]int, n+1)
+	for i := 1; i <= n; i++ {
+		fewest[i] = i
+		for j := i - 1; j >= 0; j-- {
+			if !w.fits(w.width(start+j, start+i)) && j < i-1 {
+				break
+			}
+			fewest[i] = min(fewest[i], fewest[j]+1)
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		rest[i] = n - i
+		for j := i + 1; j <= n; j++ {
+			if !w.fits(w.sums[start+j]-w.sums[start+i]-w.items[start+j-1].space) && j > i+1 {
+				break
+			}
+			if j == i+1 || w.fits(w.width(start+i, start+j)) {
+				rest[i] = min(rest[i], rest[j]+1)
+			}
+		}
+	}
+
+	// demerits[offsets[i]+l-fewest[i]] holds the minimum demerits of l lines
+	// made of the segments before start+i, and previous[...] the start of the
+	// last of these lines.
+	offsets := make([]int, n+2)
+	for i := range n + 1 {
+		offsets[i+1] = offsets[i] + max(count-rest[i]-fewest[i]+1, 0)
+	}
+	demerits := make([]float64, offsets[n+1])
+	previous := make([]int, offsets[n+1])
+	for k := 1; k < len(demerits); k++ {
+		demerits[k] = math.Inf(1)
+	}
+	for i := 1; i <= n; i++ {
+		for j := i - 1; j >= 0; j-- {
+			width := w.width(start+j, start+i)
+			if !w.fits(width) && j < i-1 {
+				break // Segments wider than the line are on their own.
+			}
+			d := w.demerits(start, start+j, start+i, width)
+			for l := max(fewest[i], fewest[j]+1); l <= min(count-rest[i], count-rest[j]+1); l++ {
+				k := offsets[i] + l - fewest[i]
+				if total := demerits[offsets[j]+l-1-fewest[j]] + d; total < demerits[k] {
+					demerits[k], previous[k] = total, j
+				}
+			}
+		}
+	}
+
+	// Collect the lines from the end.
+	for l, i := count, n; l > 0; l-- {
+		j := previous[offsets[i]+l-fewest[i]]
+		lines = w.line(lines, start+j, start+i)
+		i = j
+	}
+	slices.Reverse(lines[first:])
+	return lines
+}
+
+// demerits returns the demerits of a line made of the segments with the
+// indices from "start" (inclusive) to "end" (exclusive) and the given width,
+// in a paragraph starting with the segment "first".
+func (w *wrapLines) demerits(first, start, end int, width float64) float64 {
+	d := max(w.opts.Width-width, 0)
+	d *= d
+	if w.items[end-1].lineBreak == LineHyphenBreak {
+		d += w.opts.HyphenPenalty
+		if start > first && w.items[start-1].lineBreak == LineHyphenBreak {
+			d += w.opts.ConsecutiveHyphenPenalty
+		}
+	}
+	return d
+}
+
+// measure returns the width of the given text, which is a grapheme cluster or
+// a short string, with the given monospace width.
+func (opts *WrapOptions) measure(text string, cells int) float64 {
+	switch {
+	case opts.MeasureSegment != nil:
+		return opts.MeasureSegment(text)
+	case opts.Measure != nil:
+		return opts.Measure(text)
+	}
+	return float64(cells)
+}
+
+// isWrapSpace returns true if the given rune is white space which may extend
+// beyond the end of a line, that is any white space except non-breaking
+// spaces.
+func isWrapSpace(r rune) bool {
+	switch r {
+	case '\u00a0', '\u2007', '\u202f': // NO-BREAK SPACE, FIGURE SPACE, NARROW NO-BREAK SPACE
+		return false
+	}
+	return unicode.IsSpace(r)
+}
@@ -0,0 +1,484 @@
+package uniseg
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// lineTexts returns the texts of the given lines of s.
+func lineTexts(s string, lines []Line) []string {
+	var texts []string
+	for _, line := range lines {
+		texts = append(texts, s[line.Start:line.End])
+	}
+	return texts
+}
+
+// checkLines reports an error if the given lines of s are not consecutive or
+// don't cover all of s.
+func checkLines(t *testing.T, name, s string, lines []Line) {
+	t.Helper()
+	var end int
+	for _, line := range lines {
+		if line.Start != end || line.End <= end {
+			t.Errorf("%s: line %v doesn't continue at %d", name, line, end)
+		}
+		end = line.End
+	}
+	if end != len(s) {
+		t.Errorf("%s: lines end at %d, want %d", name, end, len(s))
+	}
+}
+
+// wrapTestCases are texts with their expected lines when wrapped greedily and
+// optimally.
+var wrapTestCases = []struct {
+	name     string
+	original string
+	options  WrapOptions
+	greedy   []string
+	optimal  []string
+}{
+	{"empty", "", WrapOptions{Width: 10}, nil, nil},
+	{"fits", "Hello, world!", WrapOptions{Width: 20}, []string{"Hello, world!"}, []string{"Hello, world!"}},
+	{"no width", "one two three\nfour", WrapOptions{}, []string{"one two three\n", "four"}, []string{"one two three\n", "four"}},
+	{"words", "The quick brown fox", WrapOptions{Width: 10}, []string{"The quick ", "brown fox"}, []string{"The quick ", "brown fox"}},
+	{"exact", "aaa bbb", WrapOptions{Width: 3}, []string{"aaa ", "bbb"}, []string{"aaa ", "bbb"}},
+	{"trailing spaces", "abc    def   ", WrapOptions{Width: 3}, []string{"abc    ", "def   "}, []string{"abc    ", "def   "}},
+	{"no-break space", "ab cd\u00a0ef", WrapOptions{Width: 4}, []string{"ab ", "cd\u00a0ef"}, []string{"ab ", "cd\u00a0ef"}},
+	{"mandatory breaks", "a b\nc d\n\ne", WrapOptions{Width: 10}, []string{"a b\n", "c d\n", "\n", "e"}, []string{"a b\n", "c d\n", "\n", "e"}},
+	{"overflow", "a verylongword b", WrapOptions{Width: 4}, []string{"a ", "verylongword ", "b"}, []string{"a ", "verylongword ", "b"}},
+	{"hyphens", "one-two three", WrapOptions{Width: 8}, []string{"one-two ", "three"}, []string{"one-two ", "three"}},
+	{"wide characters", "日本語のテキスト", WrapOptions{Width: 7}, []string{"日本語", "のテキ", "スト"}, []string{"日本語", "のテキ", "スト"}},
+	{"even lines", "aaa bb cc ddddd", WrapOptions{Width: 6}, []string{"aaa bb ", "cc ", "ddddd"}, []string{"aaa ", "bb cc ", "ddddd"}},
+	{"widow", "aaa bbb ccc ddd", WrapOptions{Width: 11}, []string{"aaa bbb ccc ", "ddd"}, []string{"aaa bbb ccc ", "ddd"}},
+	{"widow penalty", "aaa bbb ccc ddd", WrapOptions{Width: 11, WidowPenalty: 20}, []string{"aaa bbb ccc ", "ddd"}, []string{"aaa bbb ", "ccc ddd"}},
+	{"soft hyphen", "aa bbb\u00adccc", WrapOptions{Width: 7}, []string{"aa bbb\u00ad", "ccc"}, []string{"aa bbb\u00ad", "ccc"}},
+	{"hyphen penalty", "aa bbb\u00adccc", WrapOptions{Width: 7, HyphenPenalty: 100}, []string{"aa bbb\u00ad", "ccc"}, []string{"aa ", "bbb\u00adccc"}},
+	{"consecutive hyphens", "aaaa\u00adbb\u00adcccc", WrapOptions{Width: 5}, []string{"aaaa\u00ad", "bb\u00ad", "cccc"}, []string{"aaaa\u00ad", "bb\u00ad", "cccc"}},
+	{"consecutive hyphen penalty", "aaaa\u00adbb\u00adcccc", WrapOptions{Width: 7, ConsecutiveHyphenPenalty: 100}, []string{"aaaa\u00adbb\u00ad", "cccc"}, []string{"aaaa\u00adbb\u00ad", "cccc"}},
+}
+
+func TestWrap(t *testing.T) {
+	p := &Parser{Hyphens: HyphensManual}
+	for _, tt := range wrapTestCases {
+		for _, wrap := range []struct {
+			name     string
+			wrap     func(*Parser, string, WrapOptions) []Line
+			expected []string
+		}{
+			{"WrapGreedy", (*Parser).WrapGreedy, tt.greedy},
+			{"WrapOptimal", (*Parser).WrapOptimal, tt.optimal},
+		} {
+			lines := wrap.wrap(p, tt.original, tt.options)
+			if got := lineTexts(tt.original, lines); !reflect.DeepEqual(got, wrap.expected) {
+				t.Errorf("%s: %s(%q) = %q, want %q", tt.name, wrap.name, tt.original, got, wrap.expected)
+				continue
+			}
+			checkLines(t, tt.name, tt.original, lines)
+		}
+	}
+
+	// Line widths and hyphens.
+	lines := p.WrapGreedy("aa bbb\u00adccc  \n", WrapOptions{Width: 7})
+	if expected := []Line{{0, 8, 7, true, false}, {8, 14, 3, false, false}}; !reflect.DeepEqual(lines, expected) {
+		t.Errorf("WrapGreedy lines are %v, want %v", lines, expected)
+	}
+	lines = WrapGreedy("aa bbb\u00adccc", WrapOptions{Width: 7})
+	if expected := []Line{{0, 8, 6, false, false}, {8, 11, 3, false, false}}; !reflect.DeepEqual(lines, expected) {
+		t.Errorf("WrapGreedy lines without Parser.Hyphens are %v, want %v", lines, expected)
+	}
+}
+
+func TestWrapBalanced(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		original string
+		width    float64
+		expected []string
+	}{
+		{"empty", "", 10, nil},
+		{"one line", "Hello, world!", 20, []string{"Hello, world!"}},
+		{"no width", "one two\nthree", 0, []string{"one two\n", "three"}},
+		{"two lines", "The quick brown fox jumped", 20, []string{"The quick brown ", "fox jumped"}},
+		{"three lines", "aa bb cc dd ee ff gg", 9, []string{"aa bb cc ", "dd ee ", "ff gg"}},
+		{"paragraphs", "The quick brown fox jumped\nover the lazy dog", 20, []string{"The quick brown ", "fox jumped\n", "over the lazy dog"}},
+		{"overflow", "a bcdefgh i", 4, []string{"a ", "bcdefgh ", "i"}},
+		{"wide characters", "日本語のテキスト", 14, []string{"日本語の", "テキスト"}},
+	} {
+		lines := WrapBalanced(tt.original, WrapOptions{Width: tt.width})
+		if got := lineTexts(tt.original, lines); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%s: WrapBalanced(%q) = %q, want %q", tt.name, tt.original, got, tt.expected)
+			continue
+		}
+		checkLines(t, tt.name, tt.original, lines)
+	}
+}
+
+func TestWrapMeasure(t *testing.T) {
+	proportional := func(cluster string) float64 {
+		switch cluster {
+		case "i", "l", " ":
+			return 0.5
+		case "m", "w", "-":
+			return 1.5
+		case "\u00ad":
+			return 0
+		}
+		return 1
+	}
+	double := func(string) float64 { return 2 }
+	p := &Parser{Hyphens: HyphensManual}
+	for _, tt := range []struct {
+		name     string
+		original string
+		options  WrapOptions
+		greedy   []Line
+		optimal  []Line
+		balanced []Line
+	}{
+		{
+			name:     "proportional",
+			original: "mmm iii lll www",
+			options:  WrapOptions{Width: 5, Measure: proportional},
+			greedy:   []Line{{0, 4, 4.5, false, false}, {4, 12, 3.5, false, false}, {12, 15, 4.5, false, false}},
+			optimal:  []Line{{0, 4, 4.5, false, false}, {4, 12, 3.5, false, false}, {12, 15, 4.5, false, false}},
+			balanced: []Line{{0, 4, 4.5, false, false}, {4, 12, 3.5, false, false}, {12, 15, 4.5, false, false}},
+		},
+		{
+			name:     "strategies",
+			original: "aaa bb cc ddddd",
+			options:  WrapOptions{Width: 12, Measure: double},
+			greedy:   []Line{{0, 7, 12, false, false}, {7, 10, 4, false, false}, {10, 15, 10, false, false}},
+			optimal:  []Line{{0, 4, 6, false, false}, {4, 10, 10, false, false}, {10, 15, 10, false, false}},
+			balanced: []Line{{0, 4, 6, false, false}, {4, 10, 10, false, false}, {10, 15, 10, false, false}},
+		},
+		{
+			name:     "hanging spaces",
+			original: "ab   cd   ",
+			options:  WrapOptions{Width: 4, Measure: double},
+			greedy:   []Line{{0, 5, 4, false, false}, {5, 10, 4, false, false}},
+			optimal:  []Line{{0, 5, 4, false, false}, {5, 10, 4, false, false}},
+			balanced: []Line{{0, 5, 4, false, false}, {5, 10, 4, false, false}},
+		},
+		{
+			name:     "hyphen",
+			original: "ii\u00admm",
+			options:  WrapOptions{Width: 3, Measure: proportional},
+			greedy:   []Line{{0, 4, 2.5, true, false}, {4, 6, 3, false, false}},
+			optimal:  []Line{{0, 4, 2.5, true, false}, {4, 6, 3, false, false}},
+			balanced: []Line{{0, 4, 2.5, true, false}, {4, 6, 3, false, false}},
+		},
+		{
+			name:     "segments",
+			original: "one two  three",
+			options:  WrapOptions{Width: 4, MeasureSegment: func(segment string) float64 { return float64(len(segment)) / 2 }},
+			greedy:   []Line{{0, 9, 3.5, false, false}, {9, 14, 2.5, false, false}},
+			optimal:  []Line{{0, 9, 3.5, false, false}, {9, 14, 2.5, false, false}},
+			balanced: []Line{{0, 9, 3.5, false, false}, {9, 14, 2.5, false, false}},
+		},
+	} {
+		for _, wrap := range []struct {
+			name     string
+			wrap     func(*Parser, string, WrapOptions) []Line
+			expected []Line
+		}{
+			{"WrapGreedy", (*Parser).WrapGreedy, tt.greedy},
+			{"WrapOptimal", (*Parser).WrapOptimal, tt.optimal},
+			{"WrapBalanced", (*Parser).WrapBalanced, tt.balanced},
+		} {
+			if got := wrap.wrap(p, tt.original, tt.options); !reflect.DeepEqual(got, wrap.expected) {
+				t.Errorf("%s: %s(%q) = %v, want %v", tt.name, wrap.name, tt.original, got, wrap.expected)
+			}
+		}
+	}
+
+	// Rounding errors are ignored.
+	if lines := WrapGreedy("a b", WrapOptions{Width: 0.3, Measure: func(string) float64 { return 0.1 }}); len(lines) != 1 {
+		t.Errorf("Rounding errors result in %d lines, want 1", len(lines))
+	}
+
+	// Segments are measured without their trailing white space.
+	var segments []string
+	WrapGreedy("one two  three\n", WrapOptions{Width: 10, MeasureSegment: func(segment string) float64 {
+		segments = append(segments, segment)
+		return float64(len(segment))
+	}})
+	if expected := []string{"-", "one", " ", "two", "  ", "three", "\n"}; !reflect.DeepEqual(segments, expected) {
+		t.Errorf("MeasureSegment was called with %q, want %q", segments, expected)
+	}
+}
+
+func TestWrapOverflow(t *testing.T) {
+	p := &Parser{Hyphens: HyphensManual}
+	for _, tt := range []struct {
+		name     string
+		original string
+		options  WrapOptions
+		expected []Line
+	}{
+		{
+			name:     "visible",
+			original: "a verylongword b",
+			options:  WrapOptions{Width: 4},
+			expected: []Line{{0, 2, 1, false, false}, {2, 15, 12, false, false}, {15, 16, 1, false, false}},
+		},
+		{
+			name:     "anywhere",
+			original: "a verylongword b",
+			options:  WrapOptions{Width: 4, Overflow: OverflowAnywhere},
+			expected: []Line{{0, 4, 4, false, false}, {4, 8, 4, false, false}, {8, 12, 4, false, false}, {12, 16, 4, false, false}},
+		},
+		{
+			name:     "break word",
+			original: "a verylongword b",
+			options:  WrapOptions{Width: 4, Overflow: OverflowBreakWord},
+			expected: []Line{{0, 2, 1, false, false}, {2, 6, 4, false, false}, {6, 10, 4, false, false}, {10, 15, 4, false, false}, {15, 16, 1, false, false}},
+		},
+		{
+			name:     "ellipsis",
+			original: "a verylongword b",
+			options:  WrapOptions{Width: 4, Overflow: OverflowEllipsis},
+			expected: []Line{{0, 2, 1, false, false}, {2, 5, 4, false, true}, {15, 16, 1, false, false}},
+		},
+		{
+			name:     "ellipsis at the end",
+			original: "a verylongword\n",
+			options:  WrapOptions{Width: 4, Overflow: OverflowEllipsis},
+			expected: []Line{{0, 2, 1, false, false}, {2, 5, 4, false, true}},
+		},
+		{
+			name:     "nothing fits an ellipsis",
+			original: "abc",
+			options:  WrapOptions{Width: 1, Overflow: OverflowEllipsis},
+			expected: []Line{{0, 0, 1, false, true}},
+		},
+		{
+			name:     "measured ellipsis",
+			original: "abcdefgh",
+			options: WrapOptions{Width: 5, Overflow: OverflowEllipsis, Measure: func(cluster string) float64 {
+				if cluster == "\u2026" {
+					return 2
+				}
+				return 1
+			}},
+			expected: []Line{{0, 3, 5, false, true}},
+		},
+		{
+			name:     "grapheme clusters",
+			original: "e\u0301e\u0301e\u0301e\u0301e\u0301",
+			options:  WrapOptions{Width: 2, Overflow: OverflowAnywhere},
+			expected: []Line{{0, 6, 2, false, false}, {6, 12, 2, false, false}, {12, 15, 1, false, false}},
+		},
+		{
+			name:     "wide cluster",
+			original: "a🇩🇪b",
+			options:  WrapOptions{Width: 1, Overflow: OverflowBreakWord},
+			expected: []Line{{0, 1, 1, false, false}, {1, 9, 2, false, false}, {9, 10, 1, false, false}},
+		},
+		{
+			name:     "hyphen",
+			original: "abcdef\u00adgh",
+			options:  WrapOptions{Width: 4, Overflow: OverflowBreakWord},
+			expected: []Line{{0, 4, 4, false, false}, {4, 10, 4, false, false}},
+		},
+		{
+			name:     "hyphen at the end",
+			original: "abcdefg\u00adgh",
+			options:  WrapOptions{Width: 4, Overflow: OverflowBreakWord},
+			expected: []Line{{0, 4, 4, false, false}, {4, 9, 4, true, false}, {9, 11, 2, false, false}},
+		},
+		{
+			name:     "no width",
+			original: "a verylongword b",
+			options:  WrapOptions{Overflow: OverflowEllipsis},
+			expected: []Line{{0, 16, 16, false, false}},
+		},
+	} {
+		for _, wrap := range []struct {
+			name string
+			wrap func(*Parser, string, WrapOptions) []Line
+		}{
+			{"WrapGreedy", (*Parser).WrapGreedy},
+			{"WrapOptimal", (*Parser).WrapOptimal},
+			{"WrapBalanced", (*Parser).WrapBalanced},
+		} {
+			if got := wrap.wrap(p, tt.original, tt.options); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("%s: %s(%q) = %v, want %v", tt.name, wrap.name, tt.original, got, tt.expected)
+			}
+		}
+	}
+}
+
+// wrapDemerits returns the demerits of the given lines of a paragraph as
+// defined by [WrapOptimal] or, if "balanced" is true, by [WrapBalanced]. It
+// returns infinity if a line with more than one segment is too wide.
+func wrapDemerits(w *wrapLines, breaks []int, balanced bool) float64 {
+	var total float64
+	for i := 1; i < len(breaks); i++ {
+		start, end := breaks[i-1], breaks[i]
+		width := w.width(start, end)
+		if width > w.opts.Width && end-start > 1 {
+			return math.Inf(1)
+		}
+		if i == len(breaks)-1 && !balanced {
+			if end-start == 1 && start > 0 {
+				total += w.opts.WidowPenalty
+			}
+			continue
+		}
+		total += math.Pow(max(w.opts.Width-width, 0), 2)
+		if w.items[end-1].lineBreak == LineHyphenBreak {
+			total += w.opts.HyphenPenalty
+			if start > 0 && w.items[start-1].lineBreak == LineHyphenBreak {
+				total += w.opts.ConsecutiveHyphenPenalty
+			}
+		}
+	}
+	return total
+}
+
+// Compare optimal and balanced lines with all possible lines of random
+// paragraphs.
+func TestWrapExhaustive(t *testing.T) {
+	p := &Parser{Hyphens: HyphensManual}
+	r := rand.New(rand.NewSource(1))
+	for range 500 {
+		var b strings.Builder
+		for i := range 3 + r.Intn(8) {
+			if i > 0 {
+				b.WriteString([]string{" ", " ", "\u00ad", "-"}[r.Intn(4)])
+			}
+			b.WriteString(strings.Repeat("x", 1+r.Intn(6)))
+		}
+		text := b.String()
+		opts := WrapOptions{
+			Width:                    float64(4 + r.Intn(10)),
+			HyphenPenalty:            float64(r.Intn(50)),
+			ConsecutiveHyphenPenalty: float64(r.Intn(100)),
+			WidowPenalty:             float64(r.Intn(50)),
+		}
+		w := p.wrapLines(text, &opts)
+		n := len(w.items)
+
+		// Find the minimum demerits of all combinations of breaks.
+		count := len(p.WrapGreedy(text, opts))
+		best, bestBalanced := math.Inf(1), math.Inf(1)
+		for mask := range 1 << (n - 1) {
+			breaks := []int{0}
+			for i := 1; i < n; i++ {
+				if mask&(1<<(i-1)) != 0 {
+					breaks = append(breaks, i)
+				}
+			}
+			breaks = append(breaks, n)
+			best = min(best, wrapDemerits(w, breaks, false))
+			if len(breaks) == count+1 {
+				bestBalanced = min(bestBalanced, wrapDemerits(w, breaks, true))
+			}
+		}
+
+		// Compare with the demerits of the returned lines.
+		for _, wrap := range []struct {
+			name     string
+			lines    []Line
+			balanced bool
+			expected float64
+		}{
+			{"WrapOptimal", p.WrapOptimal(text, opts), false, best},
+			{"WrapBalanced", p.WrapBalanced(text, opts), true, bestBalanced},
+		} {
+			breaks := []int{0}
+			for _, line := range wrap.lines {
+				for i, item := range w.items {
+					if item.end == line.End {
+						breaks = append(breaks, i+1)
+					}
+				}
+			}
+			if wrap.balanced && len(wrap.lines) != count {
+				t.Errorf("%q with %+v: %s returned %d lines, want %d", text, opts, wrap.name, len(wrap.lines), count)
+			}
+			if got := wrapDemerits(w, breaks, wrap.balanced); math.Abs(got-wrap.expected) > 1e-9 {
+				t.Errorf("%q with %+v: demerits of %s lines %q are %f, want %f", text, opts, wrap.name, lineTexts(text, wrap.lines), got, wrap.expected)
+			}
+		}
+	}
+}
+
+func TestWrapLong(t *testing.T) {
+	// All lines fit unless they consist of a single segment or, when breaking
+	// overflowing segments, of a single grapheme cluster, and the text is
+	// covered.
+	text := strings.Repeat(benchmarkStr+" ", 100)
+	for _, overflow := range []Overflow{OverflowVisible, OverflowAnywhere, OverflowBreakWord, OverflowEllipsis} {
+		for _, width := range []float64{1, 10, 40, 80} {
+			opts := WrapOptions{Width: width, HyphenPenalty: 50, WidowPenalty: 50, Overflow: overflow}
+			for name, lines := range map[string][]Line{
+				"WrapGreedy":   WrapGreedy(text, opts),
+				"WrapOptimal":  WrapOptimal(text, opts),
+				"WrapBalanced": WrapBalanced(text, opts),
+			} {
+				if overflow != OverflowEllipsis {
+					checkLines(t, name, text, lines)
+				}
+				for _, line := range lines {
+					var unbreakable string
+					switch overflow {
+					case OverflowVisible:
+						unbreakable, _, _, _ = FirstLineSegmentInString(text[line.Start:line.End], 0)
+					case OverflowAnywhere, OverflowBreakWord:
+						unbreakable, _, _, _ = FirstGraphemeClusterInString(text[line.Start:line.End], -1)
+					}
+					if line.Width > width && len(unbreakable) < len(strings.TrimRight(text[line.Start:line.End], " ")) {
+						t.Errorf("%s with overflow %d: line %q is wider than %.0f", name, overflow, text[line.Start:line.End], width)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestWrapHyphenation(t *testing.T) {
+	// Long words are hyphenated throughout.
+	h, err := NewHyphenator([]string{"a1b"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.LeftMin, h.RightMin = 1, 1
+	p := &Parser{Hyphens: HyphensAuto, Hyphenator: h}
+	text := strings.Repeat("ab", 100)
+	opts := WrapOptions{Width: 10}
+	for name, lines := range map[string][]Line{
+		"WrapGreedy":   p.WrapGreedy(text, opts),
+		"WrapOptimal":  p.WrapOptimal(text, opts),
+		"WrapBalanced": p.WrapBalanced(text, opts),
+	} {
+		checkLines(t, name, text, lines)
+		for i, line := range lines {
+			if line.Width > opts.Width || line.Hyphen != (i < len(lines)-1) {
+				t.Errorf("%s: line %d is %v", name, i, line)
+			}
+		}
+	}
+}
+
+// Benchmark optimal line breaking.
+func BenchmarkWrapOptimal(b *testing.B) {
+	text := strings.Repeat(benchmarkStr+" ", 30)
+	for i := 0; i < b.N; i++ {
+		runtime.KeepAlive(WrapOptimal(text, WrapOptions{Width: 60}))
+	}
+}
+
+// Benchmark balanced line breaking.
+func BenchmarkWrapBalanced(b *testing.B) {
+	text := strings.Repeat(benchmarkStr+" ", 30)
+	for i := 0; i < b.N; i++ {
+		runtime.KeepAlive(WrapBalanced(text, WrapOptions{Width: 60}))
+	}
+}